@@ -1,8 +1,13 @@
 package cmd
 
 import (
+	"bufio"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui"
@@ -20,11 +25,30 @@ func Execute() error {
 		return nil
 	}
 
+	// Every run writes a structured session log for post-mortem debugging
+	// (skipped for `logs` and `report` so inspecting past runs doesn't
+	// create new ones); a failure to open the file must not block the
+	// actual operation
+	if cfg.CLICommand != "logs" && cfg.CLICommand != "report" {
+		if path, err := logger.AttachSessionLog(cfg.LogFilePath); err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️ failed to open session log: %v\n", err)
+		} else {
+			logger.Debug("log", fmt.Sprintf("Session log: %s", path))
+		}
+		defer logger.CloseSessionLog()
+	}
+
 	// Handle CLI commands
 	if cfg.CLICommand != "" {
 		return handleCLICommand(cfg, logger)
 	}
 
+	// Plain mode renders sequential prompts instead of the TUI, for screen
+	// readers, dumb terminals and CI logs
+	if cfg.Plain {
+		return tui.RunPlain(cfg, logger)
+	}
+
 	// Default: launch new multi-model TUI
 	return tui.RunNew(cfg, logger)
 }
@@ -40,15 +64,72 @@ func handleCLICommand(cfg core.Config, logger *core.Logger) error {
 		}
 	}
 
+	// Long-running operations report live progress as plain stderr lines
+	// (suppressed in quiet and JSON modes, where output is machine-read)
+	if !cfg.Quiet && !cfg.JSONOutput {
+		core.SetProgressReporter(core.PrintProgress{})
+		defer core.ClearProgressReporter()
+	}
+
 	switch cfg.CLICommand {
 	case "sync":
 		return cmdSync(cfg, logger, rootDir)
+	case "sync-all":
+		return cmdSyncAll(cfg, logger)
 	case "add":
 		return cmdAdd(cfg, logger, rootDir)
+	case "remove":
+		return cmdRemove(cfg, logger, rootDir)
+	case "update":
+		return cmdUpdate(cfg, logger, rootDir)
 	case "status":
 		return cmdStatus(cfg, logger, rootDir)
+	case "drift":
+		return cmdDrift(cfg, logger, rootDir)
+	case "plan":
+		return cmdPlan(cfg, logger, rootDir)
+	case "doctor":
+		return cmdDoctor(cfg, logger, rootDir)
+	case "install":
+		return cmdInstall(cfg, logger)
+	case "sdk":
+		return cmdSDK(cfg, logger, rootDir)
+	case "lint-deps":
+		return cmdLintDeps(cfg, logger, rootDir)
+	case "rollback":
+		return cmdRollback(cfg, logger, rootDir)
+	case "migrate":
+		return cmdMigrate(cfg, logger)
+	case "apply":
+		return cmdApply(cfg, logger)
+	case "export":
+		return cmdExport(cfg, logger, rootDir)
+	case "assert":
+		return cmdAssert(cfg, logger, rootDir)
+	case "hook":
+		return cmdHook(cfg, logger, rootDir)
+	case "overrides":
+		return cmdOverrides(cfg, logger, rootDir)
+	case "reco":
+		return cmdReco(cfg, logger, rootDir)
+	case "replay":
+		return cmdReplay(cfg, logger)
 	case "autotest":
 		return cmdAutoTest(cfg, logger)
+	case "logs":
+		return cmdLogs(cfg)
+	case "report":
+		return cmdReport(cfg)
+	case "licenses":
+		return cmdLicenses(cfg, logger, rootDir)
+	case "audit":
+		return cmdAudit(cfg, logger, rootDir)
+	case "cache":
+		return cmdCache(cfg, logger)
+	case "auth":
+		return cmdAuth(cfg, logger)
+	case "stats":
+		return cmdStats(cfg, logger)
 	default:
 		return fmt.Errorf("unknown command: %s", cfg.CLICommand)
 	}
@@ -65,6 +146,14 @@ func cmdSync(cfg core.Config, logger *core.Logger, rootDir string) error {
 	logger.Info("sync", fmt.Sprintf("Syncing dependencies for %s", project.Path))
 
 	result := core.Sync(logger, &cfg, project.Path)
+
+	// JSON output mode: emit the full structured result on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+
 	if !result.OK {
 		return fmt.Errorf("sync failed: %s", result.Err)
 	}
@@ -73,56 +162,1810 @@ func cmdSync(cfg core.Config, logger *core.Logger, rootDir string) error {
 	return nil
 }
 
-// cmdAdd handles the add command (stub - would need additional CLI parsing)
+// cmdSyncAll handles the sync-all command
+//
+// Usage: flutter-pm sync-all [package] [--json]
+//
+// It scans the configured common roots for Flutter projects (see
+// core/discovery.go), optionally narrows them to the ones declaring the
+// named dependency, and runs the express git update plus pub get in each
+// one (see core/syncall.go), printing aggregated progress and a per-project
+// result summary.
+func cmdSyncAll(cfg core.Config, logger *core.Logger) error {
+	// Optional positional argument narrows the run to dependency users
+	pkgName := ""
+	if len(cfg.CLIArgs) > 0 {
+		pkgName = cfg.CLIArgs[0]
+	}
+
+	logger.Info("syncall", "Scanning for Flutter projects...")
+	scanned, err := core.ScanCommonRoots()
+	if err != nil {
+		return fmt.Errorf("project scan failed: %w", err)
+	}
+
+	// The common roots can overlap (e.g. cwd under a scanned directory),
+	// so dedupe by path before doing any work
+	seen := make(map[string]bool)
+	var projects []core.Project
+	for _, project := range scanned {
+		if seen[project.Path] {
+			continue
+		}
+		seen[project.Path] = true
+		projects = append(projects, project)
+	}
+
+	if len(projects) == 0 {
+		return fmt.Errorf("no Flutter projects found in the common development directories")
+	}
+
+	if pkgName != "" {
+		projects = core.FilterProjectsByDependency(projects, pkgName)
+		if len(projects) == 0 {
+			return fmt.Errorf("no discovered project declares a dependency on %s", pkgName)
+		}
+		if !cfg.JSONOutput {
+			fmt.Printf("%d project(s) depend on %s:\n", len(projects), pkgName)
+			for _, project := range projects {
+				fmt.Printf("  • %s (%s)\n", project.Name, project.Path)
+			}
+			fmt.Println()
+		}
+	}
+
+	results := core.SyncAllProjects(logger, &cfg, projects, func(index, total int, project core.Project) {
+		if !cfg.JSONOutput {
+			fmt.Printf("[%d/%d] Syncing %s...\n", index+1, total, project.Name)
+		}
+	})
+
+	// JSON output mode: emit the full per-project results on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(results)
+	}
+
+	// Per-project summary with an aggregated count at the end
+	fmt.Println()
+	failed := 0
+	for _, result := range results {
+		if result.OK() {
+			fmt.Printf("✅ %s: %s\n", result.Project.Name, result.Sync.Message)
+		} else {
+			failed++
+			reason := result.Express.Err
+			if result.Express.OK {
+				reason = result.Sync.Err
+			}
+			fmt.Printf("❌ %s: %s\n", result.Project.Name, reason)
+		}
+	}
+	fmt.Printf("\nSynced %d of %d project(s)\n", len(results)-failed, len(results))
+
+	if failed > 0 {
+		return fmt.Errorf("%d project(s) failed to sync", failed)
+	}
+	return nil
+}
+
+// cmdAdd handles the add command for non-interactive use (scripts and CI)
+//
+// Usage: flutter-pm add <owner/repo|git-url> [--ref branch] [--subdir path] [--name pkg]
+//
+// It reuses the same pipeline as the TUI: backup creation, package name
+// detection from pubspec.yaml, core.AddGitDependency with conflict analysis
+// and automatic resolution. With --json the final ActionResult is printed as
+// structured JSON on stdout.
 func cmdAdd(cfg core.Config, logger *core.Logger, rootDir string) error {
-	return fmt.Errorf("add command not implemented in CLI mode yet - use TUI")
+	// Bundle mode installs a whole named group from the config in one go
+	// (see core/bundles.go)
+	if cfg.Bundle != "" {
+		return cmdAddBundle(cfg, logger, rootDir)
+	}
+
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm add <owner/repo|git-url> [--ref branch] [--subdir path] [--name pkg] [--all-packages] [--bundle name]")
+	}
+
+	// Resolve the repository argument to a git URL
+	repoArg := cfg.CLIArgs[0]
+	gitURL := repoArg
+	if !strings.Contains(repoArg, "://") && !strings.HasPrefix(repoArg, "git@") {
+		if strings.Count(repoArg, "/") != 1 {
+			return fmt.Errorf("invalid repository %q: expected owner/repo or a full git URL", repoArg)
+		}
+		gitURL = fmt.Sprintf("https://github.com/%s.git", repoArg)
+	}
+
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	// Determine the package name: --name override, otherwise fetch from the
+	// repository's pubspec.yaml (repo name is only the last-resort fallback)
+	pkgName := cfg.PkgName
+	if pkgName == "" {
+		pkgName, err = core.FetchPackageNameFromGit(logger, gitURL, cfg.GitRef, cfg.Subdir)
+		if err != nil {
+			return fmt.Errorf("failed to determine package name (use --name to override): %w", err)
+		}
+	}
+
+	spec := core.PkgSpec{
+		Name:   pkgName,
+		URL:    gitURL,
+		Ref:    cfg.GitRef,
+		Subdir: cfg.Subdir,
+	}
+
+	// Workspace mode: apply the same dependency to every member package
+	// (see core/workspace.go for workspace detection)
+	if cfg.AllPackages {
+		ws, err := core.DetectWorkspace(project.Path)
+		if err != nil {
+			return fmt.Errorf("workspace detection failed: %w", err)
+		}
+		if ws == nil || len(ws.Packages) == 0 {
+			return fmt.Errorf("%s is not a workspace (no melos.yaml or member packages found)", project.Path)
+		}
+
+		logger.Info("add", fmt.Sprintf("Adding %s to %d workspace packages in %s", spec.Name, len(ws.Packages), ws.Root))
+		results := core.AddGitDependencyToPackages(logger, &cfg, ws.Packages, spec)
+
+		var failed []string
+		for i, result := range results {
+			if cfg.JSONOutput {
+				if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+					return fmt.Errorf("failed to encode result: %w", err)
+				}
+			}
+			if !result.OK {
+				failed = append(failed, ws.Packages[i].Name)
+			}
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("add failed for: %s", strings.Join(failed, ", "))
+		}
+		return nil
+	}
+
+	logger.Info("add", fmt.Sprintf("Adding %s from %s to %s", spec.Name, spec.URL, project.Path))
+
+	// Backup pubspec.yaml before modifying (same safety net as the TUI flow)
+	if !cfg.DryRun {
+		if backup, err := core.CreateTrackedBackup(logger, project.Path, "add"); err != nil {
+			logger.Error("backup", err)
+		} else {
+			logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+		}
+	}
+
+	result := core.AddGitDependency(logger, &cfg, project.Path, spec, true)
+
+	// JSON output mode: emit the full structured result on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("add failed: %s", result.Err)
+	}
+
+	logger.Info("add", result.Message)
+	return nil
 }
 
-// cmdStatus handles the status command
-func cmdStatus(cfg core.Config, logger *core.Logger, rootDir string) error {
-	// Find project
+// cmdAddBundle handles `flutter-pm add --bundle <name>`: it prints the
+// bundle contents, asks for one confirmation (skipped in quiet, JSON and
+// dry-run modes, where stdin is not expected to be a person) and installs
+// every entry through the usual add pipelines.
+func cmdAddBundle(cfg core.Config, logger *core.Logger, rootDir string) error {
+	entries, err := core.LoadBundle(cfg.Bundle)
+	if err != nil {
+		return err
+	}
+
+	// Find the target project
 	project, err := core.NearestPubspec(rootDir)
 	if err != nil {
 		return fmt.Errorf("no Flutter project found: %w", err)
 	}
 
-	logger.Info("status", fmt.Sprintf("Checking status for %s", project.Path))
+	// One confirmation for the whole bundle
+	if !cfg.Quiet && !cfg.JSONOutput && !cfg.DryRun {
+		fmt.Printf("Bundle %q adds %d package(s) to %s:\n", cfg.Bundle, len(entries), project.Name)
+		for _, entry := range entries {
+			fmt.Printf("  - %s\n", entry.Label())
+		}
+		fmt.Print("Install? (y/n): ")
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("failed to read confirmation: %w", err)
+		}
+		if answer := strings.ToLower(strings.TrimSpace(line)); answer != "y" && answer != "yes" {
+			fmt.Println("Aborted")
+			return nil
+		}
+	}
+
+	// Backup pubspec.yaml before modifying (same safety net as the add flow)
+	if !cfg.DryRun {
+		if backup, err := core.CreateTrackedBackup(logger, project.Path, "add"); err != nil {
+			logger.Error("backup", err)
+		} else {
+			logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+		}
+	}
+
+	logger.Info("add", fmt.Sprintf("Installing bundle %q (%d packages) into %s", cfg.Bundle, len(entries), project.Path))
+	results := core.ApplyBundle(logger, &cfg, project.Path, entries)
 
-	// Check for git dependencies
-	gitDeps, err := core.ListGitDependencies(project.Path)
+	var failed []string
+	for i, result := range results {
+		if cfg.JSONOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+		}
+		if !result.OK {
+			failed = append(failed, entries[i].Label())
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("bundle %q: add failed for: %s", cfg.Bundle, strings.Join(failed, ", "))
+	}
+
+	logger.Info("add", fmt.Sprintf("Bundle %q installed: %d packages", cfg.Bundle, len(results)))
+	return nil
+}
+
+// cmdRemove handles the remove command for non-interactive use
+//
+// Usage: flutter-pm remove <pkg> [<pkg>...]
+//
+// It creates a pubspec.yaml backup (same safety net as the add flow), then
+// runs `dart pub remove` for each named package. With --json every
+// ActionResult is printed as structured JSON on stdout.
+func cmdRemove(cfg core.Config, logger *core.Logger, rootDir string) error {
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm remove <pkg> [<pkg>...]")
+	}
+
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
 	if err != nil {
-		return fmt.Errorf("failed to list git dependencies: %w", err)
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	// Backup pubspec.yaml before modifying
+	if !cfg.DryRun {
+		if backup, err := core.CreateTrackedBackup(logger, project.Path, "remove"); err != nil {
+			logger.Error("backup", err)
+		} else {
+			logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+		}
+	}
+
+	var failed []string
+	for _, pkg := range cfg.CLIArgs {
+		logger.Info("remove", fmt.Sprintf("Removing %s from %s", pkg, project.Path))
+		result := core.RemoveDependency(logger, &cfg, project.Path, pkg)
+
+		if cfg.JSONOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+		}
+
+		if !result.OK {
+			logger.Error("remove", fmt.Errorf("%s", result.Err))
+			failed = append(failed, pkg)
+			continue
+		}
+		logger.Info("remove", result.Message)
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to remove: %s", strings.Join(failed, ", "))
 	}
+	return nil
+}
 
-	logger.Info("status", fmt.Sprintf("Found %d git dependencies", len(gitDeps)))
+// cmdUpdate handles the update command for non-interactive use
+//
+// Usage: flutter-pm update <pkg> [--ref x | --latest-tag]
+//
+// It updates a single git dependency - the smallest unit of the express
+// update, usable from scripts and git hooks. The result includes the
+// old -> new ref/SHA diff (see core/update.go). With --json the final
+// ActionResult is printed as structured JSON on stdout.
+func cmdUpdate(cfg core.Config, logger *core.Logger, rootDir string) error {
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm update <pkg> [--ref branch-or-tag | --latest-tag] [--pr]")
+	}
+	if cfg.GitRef != "" && cfg.LatestTag {
+		return fmt.Errorf("--ref and --latest-tag are mutually exclusive")
+	}
 
-	// Check stale status
-	staleInfo, err := core.CheckStalePrecise(logger, project.Path)
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
 	if err != nil {
-		logger.Error("status", err)
-		// Fall back to heuristic
-		isStale, lockPath, _ := core.CheckStaleHeuristic(project.Path)
-		if isStale {
-			logger.Info("status", fmt.Sprintf("Lock file %s appears stale (>24h old)", lockPath))
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	pkg := cfg.CLIArgs[0]
+	logger.Info("update", fmt.Sprintf("Updating %s in %s", pkg, project.Path))
+
+	result := core.UpdateSingleDependency(logger, &cfg, project.Path, pkg, cfg.GitRef, cfg.LatestTag)
+
+	// JSON output mode: emit the full structured result on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("update failed: %s", result.Err)
+	}
+
+	logger.Info("update", result.Message)
+
+	// --pr: move the change onto a fresh branch and open a pull request
+	// (see core/prcreate.go)
+	if cfg.CreatePR {
+		changes := core.DependencyChangesFromResults([]core.ActionResult{result})
+		prResult := core.CreateUpdatePR(logger, &cfg, project.Path, changes)
+		if !prResult.OK {
+			return fmt.Errorf("pull request creation failed: %s", prResult.Err)
 		}
+		logger.Info("update", prResult.Message)
+	}
+	return nil
+}
+
+// depStatus is one row of the status report for a git dependency
+type depStatus struct {
+	Name        string `json:"name"`
+	URL         string `json:"url"`
+	Ref         string `json:"ref,omitempty"`
+	CurrentSHA  string `json:"current_sha,omitempty"`
+	UpstreamSHA string `json:"upstream_sha,omitempty"`
+	Stale       bool   `json:"stale"`
+	Error       string `json:"error,omitempty"`
+}
+
+// statusReport is the full status output for a project
+type statusReport struct {
+	Project      string      `json:"project"`
+	Dependencies []depStatus `json:"dependencies"`
+	StaleCount   int         `json:"stale_count"`
+}
+
+// collectStatusReport builds the per-dependency freshness report shared by
+// `status` and `drift`: pubspec.yaml is parsed for git dependencies and
+// each locked SHA is compared against the remote SHA of its ref (via git
+// ls-remote)
+func collectStatusReport(logger *core.Logger, rootDir string) (*statusReport, error) {
+	// Find project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return nil, fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	logger.Debug("status", fmt.Sprintf("Checking status for %s", project.Path))
+
+	// Parse git dependencies from pubspec.yaml
+	gitDeps, err := core.ListGitDependencies(project.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git dependencies: %w", err)
+	}
+
+	// Precise stale check: locked SHA vs remote SHA per dependency
+	// (falls back gracefully when pubspec.lock is missing)
+	staleByName := make(map[string]core.StaleInfo)
+	if staleInfo, err := core.CheckStalePrecise(logger, project.Path); err != nil {
+		logger.Debug("status", fmt.Sprintf("Precise stale check unavailable: %v", err))
 	} else {
-		staleCount := 0
 		for _, info := range staleInfo {
-			if info.IsStale {
-				staleCount++
-				logger.Info("status", fmt.Sprintf("%s is stale: %s -> %s",
-					info.PackageName, info.CurrentSHA, info.UpstreamSHA))
+			staleByName[info.PackageName] = info
+		}
+	}
+
+	report := &statusReport{Project: project.Path}
+	for _, dep := range gitDeps {
+		row := depStatus{
+			Name: dep.Name,
+			URL:  dep.URL,
+			Ref:  dep.Ref,
+		}
+
+		if info, ok := staleByName[dep.Name]; ok {
+			row.CurrentSHA = info.CurrentSHA
+			row.UpstreamSHA = info.UpstreamSHA
+			row.Stale = info.IsStale
+		} else {
+			// No lock entry: still resolve the remote SHA so the user can
+			// see what a fresh pub get would fetch
+			ref := dep.Ref
+			if ref == "" {
+				ref = "HEAD"
 			}
+			if sha, err := core.GitLsRemote(dep.URL, ref); err != nil {
+				row.Error = err.Error()
+			} else {
+				row.UpstreamSHA = sha
+			}
+		}
+
+		if row.Stale {
+			report.StaleCount++
+		}
+		report.Dependencies = append(report.Dependencies, row)
+	}
+
+	return report, nil
+}
+
+// cmdStatus handles the status command
+//
+// It prints the freshness report as a table, or as structured JSON with
+// --json.
+func cmdStatus(cfg core.Config, logger *core.Logger, rootDir string) error {
+	report, err := collectStatusReport(logger, rootDir)
+	if err != nil {
+		return err
+	}
+
+	// JSON output mode: emit the full structured report on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(report)
+	}
+
+	// Table output mode
+	fmt.Printf("Project: %s\n", report.Project)
+	if len(report.Dependencies) == 0 {
+		fmt.Println("No git dependencies found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PACKAGE\tREF\tLOCKED\tREMOTE\tSTATUS")
+	for _, row := range report.Dependencies {
+		ref := row.Ref
+		if ref == "" {
+			ref = "HEAD"
 		}
-		if staleCount == 0 {
-			logger.Info("status", "All git dependencies are up to date")
+		status := "up to date"
+		switch {
+		case row.Error != "":
+			status = "error: " + row.Error
+		case row.Stale:
+			status = "stale"
+		case row.CurrentSHA == "":
+			status = "not locked"
 		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			row.Name, ref, shortSHA(row.CurrentSHA), shortSHA(row.UpstreamSHA), status)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if report.StaleCount > 0 {
+		fmt.Printf("\n%d of %d git dependencies are stale (run 'flutter-pm sync' to update)\n",
+			report.StaleCount, len(report.Dependencies))
 	}
 
 	return nil
 }
 
-// cmdAutoTest handles the autotest command
-func cmdAutoTest(cfg core.Config, logger *core.Logger) error {
-	return tui.RunNewAutoTest(cfg, logger) // Use new architecture autotest
+// cmdDrift handles the drift command
+//
+// Designed for scheduled/CI pipelines: it prints the freshness report as a
+// markdown table (pasteable into job summaries) and, with --fail-on-stale,
+// exits non-zero when any git dependency is behind its remote so a nightly
+// job turns red on drift. With --json the report is structured JSON.
+func cmdDrift(cfg core.Config, logger *core.Logger, rootDir string) error {
+	report, err := collectStatusReport(logger, rootDir)
+	if err != nil {
+		return err
+	}
+
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(report); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("## Dependency drift report\n\n")
+		fmt.Printf("Project: `%s`\n\n", report.Project)
+		if len(report.Dependencies) == 0 {
+			fmt.Println("No git dependencies found.")
+		} else {
+			fmt.Println("| Package | Ref | Locked | Remote | Status |")
+			fmt.Println("|---|---|---|---|---|")
+			for _, row := range report.Dependencies {
+				ref := row.Ref
+				if ref == "" {
+					ref = "HEAD"
+				}
+				status := "✅ up to date"
+				switch {
+				case row.Error != "":
+					status = "⚠️ error: " + row.Error
+				case row.Stale:
+					status = "❌ behind"
+				case row.CurrentSHA == "":
+					status = "⚠️ not locked"
+				}
+				fmt.Printf("| %s | %s | %s | %s | %s |\n",
+					row.Name, ref, shortSHA(row.CurrentSHA), shortSHA(row.UpstreamSHA), status)
+			}
+			fmt.Printf("\n%d of %d git dependencies are behind.\n", report.StaleCount, len(report.Dependencies))
+		}
+	}
+
+	if cfg.FailOnStale && report.StaleCount > 0 {
+		return fmt.Errorf("%d git dependencies are behind their remotes", report.StaleCount)
+	}
+	return nil
+}
+
+// cmdPlan handles the plan command
+//
+// It builds a lockfile-aware update plan (see core/planner.go): every direct
+// dependency in pubspec.lock is compared against upstream and outdated ones
+// are printed with a breaking-risk estimate. The plan is read-only; nothing
+// is modified. With --json the full UpdatePlan is printed as structured JSON.
+func cmdPlan(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Find project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	plan, err := core.BuildUpdatePlan(logger, project.Path)
+	if err != nil {
+		return err
+	}
+
+	// JSON output mode: emit the full structured plan on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(plan)
+	}
+
+	// Table output mode
+	fmt.Printf("Project: %s\n", plan.Project)
+	if len(plan.Items) == 0 {
+		fmt.Printf("All %d direct dependencies are up to date\n", plan.UpToDate)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PACKAGE\tSOURCE\tCURRENT\tTARGET\tRISK")
+	for _, item := range plan.Items {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+			item.Package, item.Source, item.Current, item.Target, item.BreakingRisk)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Printf("\n%d of %d direct dependencies have updates available\n",
+		len(plan.Items), len(plan.Items)+plan.UpToDate)
+
+	return nil
+}
+
+// shortSHA abbreviates a commit SHA for table display
+func shortSHA(sha string) string {
+	if sha == "" {
+		return "-"
+	}
+	if len(sha) > 8 {
+		return sha[:8]
+	}
+	return sha
+}
+
+// cmdDoctor handles the doctor command
+//
+// It runs the prerequisite checks (git, dart/flutter, gh), the environment
+// diagnostics (gh auth state, network reachability to github.com and
+// pub.dev, write permissions - see core/diagnostics.go) and then verifies
+// SSH authentication for every host with a configured identity (see
+// core/sshident.go), so a broken key or agent socket is caught before a
+// clone fails halfway through a flow. Each failed check prints a concrete
+// remediation step.
+func cmdDoctor(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Point long-time shell script users at the guided migration
+	if legacy, err := core.DetectLegacyConfig(""); err == nil && legacy != nil && !core.ShellMigrationDone() {
+		logger.Info("doctor", fmt.Sprintf("Found shell script config at %s - run 'flutter-pm migrate' to import it", legacy.Path))
+	}
+
+	// Tool checks
+	check := core.CheckPrerequisites(logger)
+
+	// SSH identity checks for configured hosts; the project config is
+	// included when the doctor runs inside a project
+	projectPath := ""
+	if project, err := core.NearestPubspec(rootDir); err == nil {
+		projectPath = project.Path
+	}
+
+	// Environment diagnostics: auth, network and permissions
+	diagnostics := core.RunEnvironmentDiagnostics(logger, projectPath)
+
+	hosts := core.ConfiguredSSHHosts(projectPath)
+	var sshResults []core.ActionResult
+	failed := 0
+	for _, host := range hosts {
+		result := core.CheckSSHAuth(logger, projectPath, host)
+		sshResults = append(sshResults, result)
+		if !result.OK {
+			failed++
+		}
+	}
+
+	// JSON output mode: emit the full structured report on stdout
+	if cfg.JSONOutput {
+		report := struct {
+			Prerequisites core.PrerequisiteCheck `json:"prerequisites"`
+			Environment   []core.DiagnosticCheck `json:"environment"`
+			SSH           []core.ActionResult    `json:"ssh,omitempty"`
+		}{Prerequisites: check, Environment: diagnostics, SSH: sshResults}
+		if err := json.NewEncoder(os.Stdout).Encode(report); err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+	} else {
+		for _, line := range core.GetInstallationGuidance(check) {
+			fmt.Println(line)
+		}
+
+		fmt.Println("\nEnvironment:")
+		for _, diag := range diagnostics {
+			if diag.OK {
+				fmt.Printf("  ✅ %s: %s\n", diag.Name, diag.Detail)
+			} else {
+				fmt.Printf("  ❌ %s: %s\n", diag.Name, diag.Detail)
+				if diag.Remedy != "" {
+					fmt.Printf("     💡 %s\n", diag.Remedy)
+				}
+			}
+		}
+
+		if len(hosts) == 0 {
+			fmt.Println("\nNo SSH identities configured (global config or .flutter-pm.yaml)")
+		} else {
+			fmt.Println("\nSSH authentication:")
+			for _, result := range sshResults {
+				if result.OK {
+					fmt.Printf("  ✅ %s\n", result.Message)
+				} else {
+					fmt.Printf("  ❌ %s\n", result.Err)
+				}
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d of %d SSH hosts failed authentication", failed, len(hosts))
+	}
+	return nil
+}
+
+// cmdInstall handles the install command
+//
+// Usage: flutter-pm install [--dry-run]
+//
+// It checks the prerequisites (git, flutter/dart, gh) and installs the
+// missing ones through the platform's package manager - winget/choco on
+// Windows, brew on macOS, apt/snap on Linux - verifying each tool
+// afterwards, so a fresh developer machine can be bootstrapped with one
+// command. With --dry-run the install commands are printed instead of run.
+func cmdInstall(cfg core.Config, logger *core.Logger) error {
+	check := core.CheckPrerequisites(logger)
+
+	// Optional tools are worth bootstrapping too: gh drives the whole
+	// GitHub flow, so treat it as missing when it's not on PATH
+	missing := check.Missing
+	for _, result := range check.Results {
+		if result.Name == "GitHub CLI" && !result.Available {
+			missing = append(missing, result.Name)
+		}
+	}
+
+	if len(missing) == 0 {
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(check)
+		}
+		fmt.Println("✅ All prerequisites are already available!")
+		return nil
+	}
+
+	result := core.AutoInstallPrerequisites(logger, missing, cfg.DryRun)
+
+	if cfg.JSONOutput {
+		report := struct {
+			Prerequisites core.PrerequisiteCheck `json:"prerequisites"`
+			Install       core.ActionResult      `json:"install"`
+		}{Prerequisites: check, Install: result}
+		if err := json.NewEncoder(os.Stdout).Encode(report); err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+	} else {
+		for _, line := range result.Logs {
+			fmt.Println(line)
+		}
+		if result.OK {
+			fmt.Printf("\n✅ %s\n", result.Message)
+		} else {
+			fmt.Printf("\n❌ %s\n", result.Message)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("%s", result.Err)
+	}
+	return nil
+}
+
+// cmdSDK handles the sdk command
+//
+// Usage: flutter-pm sdk [list|install <version>|use <version>]
+//
+// `list` shows every Flutter SDK on the machine (global, FVM cache, managed
+// directory) and marks the one the project pins. `install` downloads a
+// version or channel - through FVM when available, shallow git clone
+// otherwise. `use` pins the current project to a version by writing the FVM
+// marker files, so pub commands run through that SDK (see core/sdkmanager.go).
+func cmdSDK(cfg core.Config, logger *core.Logger, rootDir string) error {
+	action := "list"
+	if len(cfg.CLIArgs) > 0 {
+		action = cfg.CLIArgs[0]
+	}
+
+	projectPath := rootDir
+	if project, err := core.NearestPubspec(rootDir); err == nil {
+		projectPath = project.Path
+	}
+
+	switch action {
+	case "list":
+		sdks := core.ListInstalledSDKs(logger, projectPath)
+
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(sdks)
+		}
+
+		if len(sdks) == 0 {
+			fmt.Println("No Flutter SDKs found - run 'flutter-pm sdk install <version>'")
+			return nil
+		}
+		for _, sdk := range sdks {
+			marker := "  "
+			if sdk.Active {
+				marker = "* "
+			}
+			location := sdk.Path
+			if location == "" {
+				location = "(PATH)"
+			}
+			fmt.Printf("%s%-12s %-8s %s\n", marker, sdk.Version, sdk.Source, location)
+		}
+		return nil
+
+	case "install":
+		if len(cfg.CLIArgs) < 2 {
+			return fmt.Errorf("usage: flutter-pm sdk install <version|channel>")
+		}
+		result := core.InstallSDK(logger, &cfg, cfg.CLIArgs[1])
+		return printSDKResult(cfg, result)
+
+	case "use":
+		if len(cfg.CLIArgs) < 2 {
+			return fmt.Errorf("usage: flutter-pm sdk use <version>")
+		}
+		result := core.UseSDKForProject(logger, &cfg, projectPath, cfg.CLIArgs[1])
+		return printSDKResult(cfg, result)
+
+	default:
+		return fmt.Errorf("usage: flutter-pm sdk [list|install <version>|use <version>]")
+	}
+}
+
+// cmdLintDeps handles the lint-deps command
+//
+// Usage: flutter-pm lint-deps [--force]
+//
+// It checks every git dependency against the team's pinning policy and
+// flags dependency_overrides on forbidden branches (see core/policy.go).
+// Violations exit non-zero so the command can gate CI; --force downgrades
+// them to warnings and exits zero.
+func cmdLintDeps(cfg core.Config, logger *core.Logger, rootDir string) error {
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	violations := core.CheckProjectPolicy(project.Path)
+	logger.Info("lint-deps", fmt.Sprintf("Checked %s: %d violations", project.Path, len(violations)))
+
+	if cfg.JSONOutput {
+		report := struct {
+			Project    string   `json:"project"`
+			Violations []string `json:"violations"`
+			Forced     bool     `json:"forced,omitempty"`
+		}{Project: project.Path, Violations: violations, Forced: cfg.Force}
+		if err := json.NewEncoder(os.Stdout).Encode(report); err != nil {
+			return fmt.Errorf("failed to encode report: %w", err)
+		}
+	} else {
+		if len(violations) == 0 {
+			fmt.Println("✅ All dependencies satisfy the team policy")
+		} else {
+			for _, violation := range violations {
+				fmt.Printf("❌ %s\n", violation)
+			}
+		}
+	}
+
+	if len(violations) > 0 && !cfg.Force {
+		return fmt.Errorf("%d policy violation(s) - fix them or re-run with --force", len(violations))
+	}
+	return nil
+}
+
+// printSDKResult renders an sdk subcommand result in text or JSON mode
+func printSDKResult(cfg core.Config, result core.ActionResult) error {
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	} else {
+		for _, log := range result.Logs {
+			fmt.Println(log)
+		}
+		if result.OK {
+			fmt.Printf("✅ %s\n", result.Message)
+		}
+	}
+	if !result.OK {
+		return fmt.Errorf("%s", result.Err)
+	}
+	return nil
+}
+
+// cmdRollback handles the rollback command for non-interactive use
+//
+// Usage: flutter-pm rollback [--last|--list]
+//
+// Without flags (or with --last) it restores pubspec.yaml from the most
+// recent journaled backup and re-runs pub get. With --list it prints the
+// rollback journal (see core/rollback.go) instead of restoring anything.
+func cmdRollback(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	if cfg.RollbackList {
+		entries, err := core.ListRollbackEntries(project.Path)
+		if err != nil {
+			return err
+		}
+
+		// JSON output mode: emit the full journal on stdout
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(entries)
+		}
+
+		if len(entries) == 0 {
+			fmt.Println("No journaled backups found")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "WHEN\tOPERATION\tSESSION\tBACKUP\tDIFF")
+		for _, entry := range entries {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				entry.Timestamp.Format("2006-01-02 15:04:05"), entry.Operation,
+				entry.SessionID, entry.BackupPath, entry.DiffSummary)
+		}
+		return w.Flush()
+	}
+
+	result := core.RollbackLast(logger, &cfg, project.Path)
+
+	// JSON output mode: emit the full structured result on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("rollback failed: %s", result.Err)
+	}
+
+	logger.Info("rollback", result.Message)
+	return nil
+}
+
+// cmdMigrate handles the migrate command
+//
+// Usage: flutter-pm migrate
+//
+// It detects the original shell script's config file (~/.flutter-pm.conf),
+// imports the recognized settings into the global config, and prints a
+// compatibility report of behaviors that differ from the shell version
+// (see core/migration.go).
+func cmdMigrate(cfg core.Config, logger *core.Logger) error {
+	legacy, err := core.DetectLegacyConfig("")
+	if err != nil {
+		return err
+	}
+	if legacy == nil {
+		fmt.Println("No shell script config found (~/" + core.LegacyConfigFileName + ") - nothing to migrate")
+		return nil
+	}
+
+	var result core.ActionResult
+	if cfg.DryRun {
+		result = core.ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would migrate settings from %s", legacy.Path),
+		}
+	} else {
+		result = core.MigrateLegacyConfig(logger, legacy)
+	}
+
+	report := core.CompatibilityReport(legacy)
+
+	// JSON output mode: emit result and report on stdout
+	if cfg.JSONOutput {
+		payload := struct {
+			Result core.ActionResult `json:"result"`
+			Report []string          `json:"compatibility_report"`
+		}{Result: result, Report: report}
+		if err := json.NewEncoder(os.Stdout).Encode(payload); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	} else {
+		if result.OK {
+			fmt.Println("✅ " + result.Message)
+		}
+		fmt.Println("\nCompatibility notes:")
+		for _, line := range report {
+			fmt.Println("  • " + line)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("migration failed: %s", result.Err)
+	}
+	return nil
+}
+
+// cmdExport handles the export command
+//
+// Usage: flutter-pm export [deps.yaml]
+//
+// It dumps the project's git dependencies (url, ref, locked SHA) as a
+// deps.yaml manifest - the machine-readable handshake for external update
+// bots. A bot edits the refs and feeds the file back through
+// `flutter-pm apply` to bump them. Without a file argument the manifest is
+// printed to stdout; with --json it is JSON instead of YAML.
+func cmdExport(cfg core.Config, logger *core.Logger, rootDir string) error {
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	manifest, err := core.ExportDependencyManifest(project.Path)
+	if err != nil {
+		return fmt.Errorf("failed to export dependencies: %w", err)
+	}
+	logger.Debug("export", fmt.Sprintf("Exporting %d git dependencies from %s", len(manifest.Dependencies), project.Path))
+
+	var content []byte
+	if cfg.JSONOutput {
+		if content, err = json.MarshalIndent(manifest, "", "  "); err != nil {
+			return fmt.Errorf("failed to encode manifest: %w", err)
+		}
+		content = append(content, '\n')
+	} else {
+		if content, err = manifest.Bytes(); err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.CLIArgs) > 0 {
+		if err := os.WriteFile(cfg.CLIArgs[0], content, 0644); err != nil {
+			return fmt.Errorf("failed to write manifest: %w", err)
+		}
+		logger.Info("export", fmt.Sprintf("Wrote %d git dependencies to %s", len(manifest.Dependencies), cfg.CLIArgs[0]))
+		return nil
+	}
+
+	_, err = os.Stdout.Write(content)
+	return err
+}
+
+// cmdApply handles the apply command for headless/CI use
+//
+// Usage: flutter-pm apply <deps.yaml> [--dry-run]
+//
+// It reads a declarative manifest of git dependencies and converges the
+// target project to that state - adding missing dependencies, re-adding
+// changed ones and removing extras (see core/apply.go). The diff is always
+// printed; with --dry-run nothing is executed.
+func cmdApply(cfg core.Config, logger *core.Logger) error {
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm apply <deps.yaml> [--dry-run]")
+	}
+
+	manifest, err := core.LoadDependencyManifest(cfg.CLIArgs[0])
+	if err != nil {
+		return err
+	}
+
+	projectPath := manifest.ProjectPath()
+	logger.Info("apply", fmt.Sprintf("Converging %s to %s", projectPath, cfg.CLIArgs[0]))
+
+	changes, results, err := core.ApplyManifest(logger, &cfg, manifest)
+	if err != nil {
+		return err
+	}
+
+	// JSON output mode: emit the diff and per-step results on stdout
+	if cfg.JSONOutput {
+		payload := struct {
+			Project string              `json:"project"`
+			Changes []core.ApplyChange  `json:"changes"`
+			Results []core.ActionResult `json:"results"`
+		}{Project: projectPath, Changes: changes, Results: results}
+		if err := json.NewEncoder(os.Stdout).Encode(payload); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	} else {
+		for _, change := range changes {
+			symbol := "="
+			switch change.Action {
+			case "add":
+				symbol = "+"
+			case "update":
+				symbol = "~"
+			case "remove":
+				symbol = "-"
+			}
+			fmt.Printf("  %s %s  %s\n", symbol, change.Name, change.Detail)
+		}
+		if cfg.DryRun {
+			fmt.Println("\nDry run - no changes applied")
+		}
+	}
+
+	failed := 0
+	for _, result := range results {
+		if !result.OK {
+			failed++
+			logger.Error("apply", fmt.Errorf("%s", result.Err))
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("apply failed: %d of %d change(s) could not be applied", failed, len(results))
+	}
+
+	if !cfg.DryRun && !cfg.JSONOutput {
+		fmt.Printf("\n✅ Project matches manifest (%d change(s) applied)\n", len(results))
+	}
+	return nil
+}
+
+// cmdAssert handles the assert command
+//
+// Usage: flutter-pm assert
+//
+// It runs the fast dependency hygiene checks (lock consistency, pinning
+// policy - see core/hooks.go) and exits non-zero on any failure, so git
+// hooks and CI steps can gate on it. With --json every check result is
+// printed as structured JSON on stdout.
+func cmdAssert(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	results := core.AssertProject(logger, project.Path)
+
+	// JSON output mode: emit all check results on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(results); err != nil {
+			return fmt.Errorf("failed to encode results: %w", err)
+		}
+	}
+
+	failed := 0
+	for _, result := range results {
+		if result.OK {
+			if !cfg.JSONOutput && !cfg.Quiet {
+				fmt.Println("✅ " + result.Message)
+			}
+		} else {
+			failed++
+			if !cfg.JSONOutput {
+				fmt.Println("❌ " + result.Err)
+			}
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d dependency hygiene check(s) failed", failed)
+	}
+	return nil
+}
+
+// cmdHook handles the hook command
+//
+// Usage: flutter-pm hook install [--pre-push]
+//
+// It installs a git hook running `flutter-pm assert` into the project, so
+// commits (or pushes with --pre-push) are blocked while dependency hygiene
+// rules are violated. Existing user hooks are never overwritten.
+func cmdHook(cfg core.Config, logger *core.Logger, rootDir string) error {
+	if len(cfg.CLIArgs) == 0 || cfg.CLIArgs[0] != "install" {
+		return fmt.Errorf("usage: flutter-pm hook install [--pre-push]")
+	}
+
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	hookName := "pre-commit"
+	if cfg.PrePush {
+		hookName = "pre-push"
+	}
+
+	result := core.InstallGitHook(logger, &cfg, project.Path, hookName)
+
+	// JSON output mode: emit the full structured result on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+			return fmt.Errorf("failed to encode result: %w", err)
+		}
+	}
+
+	if !result.OK {
+		return fmt.Errorf("hook install failed: %s", result.Err)
+	}
+
+	logger.Info("hooks", result.Message)
+	return nil
+}
+
+// cmdOverrides handles the overrides command
+//
+// Usage: flutter-pm overrides list
+//
+//	flutter-pm overrides remove <package>
+//
+// `list` prints the dependency_overrides section of pubspec.yaml with the
+// journaled reason each override exists; `remove` deletes one override and
+// verifies the project still resolves, restoring the backup if it does not
+// (see core/overrides.go).
+func cmdOverrides(cfg core.Config, logger *core.Logger, rootDir string) error {
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm overrides list|remove <package>")
+	}
+
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	switch cfg.CLIArgs[0] {
+	case "list":
+		overrides, err := core.ListDependencyOverrides(project.Path)
+		if err != nil {
+			return err
+		}
+
+		// JSON output mode: emit the full override list on stdout
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(overrides)
+		}
+
+		if len(overrides) == 0 {
+			fmt.Println("No dependency_overrides in pubspec.yaml")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "PACKAGE\tSOURCE\tDETAIL\tWHY")
+		for _, override := range overrides {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+				override.Name, override.Source, override.Detail, override.Why())
+		}
+		return w.Flush()
+
+	case "remove":
+		if len(cfg.CLIArgs) < 2 {
+			return fmt.Errorf("usage: flutter-pm overrides remove <package>")
+		}
+
+		result := core.RemoveDependencyOverride(logger, &cfg, project.Path, cfg.CLIArgs[1])
+
+		// JSON output mode: emit the full structured result on stdout
+		if cfg.JSONOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(result); err != nil {
+				return fmt.Errorf("failed to encode result: %w", err)
+			}
+		}
+
+		if !result.OK {
+			return fmt.Errorf("override removal failed: %s", result.Err)
+		}
+
+		logger.Info("overrides", result.Message)
+		return nil
+
+	default:
+		return fmt.Errorf("unknown overrides subcommand %q (use list or remove)", cfg.CLIArgs[0])
+	}
+}
+
+// cmdReco handles the reco command
+//
+// Usage: flutter-pm reco [--json] [--fail-on warn|error]
+//
+// It runs the smart recommendations engine headlessly against the nearest
+// project and prints the findings grouped by severity. With --fail-on the
+// command exits non-zero when findings at or above that severity exist, so
+// recommendations become enforceable in CI (see core/reco.go).
+func cmdReco(cfg core.Config, logger *core.Logger, rootDir string) error {
+	if cfg.RecoFailOn != "" && cfg.RecoFailOn != "warn" && cfg.RecoFailOn != "error" {
+		return fmt.Errorf("invalid --fail-on value %q (use warn or error)", cfg.RecoFailOn)
+	}
+
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	logger.Info("reco", fmt.Sprintf("Analyzing %s", project.Path))
+
+	recos, err := core.GenerateFullRecommendations(logger, project.Path)
+	if err != nil {
+		return fmt.Errorf("recommendation analysis failed: %w", err)
+	}
+
+	// JSON output mode: emit the full recommendation list on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(recos); err != nil {
+			return fmt.Errorf("failed to encode recommendations: %w", err)
+		}
+	} else if len(recos) == 0 {
+		fmt.Println("No recommendations - project looks good")
+	} else {
+		// Group findings by severity, most serious first
+		for _, severity := range []string{"error", "warn", "info"} {
+			printed := false
+			for _, reco := range recos {
+				if reco.Severity != severity {
+					continue
+				}
+				if !printed {
+					fmt.Printf("%s %s:\n", recoSeverityIcon(severity), strings.ToUpper(severity))
+					printed = true
+				}
+				fmt.Printf("  • %s\n", reco.Message)
+				fmt.Printf("    %s\n", reco.Rationale)
+				if reco.Fix != "" {
+					fmt.Printf("    Fix: %s\n", reco.Fix)
+				}
+			}
+			if printed {
+				fmt.Println()
+			}
+		}
+	}
+
+	// Enforce the severity threshold for CI use
+	if cfg.RecoFailOn != "" {
+		failing := 0
+		for _, reco := range recos {
+			if recoSeverityRank(reco.Severity) >= recoSeverityRank(cfg.RecoFailOn) {
+				failing++
+			}
+		}
+		if failing > 0 {
+			return fmt.Errorf("%d recommendation(s) at or above severity %q", failing, cfg.RecoFailOn)
+		}
+	}
+
+	return nil
+}
+
+// recoSeverityRank orders recommendation severities for --fail-on comparison
+func recoSeverityRank(severity string) int {
+	switch severity {
+	case "error":
+		return 2
+	case "warn":
+		return 1
+	default:
+		return 0
+	}
+}
+
+// recoSeverityIcon returns the display icon for a severity group header
+func recoSeverityIcon(severity string) string {
+	switch severity {
+	case "error":
+		return "❌"
+	case "warn":
+		return "⚠️"
+	default:
+		return "💡"
+	}
+}
+
+// cmdReplay handles the replay developer command
+//
+// Usage: flutter-pm replay <bundle.json>
+//
+// It re-runs the conflict analysis against a debug bundle captured from a
+// failed pub operation (see core/debug_bundle.go), so parser changes can be
+// validated against user-submitted reports without reproducing their
+// environment. The recorded and fresh analyses are printed side by side.
+func cmdReplay(cfg core.Config, logger *core.Logger) error {
+	if len(cfg.CLIArgs) == 0 {
+		return fmt.Errorf("usage: flutter-pm replay <bundle.json>")
+	}
+
+	bundle, analysis, err := core.ReplayDebugBundle(logger, cfg.CLIArgs[0])
+	if err != nil {
+		return err
+	}
+
+	// JSON output mode: emit bundle plus fresh analysis on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(map[string]interface{}{
+			"bundle":         bundle,
+			"fresh_analysis": analysis,
+		})
+	}
+
+	fmt.Printf("Bundle:      %s\n", cfg.CLIArgs[0])
+	fmt.Printf("Captured:    %s\n", bundle.CapturedAt.Format("2006-01-02 15:04:05"))
+	fmt.Printf("Command:     %s %s\n", bundle.Tool, strings.Join(bundle.Args, " "))
+	fmt.Printf("Package:     %s (%s)\n\n", bundle.Spec.Name, bundle.Spec.URL)
+
+	fmt.Printf("Recorded analysis: type=%s subtype=%s recoverable=%t pkg=%s\n",
+		bundle.Analysis.ConflictType, bundle.Analysis.SubType, bundle.Analysis.IsRecoverable, bundle.Analysis.ConflictingPkg)
+	fmt.Printf("Fresh analysis:    type=%s subtype=%s recoverable=%t pkg=%s\n",
+		analysis.ConflictType, analysis.SubType, analysis.IsRecoverable, analysis.ConflictingPkg)
+
+	if analysis.ConflictType != bundle.Analysis.ConflictType || analysis.SubType != bundle.Analysis.SubType {
+		fmt.Println("\nAnalysis changed since capture (parser behavior differs)")
+	} else {
+		fmt.Println("\nAnalysis unchanged since capture")
+	}
+
+	return nil
+}
+
+// cmdAutoTest handles the autotest command
+func cmdAutoTest(cfg core.Config, logger *core.Logger) error {
+	return tui.RunNewAutoTest(cfg, logger) // Use new architecture autotest
+}
+
+// cmdLogs handles the logs command
+//
+// Usage: flutter-pm logs            # list session log files, newest first
+//
+//	flutter-pm logs --last     # print the latest session log
+//	flutter-pm logs <file>     # print a specific session log
+//
+// Session logs are written per run under ~/.cache/flutter-pm/logs (see
+// core/sessionlog.go) and capture every command, output and result.
+// cmdLicenses handles the licenses command
+//
+// Usage: flutter-pm licenses [--json]
+//
+// It classifies the license of every git dependency (see core/license.go)
+// and prints a report. For application projects (publish_to: none) copyleft
+// licenses are called out explicitly, since distributing the app carries
+// source-disclosure obligations.
+func cmdLicenses(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	logger.Info("license", fmt.Sprintf("Scanning git dependency licenses in %s", project.Path))
+
+	infos, err := core.ScanDependencyLicenses(logger, project.Path)
+	if err != nil {
+		return fmt.Errorf("license scan failed: %w", err)
+	}
+
+	// JSON output mode: emit the full report on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(infos)
+	}
+
+	if len(infos) == 0 {
+		fmt.Println("No git dependencies found")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PACKAGE\tLICENSE\tSOURCE")
+	for _, info := range infos {
+		license := info.License
+		if info.Copyleft {
+			license += " ⚠️"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", info.Package, license, info.Source)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	// Call out copyleft dependencies; strongest warning for app projects
+	isApp := core.IsAppProject(project.Path)
+	for _, info := range infos {
+		if !info.Copyleft {
+			continue
+		}
+		if isApp {
+			fmt.Printf("\n⚠️  %s is licensed under %s - distributing this application carries source-disclosure obligations\n",
+				info.Package, info.License)
+		} else {
+			fmt.Printf("\nℹ️  %s is licensed under %s - verify the terms are compatible with your project\n",
+				info.Package, info.License)
+		}
+	}
+
+	return nil
+}
+
+// cmdAudit handles the audit command
+//
+// Usage: flutter-pm audit [--json]
+//
+// It checks every resolved package version in pubspec.lock against the
+// OSV.dev vulnerability database (see core/audit.go) and exits non-zero
+// when known vulnerabilities are found, so the audit can gate CI.
+func cmdAudit(cfg core.Config, logger *core.Logger, rootDir string) error {
+	// Find the target project
+	project, err := core.NearestPubspec(rootDir)
+	if err != nil {
+		return fmt.Errorf("no Flutter project found: %w", err)
+	}
+
+	logger.Info("audit", fmt.Sprintf("Auditing %s against OSV.dev", project.Path))
+
+	findings, err := core.AuditDependencies(logger, project.Path)
+	if err != nil {
+		return fmt.Errorf("audit failed: %w", err)
+	}
+
+	// JSON output mode: emit the full findings list on stdout
+	if cfg.JSONOutput {
+		if err := json.NewEncoder(os.Stdout).Encode(findings); err != nil {
+			return fmt.Errorf("failed to encode findings: %w", err)
+		}
+	} else if len(findings) == 0 {
+		fmt.Println("No known vulnerabilities - all locked package versions are clean")
+	} else {
+		for _, finding := range findings {
+			fmt.Printf("❗ %s %s:\n", finding.Package, finding.Version)
+			for _, vuln := range finding.Vulns {
+				fmt.Printf("  • %s: %s\n", vuln.ID, vuln.Summary)
+				if len(vuln.Aliases) > 0 {
+					fmt.Printf("    Aliases: %s\n", strings.Join(vuln.Aliases, ", "))
+				}
+				fmt.Printf("    %s\n", vuln.URL)
+			}
+			fmt.Println()
+		}
+	}
+
+	// Non-zero exit so the audit can gate CI
+	if len(findings) > 0 {
+		return fmt.Errorf("%d package(s) with known vulnerabilities", len(findings))
+	}
+	return nil
+}
+
+// cmdCache handles the cache command for non-interactive use
+//
+// Usage: flutter-pm cache [stats|clear]
+//
+// `stats` (the default) summarizes the persistent lookup cache under
+// ~/.cache/flutter-pm/cache (see core/diskcache.go); `clear` removes it so
+// the next lookup hits the network.
+func cmdCache(cfg core.Config, logger *core.Logger) error {
+	action := "stats"
+	if len(cfg.CLIArgs) > 0 {
+		action = cfg.CLIArgs[0]
+	}
+
+	switch action {
+	case "clear":
+		if err := core.ClearDiskCache(); err != nil {
+			return err
+		}
+		logger.Info("cache", "Persistent cache cleared")
+		fmt.Println("Persistent cache cleared")
+		return nil
+
+	case "stats":
+		stats := core.GetDiskCacheStats()
+
+		// JSON output mode: emit the stats struct on stdout
+		if cfg.JSONOutput {
+			if err := json.NewEncoder(os.Stdout).Encode(stats); err != nil {
+				return fmt.Errorf("failed to encode stats: %w", err)
+			}
+			return nil
+		}
+
+		fmt.Printf("Cache directory: %s\n", stats.Dir)
+		if stats.RepoCount == 0 {
+			fmt.Println("Repository listing: not cached")
+		} else {
+			etag := "no ETag"
+			if stats.RepoHasETag {
+				etag = "with ETag"
+			}
+			fmt.Printf("Repository listing: %d repos, saved %s (%s)\n",
+				stats.RepoCount, stats.RepoSavedAt.Format("2006-01-02 15:04:05"), etag)
+		}
+		fmt.Printf("ls-remote entries: %d\n", stats.LsRemoteEntries)
+		return nil
+
+	default:
+		return fmt.Errorf("usage: flutter-pm cache [stats|clear]")
+	}
+}
+
+// cmdAuth handles the auth command for keychain credential management
+//
+// Usage: flutter-pm auth login [host] [token]  # store a token (prompts when omitted)
+//
+//	flutter-pm auth logout [host]         # remove the stored token
+//	flutter-pm auth status [host]         # show the credential situation
+//
+// Tokens are stored per host in the OS keychain (macOS Keychain, Windows
+// Credential Manager, libsecret; see core/keychain.go) and picked up
+// automatically during token resolution, so they no longer need to live
+// in environment variables. The host defaults to github.com.
+func cmdAuth(cfg core.Config, logger *core.Logger) error {
+	action := "status"
+	if len(cfg.CLIArgs) > 0 {
+		action = cfg.CLIArgs[0]
+	}
+	host := "github.com"
+	if len(cfg.CLIArgs) > 1 {
+		host = cfg.CLIArgs[1]
+	}
+
+	switch action {
+	case "login":
+		backend := core.KeychainBackend()
+		if backend == "" {
+			return fmt.Errorf("no OS keychain available on this system - keep using environment variables")
+		}
+
+		token := ""
+		if len(cfg.CLIArgs) > 2 {
+			token = cfg.CLIArgs[2]
+		} else {
+			fmt.Printf("Paste the token for %s: ", host)
+			line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+			if err != nil && line == "" {
+				return fmt.Errorf("failed to read token: %w", err)
+			}
+			token = strings.TrimSpace(line)
+		}
+		if token == "" {
+			return fmt.Errorf("no token provided")
+		}
+
+		if err := core.StoreKeychainToken(host, token); err != nil {
+			return err
+		}
+		logger.Info("auth", fmt.Sprintf("Token for %s stored in the %s", host, backend))
+		fmt.Printf("Token for %s stored in the %s\n", host, backend)
+		return nil
+
+	case "logout":
+		if err := core.DeleteKeychainToken(host); err != nil {
+			return err
+		}
+		logger.Info("auth", fmt.Sprintf("Removed stored token for %s", host))
+		fmt.Printf("Removed stored token for %s\n", host)
+		return nil
+
+	case "status":
+		backend := core.KeychainBackend()
+		if backend == "" {
+			fmt.Println("Keychain: not available on this system")
+		} else {
+			fmt.Printf("Keychain: %s\n", backend)
+		}
+
+		if core.LoadKeychainToken(host) != "" {
+			fmt.Printf("%s: token stored in the keychain\n", host)
+		} else {
+			fmt.Printf("%s: no token in the keychain\n", host)
+		}
+
+		// Environment variables take precedence over the keychain
+		if host == "github.com" {
+			switch {
+			case os.Getenv("GITHUB_TOKEN") != "":
+				fmt.Println("github.com: GITHUB_TOKEN is set and takes precedence")
+			case os.Getenv("GH_TOKEN") != "":
+				fmt.Println("github.com: GH_TOKEN is set and takes precedence")
+			}
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("usage: flutter-pm auth [login|logout|status] [host] [token]")
+	}
+}
+
+// cmdStats handles the stats command
+//
+// Usage: flutter-pm stats [show|reset]
+//
+// `show` (the default) prints the locally recorded operation timings and
+// cache hit rates (see core/metrics.go - nothing ever leaves the machine);
+// `reset` clears the aggregates.
+func cmdStats(cfg core.Config, logger *core.Logger) error {
+	action := "show"
+	if len(cfg.CLIArgs) > 0 {
+		action = cfg.CLIArgs[0]
+	}
+
+	switch action {
+	case "reset":
+		if err := core.ResetMetrics(); err != nil {
+			return err
+		}
+		logger.Info("stats", "Local metrics cleared")
+		fmt.Println("Local metrics cleared")
+		return nil
+
+	case "show":
+		data := core.LoadMetrics()
+
+		// JSON output mode: emit the aggregates on stdout
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(data)
+		}
+
+		for _, line := range core.MetricsLines(data) {
+			fmt.Println(line)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("usage: flutter-pm stats [show|reset]")
+	}
+}
+
+func cmdLogs(cfg core.Config) error {
+	// Resolve which file to print, if any
+	path := ""
+	if len(cfg.CLIArgs) > 0 {
+		path = cfg.CLIArgs[0]
+	} else if cfg.LogsLast {
+		latest, err := core.LatestSessionLog()
+		if err != nil {
+			return err
+		}
+		path = latest
+	}
+
+	// No file selected: list the available sessions
+	if path == "" {
+		paths, err := core.ListSessionLogs()
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			fmt.Printf("No session logs found in %s\n", core.SessionLogDir())
+			return nil
+		}
+
+		if cfg.JSONOutput {
+			return json.NewEncoder(os.Stdout).Encode(paths)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "SESSION\tENTRIES\tPATH")
+		for _, p := range paths {
+			entries, readErr := core.ReadSessionLog(p)
+			count := "?"
+			if readErr == nil {
+				count = fmt.Sprintf("%d", len(entries))
+			}
+			name := strings.TrimSuffix(filepath.Base(p), ".jsonl")
+			fmt.Fprintf(w, "%s\t%s\t%s\n", name, count, p)
+		}
+		return w.Flush()
+	}
+
+	entries, err := core.ReadSessionLog(path)
+	if err != nil {
+		return err
+	}
+
+	// JSON output mode: emit the parsed entries on stdout
+	if cfg.JSONOutput {
+		return json.NewEncoder(os.Stdout).Encode(entries)
+	}
+
+	for _, entry := range entries {
+		line := fmt.Sprintf("%s %-5s %s", entry.Time.Format("15:04:05"), entry.Level, entry.Message)
+		if entry.Component != "" {
+			line = fmt.Sprintf("%s %-5s [%s] %s", entry.Time.Format("15:04:05"), entry.Level, entry.Component, entry.Message)
+		}
+		fmt.Println(line)
+	}
+	return nil
+}
+
+// cmdReport handles the report command
+//
+// Usage: flutter-pm report [logfile] [--last] [--html]
+//
+// Rebuilds a shareable run report - commands executed, errors, timings -
+// from a session log (the latest one by default; see core/report.go) and
+// writes it as markdown or HTML for attaching to PRs or tickets.
+func cmdReport(cfg core.Config) error {
+	path := ""
+	if len(cfg.CLIArgs) > 0 {
+		path = cfg.CLIArgs[0]
+	} else {
+		latest, err := core.LatestSessionLog()
+		if err != nil {
+			return err
+		}
+		path = latest
+	}
+
+	report, err := core.BuildRunReportFromLog(path)
+	if err != nil {
+		return err
+	}
+
+	format := "markdown"
+	if cfg.ReportHTML {
+		format = "html"
+	}
+	outPath, err := report.WriteFile("", format)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Run report written to %s\n", outPath)
+	return nil
 }
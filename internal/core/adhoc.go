@@ -0,0 +1,132 @@
+// Package core/adhoc.go - Ad-hoc Command Execution for the Command Pane
+//
+// This file backs the TUI's mini terminal pane: the user types a command,
+// it runs in the active project directory, and its output streams into a
+// viewport. Only a small allowlist of tools is accepted (dart, flutter,
+// git, gh) so the pane stays a project-scoped convenience rather than a
+// general shell. Streaming uses the same poll-friendly shape as the
+// directory scan: a background goroutine appends lines under a mutex and
+// the screen takes snapshots on a tick.
+//
+// Key features:
+// - ParseAdhocCommand: Split and validate user input against the allowlist
+// - StartAdhocCommand: Run the command with streamed, snapshot-able output
+// - AdhocRunner: Mutex-guarded line buffer shared with the TUI
+
+package core
+
+import (
+	"bufio"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// adhocAllowlist is the set of tools the command pane may run. Everything
+// here already appears elsewhere in the app's own command execution.
+var adhocAllowlist = map[string]bool{
+	"dart":    true,
+	"flutter": true,
+	"git":     true,
+	"gh":      true,
+}
+
+// AdhocAllowedTools returns the allowlist for display in help text
+func AdhocAllowedTools() []string {
+	return []string{"dart", "flutter", "gh", "git"}
+}
+
+// ParseAdhocCommand splits user input into a binary and arguments and
+// rejects anything outside the allowlist. Splitting is whitespace-based;
+// the pane is for quick checks, not shell quoting.
+func ParseAdhocCommand(input string) (string, []string, error) {
+	fields := strings.Fields(input)
+	if len(fields) == 0 {
+		return "", nil, fmt.Errorf("empty command")
+	}
+
+	bin := fields[0]
+	if !adhocAllowlist[bin] {
+		return "", nil, fmt.Errorf("%q is not allowed here (available: %s)", bin, strings.Join(AdhocAllowedTools(), ", "))
+	}
+
+	return bin, fields[1:], nil
+}
+
+// AdhocRunner holds the streamed output of a running ad-hoc command.
+// The goroutine started by StartAdhocCommand appends lines under the
+// mutex; the TUI polls Snapshot on a tick.
+type AdhocRunner struct {
+	mu    sync.Mutex
+	lines []string
+	done  bool
+	err   error
+}
+
+// Snapshot returns a copy of the output so far, whether the command has
+// finished, and its final error (nil until done)
+func (r *AdhocRunner) Snapshot() ([]string, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	lines := make([]string, len(r.lines))
+	copy(lines, r.lines)
+	return lines, r.done, r.err
+}
+
+func (r *AdhocRunner) appendLine(line string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lines = append(r.lines, line)
+}
+
+func (r *AdhocRunner) finish(err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.done = true
+	r.err = err
+}
+
+// StartAdhocCommand runs an allowlisted command in the project directory
+// and streams combined stdout/stderr into the returned runner. In dry-run
+// mode the command is echoed but not executed.
+func StartAdhocCommand(logger *Logger, cfg *Config, projectPath, bin string, args []string) *AdhocRunner {
+	runner := &AdhocRunner{}
+
+	if cfg.DryRun {
+		runner.appendLine(fmt.Sprintf("Would execute: %s %s", bin, strings.Join(args, " ")))
+		runner.finish(nil)
+		return runner
+	}
+
+	logger.LogCommand("adhoc", bin, args)
+	logger.Debug("adhoc", fmt.Sprintf("Running %s %s in %s", bin, strings.Join(args, " "), projectPath))
+
+	cmd := exec.Command(bin, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	// One pipe for both streams so output interleaves like a terminal
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		runner.finish(fmt.Errorf("failed to open output pipe: %w", err))
+		return runner
+	}
+	cmd.Stderr = cmd.Stdout
+
+	if err := cmd.Start(); err != nil {
+		runner.finish(fmt.Errorf("failed to start %s: %w", bin, err))
+		return runner
+	}
+
+	go func() {
+		scanner := bufio.NewScanner(stdout)
+		for scanner.Scan() {
+			runner.appendLine(scanner.Text())
+		}
+		runner.finish(cmd.Wait())
+	}()
+
+	return runner
+}
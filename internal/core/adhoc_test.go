@@ -0,0 +1,60 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestParseAdhocCommand verifies allowlist enforcement and splitting
+func TestParseAdhocCommand(t *testing.T) {
+	tests := []struct {
+		input    string
+		wantBin  string
+		wantArgs int
+		wantErr  bool
+	}{
+		{"git status", "git", 1, false},
+		{"dart pub deps --style=compact", "dart", 3, false},
+		{"flutter doctor", "flutter", 1, false},
+		{"gh repo list", "gh", 2, false},
+		{"rm -rf /", "", 0, true},
+		{"bash -c 'echo hi'", "", 0, true},
+		{"   ", "", 0, true},
+	}
+
+	for _, tt := range tests {
+		bin, args, err := ParseAdhocCommand(tt.input)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("ParseAdhocCommand(%q): expected error, got %q %v", tt.input, bin, args)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("ParseAdhocCommand(%q) failed: %v", tt.input, err)
+			continue
+		}
+		if bin != tt.wantBin || len(args) != tt.wantArgs {
+			t.Errorf("ParseAdhocCommand(%q) = %q %v, want %q with %d args", tt.input, bin, args, tt.wantBin, tt.wantArgs)
+		}
+	}
+}
+
+// TestStartAdhocCommandDryRun verifies dry-run mode echoes without executing
+func TestStartAdhocCommandDryRun(t *testing.T) {
+	cfg := Config{DryRun: true, Quiet: true}
+	logger := NewLogger(&cfg)
+
+	runner := StartAdhocCommand(logger, &cfg, t.TempDir(), "git", []string{"status"})
+
+	lines, done, err := runner.Snapshot()
+	if !done {
+		t.Error("dry-run runner should finish immediately")
+	}
+	if err != nil {
+		t.Errorf("dry-run runner returned error: %v", err)
+	}
+	if len(lines) != 1 || !strings.Contains(lines[0], "Would execute: git status") {
+		t.Errorf("dry-run output = %v, want single 'Would execute' line", lines)
+	}
+}
@@ -0,0 +1,251 @@
+// Package core/apply.go - Declarative Manifest Apply (Headless Mode)
+//
+// This file implements `flutter-pm apply deps.yaml`: a declarative manifest
+// lists the git dependencies a project should have (url/ref/subdir and the
+// target project path), and the tool converges pubspec.yaml to that state -
+// adding missing dependencies, re-adding ones whose url/ref/subdir changed,
+// and removing git dependencies the manifest no longer lists. The diff is
+// computed first so dry-run mode can print what would change, which makes
+// the command safe to wire into CI.
+//
+// Key features:
+// - LoadDependencyManifest: Parse the deps.yaml manifest
+// - BuildApplyDiff: Compare manifest against current git dependencies
+// - ApplyManifest: Execute the diff with tracked backup and per-step results
+// - ExportDependencyManifest: The inverse - dump the current git
+//   dependencies (with locked SHAs) for external update bots; a bot edits
+//   the refs and feeds the file back through apply
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestDep is one desired git dependency in the manifest. CurrentSHA is
+// informational output of the exporter (what the lockfile resolved) for
+// update bots to diff against; apply ignores it.
+type ManifestDep struct {
+	Name       string `yaml:"name" json:"name"`
+	URL        string `yaml:"url" json:"url"`
+	Ref        string `yaml:"ref,omitempty" json:"ref,omitempty"`
+	Subdir     string `yaml:"subdir,omitempty" json:"subdir,omitempty"`
+	CurrentSHA string `yaml:"current_sha,omitempty" json:"current_sha,omitempty"`
+}
+
+// DependencyManifest is the declarative state a project is converged to
+type DependencyManifest struct {
+	Project      string        `yaml:"project,omitempty" json:"project,omitempty"` // Target project path, relative to the manifest
+	Dependencies []ManifestDep `yaml:"dependencies" json:"dependencies"`
+
+	// dir is where the manifest file lives; Project resolves against it
+	dir string
+}
+
+// ApplyChange is one step of the convergence diff
+type ApplyChange struct {
+	Action string  `json:"action"` // "add", "update", "remove" or "keep"
+	Name   string  `json:"name"`
+	Detail string  `json:"detail,omitempty"`
+	Spec   PkgSpec `json:"spec,omitempty"`
+}
+
+// LoadDependencyManifest reads and validates a deps.yaml manifest
+func LoadDependencyManifest(path string) (*DependencyManifest, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	var manifest DependencyManifest
+	if err := yaml.Unmarshal(content, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+
+	for i, dep := range manifest.Dependencies {
+		if dep.Name == "" {
+			return nil, fmt.Errorf("manifest dependency %d has no name", i+1)
+		}
+		if dep.URL == "" {
+			return nil, fmt.Errorf("manifest dependency %q has no url", dep.Name)
+		}
+	}
+
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve manifest path: %w", err)
+	}
+	manifest.dir = filepath.Dir(absPath)
+
+	return &manifest, nil
+}
+
+// ExportDependencyManifest builds a manifest from the project's current git
+// dependencies, with each entry's locked SHA filled in. The output is the
+// machine-readable handshake for external update automation: a bot bumps
+// the refs in the file and the change comes back through `flutter-pm apply`.
+func ExportDependencyManifest(projectPath string) (*DependencyManifest, error) {
+	deps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &DependencyManifest{dir: projectPath}
+	for _, dep := range deps {
+		manifest.Dependencies = append(manifest.Dependencies, ManifestDep{
+			Name:       dep.Name,
+			URL:        dep.URL,
+			Ref:        dep.Ref,
+			Subdir:     dep.Subdir,
+			CurrentSHA: lockedSHA(projectPath, dep.Name),
+		})
+	}
+	return manifest, nil
+}
+
+// Bytes renders the manifest as deps.yaml content
+func (m *DependencyManifest) Bytes() ([]byte, error) {
+	content, err := yaml.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize manifest: %w", err)
+	}
+	return content, nil
+}
+
+// ProjectPath resolves the manifest's target project directory. An empty or
+// relative project entry resolves against the manifest's own directory.
+func (m *DependencyManifest) ProjectPath() string {
+	if m.Project == "" {
+		return m.dir
+	}
+	if filepath.IsAbs(m.Project) {
+		return m.Project
+	}
+	return filepath.Join(m.dir, m.Project)
+}
+
+// BuildApplyDiff compares the manifest against the project's current git
+// dependencies and returns the convergence steps, adds and updates first,
+// removals last
+func BuildApplyDiff(projectPath string, manifest *DependencyManifest) ([]ApplyChange, error) {
+	current, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByName := make(map[string]PkgSpec)
+	for _, dep := range current {
+		currentByName[dep.Name] = dep
+	}
+
+	wanted := make(map[string]bool)
+	var changes []ApplyChange
+
+	for _, dep := range manifest.Dependencies {
+		wanted[dep.Name] = true
+		spec := PkgSpec{Name: dep.Name, URL: dep.URL, Ref: dep.Ref, Subdir: dep.Subdir}
+
+		existing, ok := currentByName[dep.Name]
+		switch {
+		case !ok:
+			changes = append(changes, ApplyChange{
+				Action: "add",
+				Name:   dep.Name,
+				Detail: describeSpec(spec),
+				Spec:   spec,
+			})
+		case existing.URL != spec.URL || existing.Ref != spec.Ref || existing.Subdir != spec.Subdir:
+			changes = append(changes, ApplyChange{
+				Action: "update",
+				Name:   dep.Name,
+				Detail: fmt.Sprintf("%s -> %s", describeSpec(existing), describeSpec(spec)),
+				Spec:   spec,
+			})
+		default:
+			changes = append(changes, ApplyChange{
+				Action: "keep",
+				Name:   dep.Name,
+				Detail: describeSpec(spec),
+				Spec:   spec,
+			})
+		}
+	}
+
+	// Git dependencies the manifest no longer lists are extras to remove
+	var removals []ApplyChange
+	for name, dep := range currentByName {
+		if !wanted[name] {
+			removals = append(removals, ApplyChange{
+				Action: "remove",
+				Name:   name,
+				Detail: describeSpec(dep),
+				Spec:   dep,
+			})
+		}
+	}
+	sort.Slice(removals, func(i, j int) bool {
+		return removals[i].Name < removals[j].Name
+	})
+
+	return append(changes, removals...), nil
+}
+
+// ApplyManifest executes the convergence diff: a tracked backup first, then
+// pub add for adds/updates and pub remove for extras. Keeps are skipped, and
+// in dry-run mode the diff is returned without executing anything.
+func ApplyManifest(logger *Logger, cfg *Config, manifest *DependencyManifest) ([]ApplyChange, []ActionResult, error) {
+	projectPath := manifest.ProjectPath()
+
+	changes, err := BuildApplyDiff(projectPath, manifest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pending := 0
+	for _, change := range changes {
+		if change.Action != "keep" {
+			pending++
+		}
+	}
+	if pending == 0 || cfg.DryRun {
+		return changes, nil, nil
+	}
+
+	// Same safety net as the interactive flows
+	if backup, err := CreateTrackedBackup(logger, projectPath, "apply"); err != nil {
+		logger.Error("backup", err)
+	} else {
+		logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+	}
+
+	var results []ActionResult
+	for _, change := range changes {
+		switch change.Action {
+		case "add", "update":
+			logger.Info("apply", fmt.Sprintf("%s %s (%s)", change.Action, change.Name, change.Detail))
+			results = append(results, AddGitDependency(logger, cfg, projectPath, change.Spec, true))
+		case "remove":
+			logger.Info("apply", fmt.Sprintf("remove %s (%s)", change.Name, change.Detail))
+			results = append(results, RemoveDependency(logger, cfg, projectPath, change.Name))
+		}
+	}
+
+	return changes, results, nil
+}
+
+// describeSpec renders a git dependency spec for diff output
+func describeSpec(spec PkgSpec) string {
+	desc := spec.URL
+	if spec.Ref != "" {
+		desc += "@" + spec.Ref
+	}
+	if spec.Subdir != "" {
+		desc += " path=" + spec.Subdir
+	}
+	return desc
+}
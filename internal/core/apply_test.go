@@ -0,0 +1,190 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadDependencyManifest verifies manifest parsing and validation
+func TestLoadDependencyManifest(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "deps.yaml")
+	content := `project: app
+dependencies:
+  - name: my_pkg
+    url: https://github.com/owner/my_pkg.git
+    ref: main
+  - name: mono_pkg
+    url: https://github.com/owner/monorepo.git
+    ref: v1.2.3
+    subdir: packages/mono_pkg
+`
+	if err := os.WriteFile(manifestPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	manifest, err := LoadDependencyManifest(manifestPath)
+	if err != nil {
+		t.Fatalf("LoadDependencyManifest failed: %v", err)
+	}
+
+	if len(manifest.Dependencies) != 2 {
+		t.Fatalf("dependencies = %d, want 2", len(manifest.Dependencies))
+	}
+	if manifest.Dependencies[1].Subdir != "packages/mono_pkg" {
+		t.Errorf("subdir = %q, want packages/mono_pkg", manifest.Dependencies[1].Subdir)
+	}
+	if want := filepath.Join(dir, "app"); manifest.ProjectPath() != want {
+		t.Errorf("project path = %q, want %q", manifest.ProjectPath(), want)
+	}
+}
+
+// TestLoadDependencyManifestInvalid verifies missing name/url are rejected
+func TestLoadDependencyManifestInvalid(t *testing.T) {
+	dir := t.TempDir()
+	manifestPath := filepath.Join(dir, "deps.yaml")
+	content := `dependencies:
+  - name: my_pkg
+`
+	if err := os.WriteFile(manifestPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+
+	if _, err := LoadDependencyManifest(manifestPath); err == nil {
+		t.Error("expected error for dependency without url")
+	}
+}
+
+// TestBuildApplyDiff verifies the convergence diff covers add, update,
+// keep and remove against a real pubspec fixture
+func TestBuildApplyDiff(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, `name: test_app
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  kept_pkg:
+    git:
+      url: https://github.com/owner/kept_pkg.git
+      ref: main
+  moved_pkg:
+    git:
+      url: https://github.com/owner/moved_pkg.git
+      ref: develop
+  extra_pkg:
+    git:
+      url: https://github.com/owner/extra_pkg.git
+`)
+
+	manifest := &DependencyManifest{
+		Dependencies: []ManifestDep{
+			{Name: "kept_pkg", URL: "https://github.com/owner/kept_pkg.git", Ref: "main"},
+			{Name: "moved_pkg", URL: "https://github.com/owner/moved_pkg.git", Ref: "v2.0.0"},
+			{Name: "new_pkg", URL: "https://github.com/owner/new_pkg.git", Ref: "main"},
+		},
+	}
+
+	changes, err := BuildApplyDiff(projectPath, manifest)
+	if err != nil {
+		t.Fatalf("BuildApplyDiff failed: %v", err)
+	}
+
+	actions := make(map[string]string)
+	for _, change := range changes {
+		actions[change.Name] = change.Action
+	}
+
+	want := map[string]string{
+		"kept_pkg":  "keep",
+		"moved_pkg": "update",
+		"new_pkg":   "add",
+		"extra_pkg": "remove",
+	}
+	for name, action := range want {
+		if actions[name] != action {
+			t.Errorf("%s action = %q, want %q", name, actions[name], action)
+		}
+	}
+
+	// Removals come last so adds/updates land before extras disappear
+	if last := changes[len(changes)-1]; last.Action != "remove" {
+		t.Errorf("last change action = %q, want remove", last.Action)
+	}
+}
+
+// TestExportDependencyManifest verifies the exporter captures url/ref/subdir
+// plus the locked SHA, and that its output round-trips through the loader
+func TestExportDependencyManifest(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, `name: test_app
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+      ref: main
+  mono_pkg:
+    git:
+      url: https://github.com/owner/monorepo.git
+      ref: v1.2.3
+      path: packages/mono_pkg
+`)
+	lock := `packages:
+  my_pkg:
+    dependency: "direct main"
+    source: git
+    description:
+      url: "https://github.com/owner/my_pkg.git"
+      resolved-ref: "abc1234def5678"
+    version: "1.0.0"
+`
+	if err := os.WriteFile(filepath.Join(projectPath, "pubspec.lock"), []byte(lock), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.lock: %v", err)
+	}
+
+	manifest, err := ExportDependencyManifest(projectPath)
+	if err != nil {
+		t.Fatalf("ExportDependencyManifest failed: %v", err)
+	}
+	if len(manifest.Dependencies) != 2 {
+		t.Fatalf("dependencies = %d, want 2", len(manifest.Dependencies))
+	}
+
+	byName := make(map[string]ManifestDep)
+	for _, dep := range manifest.Dependencies {
+		byName[dep.Name] = dep
+	}
+	if dep := byName["my_pkg"]; dep.URL != "https://github.com/owner/my_pkg.git" || dep.Ref != "main" || dep.CurrentSHA != "abc1234" {
+		t.Errorf("unexpected my_pkg entry: %+v", dep)
+	}
+	if dep := byName["mono_pkg"]; dep.Subdir != "packages/mono_pkg" || dep.CurrentSHA != "" {
+		t.Errorf("unexpected mono_pkg entry: %+v", dep)
+	}
+
+	// The exported file must feed straight back into apply
+	content, err := manifest.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	manifestPath := filepath.Join(projectPath, "deps.yaml")
+	if err := os.WriteFile(manifestPath, content, 0644); err != nil {
+		t.Fatalf("failed to write manifest: %v", err)
+	}
+	loaded, err := LoadDependencyManifest(manifestPath)
+	if err != nil {
+		t.Fatalf("round-trip load failed: %v", err)
+	}
+	changes, err := BuildApplyDiff(projectPath, loaded)
+	if err != nil {
+		t.Fatalf("BuildApplyDiff failed: %v", err)
+	}
+	for _, change := range changes {
+		if change.Action != "keep" {
+			t.Errorf("round-trip diff should be all keeps, got %s %s", change.Action, change.Name)
+		}
+	}
+}
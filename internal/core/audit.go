@@ -0,0 +1,187 @@
+// Package core/audit.go - Security Advisory Check (OSV.dev)
+//
+// This file checks the resolved package versions in pubspec.lock against
+// the OSV.dev vulnerability database (https://osv.dev, ecosystem "Pub").
+// The lock file is the right input: it names the exact versions a build
+// actually uses, including transitive dependencies that never appear in
+// pubspec.yaml. Findings feed the Security section on the results screen
+// and the `flutter-pm audit` CLI command, which exits non-zero so the check
+// can gate CI.
+//
+// Key features:
+// - AuditDependencies: Check every locked package version against OSV.dev
+// - queryOSV: One OSV.dev API query with per-version caching
+// - AuditFinding / Vulnerability: Report structures shared by TUI and CLI
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Vulnerability describes one known vulnerability affecting a package version
+type Vulnerability struct {
+	ID      string   `json:"id"`                // OSV id, e.g. GHSA-xxxx-xxxx-xxxx
+	Summary string   `json:"summary"`           // One-line description
+	Aliases []string `json:"aliases,omitempty"` // CVE and other database ids
+	URL     string   `json:"url"`               // Advisory page on osv.dev
+}
+
+// AuditFinding groups the vulnerabilities found for one locked package
+type AuditFinding struct {
+	Package string          `json:"package"`
+	Version string          `json:"version"`
+	Vulns   []Vulnerability `json:"vulnerabilities"`
+}
+
+// osvQuery models the OSV.dev query request body
+// POST https://api.osv.dev/v1/query
+type osvQuery struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Version string `json:"version"`
+}
+
+// osvResponse models the relevant parts of the OSV.dev query response
+type osvResponse struct {
+	Vulns []struct {
+		ID      string   `json:"id"`
+		Summary string   `json:"summary"`
+		Details string   `json:"details"`
+		Aliases []string `json:"aliases"`
+	} `json:"vulns"`
+}
+
+// osvCache avoids re-querying the same package version within a session
+var osvCache = struct {
+	mu    sync.RWMutex
+	cache map[string][]Vulnerability // "name@version" -> vulnerabilities
+	times map[string]time.Time
+}{
+	cache: make(map[string][]Vulnerability),
+	times: make(map[string]time.Time),
+}
+
+const osvCacheTTL = 30 * time.Minute
+
+// AuditDependencies checks every hosted and git package in the project's
+// pubspec.lock against OSV.dev and returns the packages with known
+// vulnerabilities. Individual query failures are logged and skipped, so an
+// API hiccup never fails the whole audit.
+func AuditDependencies(logger *Logger, projectPath string) ([]AuditFinding, error) {
+	lock, err := parsePubspecLock(filepath.Join(projectPath, "pubspec.lock"))
+	if err != nil {
+		return nil, fmt.Errorf("cannot read pubspec.lock (run 'dart pub get' first): %w", err)
+	}
+
+	// Sort names so findings come out in a stable order
+	names := make([]string, 0, len(lock.Dependencies))
+	for name := range lock.Dependencies {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var findings []AuditFinding
+	for _, name := range names {
+		dep := lock.Dependencies[name]
+		// SDK and path packages have no published versions to look up
+		if dep.Version == "" || dep.Source == "sdk" || dep.Source == "path" {
+			continue
+		}
+
+		vulns, err := queryOSV(name, dep.Version)
+		if err != nil {
+			logger.Debug("audit", fmt.Sprintf("OSV query for %s %s failed: %v", name, dep.Version, err))
+			continue
+		}
+		if len(vulns) > 0 {
+			findings = append(findings, AuditFinding{
+				Package: name,
+				Version: dep.Version,
+				Vulns:   vulns,
+			})
+		}
+	}
+
+	logger.Info("audit", fmt.Sprintf("Audited %d locked packages, %d with known vulnerabilities",
+		len(lock.Dependencies), len(findings)))
+	return findings, nil
+}
+
+// queryOSV asks OSV.dev for the known vulnerabilities of one package
+// version, caching the answer (including the "none known" answer)
+func queryOSV(name, version string) ([]Vulnerability, error) {
+	key := name + "@" + version
+
+	osvCache.mu.RLock()
+	cachedAt, exists := osvCache.times[key]
+	cached := osvCache.cache[key]
+	osvCache.mu.RUnlock()
+	if exists && time.Since(cachedAt) <= osvCacheTTL {
+		return cached, nil
+	}
+
+	query := osvQuery{Version: version}
+	query.Package.Name = name
+	query.Package.Ecosystem = "Pub"
+
+	body, err := json.Marshal(query)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post("https://api.osv.dev/v1/query", "application/json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OSV.dev returned HTTP %d for %s", resp.StatusCode, key)
+	}
+
+	var parsed osvResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, err
+	}
+
+	vulns := make([]Vulnerability, 0, len(parsed.Vulns))
+	for _, vuln := range parsed.Vulns {
+		vulns = append(vulns, Vulnerability{
+			ID:      vuln.ID,
+			Summary: vulnerabilitySummary(vuln.Summary, vuln.Details),
+			Aliases: vuln.Aliases,
+			URL:     fmt.Sprintf("https://osv.dev/vulnerability/%s", vuln.ID),
+		})
+	}
+
+	osvCache.mu.Lock()
+	osvCache.cache[key] = vulns
+	osvCache.times[key] = time.Now()
+	osvCache.mu.Unlock()
+
+	return vulns, nil
+}
+
+// vulnerabilitySummary picks a one-line description: the summary when OSV
+// has one, otherwise the first line of the details
+func vulnerabilitySummary(summary, details string) string {
+	if summary != "" {
+		return summary
+	}
+	if line, _, _ := strings.Cut(details, "\n"); line != "" {
+		return strings.TrimSpace(line)
+	}
+	return "(no description)"
+}
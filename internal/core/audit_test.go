@@ -0,0 +1,16 @@
+package core
+
+import "testing"
+
+// TestVulnerabilitySummary verifies the one-line description fallback
+func TestVulnerabilitySummary(t *testing.T) {
+	if got := vulnerabilitySummary("Heap overflow in parser", "long details"); got != "Heap overflow in parser" {
+		t.Errorf("expected the summary to win, got %q", got)
+	}
+	if got := vulnerabilitySummary("", "First line of details\nSecond line"); got != "First line of details" {
+		t.Errorf("expected the first details line, got %q", got)
+	}
+	if got := vulnerabilitySummary("", ""); got != "(no description)" {
+		t.Errorf("expected the placeholder, got %q", got)
+	}
+}
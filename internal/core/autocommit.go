@@ -0,0 +1,157 @@
+// Package core/autocommit.go - Post-Run Pubspec Auto-Commit
+//
+// Teams that want every dependency change captured as its own commit can
+// enable an automatic post-step (--auto-commit or FLUTTER_PM_AUTO_COMMIT)
+// that commits pubspec.yaml and pubspec.lock after a successful run with a
+// generated message like "Add git dependency foo @ abc123". With
+// --commit-branch (FLUTTER_PM_AUTO_COMMIT_BRANCH) the commit lands on a
+// dedicated branch instead of the current one. The resulting commit hash is
+// surfaced on the results screen.
+//
+// Key features:
+// - AutoCommitChanges: Stage and commit the pubspec files, hash in Data
+// - AutoCommitMessage: Generated subject naming packages and locked SHAs
+// - Optional branch creation (reuses the branch when it already exists)
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// AutoCommitMessage builds the commit subject for the added specs, naming
+// the locked SHA from pubspec.lock when it resolved (e.g. "Add git
+// dependency foo @ abc123")
+func AutoCommitMessage(projectPath string, specs []PkgSpec) string {
+	shas := map[string]string{}
+	if lock, err := parsePubspecLock(filepath.Join(projectPath, "pubspec.lock")); err == nil {
+		for name, dep := range lock.Dependencies {
+			sha := dep.ResolvedRef
+			if len(sha) > 7 {
+				sha = sha[:7]
+			}
+			shas[name] = sha
+		}
+	}
+
+	describe := func(spec PkgSpec) string {
+		if sha := shas[spec.Name]; sha != "" {
+			return fmt.Sprintf("%s @ %s", spec.Name, sha)
+		}
+		if spec.Ref != "" {
+			return fmt.Sprintf("%s @ %s", spec.Name, spec.Ref)
+		}
+		return spec.Name
+	}
+
+	if len(specs) == 1 {
+		return "Add git dependency " + describe(specs[0])
+	}
+	parts := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		parts = append(parts, describe(spec))
+	}
+	return fmt.Sprintf("Add %d git dependencies: %s", len(specs), strings.Join(parts, ", "))
+}
+
+// AutoCommitChanges commits the project's pubspec.yaml and pubspec.lock
+// with a generated message, optionally on cfg.AutoCommitBranch. The commit
+// hash is returned in Data["commit"] for the results screen.
+func AutoCommitChanges(logger *Logger, cfg *Config, projectPath string, specs []PkgSpec) ActionResult {
+	message := AutoCommitMessage(projectPath, specs)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would commit pubspec changes: %q", message),
+		}
+	}
+
+	git := func(args ...string) (string, error) {
+		output, err := exec.Command("git", append([]string{"-C", projectPath}, args...)...).CombinedOutput()
+		return strings.TrimSpace(string(output)), err
+	}
+
+	if _, err := git("rev-parse", "--is-inside-work-tree"); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("auto-commit skipped: %s is not a git repository", projectPath),
+		}
+	}
+
+	// Optional dedicated branch: create it, or reuse it when a previous run
+	// already did
+	if cfg.AutoCommitBranch != "" {
+		if _, err := git("checkout", "-b", cfg.AutoCommitBranch); err != nil {
+			if output, err := git("checkout", cfg.AutoCommitBranch); err != nil {
+				return ActionResult{
+					OK:  false,
+					Err: fmt.Sprintf("failed to switch to branch %s: %s", cfg.AutoCommitBranch, output),
+				}
+			}
+		}
+		logger.Info("autocommit", fmt.Sprintf("Committing on branch %s", cfg.AutoCommitBranch))
+	}
+
+	// Stage only the pubspec files that exist - a fresh project may not
+	// have a pubspec.lock yet
+	staged := 0
+	for _, file := range []string{"pubspec.yaml", "pubspec.lock"} {
+		if _, err := os.Stat(filepath.Join(projectPath, file)); err != nil {
+			continue
+		}
+		if output, err := git("add", "--", file); err != nil {
+			return ActionResult{
+				OK:  false,
+				Err: fmt.Sprintf("failed to stage %s: %s", file, output),
+			}
+		}
+		staged++
+	}
+	if staged == 0 {
+		return ActionResult{
+			OK:  false,
+			Err: "auto-commit skipped: no pubspec files to stage",
+		}
+	}
+
+	// Nothing staged means the run didn't change the files (e.g. the
+	// dependency was already present)
+	if _, err := git("diff", "--cached", "--quiet"); err == nil {
+		return ActionResult{
+			OK:      true,
+			Message: "Auto-commit skipped: pubspec files are unchanged",
+		}
+	}
+
+	if output, err := git("commit", "-m", message); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("git commit failed: %s", output),
+		}
+	}
+
+	hash, err := git("rev-parse", "--short", "HEAD")
+	if err != nil {
+		hash = "unknown"
+	}
+
+	logger.Info("autocommit", fmt.Sprintf("Committed pubspec changes as %s: %s", hash, message))
+	resultMessage := fmt.Sprintf("Committed pubspec changes as %s", hash)
+	if cfg.AutoCommitBranch != "" {
+		resultMessage += fmt.Sprintf(" on branch %s", cfg.AutoCommitBranch)
+	}
+	return ActionResult{
+		OK:      true,
+		Message: resultMessage,
+		Data: map[string]interface{}{
+			"commit":         hash,
+			"commit_message": message,
+			"branch":         cfg.AutoCommitBranch,
+		},
+	}
+}
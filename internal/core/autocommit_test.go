@@ -0,0 +1,150 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// initAutoCommitRepo creates a git repo with a committed pubspec.yaml and
+// an uncommitted dependency edit, mimicking the state after a pub add
+func initAutoCommitRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, output)
+		}
+	}
+	run("init")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: app\n"), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+	run("add", "pubspec.yaml")
+	run("commit", "-m", "initial")
+
+	pubspec := `name: app
+dependencies:
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to modify pubspec.yaml: %v", err)
+	}
+	return dir
+}
+
+func TestAutoCommitMessage(t *testing.T) {
+	dir := t.TempDir()
+	lock := `packages:
+  my_pkg:
+    dependency: "direct main"
+    source: git
+    description:
+      url: "https://github.com/owner/my_pkg.git"
+      resolved-ref: "abc1234def5678"
+    version: "1.0.0"
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.lock"), []byte(lock), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.lock: %v", err)
+	}
+
+	// Locked SHA wins over the requested ref
+	message := AutoCommitMessage(dir, []PkgSpec{{Name: "my_pkg", Ref: "main"}})
+	if message != "Add git dependency my_pkg @ abc1234" {
+		t.Errorf("unexpected message: %q", message)
+	}
+
+	// Without a lock entry the ref is used, without a ref just the name
+	message = AutoCommitMessage(dir, []PkgSpec{{Name: "other_pkg", Ref: "dev"}})
+	if message != "Add git dependency other_pkg @ dev" {
+		t.Errorf("unexpected message: %q", message)
+	}
+
+	// Multiple packages are listed in one subject
+	message = AutoCommitMessage(dir, []PkgSpec{{Name: "my_pkg"}, {Name: "other_pkg"}})
+	if !strings.HasPrefix(message, "Add 2 git dependencies:") || !strings.Contains(message, "abc1234") {
+		t.Errorf("unexpected message: %q", message)
+	}
+}
+
+func TestAutoCommitChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := initAutoCommitRepo(t)
+	logger := NewLogger(&Config{Quiet: true})
+	specs := []PkgSpec{{Name: "my_pkg", Ref: "main"}}
+
+	// Dry run reports without committing
+	result := AutoCommitChanges(logger, &Config{Quiet: true, DryRun: true}, dir, specs)
+	if !result.OK || !strings.Contains(result.Message, "Would commit") {
+		t.Errorf("unexpected dry run result: %+v", result)
+	}
+
+	result = AutoCommitChanges(logger, &Config{Quiet: true}, dir, specs)
+	if !result.OK {
+		t.Fatalf("auto-commit failed: %s", result.Err)
+	}
+	hash, ok := result.Data["commit"].(string)
+	if !ok || hash == "" {
+		t.Fatalf("commit hash missing from result: %+v", result.Data)
+	}
+
+	// The commit subject carries the generated message
+	output, err := exec.Command("git", "-C", dir, "log", "-1", "--pretty=%s").Output()
+	if err != nil {
+		t.Fatalf("git log failed: %v", err)
+	}
+	if subject := strings.TrimSpace(string(output)); subject != "Add git dependency my_pkg @ main" {
+		t.Errorf("unexpected commit subject: %q", subject)
+	}
+
+	// A second run with nothing changed is a clean no-op
+	result = AutoCommitChanges(logger, &Config{Quiet: true}, dir, specs)
+	if !result.OK || !strings.Contains(result.Message, "unchanged") {
+		t.Errorf("unchanged run should be skipped cleanly: %+v", result)
+	}
+}
+
+func TestAutoCommitChangesOnBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := initAutoCommitRepo(t)
+	logger := NewLogger(&Config{Quiet: true})
+	cfg := &Config{Quiet: true, AutoCommitBranch: "deps/my-pkg"}
+
+	result := AutoCommitChanges(logger, cfg, dir, []PkgSpec{{Name: "my_pkg"}})
+	if !result.OK {
+		t.Fatalf("auto-commit failed: %s", result.Err)
+	}
+	if !strings.Contains(result.Message, "deps/my-pkg") {
+		t.Errorf("message should name the branch: %s", result.Message)
+	}
+
+	output, err := exec.Command("git", "-C", dir, "rev-parse", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		t.Fatalf("git rev-parse failed: %v", err)
+	}
+	if branch := strings.TrimSpace(string(output)); branch != "deps/my-pkg" {
+		t.Errorf("expected to be on deps/my-pkg, got %s", branch)
+	}
+}
+
+func TestAutoCommitChangesNonGit(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+	result := AutoCommitChanges(logger, &Config{Quiet: true}, t.TempDir(), []PkgSpec{{Name: "my_pkg"}})
+	if result.OK {
+		t.Error("auto-commit in a non-git directory should fail")
+	}
+}
@@ -0,0 +1,115 @@
+// Package core/batch.go - Single-Pass Batch Dependency Addition
+//
+// Adding N packages through `dart pub add` runs N pub processes, each
+// resolving the full dependency graph. This file implements a batch mode:
+// all git dependency entries are written into pubspec.yaml in one edit
+// (a yaml.v3 node round-trip, so comments and ordering survive) followed
+// by a single `pub get`. The batch path is an optimization, not a new
+// behavior: any failure restores the original pubspec.yaml and the caller
+// falls back to the per-package pub add flow with its conflict analysis.
+//
+// Key features:
+// - AddGitDependenciesBatch: One atomic pubspec edit plus one pub get
+// - Comment-preserving yaml.Node round-trip (no regex surgery)
+// - Automatic restore and per-package fallback signal on failure
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// AddGitDependenciesBatch writes all specs into pubspec.yaml in a single
+// edit and runs one pub get. It returns per-spec results and true when the
+// batch path handled the installation; (nil, false) means the caller should
+// fall back to per-package AddGitDependency (the original pubspec.yaml has
+// been restored in that case).
+func AddGitDependenciesBatch(logger *Logger, cfg *Config, projectPath string, specs []PkgSpec) ([]ActionResult, bool) {
+	// Batching a single package buys nothing over pub add
+	if len(specs) < 2 {
+		return nil, false
+	}
+
+	// Policy violations get their actionable per-package messages from the
+	// fallback path (see core/policy.go)
+	policy := LoadPolicyConfig()
+	for _, spec := range specs {
+		if len(policy.Check(spec)) > 0 {
+			logger.Debug("batch", fmt.Sprintf("Policy violation for %s, using per-package mode", spec.Name))
+			return nil, false
+		}
+	}
+
+	if cfg.DryRun {
+		results := make([]ActionResult, 0, len(specs))
+		for _, spec := range specs {
+			results = append(results, ActionResult{
+				OK:      true,
+				Message: fmt.Sprintf("Would add %s in a single pubspec.yaml edit", spec.Name),
+			})
+		}
+		return results, true
+	}
+
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	original, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		logger.Debug("batch", fmt.Sprintf("Cannot read pubspec.yaml, using per-package mode: %v", err))
+		return nil, false
+	}
+
+	edited, err := insertGitDependencies(original, specs)
+	if err != nil {
+		logger.Debug("batch", fmt.Sprintf("Cannot edit pubspec.yaml, using per-package mode: %v", err))
+		return nil, false
+	}
+
+	if err := os.WriteFile(pubspecPath, edited, 0644); err != nil {
+		logger.Debug("batch", fmt.Sprintf("Cannot write pubspec.yaml, using per-package mode: %v", err))
+		return nil, false
+	}
+
+	logger.Info("batch", fmt.Sprintf("Wrote %d git dependencies in one pubspec.yaml edit", len(specs)))
+
+	// One pub get resolves the whole graph once for all new entries
+	syncResult := Sync(logger, cfg, projectPath)
+	if !syncResult.OK {
+		// Restore and let the per-package flow surface conflicts properly
+		if restoreErr := os.WriteFile(pubspecPath, original, 0644); restoreErr != nil {
+			logger.Error("batch", fmt.Errorf("failed to restore pubspec.yaml after batch failure: %w", restoreErr))
+		}
+		logger.Info("batch", fmt.Sprintf("Batch pub get failed (%s), falling back to per-package mode", syncResult.Err))
+		return nil, false
+	}
+
+	results := make([]ActionResult, 0, len(specs))
+	for _, spec := range specs {
+		message := fmt.Sprintf("Added %s", spec.Name)
+		if spec.Ref != "" {
+			message += fmt.Sprintf(" (ref: %s)", spec.Ref)
+		}
+		results = append(results, ActionResult{
+			OK:      true,
+			Message: message,
+			Data: map[string]interface{}{
+				"batch": true,
+			},
+		})
+	}
+	return results, true
+}
+
+// insertGitDependencies upserts git dependency entries into pubspec.yaml
+// content through the comment-preserving editor (see core/pubspec_editor.go)
+func insertGitDependencies(content []byte, specs []PkgSpec) ([]byte, error) {
+	editor, err := NewPubspecEditorFromBytes(content)
+	if err != nil {
+		return nil, err
+	}
+	for _, spec := range specs {
+		editor.SetGitDependency(spec)
+	}
+	return editor.Bytes()
+}
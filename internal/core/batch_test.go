@@ -0,0 +1,100 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestInsertGitDependencies verifies the single-pass edit adds entries
+// under dependencies while preserving comments and existing entries
+func TestInsertGitDependencies(t *testing.T) {
+	original := `name: test_app
+# SDK constraint - do not touch
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  # hosted packages
+  http: ^1.2.0
+`
+	specs := []PkgSpec{
+		{Name: "pkg_a", URL: "https://github.com/owner/pkg_a.git", Ref: "v1.0.0"},
+		{Name: "pkg_b", URL: "https://github.com/owner/monorepo.git", Ref: "main", Subdir: "packages/pkg_b"},
+	}
+
+	edited, err := insertGitDependencies([]byte(original), specs)
+	if err != nil {
+		t.Fatalf("insertGitDependencies failed: %v", err)
+	}
+	output := string(edited)
+
+	for _, want := range []string{
+		"# SDK constraint - do not touch",
+		"# hosted packages",
+		"http: ^1.2.0",
+		"url: https://github.com/owner/pkg_a.git",
+		"ref: v1.0.0",
+		"path: packages/pkg_b",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("edited pubspec missing %q:\n%s", want, output)
+		}
+	}
+}
+
+// TestInsertGitDependenciesUpsert verifies an existing entry is replaced
+// rather than duplicated
+func TestInsertGitDependenciesUpsert(t *testing.T) {
+	original := `name: test_app
+dependencies:
+  pkg_a:
+    git:
+      url: https://github.com/owner/pkg_a.git
+      ref: main
+`
+	specs := []PkgSpec{
+		{Name: "pkg_a", URL: "https://github.com/owner/pkg_a.git", Ref: "v2.0.0"},
+		{Name: "pkg_b", URL: "https://github.com/owner/pkg_b.git"},
+	}
+
+	edited, err := insertGitDependencies([]byte(original), specs)
+	if err != nil {
+		t.Fatalf("insertGitDependencies failed: %v", err)
+	}
+	output := string(edited)
+
+	if strings.Count(output, "pkg_a:") != 1 {
+		t.Errorf("pkg_a should appear exactly once:\n%s", output)
+	}
+	if strings.Contains(output, "ref: main") || !strings.Contains(output, "ref: v2.0.0") {
+		t.Errorf("pkg_a ref not replaced:\n%s", output)
+	}
+}
+
+// TestInsertGitDependenciesNoSection verifies a missing dependencies
+// section is created
+func TestInsertGitDependenciesNoSection(t *testing.T) {
+	original := `name: test_app
+environment:
+  sdk: ^3.0.0
+`
+	specs := []PkgSpec{
+		{Name: "pkg_a", URL: "https://github.com/owner/pkg_a.git"},
+		{Name: "pkg_b", URL: "https://github.com/owner/pkg_b.git"},
+	}
+
+	edited, err := insertGitDependencies([]byte(original), specs)
+	if err != nil {
+		t.Fatalf("insertGitDependencies failed: %v", err)
+	}
+	output := string(edited)
+
+	if !strings.Contains(output, "dependencies:") {
+		t.Errorf("dependencies section not created:\n%s", output)
+	}
+	for _, want := range []string{"pkg_a:", "pkg_b:"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("edited pubspec missing %q:\n%s", want, output)
+		}
+	}
+}
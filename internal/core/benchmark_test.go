@@ -6,6 +6,7 @@
 //
 // Key benchmarks:
 // - BenchmarkProjectDiscovery: Tests concurrent project scanning
+// - BenchmarkScanTree*: Sequential vs worker pool scan on a generated tree
 // - BenchmarkGitHubAPICaching: Tests GitHub API cache effectiveness
 // - BenchmarkGitLsRemoteCaching: Tests Git operation caching
 // - BenchmarkPubspecParsing: Tests pubspec.yaml parsing performance
@@ -15,6 +16,9 @@ package core
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -292,3 +296,118 @@ func BenchmarkMemoryPoolUsage(b *testing.B) {
 		readerPool.Put(reader)
 	}
 }
+
+// makeScanTree builds a synthetic directory tree for scan benchmarks:
+// width subdirectories per level, depth levels deep, with a Flutter project
+// (pubspec.yaml) at every leaf and a heavy node_modules decoy at every level
+func makeScanTree(b *testing.B, root string, width, depth int) {
+	b.Helper()
+
+	if depth <= 0 {
+		pubspec := filepath.Join(root, "pubspec.yaml")
+		if err := os.WriteFile(pubspec, []byte("name: bench_project\n"), 0644); err != nil {
+			b.Fatalf("failed to write pubspec fixture: %v", err)
+		}
+		return
+	}
+
+	// Heavy directory that the skip list should prune without descending
+	if err := os.MkdirAll(filepath.Join(root, "node_modules", "some", "deep", "dep"), 0755); err != nil {
+		b.Fatalf("failed to create decoy directory: %v", err)
+	}
+
+	for i := 0; i < width; i++ {
+		sub := filepath.Join(root, fmt.Sprintf("dir%d", i))
+		if err := os.MkdirAll(sub, 0755); err != nil {
+			b.Fatalf("failed to create directory: %v", err)
+		}
+		makeScanTree(b, sub, width, depth-1)
+	}
+}
+
+// scanTreeSequentialReference is a plain single-threaded walk used as the
+// baseline the worker pool scan is measured against. It applies the same
+// skip list so both benchmarks visit the same directories.
+func scanTreeSequentialReference(dir string, maxDepth int) []Project {
+	var projects []Project
+
+	if maxDepth <= 0 {
+		return projects
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return projects
+	}
+
+	pubspecPath := filepath.Join(dir, "pubspec.yaml")
+	if _, err := os.Stat(pubspecPath); err == nil {
+		return append(projects, Project{Path: dir, PubspecPath: pubspecPath})
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") || skipDirNames[name] {
+			continue
+		}
+		projects = append(projects, scanTreeSequentialReference(filepath.Join(dir, name), maxDepth-1)...)
+	}
+
+	return projects
+}
+
+// BenchmarkScanTreeSequential benchmarks the single-threaded reference walk
+// over a generated tree (baseline for BenchmarkScanTreeConcurrent)
+func BenchmarkScanTreeSequential(b *testing.B) {
+	root := b.TempDir()
+	makeScanTree(b, root, 8, 2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		projects := scanTreeSequentialReference(root, 3)
+		if len(projects) == 0 {
+			b.Fatal("sequential scan found no projects")
+		}
+	}
+}
+
+// BenchmarkScanTreeConcurrent benchmarks the bounded worker pool scan over
+// the same generated tree, demonstrating the parallel speedup
+func BenchmarkScanTreeConcurrent(b *testing.B) {
+	root := b.TempDir()
+	makeScanTree(b, root, 8, 2)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		projects, err := scanDirectoryForProjectsWithContext(ctx, root, 3, nil)
+		if err != nil {
+			b.Fatalf("concurrent scan failed: %v", err)
+		}
+		if len(projects) == 0 {
+			b.Fatal("concurrent scan found no projects")
+		}
+	}
+}
+
+// BenchmarkScanTreeConcurrentWithProgress measures the overhead of the
+// atomic progress counters relative to BenchmarkScanTreeConcurrent
+func BenchmarkScanTreeConcurrentWithProgress(b *testing.B) {
+	root := b.TempDir()
+	makeScanTree(b, root, 8, 2)
+	ctx := context.Background()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		progress := &ScanProgress{}
+		if _, err := scanDirectoryForProjectsWithContext(ctx, root, 3, progress); err != nil {
+			b.Fatalf("concurrent scan failed: %v", err)
+		}
+		if progress.DirsScanned() == 0 {
+			b.Fatal("progress counter never incremented")
+		}
+	}
+}
@@ -0,0 +1,134 @@
+// Package core/bootstrap.go - Source Project Bootstrap Pipeline
+//
+// This file implements the stages that turn a freshly cloned repository
+// into a ready-to-use Flutter project. The clone flow (GitHub option 2)
+// runs them as individual execution steps: verify the clone is a Flutter
+// project - running `flutter create .` when the repository ships without a
+// pubspec.yaml - then resolve dependencies with pub get and finally open
+// the project directory in the platform file manager.
+//
+// Key features:
+// - EnsureFlutterProject: Run `flutter create .` when pubspec.yaml is missing
+// - OpenProjectDir: Open the project in the platform file manager
+// - Context-aware: the flutter child process dies with the caller's context
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// EnsureFlutterProject verifies that projectPath contains a Flutter project
+// and initializes one with `flutter create .` when it doesn't.
+func EnsureFlutterProject(logger *Logger, cfg *Config, projectPath string) ActionResult {
+	return EnsureFlutterProjectContext(context.Background(), logger, cfg, projectPath)
+}
+
+// EnsureFlutterProjectContext is the context-aware variant of
+// EnsureFlutterProject. The flutter child process is killed when the context
+// is cancelled; a default timeout applies when the caller has no deadline.
+func EnsureFlutterProjectContext(ctx context.Context, logger *Logger, cfg *Config, projectPath string) ActionResult {
+	// A pubspec.yaml means the clone already is a Dart/Flutter project
+	if _, err := os.Stat(filepath.Join(projectPath, "pubspec.yaml")); err == nil {
+		return ActionResult{
+			OK:      true,
+			Message: "Already a Flutter project (pubspec.yaml found)",
+		}
+	}
+
+	// `create` is flutter-only, so this stage needs the flutter tool even
+	// when dart would normally win the pub tool lookup
+	if _, err := exec.LookPath("flutter"); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: "repository has no pubspec.yaml and 'flutter' is not in PATH to initialize one",
+		}
+	}
+
+	ctx, cancel := withDefaultTimeout(ctx, DefaultPubTimeout)
+	defer cancel()
+
+	args := []string{"create", "."}
+	logger.LogCommand("bootstrap", "flutter", args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: flutter create . in %s", projectPath),
+		}
+	}
+
+	logger.Narrate("Initializing Flutter project scaffolding with flutter create")
+
+	cmd := exec.CommandContext(ctx, "flutter", args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	output, err := runCommandStreaming(cmd)
+	logs := []string{strings.TrimSpace(string(output))}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  contextFailure(ctx, "flutter create", err),
+			Logs: logs,
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: "Initialized Flutter project with flutter create",
+		Logs:    logs,
+	}
+}
+
+// OpenProjectDir opens the project directory in the platform file manager.
+// The opener is fire-and-forget: the child is started but not awaited, and
+// a missing opener only fails this stage, never the overall flow.
+func OpenProjectDir(logger *Logger, cfg *Config, projectPath string) ActionResult {
+	opener := "xdg-open"
+	switch runtime.GOOS {
+	case "windows":
+		opener = "explorer"
+	case "darwin":
+		opener = "open"
+	}
+
+	absPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		absPath = projectPath
+	}
+
+	logger.LogCommand("bootstrap", opener, []string{absPath})
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: %s %s", opener, absPath),
+		}
+	}
+
+	cmd := exec.Command(opener, absPath)
+	if err := cmd.Start(); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to open %s with %s: %v", absPath, opener, err),
+		}
+	}
+
+	// Don't wait for the file manager to exit, but reap the process so it
+	// doesn't linger as a zombie
+	go func() { _ = cmd.Wait() }()
+
+	logger.Narrate("Opened the project directory in the file manager")
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Opened %s", absPath),
+	}
+}
@@ -0,0 +1,173 @@
+// Package core/bundles.go - Named Dependency Bundles
+//
+// Teams tend to add the same groups of packages together: a "networking"
+// stack, the internal core repos at specific refs, a testing toolkit. This
+// file reads named bundles from the `bundles:` section of the global
+// config so the whole group installs with one confirmation, via the TUI
+// bundle screen or `flutter-pm add --bundle <name>`:
+//
+//	bundles:
+//	  networking:
+//	    - name: dio
+//	    - name: retrofit
+//	      version: ^4.0.0
+//	  internal-core:
+//	    - url: https://github.com/acme/core_models.git
+//	      ref: v2
+//	    - url: https://github.com/acme/core_api.git
+//	      ref: v2
+//
+// Entries with a url are git dependencies (optional ref/subdir); entries
+// with only a name are hosted pub.dev packages (optional version
+// constraint, defaulting to the latest compatible).
+//
+// Key features:
+// - LoadBundles: Every named bundle from the global config
+// - LoadBundle: One bundle by name, with the available names on a miss
+// - ApplyBundle: Install every entry through the usual add pipelines
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// BundleEntry is one package in a named bundle: git (URL set) or hosted
+// (name only, optional version constraint)
+type BundleEntry struct {
+	Name    string `yaml:"name"`
+	URL     string `yaml:"url"`
+	Ref     string `yaml:"ref"`
+	Subdir  string `yaml:"subdir"`
+	Version string `yaml:"version"` // Hosted constraint, e.g. ^4.0.0
+}
+
+// IsGit reports whether the entry is a git dependency
+func (e BundleEntry) IsGit() bool {
+	return e.URL != ""
+}
+
+// Spec converts a git entry to the package spec the add pipeline consumes
+func (e BundleEntry) Spec() PkgSpec {
+	name := e.Name
+	if name == "" {
+		name = teamPackageNameFromURL(e.URL)
+	}
+	return PkgSpec{Name: name, URL: e.URL, Ref: e.Ref, Subdir: e.Subdir}
+}
+
+// Label renders the entry for plans and confirmation screens
+func (e BundleEntry) Label() string {
+	if e.IsGit() {
+		label := e.Spec().Name + " (git: " + e.URL
+		if e.Ref != "" {
+			label += " @ " + e.Ref
+		}
+		return label + ")"
+	}
+	if e.Version != "" {
+		return e.Name + " " + e.Version
+	}
+	return e.Name
+}
+
+// bundlesYAML models the `bundles:` section of the global config
+type bundlesYAML struct {
+	Bundles map[string][]BundleEntry `yaml:"bundles"`
+}
+
+// LoadBundles reads every named bundle from the global config, dropping
+// entries with neither a name nor a URL. Returns nil when the config is
+// missing or has no bundles section.
+func LoadBundles() map[string][]BundleEntry {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return nil
+	}
+
+	var parsed bundlesYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+
+	bundles := make(map[string][]BundleEntry)
+	for name, entries := range parsed.Bundles {
+		var kept []BundleEntry
+		for _, entry := range entries {
+			if entry.Name == "" && entry.URL == "" {
+				continue
+			}
+			kept = append(kept, entry)
+		}
+		if len(kept) > 0 {
+			bundles[name] = kept
+		}
+	}
+	if len(bundles) == 0 {
+		return nil
+	}
+	return bundles
+}
+
+// BundleNames returns the configured bundle names, sorted for stable menus
+func BundleNames() []string {
+	bundles := LoadBundles()
+	names := make([]string, 0, len(bundles))
+	for name := range bundles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadBundle returns one bundle by name; an unknown name reports the
+// available bundles so typos are easy to fix
+func LoadBundle(name string) ([]BundleEntry, error) {
+	bundles := LoadBundles()
+	if entries, ok := bundles[name]; ok {
+		return entries, nil
+	}
+	if len(bundles) == 0 {
+		return nil, fmt.Errorf("no bundles defined in %s", GlobalConfigPath())
+	}
+	names := make([]string, 0, len(bundles))
+	for n := range bundles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return nil, fmt.Errorf("unknown bundle %q (available: %s)", name, strings.Join(names, ", "))
+}
+
+// ApplyBundle installs every bundle entry into the project, git entries
+// through the git add pipeline (with conflict auto-resolution) and hosted
+// entries through `pub add`. One ActionResult per entry, in bundle order;
+// a failed entry does not stop the rest.
+func ApplyBundle(logger *Logger, cfg *Config, projectPath string, entries []BundleEntry) []ActionResult {
+	results := make([]ActionResult, 0, len(entries))
+	for _, entry := range entries {
+		results = append(results, ApplyBundleEntry(logger, cfg, projectPath, entry))
+	}
+	return results
+}
+
+// ApplyBundleEntry installs one bundle entry through the matching add
+// pipeline; the TUI bundle screen calls this per entry for live progress
+func ApplyBundleEntry(logger *Logger, cfg *Config, projectPath string, entry BundleEntry) ActionResult {
+	if entry.IsGit() {
+		result := AddGitDependency(logger, cfg, projectPath, entry.Spec(), true)
+		// Count the add for the quick-add favorites list (simulated adds
+		// don't count)
+		if result.OK && !cfg.DryRun {
+			if err := RecordFavoriteAdd(entry.Spec()); err != nil {
+				logger.Debug("bundle", fmt.Sprintf("Could not update favorites: %v", err))
+			}
+		}
+		return result
+	}
+	return AddHostedDependency(logger, cfg, projectPath, entry.Name, entry.Version)
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeBundleConfig writes a global config with the given content into a
+// temp home directory
+func writeBundleConfig(t *testing.T, content string) {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	configDir := filepath.Join(home, ".config", "flutter-pm")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestLoadBundles verifies parsing of the bundles config section,
+// including dropping empty entries and empty bundles
+func TestLoadBundles(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if got := LoadBundles(); got != nil {
+		t.Fatalf("expected nil without a config, got %+v", got)
+	}
+
+	writeBundleConfig(t, `bundles:
+  networking:
+    - name: dio
+    - name: retrofit
+      version: ^4.0.0
+  internal-core:
+    - url: https://github.com/acme/core_models.git
+      ref: v2
+  broken:
+    - version: ^1.0.0
+`)
+
+	bundles := LoadBundles()
+	if len(bundles) != 2 {
+		t.Fatalf("expected 2 bundles (the name-less entry drops its bundle), got %d", len(bundles))
+	}
+	if len(bundles["networking"]) != 2 {
+		t.Errorf("expected 2 networking entries, got %d", len(bundles["networking"]))
+	}
+	if entry := bundles["internal-core"][0]; !entry.IsGit() || entry.Ref != "v2" {
+		t.Errorf("unexpected git entry: %+v", entry)
+	}
+
+	// Names come back sorted for stable menus
+	names := BundleNames()
+	if len(names) != 2 || names[0] != "internal-core" || names[1] != "networking" {
+		t.Errorf("unexpected bundle names: %v", names)
+	}
+}
+
+// TestLoadBundle verifies lookup by name and the helpful unknown-name error
+func TestLoadBundle(t *testing.T) {
+	writeBundleConfig(t, `bundles:
+  networking:
+    - name: dio
+`)
+
+	entries, err := LoadBundle("networking")
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("LoadBundle = (%d entries, %v), want (1, nil)", len(entries), err)
+	}
+
+	if _, err := LoadBundle("networkign"); err == nil {
+		t.Error("expected an error for an unknown bundle")
+	} else if got := err.Error(); !strings.Contains(got, "networking") {
+		t.Errorf("expected the available names in the error, got %q", got)
+	}
+}
+
+// TestBundleEntrySpecAndLabel verifies the git spec conversion (with the
+// URL-derived name fallback) and the display labels
+func TestBundleEntrySpecAndLabel(t *testing.T) {
+	git := BundleEntry{URL: "https://github.com/acme/core_api.git", Ref: "v2"}
+	spec := git.Spec()
+	if spec.Name != "core_api" || spec.Ref != "v2" {
+		t.Errorf("unexpected spec: %+v", spec)
+	}
+	if got := git.Label(); got != "core_api (git: https://github.com/acme/core_api.git @ v2)" {
+		t.Errorf("unexpected git label: %q", got)
+	}
+
+	hosted := BundleEntry{Name: "dio", Version: "^5.0.0"}
+	if got := hosted.Label(); got != "dio ^5.0.0" {
+		t.Errorf("unexpected hosted label: %q", got)
+	}
+	if hosted.IsGit() {
+		t.Error("expected a hosted entry not to report as git")
+	}
+}
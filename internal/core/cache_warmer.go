@@ -134,6 +134,13 @@ func (cw *CacheWarmer) warmGitHubCache(ctx context.Context) {
 		return
 	}
 
+	// Leave the remaining API budget to interactive operations when it
+	// runs low or the client is rate limited (see ratelimit.go)
+	if ThrottleBackgroundGitHubWork() {
+		cw.logger.Debug("cache-warmer", "GitHub API budget low - skipping background warm")
+		return
+	}
+
 	cw.logger.Debug("cache-warmer", "Warming GitHub API cache")
 
 	repos, err := ListGitHubRepos(cw.logger)
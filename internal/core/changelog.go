@@ -0,0 +1,163 @@
+// Package core/changelog.go - Commit Log Between Two SHAs
+//
+// This file fetches the commit log a dependency update would pull in: the
+// commits between the SHA locked in pubspec.lock and the upstream SHA. The
+// express update screen shows the result in a scrollable pane so the user
+// reviews what they are pulling in before confirming, instead of trusting
+// a bare old -> new SHA pair.
+//
+// Fetching mirrors the package-name fallback chain in git.go: the GitHub
+// compare API via gh CLI first (works for private repos when authenticated,
+// no clone needed), then a shallow bare clone queried with git log.
+//
+// Key features:
+// - FetchCommitLog: "sha message" lines between two SHAs, newest first
+// - gh api compare primary, shallow clone + git log fallback
+// - Line cap so a huge range doesn't flood the pane
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// maxChangelogLines caps how many commits are returned; ranges beyond the
+// cap end with a summary line instead
+const maxChangelogLines = 100
+
+// FetchCommitLog returns one "sha subject" line per commit between fromSHA
+// (exclusive) and toSHA (inclusive), newest first. Both SHAs must be known;
+// an identical pair returns an empty list.
+func FetchCommitLog(logger *Logger, gitURL, fromSHA, toSHA string) ([]string, error) {
+	if fromSHA == "" || toSHA == "" {
+		return nil, fmt.Errorf("both SHAs are required to compare")
+	}
+	if fromSHA == toSHA {
+		return nil, nil
+	}
+
+	// Primary: GitHub compare API via gh CLI
+	if ownerRepo := githubOwnerRepo(gitURL); ownerRepo != "" {
+		if _, err := exec.LookPath("gh"); err == nil {
+			if lines, err := commitLogViaGhAPI(logger, ownerRepo, fromSHA, toSHA); err == nil {
+				return lines, nil
+			} else {
+				logger.Debug("changelog", fmt.Sprintf("gh api compare failed: %v", err))
+			}
+		}
+	}
+
+	// Fallback: shallow bare clone queried with git log
+	return commitLogViaShallowClone(logger, gitURL, fromSHA, toSHA)
+}
+
+// githubOwnerRepo extracts "owner/repo" from a GitHub URL (https or ssh),
+// or "" when the URL is not a GitHub one
+func githubOwnerRepo(gitURL string) string {
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(gitURL, "/"), ".git")
+	for _, prefix := range []string{"github.com/", "github.com:"} {
+		if index := strings.Index(trimmed, prefix); index != -1 {
+			ownerRepo := trimmed[index+len(prefix):]
+			if strings.Count(ownerRepo, "/") == 1 {
+				return ownerRepo
+			}
+		}
+	}
+	return ""
+}
+
+// ghCompareJSON models the subset of the GitHub compare API response we read
+type ghCompareJSON struct {
+	TotalCommits int `json:"total_commits"`
+	Commits      []struct {
+		SHA    string `json:"sha"`
+		Commit struct {
+			Message string `json:"message"`
+		} `json:"commit"`
+	} `json:"commits"`
+}
+
+// commitLogViaGhAPI fetches the compare range through the GitHub API
+func commitLogViaGhAPI(logger *Logger, ownerRepo, fromSHA, toSHA string) ([]string, error) {
+	endpoint := fmt.Sprintf("repos/%s/compare/%s...%s", ownerRepo, fromSHA, toSHA)
+	logger.Debug("changelog", fmt.Sprintf("Running gh api %s", endpoint))
+
+	cmd := exec.Command("gh", "api", endpoint)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("gh api compare failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to run gh api: %w", err)
+	}
+
+	var compare ghCompareJSON
+	if err := json.Unmarshal(output, &compare); err != nil {
+		return nil, fmt.Errorf("failed to parse compare response: %w", err)
+	}
+
+	// The API returns oldest first; the pane wants newest first
+	var lines []string
+	for i := len(compare.Commits) - 1; i >= 0; i-- {
+		commit := compare.Commits[i]
+		lines = append(lines, formatCommitLine(commit.SHA, commit.Commit.Message))
+		if len(lines) >= maxChangelogLines {
+			break
+		}
+	}
+	if compare.TotalCommits > len(lines) {
+		lines = append(lines, fmt.Sprintf("... and %d more commit(s)", compare.TotalCommits-len(lines)))
+	}
+	return lines, nil
+}
+
+// commitLogViaShallowClone clones the repository bare and shallow, then
+// reads the range with git log. Fails when fromSHA is older than the
+// shallow history - the caller reports that instead of deepening forever.
+func commitLogViaShallowClone(logger *Logger, gitURL, fromSHA, toSHA string) ([]string, error) {
+	tempDir, err := os.MkdirTemp("", "flutter-pm-changelog-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	logger.Debug("changelog", fmt.Sprintf("Shallow cloning %s for commit log", gitURL))
+	clone := exec.Command("git", "clone", "--bare", "--depth", "200", gitURL, tempDir)
+	if output, err := clone.CombinedOutput(); err != nil {
+		return nil, fmt.Errorf("shallow clone failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	log := exec.Command("git", "-C", tempDir, "log", "--no-decorate",
+		fmt.Sprintf("--max-count=%d", maxChangelogLines),
+		"--format=%H %s", fmt.Sprintf("%s..%s", fromSHA, toSHA))
+	output, err := log.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git log failed - the locked SHA may be beyond the shallow history")
+	}
+
+	var lines []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line == "" {
+			continue
+		}
+		sha, message, _ := strings.Cut(line, " ")
+		lines = append(lines, formatCommitLine(sha, message))
+	}
+	return lines, nil
+}
+
+// formatCommitLine renders one pane line: short SHA plus the commit subject
+func formatCommitLine(sha, message string) string {
+	if len(sha) > 7 {
+		sha = sha[:7]
+	}
+	subject := message
+	if index := strings.IndexByte(message, '\n'); index != -1 {
+		subject = message[:index]
+	}
+	return fmt.Sprintf("%s %s", sha, strings.TrimSpace(subject))
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGithubOwnerRepo(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://github.com/owner/repo.git", "owner/repo"},
+		{"https://github.com/owner/repo", "owner/repo"},
+		{"git@github.com:owner/repo.git", "owner/repo"},
+		{"https://gitlab.com/owner/repo.git", ""},
+		{"https://github.com/owner", ""},
+	}
+	for _, tt := range tests {
+		if got := githubOwnerRepo(tt.url); got != tt.want {
+			t.Errorf("githubOwnerRepo(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestFormatCommitLine(t *testing.T) {
+	line := formatCommitLine("a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", "Fix the thing\n\nLonger body text")
+	if line != "a1b2c3d Fix the thing" {
+		t.Errorf("formatCommitLine = %q", line)
+	}
+}
+
+// TestFetchCommitLogLocalRepo exercises the shallow-clone fallback against
+// a local repository with a known commit range
+func TestFetchCommitLogLocalRepo(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) string {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		output, err := cmd.CombinedOutput()
+		if err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, output)
+		}
+		return strings.TrimSpace(string(output))
+	}
+	run("init", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	commit := func(message string) string {
+		if err := os.WriteFile(filepath.Join(dir, "file.txt"), []byte(message), 0644); err != nil {
+			t.Fatalf("failed to write file: %v", err)
+		}
+		run("add", "file.txt")
+		run("commit", "-m", message)
+		return run("rev-parse", "HEAD")
+	}
+	first := commit("first commit")
+	commit("second commit")
+	third := commit("third commit")
+
+	logger := NewLogger(&Config{Quiet: true})
+	lines, err := FetchCommitLog(logger, dir, first, third)
+	if err != nil {
+		t.Fatalf("FetchCommitLog failed: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 commits, got %v", lines)
+	}
+	// Newest first
+	if !strings.Contains(lines[0], "third commit") || !strings.Contains(lines[1], "second commit") {
+		t.Errorf("unexpected log order: %v", lines)
+	}
+
+	// Identical SHAs compare empty
+	if lines, err := FetchCommitLog(logger, dir, third, third); err != nil || len(lines) != 0 {
+		t.Errorf("identical SHAs should return no commits, got %v, %v", lines, err)
+	}
+}
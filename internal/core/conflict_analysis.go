@@ -0,0 +1,266 @@
+// Package core/conflict_analysis.go - Pub Output Conflict Classification
+//
+// This file implements the conflict classifier for dart pub output. Earlier
+// versions relied on a fixed chain of English substring checks; the classifier
+// is now corpus-driven: each conflict type is described by weighted signal
+// groups, outputs are scored against every profile, and the best match is
+// returned together with a confidence score. Outputs that score below the
+// confidence threshold classify as "unknown" instead of risking a wrong
+// auto-resolution, which keeps wording changes across Dart SDK versions (and
+// partially translated output) from triggering bad overrides.
+//
+// Key features:
+// - analyzeDependencyConflict: Score pub output against all conflict profiles
+// - Confidence threshold: Low-signal output falls back to "unknown"
+// - extractConflictingPackageName: Pull the conflicting package from output
+
+package core
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ConflictAnalysis holds information about a dependency conflict
+type ConflictAnalysis struct {
+	ConflictType    string   // Type of conflict: "version", "sdk", "circular", "platform", "git_vs_hosted", "transitive", "unknown"
+	SubType         string   // More specific conflict classification
+	IsRecoverable   bool     // Whether we can attempt automatic resolution
+	Confidence      float64  // Classification confidence (0..1); below threshold classifies as unknown
+	SuggestedFix    string   // Human-readable description of fix strategy
+	UserMessage     string   // Clear message for the user
+	ConflictingPkg  string   // Name of conflicting package
+	SourceConflict  string   // Details about source conflict (git vs hosted)
+	ResolutionSteps []string // Step-by-step resolution approach
+}
+
+// minConflictConfidence is the score below which a classification is not
+// trusted and the analysis falls back to "unknown" (never auto-resolved)
+const minConflictConfidence = 0.5
+
+// conflictSignal is a weighted group of interchangeable wordings; matching
+// any pattern in the group earns the group's weight once
+type conflictSignal struct {
+	patterns []string
+	weight   float64
+}
+
+// conflictProfile describes one conflict type as a set of weighted signals.
+// The weights of a profile sum to 1.0, so the matched weight doubles as the
+// confidence score. Profile order encodes priority: on equal scores the
+// earlier profile wins, preserving the historical check order.
+type conflictProfile struct {
+	conflictType string
+	subType      string
+	recoverable  bool
+	suggestedFix string
+	userMessage  string
+	steps        []string
+	signals      []conflictSignal
+}
+
+// conflictProfiles is the classification corpus. Pattern groups collect the
+// wording variations observed across Dart SDK versions, so a rephrased
+// message still matches via its siblings instead of falling through to a
+// wrong branch.
+var conflictProfiles = []conflictProfile{
+	{
+		conflictType: "sdk",
+		subType:      "constraint_violation",
+		recoverable:  false,
+		suggestedFix: "Update Dart/Flutter SDK or choose compatible package version",
+		userMessage:  "Package requires newer SDK version than project supports",
+		steps:        []string{"Check package SDK requirements", "Update Flutter/Dart SDK", "Or use older package version"},
+		signals: []conflictSignal{
+			{patterns: []string{"sdk constraint", "requires sdk", "dart sdk", "flutter sdk", "sdk version"}, weight: 0.8},
+			{patterns: []string{"version solving failed", "solving failed", "resolution failed"}, weight: 0.2},
+		},
+	},
+	{
+		conflictType: "platform",
+		subType:      "incompatible_platform",
+		recoverable:  false,
+		suggestedFix: "Choose package compatible with target platforms",
+		userMessage:  "Package not compatible with project's target platforms",
+		steps:        []string{"Check package platform support", "Update pubspec.yaml platforms", "Or choose alternative package"},
+		signals: []conflictSignal{
+			{patterns: []string{"platform"}, weight: 0.4},
+			{patterns: []string{"not supported", "doesn't support", "does not support", "unsupported"}, weight: 0.6},
+		},
+	},
+	{
+		conflictType: "circular",
+		subType:      "dependency_cycle",
+		recoverable:  false,
+		suggestedFix: "Remove circular dependency or choose different packages",
+		userMessage:  "Circular dependency detected between packages",
+		steps:        []string{"Identify circular dependency chain", "Remove one dependency", "Or restructure dependencies"},
+		signals: []conflictSignal{
+			{patterns: []string{"circular", "cycle", "depends on itself"}, weight: 1.0},
+		},
+	},
+	{
+		conflictType: "git_vs_hosted",
+		subType:      "source_conflict",
+		recoverable:  true,
+		suggestedFix: "Use dependency_overrides to force git source",
+		userMessage:  "Package source conflict between git and hosted versions",
+		steps:        []string{"Add dependency_overrides section", "Force git source for conflicting package", "Retry package addition"},
+		signals: []conflictSignal{
+			{patterns: []string{"from git"}, weight: 0.35},
+			{patterns: []string{"from hosted"}, weight: 0.35},
+			{patterns: []string{"version solving failed", "solving failed", "incompatible"}, weight: 0.3},
+		},
+	},
+	{
+		conflictType: "transitive",
+		subType:      "indirect_conflict",
+		recoverable:  true,
+		suggestedFix: "Resolve transitive dependency with dependency overrides",
+		userMessage:  "Conflict in transitive dependencies - attempting resolution",
+		steps:        []string{"Run pub deps to analyze", "Add dependency_overrides if needed", "Retry package addition"},
+		signals: []conflictSignal{
+			{patterns: []string{"transitive", "indirect dependency"}, weight: 0.8},
+			{patterns: []string{"depends on", "requires"}, weight: 0.2},
+		},
+	},
+	{
+		conflictType: "version",
+		subType:      "version_mismatch",
+		recoverable:  true,
+		suggestedFix: "Run pub get to resolve version constraints",
+		userMessage:  "Version conflict detected - attempting automatic resolution",
+		steps:        []string{"Run pub get to resolve versions", "Check for constraint conflicts", "Retry package addition"},
+		signals: []conflictSignal{
+			{patterns: []string{"version solving failed", "solving failed", "version conflict", "incompatible version", "no versions"}, weight: 0.7},
+			{patterns: []string{"depends on", "requires", "is incompatible with"}, weight: 0.3},
+		},
+	},
+}
+
+// score returns the matched weight of a profile against lowercased output
+func (p *conflictProfile) score(lowerOutput string) float64 {
+	total := 0.0
+	for _, signal := range p.signals {
+		for _, pattern := range signal.patterns {
+			if strings.Contains(lowerOutput, pattern) {
+				total += signal.weight
+				break
+			}
+		}
+	}
+	return total
+}
+
+// analyzeDependencyConflict analyzes pub add output to identify conflict
+// types. Every profile is scored; the best match wins if it clears the
+// confidence threshold, otherwise the conflict is reported as unknown so no
+// automatic resolution is attempted on a guess.
+func analyzeDependencyConflict(output string, err error) ConflictAnalysis {
+	lowerOutput := strings.ToLower(output)
+	originalOutput := output
+
+	// Extract conflicting package name if possible
+	conflictingPkg := extractConflictingPackageName(originalOutput)
+
+	// Score all profiles and keep the best; ties keep the earlier profile,
+	// preserving the historical check order (sdk > platform > ... > version)
+	var best *conflictProfile
+	bestScore := 0.0
+	for i := range conflictProfiles {
+		profile := &conflictProfiles[i]
+		if score := profile.score(lowerOutput); score > bestScore {
+			best = profile
+			bestScore = score
+		}
+	}
+
+	if best != nil && bestScore >= minConflictConfidence {
+		analysis := ConflictAnalysis{
+			ConflictType:    best.conflictType,
+			SubType:         best.subType,
+			IsRecoverable:   best.recoverable,
+			Confidence:      bestScore,
+			SuggestedFix:    best.suggestedFix,
+			UserMessage:     best.userMessage,
+			ConflictingPkg:  conflictingPkg,
+			ResolutionSteps: best.steps,
+		}
+
+		// Git vs hosted conflicts carry extra source details for the user
+		if best.conflictType == "git_vs_hosted" {
+			conflictDetails := analyzeGitVsHostedConflict(originalOutput)
+			analysis.SourceConflict = conflictDetails
+			analysis.UserMessage = fmt.Sprintf("Package source conflict: %s", conflictDetails)
+		}
+
+		return analysis
+	}
+
+	// Below threshold: report unknown rather than risk a wrong auto-resolution
+	return ConflictAnalysis{
+		ConflictType:    "unknown",
+		SubType:         "unclassified",
+		IsRecoverable:   false,
+		Confidence:      bestScore,
+		SuggestedFix:    "Manual intervention required",
+		UserMessage:     "Unknown dependency conflict - check pub output for details",
+		ConflictingPkg:  conflictingPkg,
+		ResolutionSteps: []string{"Review full error output", "Check package compatibility", "Manual resolution required"},
+	}
+}
+
+// extractConflictingPackageName attempts to extract the conflicting package name from error output
+// Note: This function uses regex because it parses error messages from dart pub, not YAML files.
+// For YAML parsing, see ListGitDependencies which uses yaml.v3 properly.
+func extractConflictingPackageName(output string) string {
+	// Look for patterns like "because project_name depends on package_name"
+	patterns := []string{
+		// Git vs hosted specific patterns - prioritize these
+		`(\w+) from hosted is required`,
+		`depends on (\w+) from git`,
+		`depends on (\w+) from hosted`,
+		`So, because \w+ depends on (\w+) from`,
+		// General patterns
+		`depends on (\w+) [\^\~]?[\d\.]+`,
+		`package (\w+) from`,
+		`(\w+) from git`,
+		`(\w+) from hosted`,
+		// Fallback patterns
+		`depends on (\w+)`,
+	}
+
+	for _, pattern := range patterns {
+		re := regexp.MustCompile(pattern)
+		if matches := re.FindStringSubmatch(output); len(matches) > 1 {
+			pkg := matches[1]
+			// Skip common project-type words
+			if pkg != "flutter" && pkg != "sdk" && pkg != "dart" {
+				return pkg
+			}
+		}
+	}
+
+	return ""
+}
+
+// analyzeGitVsHostedConflict provides detailed analysis of git vs hosted conflicts
+func analyzeGitVsHostedConflict(output string) string {
+	lines := strings.Split(output, "\n")
+	var conflictLines []string
+
+	for _, line := range lines {
+		lowerLine := strings.ToLower(line)
+		if (strings.Contains(lowerLine, "from git") || strings.Contains(lowerLine, "from hosted")) &&
+			(strings.Contains(lowerLine, "depends on") || strings.Contains(lowerLine, "requires")) {
+			conflictLines = append(conflictLines, strings.TrimSpace(line))
+		}
+	}
+
+	if len(conflictLines) > 0 {
+		return strings.Join(conflictLines, " | ")
+	}
+
+	return "Git vs hosted source conflict detected"
+}
@@ -0,0 +1,135 @@
+// Package core/conflict_analysis_test.go - Conflict Classifier Corpus Tests
+//
+// Fixture-driven tests for analyzeDependencyConflict. The corpus collects
+// real-world dart pub failure outputs across SDK versions and wording
+// variations; each fixture pins the expected conflict type, recoverability
+// and whether the classification clears the confidence threshold. Outputs
+// with too little signal must classify as "unknown" so no auto-resolution is
+// attempted on a guess.
+
+package core
+
+import (
+	"errors"
+	"testing"
+)
+
+// conflictFixture is one captured pub output with its expected classification
+type conflictFixture struct {
+	name          string
+	output        string
+	wantType      string
+	wantRecover   bool
+	wantConfident bool // Whether confidence should clear the threshold
+}
+
+var conflictCorpus = []conflictFixture{
+	{
+		name: "version solving classic dart 2 wording",
+		output: `Because my_app depends on http ^0.13.0 and fancy_pkg >=1.0.0 depends on http ^1.0.0,
+fancy_pkg >=1.0.0 is forbidden.
+So, because my_app depends on fancy_pkg ^1.2.0, version solving failed.`,
+		wantType:      "version",
+		wantRecover:   true,
+		wantConfident: true,
+	},
+	{
+		name:          "version solving dart 3 rephrased wording",
+		output:        `Because my_app depends on intl ^0.19.0 and flutter_localizations depends on intl 0.18.1, solving failed.`,
+		wantType:      "version",
+		wantRecover:   true,
+		wantConfident: true,
+	},
+	{
+		name: "sdk constraint violation",
+		output: `The current Dart SDK version is 3.0.5.
+Because super_pkg 2.0.0 requires SDK version >=3.2.0 <4.0.0, version solving failed.`,
+		wantType:      "sdk",
+		wantRecover:   false,
+		wantConfident: true,
+	},
+	{
+		name:          "platform incompatibility",
+		output:        `Because web_only_pkg doesn't support platform android, version solving failed for the requested platforms.`,
+		wantType:      "platform",
+		wantRecover:   false,
+		wantConfident: true,
+	},
+	{
+		name:          "circular dependency",
+		output:        `Because pkg_a depends on pkg_b and pkg_b depends on pkg_a, there is a dependency cycle.`,
+		wantType:      "circular",
+		wantRecover:   false,
+		wantConfident: true,
+	},
+	{
+		name: "git vs hosted source conflict",
+		output: `Because my_app depends on shared_models from git and other_pkg depends on shared_models from hosted,
+version solving failed.`,
+		wantType:      "git_vs_hosted",
+		wantRecover:   true,
+		wantConfident: true,
+	},
+	{
+		name:          "transitive dependency conflict",
+		output:        `A transitive dependency of fancy_pkg depends on collection ^1.18.0 which is incompatible.`,
+		wantType:      "transitive",
+		wantRecover:   true,
+		wantConfident: true,
+	},
+	{
+		name:          "network error must not classify",
+		output:        `Got socket error trying to find package http at https://pub.dev. Connection timed out.`,
+		wantType:      "unknown",
+		wantRecover:   false,
+		wantConfident: false,
+	},
+	{
+		name:          "empty output must not classify",
+		output:        ``,
+		wantType:      "unknown",
+		wantRecover:   false,
+		wantConfident: false,
+	},
+	{
+		name:          "single weak signal stays unknown",
+		output:        `Resolving dependencies on platform android...`,
+		wantType:      "unknown",
+		wantRecover:   false,
+		wantConfident: false,
+	},
+}
+
+// TestAnalyzeDependencyConflictCorpus runs the classifier against every fixture
+func TestAnalyzeDependencyConflictCorpus(t *testing.T) {
+	for _, fixture := range conflictCorpus {
+		t.Run(fixture.name, func(t *testing.T) {
+			analysis := analyzeDependencyConflict(fixture.output, errors.New("exit status 65"))
+
+			if analysis.ConflictType != fixture.wantType {
+				t.Errorf("ConflictType = %q, want %q (confidence %.2f)", analysis.ConflictType, fixture.wantType, analysis.Confidence)
+			}
+			if analysis.IsRecoverable != fixture.wantRecover {
+				t.Errorf("IsRecoverable = %t, want %t", analysis.IsRecoverable, fixture.wantRecover)
+			}
+			if fixture.wantConfident && analysis.Confidence < minConflictConfidence {
+				t.Errorf("Confidence = %.2f, want >= %.2f", analysis.Confidence, minConflictConfidence)
+			}
+			if !fixture.wantConfident && analysis.Confidence >= minConflictConfidence {
+				t.Errorf("Confidence = %.2f, want < %.2f for unknown fallback", analysis.Confidence, minConflictConfidence)
+			}
+		})
+	}
+}
+
+// TestUnknownConflictNeverRecoverable guards the auto-resolution safety rule:
+// a below-threshold classification must never be marked recoverable
+func TestUnknownConflictNeverRecoverable(t *testing.T) {
+	analysis := analyzeDependencyConflict("some entirely unrelated tool output", nil)
+	if analysis.ConflictType != "unknown" {
+		t.Fatalf("ConflictType = %q, want unknown", analysis.ConflictType)
+	}
+	if analysis.IsRecoverable {
+		t.Error("unknown conflicts must not be auto-resolvable")
+	}
+}
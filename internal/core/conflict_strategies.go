@@ -0,0 +1,253 @@
+// Package core/conflict_strategies.go - Alternative Conflict Resolution Strategies
+//
+// The automatic resolver always pins the conflicting package to `any`
+// (see DefaultOverrideArg), which works but throws away version
+// information. This file computes smarter, selectable alternatives for the
+// conflict resolver screen: pin the conflicting package to the highest
+// pub.dev version compatible with what the project already resolves
+// (via `pub deps --json` with a pubspec.lock fallback), or retry the new
+// package at a different release tag of its repository. All lookups are
+// best-effort - a failed network call just means fewer suggestions.
+//
+// Key features:
+// - SuggestConflictStrategies: Ranked list of concrete ways out of a conflict
+// - resolvedPackageVersion: Currently resolved version from pub deps/lock
+// - fetchPubDevVersions: Published versions of a hosted package
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// OverrideStrategy is one concrete, selectable way out of a dependency
+// conflict. Exactly one of OverrideArg and Ref is set: OverrideArg retries
+// with an inline dependency override, Ref retries the new package at a
+// different git ref.
+type OverrideStrategy struct {
+	Title       string `json:"title"`                  // One-line option label for the resolver screen
+	Detail      string `json:"detail"`                 // Where the suggestion came from
+	OverrideArg string `json:"override_arg,omitempty"` // Inline override argument
+	Ref         string `json:"ref,omitempty"`          // Alternative ref for the new package
+}
+
+// SuggestConflictStrategies computes resolution strategies for a conflict
+// between the package being added (spec) and conflictingPkg, ordered most
+// specific first with the `any` override as the final fallback
+func SuggestConflictStrategies(logger *Logger, projectPath string, spec PkgSpec, conflictingPkg string) []OverrideStrategy {
+	var strategies []OverrideStrategy
+
+	if conflictingPkg != "" {
+		resolved := resolvedPackageVersion(logger, projectPath, conflictingPkg)
+
+		// Highest published version still compatible (same major) with what
+		// the project currently resolves
+		if resolved != "" {
+			if versions, err := fetchPubDevVersions(conflictingPkg); err == nil {
+				if best := highestCompatibleVersion(versions, resolved); best != "" && best != resolved {
+					strategies = append(strategies, OverrideStrategy{
+						Title:       fmt.Sprintf("Pin %s to %s", conflictingPkg, best),
+						Detail:      fmt.Sprintf("highest pub.dev version compatible with resolved %s", resolved),
+						OverrideArg: fmt.Sprintf("override:%s:%s", conflictingPkg, best),
+					})
+				}
+			} else {
+				logger.Debug("conflict", fmt.Sprintf("pub.dev version lookup failed for %s: %v", conflictingPkg, err))
+			}
+
+			strategies = append(strategies, OverrideStrategy{
+				Title:       fmt.Sprintf("Pin %s to ^%s", conflictingPkg, resolved),
+				Detail:      "keep the version the project already resolves",
+				OverrideArg: fmt.Sprintf("override:%s:^%s", conflictingPkg, resolved),
+			})
+		}
+	}
+
+	// Retry the new package at a different release tag of its repository:
+	// the previous tag when the current ref is itself a release, otherwise
+	// the highest available tag
+	if tags, err := gitVersionTags(spec.URL); err == nil && len(tags) > 0 {
+		if previous := previousVersionTag(tags, spec.Ref); previous != "" {
+			strategies = append(strategies, OverrideStrategy{
+				Title:  fmt.Sprintf("Downgrade %s to %s", spec.Name, previous),
+				Detail: fmt.Sprintf("previous release tag before %s", spec.Ref),
+				Ref:    previous,
+			})
+		} else if latest := tags[len(tags)-1]; latest != spec.Ref {
+			strategies = append(strategies, OverrideStrategy{
+				Title:  fmt.Sprintf("Retry %s at release tag %s", spec.Name, latest),
+				Detail: "highest release tag of the repository",
+				Ref:    latest,
+			})
+		}
+	} else if err != nil {
+		logger.Debug("conflict", fmt.Sprintf("tag lookup failed for %s: %v", spec.URL, err))
+	}
+
+	// The current behavior stays available as the final fallback
+	if conflictingPkg != "" {
+		strategies = append(strategies, OverrideStrategy{
+			Title:       fmt.Sprintf("Pin %s to any", conflictingPkg),
+			Detail:      "accept whatever version resolves (current default)",
+			OverrideArg: DefaultOverrideArg(conflictingPkg),
+		})
+	}
+
+	return strategies
+}
+
+// pubDepsJSON models the subset of `pub deps --json` output we read
+type pubDepsJSON struct {
+	Packages []struct {
+		Name    string `json:"name"`
+		Version string `json:"version"`
+	} `json:"packages"`
+}
+
+// resolvedPackageVersion returns the version of a package the project
+// currently resolves, preferring `pub deps --json` and falling back to
+// pubspec.lock; empty when unknown
+func resolvedPackageVersion(logger *Logger, projectPath, pkgName string) string {
+	if tool, err := FindPubTool(); err == nil {
+		cmd := exec.Command(tool, "pub", "deps", "--json")
+		cmd.Dir = projectPath
+		ApplyProjectEnv(logger, cmd)
+		if output, err := cmd.Output(); err == nil {
+			var deps pubDepsJSON
+			if err := json.Unmarshal(output, &deps); err == nil {
+				for _, pkg := range deps.Packages {
+					if pkg.Name == pkgName && pkg.Version != "" {
+						return pkg.Version
+					}
+				}
+			}
+		}
+	}
+
+	lock, err := parsePubspecLock(filepath.Join(projectPath, "pubspec.lock"))
+	if err != nil {
+		return ""
+	}
+	if dep, ok := lock.Dependencies[pkgName]; ok {
+		return dep.Version
+	}
+	return ""
+}
+
+// pubDevVersionsResponse models GET https://pub.dev/api/packages/<name>
+type pubDevVersionsResponse struct {
+	Versions []struct {
+		Version string `json:"version"`
+	} `json:"versions"`
+}
+
+// fetchPubDevVersions returns the published versions of a hosted package,
+// sorted ascending by version
+func fetchPubDevVersions(packageName string) ([]string, error) {
+	packageURL := fmt.Sprintf("https://pub.dev/api/packages/%s", packageName)
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(packageURL)
+	if err != nil {
+		return nil, fmt.Errorf("pub.dev request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pub.dev returned HTTP %d for %s", resp.StatusCode, packageName)
+	}
+
+	var parsed pubDevVersionsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse pub.dev response: %w", err)
+	}
+
+	versions := make([]string, 0, len(parsed.Versions))
+	for _, v := range parsed.Versions {
+		if versionTagParts(v.Version) != nil {
+			versions = append(versions, v.Version)
+		}
+	}
+	sort.Slice(versions, func(i, j int) bool {
+		return compareVersionTags(versions[i], versions[j]) < 0
+	})
+	return versions, nil
+}
+
+// highestCompatibleVersion returns the highest version sharing the resolved
+// version's major (caret-compatible); empty when none qualifies
+func highestCompatibleVersion(versions []string, resolved string) string {
+	resolvedParts := versionTagParts(resolved)
+	if resolvedParts == nil {
+		return ""
+	}
+
+	best := ""
+	for _, version := range versions {
+		parts := versionTagParts(version)
+		if parts == nil || parts[0] != resolvedParts[0] {
+			continue
+		}
+		// Skip pre-releases of other versions; exact resolved is fine
+		if strings.ContainsAny(version, "-+") && version != resolved {
+			continue
+		}
+		if best == "" || compareVersionTags(version, best) > 0 {
+			best = version
+		}
+	}
+	return best
+}
+
+// gitVersionTags returns a repository's version-shaped tags sorted
+// ascending, resolved via git ls-remote so no clone is needed
+func gitVersionTags(url string) ([]string, error) {
+	cmd := exec.Command("git", "ls-remote", "--tags", "--refs", url)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-remote --tags failed: %w", err)
+	}
+
+	var tags []string
+	for _, line := range strings.Split(string(output), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		tag := strings.TrimPrefix(fields[1], "refs/tags/")
+		if versionTagParts(tag) != nil {
+			tags = append(tags, tag)
+		}
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return compareVersionTags(tags[i], tags[j]) < 0
+	})
+	return tags, nil
+}
+
+// previousVersionTag returns the highest tag strictly below the current
+// ref, or empty when the ref is not version-shaped or has no predecessor
+func previousVersionTag(tags []string, currentRef string) string {
+	if versionTagParts(currentRef) == nil {
+		return ""
+	}
+
+	previous := ""
+	for _, tag := range tags {
+		if compareVersionTags(tag, currentRef) < 0 {
+			previous = tag
+		}
+	}
+	return previous
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+)
+
+// TestHighestCompatibleVersion verifies major-version matching and
+// pre-release filtering
+func TestHighestCompatibleVersion(t *testing.T) {
+	versions := []string{"1.0.0", "1.2.0", "1.5.3", "2.0.0", "2.1.0", "1.6.0-beta"}
+
+	if got := highestCompatibleVersion(versions, "1.2.0"); got != "1.5.3" {
+		t.Errorf("expected 1.5.3 for resolved 1.2.0, got %q", got)
+	}
+	if got := highestCompatibleVersion(versions, "2.0.0"); got != "2.1.0" {
+		t.Errorf("expected 2.1.0 for resolved 2.0.0, got %q", got)
+	}
+	// Non-version-shaped resolved yields nothing
+	if got := highestCompatibleVersion(versions, "main"); got != "" {
+		t.Errorf("expected empty for non-version resolved, got %q", got)
+	}
+	// No version shares the major
+	if got := highestCompatibleVersion([]string{"3.0.0"}, "1.0.0"); got != "" {
+		t.Errorf("expected empty when no major matches, got %q", got)
+	}
+}
+
+// TestPreviousVersionTag verifies predecessor lookup and branch refs
+func TestPreviousVersionTag(t *testing.T) {
+	tags := []string{"v1.0.0", "v1.1.0", "v2.0.0"}
+
+	if got := previousVersionTag(tags, "v2.0.0"); got != "v1.1.0" {
+		t.Errorf("expected v1.1.0 before v2.0.0, got %q", got)
+	}
+	if got := previousVersionTag(tags, "v1.0.0"); got != "" {
+		t.Errorf("expected no predecessor for v1.0.0, got %q", got)
+	}
+	// Branch refs are not version-shaped, so no downgrade is suggested
+	if got := previousVersionTag(tags, "main"); got != "" {
+		t.Errorf("expected empty for branch ref, got %q", got)
+	}
+}
+
+// TestSuggestConflictStrategiesFallback verifies the offline fallback: with
+// no resolvable version and no reachable repository, only the `any`
+// override remains
+func TestSuggestConflictStrategiesFallback(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+	spec := PkgSpec{
+		Name: "my_pkg",
+		URL:  t.TempDir() + "/no-such-repo.git",
+		Ref:  "main",
+	}
+
+	strategies := SuggestConflictStrategies(logger, t.TempDir(), spec, "http")
+	if len(strategies) != 1 {
+		t.Fatalf("expected only the fallback strategy, got %v", strategies)
+	}
+	if strategies[0].OverrideArg != DefaultOverrideArg("http") {
+		t.Errorf("fallback should use the default override, got %q", strategies[0].OverrideArg)
+	}
+}
@@ -0,0 +1,56 @@
+// Package core/context.go - Context Defaults for Long-Running Operations
+//
+// Git clones, pub commands and GitHub listings shell out to external tools
+// that can hang indefinitely on a bad network. The context-aware variants of
+// those operations (GitCloneContext, AddGitDependencyContext, SyncContext,
+// ListGitHubReposContext) use these helpers to apply a sensible default
+// timeout when the caller hasn't set a deadline, and to turn context
+// failures into readable messages. Child processes started through
+// exec.CommandContext are killed when the context is cancelled, so aborting
+// from the UI actually stops the work instead of orphaning it.
+//
+// Key features:
+// - Default timeouts per operation class (git, pub, listing)
+// - withDefaultTimeout: Apply a fallback deadline without overriding the caller's
+// - contextFailure: Map cancellation/timeout to an actionable message
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Default deadlines applied when the caller's context has none. Clones and
+// pub resolutions can legitimately take minutes on large repos; listings
+// should never take that long.
+const (
+	DefaultGitTimeout  = 10 * time.Minute
+	DefaultPubTimeout  = 10 * time.Minute
+	DefaultListTimeout = 60 * time.Second
+)
+
+// withDefaultTimeout returns a context with the given timeout unless the
+// caller already set a deadline, in which case the context is returned
+// unchanged (with a no-op cancel so callers can always defer it)
+func withDefaultTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := ctx.Deadline(); ok {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// contextFailure turns a command error into a readable message when the
+// context caused it: cancellation reads as an abort and a deadline as a
+// timeout. Other errors pass through unchanged.
+func contextFailure(ctx context.Context, operation string, err error) string {
+	switch {
+	case errors.Is(ctx.Err(), context.Canceled):
+		return fmt.Sprintf("%s aborted", operation)
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return fmt.Sprintf("%s timed out", operation)
+	}
+	return err.Error()
+}
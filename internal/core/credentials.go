@@ -0,0 +1,244 @@
+// Package core/credentials.go - Per-Host Git Credential Scheme Selection
+//
+// Private git dependencies fail in confusing ways when the URL scheme
+// doesn't match the credentials at hand: an https:// URL prompts for a
+// username when only SSH keys are set up, and a git@ URL gets "permission
+// denied" when only a token is configured. This file picks the right
+// scheme per host (explicit config > configured SSH identity > GitHub
+// token > ambient SSH setup), rewrites dependency URLs to match, and
+// validates access with `git ls-remote` before anything is written to
+// pubspec.yaml, so auth problems surface as clear errors up front.
+//
+// Example config (global config.yaml or project .flutter-pm.yaml):
+//
+//	credentials:
+//	  hosts:
+//	    github.com: ssh
+//	    gitlab.example.com: https
+//
+// Key features:
+// - ResolveCredentialScheme: Pick ssh or https for a host
+// - RewriteGitURLScheme: Convert between git@host: and https://host/ forms
+// - ValidateDependencyAccess: Pre-flight ls-remote with clear auth errors
+// - PrepareDependencyURL: Rewrite, validate, fall back to the other scheme
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// CredentialScheme names how a host should be accessed
+type CredentialScheme string
+
+const (
+	CredSchemeSSH   CredentialScheme = "ssh"
+	CredSchemeHTTPS CredentialScheme = "https"
+)
+
+// credsFileYAML models a config file containing a `credentials:` section
+// (both the global config and the per-project .flutter-pm.yaml use the
+// same schema, mirroring the ssh section)
+type credsFileYAML struct {
+	Credentials struct {
+		Hosts map[string]string `yaml:"hosts"`
+	} `yaml:"credentials"`
+}
+
+// loadCredentialHosts parses the credentials section of a config file; a
+// missing file returns an empty map without error
+func loadCredentialHosts(path string) map[string]string {
+	if path == "" {
+		return nil
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var parsed credsFileYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+	return parsed.Credentials.Hosts
+}
+
+// ResolveCredentialScheme picks the URL scheme for a host. Explicit config
+// wins (project over global), then a configured SSH identity, then a
+// GitHub token, then the ambient SSH setup; https is the final default.
+func ResolveCredentialScheme(projectPath, host string) CredentialScheme {
+	// Explicit configuration wins, project over global
+	if projectPath != "" {
+		if scheme, ok := schemeFromConfig(loadCredentialHosts(filepath.Join(projectPath, ProjectEnvFileName)), host); ok {
+			return scheme
+		}
+	}
+	if scheme, ok := schemeFromConfig(loadCredentialHosts(GlobalConfigPath()), host); ok {
+		return scheme
+	}
+
+	// An SSH identity configured for the host implies SSH
+	if !ResolveSSHIdentity(projectPath, host).isZero() {
+		return CredSchemeSSH
+	}
+
+	// A GitHub token implies HTTPS for github.com
+	if host == "github.com" && GitHubToken() != "" {
+		return CredSchemeHTTPS
+	}
+
+	// A token stored in the OS keychain for any other host implies HTTPS
+	// for that host (see core/keychain.go)
+	if LoadKeychainToken(host) != "" {
+		return CredSchemeHTTPS
+	}
+
+	// Ambient SSH setup (agent or default key) implies SSH
+	if os.Getenv("SSH_AUTH_SOCK") != "" || defaultSSHKeyExists() {
+		return CredSchemeSSH
+	}
+
+	return CredSchemeHTTPS
+}
+
+// schemeFromConfig reads a host's scheme from a credentials map, accepting
+// only the two known values
+func schemeFromConfig(hosts map[string]string, host string) (CredentialScheme, bool) {
+	switch strings.ToLower(strings.TrimSpace(hosts[host])) {
+	case "ssh":
+		return CredSchemeSSH, true
+	case "https":
+		return CredSchemeHTTPS, true
+	}
+	return "", false
+}
+
+// defaultSSHKeyExists reports whether one of the standard key files is
+// present in ~/.ssh
+func defaultSSHKeyExists() bool {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return false
+	}
+	for _, name := range []string{"id_ed25519", "id_rsa", "id_ecdsa"} {
+		if _, err := os.Stat(filepath.Join(homeDir, ".ssh", name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// RewriteGitURLScheme converts a git URL to the given scheme, preserving
+// the path and .git suffix. URLs already in the target scheme (or in a
+// form we don't recognize) are returned unchanged.
+func RewriteGitURLScheme(gitURL string, scheme CredentialScheme) string {
+	host := gitURLHost(gitURL)
+	if host == "" {
+		return gitURL
+	}
+
+	switch scheme {
+	case CredSchemeSSH:
+		if strings.HasPrefix(gitURL, "https://") || strings.HasPrefix(gitURL, "http://") {
+			trimmed := strings.TrimPrefix(strings.TrimPrefix(gitURL, "https://"), "http://")
+			if idx := strings.Index(trimmed, "/"); idx > 0 && idx < len(trimmed)-1 {
+				return "git@" + host + ":" + trimmed[idx+1:]
+			}
+		}
+	case CredSchemeHTTPS:
+		if strings.HasPrefix(gitURL, "git@") {
+			rest := strings.TrimPrefix(gitURL, "git@")
+			if idx := strings.Index(rest, ":"); idx > 0 && idx < len(rest)-1 {
+				return "https://" + host + "/" + rest[idx+1:]
+			}
+		}
+	}
+	return gitURL
+}
+
+// ValidateDependencyAccess verifies that a git URL is reachable with the
+// current credentials by running `git ls-remote`. Terminal prompts are
+// disabled so a missing HTTPS credential fails fast instead of hanging.
+func ValidateDependencyAccess(logger *Logger, projectPath, gitURL string) error {
+	cmd := exec.Command("git", "ls-remote", gitURL, "HEAD")
+	cmd.Dir = projectPath
+	ApplySSHIdentity(logger, cmd, gitURL)
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, "GIT_TERMINAL_PROMPT=0")
+
+	output, err := cmd.CombinedOutput()
+	if err == nil {
+		return nil
+	}
+
+	host := gitURLHost(gitURL)
+	return fmt.Errorf("%s", classifyGitAccessError(host, gitURL, string(output)))
+}
+
+// classifyGitAccessError turns raw ls-remote output into an actionable
+// message naming the credential that is missing or wrong
+func classifyGitAccessError(host, gitURL, output string) string {
+	lower := strings.ToLower(output)
+
+	switch {
+	case strings.Contains(lower, "permission denied (publickey)"):
+		return fmt.Sprintf("SSH authentication to %s failed (permission denied) - add your key to the agent or configure an identity for this host", host)
+	case strings.Contains(lower, "could not read username"), strings.Contains(lower, "authentication failed"), strings.Contains(lower, "invalid username or password"):
+		return fmt.Sprintf("HTTPS authentication to %s failed - configure a token (GITHUB_TOKEN or the sign-in screen) or switch this host to SSH", host)
+	case strings.Contains(lower, "repository not found"), strings.Contains(lower, "not found"):
+		return fmt.Sprintf("repository %s was not found - check the URL or your access rights", gitURL)
+	case strings.Contains(lower, "could not resolve host"):
+		return fmt.Sprintf("could not resolve host %s - check your network connection", host)
+	}
+
+	return fmt.Sprintf("git ls-remote for %s failed: %s", gitURL, strings.TrimSpace(output))
+}
+
+// PrepareDependencyURL rewrites a dependency URL to the scheme matching
+// the host's credentials and validates access before anything is written.
+// If the preferred scheme fails validation, the other scheme is tried
+// before giving up. skipValidate keeps the rewrite but skips the network
+// check (used for dry runs).
+func PrepareDependencyURL(logger *Logger, projectPath, gitURL string, skipValidate bool) (string, error) {
+	host := gitURLHost(gitURL)
+	if host == "" {
+		return gitURL, nil
+	}
+
+	scheme := ResolveCredentialScheme(projectPath, host)
+	primary := RewriteGitURLScheme(gitURL, scheme)
+	if primary != gitURL {
+		logger.Info("credentials", fmt.Sprintf("Rewrote %s to %s (%s credentials configured for %s)", gitURL, primary, scheme, host))
+	}
+
+	if skipValidate {
+		return primary, nil
+	}
+
+	primaryErr := ValidateDependencyAccess(logger, projectPath, primary)
+	if primaryErr == nil {
+		return primary, nil
+	}
+
+	// Try the other scheme before giving up - the detection is heuristic
+	// and the other credential may well work
+	other := CredSchemeHTTPS
+	if scheme == CredSchemeHTTPS {
+		other = CredSchemeSSH
+	}
+	if alt := RewriteGitURLScheme(gitURL, other); alt != primary {
+		if err := ValidateDependencyAccess(logger, projectPath, alt); err == nil {
+			logger.Info("credentials", fmt.Sprintf("%s access to %s failed, using %s instead: %s", scheme, host, other, alt))
+			return alt, nil
+		}
+	}
+
+	return "", primaryErr
+}
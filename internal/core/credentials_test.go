@@ -0,0 +1,78 @@
+// Package core/credentials_test.go - Tests for credential scheme handling
+//
+// Covers the pure parts of the credentials subsystem: URL scheme rewriting
+// and auth error classification. Scheme resolution and ls-remote
+// validation depend on the local environment and are exercised manually.
+
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRewriteGitURLScheme(t *testing.T) {
+	tests := []struct {
+		url    string
+		scheme CredentialScheme
+		want   string
+	}{
+		// HTTPS -> SSH
+		{"https://github.com/owner/repo.git", CredSchemeSSH, "git@github.com:owner/repo.git"},
+		{"https://gitlab.example.com/group/sub/repo.git", CredSchemeSSH, "git@gitlab.example.com:group/sub/repo.git"},
+		// SSH -> HTTPS
+		{"git@github.com:owner/repo.git", CredSchemeHTTPS, "https://github.com/owner/repo.git"},
+		// Already in the target scheme - unchanged
+		{"https://github.com/owner/repo.git", CredSchemeHTTPS, "https://github.com/owner/repo.git"},
+		{"git@github.com:owner/repo.git", CredSchemeSSH, "git@github.com:owner/repo.git"},
+	}
+
+	for _, tt := range tests {
+		if got := RewriteGitURLScheme(tt.url, tt.scheme); got != tt.want {
+			t.Errorf("RewriteGitURLScheme(%q, %q) = %q, want %q", tt.url, tt.scheme, got, tt.want)
+		}
+	}
+}
+
+func TestRewriteGitURLSchemeRoundTrip(t *testing.T) {
+	original := "https://github.com/owner/repo.git"
+	ssh := RewriteGitURLScheme(original, CredSchemeSSH)
+	back := RewriteGitURLScheme(ssh, CredSchemeHTTPS)
+	if back != original {
+		t.Errorf("Round trip changed URL: %q -> %q -> %q", original, ssh, back)
+	}
+}
+
+func TestClassifyGitAccessError(t *testing.T) {
+	sshMsg := classifyGitAccessError("github.com", "git@github.com:o/r.git", "git@github.com: Permission denied (publickey).")
+	if !strings.Contains(sshMsg, "SSH authentication") {
+		t.Errorf("Expected SSH auth message, got %q", sshMsg)
+	}
+
+	httpsMsg := classifyGitAccessError("github.com", "https://github.com/o/r.git", "fatal: could not read Username for 'https://github.com'")
+	if !strings.Contains(httpsMsg, "HTTPS authentication") {
+		t.Errorf("Expected HTTPS auth message, got %q", httpsMsg)
+	}
+
+	notFound := classifyGitAccessError("github.com", "https://github.com/o/r.git", "ERROR: Repository not found.")
+	if !strings.Contains(notFound, "not found") {
+		t.Errorf("Expected not-found message, got %q", notFound)
+	}
+}
+
+func TestSchemeFromConfig(t *testing.T) {
+	hosts := map[string]string{"github.com": "SSH", "gitlab.com": "https", "bad.example": "token"}
+
+	if scheme, ok := schemeFromConfig(hosts, "github.com"); !ok || scheme != CredSchemeSSH {
+		t.Errorf("Expected ssh for github.com, got %q (ok=%v)", scheme, ok)
+	}
+	if scheme, ok := schemeFromConfig(hosts, "gitlab.com"); !ok || scheme != CredSchemeHTTPS {
+		t.Errorf("Expected https for gitlab.com, got %q (ok=%v)", scheme, ok)
+	}
+	if _, ok := schemeFromConfig(hosts, "bad.example"); ok {
+		t.Error("Expected unknown scheme value to be rejected")
+	}
+	if _, ok := schemeFromConfig(hosts, "missing.example"); ok {
+		t.Error("Expected missing host to be rejected")
+	}
+}
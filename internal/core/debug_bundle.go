@@ -0,0 +1,114 @@
+// Package core/debug_bundle.go - Pub Resolver Output Capture and Replay
+//
+// This file implements a debug capture mode for failed pub operations. When
+// debug mode is active, the full pub command inputs and outputs of a failed
+// add operation are saved as a JSON bundle under
+// ~/.config/flutter-pm/debug/. A saved bundle can later be replayed through
+// analyzeDependencyConflict, so parser fixes can be made from user-submitted
+// reports without reproducing their environment.
+//
+// Key features:
+// - CaptureDebugBundle: Save command, output and analysis of a failed pub add
+// - LoadDebugBundle: Read a saved bundle back from disk
+// - ReplayDebugBundle: Re-run conflict analysis against a saved bundle
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DebugBundle is a replayable record of a failed pub command
+type DebugBundle struct {
+	CapturedAt  time.Time        `json:"captured_at"`
+	Tool        string           `json:"tool"`
+	Args        []string         `json:"args"`
+	ProjectPath string           `json:"project_path"`
+	Spec        PkgSpec          `json:"spec"`
+	ExitError   string           `json:"exit_error"`
+	Output      string           `json:"output"`
+	Analysis    ConflictAnalysis `json:"analysis"` // Analysis at capture time
+}
+
+// DebugBundleDir returns the directory where debug bundles are stored
+func DebugBundleDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".config", "flutter-pm", "debug")
+}
+
+// CaptureDebugBundle saves a failed pub command as a replayable bundle.
+// Capture only runs in debug mode and never fails the surrounding operation -
+// problems are logged and swallowed.
+func CaptureDebugBundle(logger *Logger, cfg *Config, bundle DebugBundle) {
+	if !cfg.Debug {
+		return
+	}
+
+	dir := DebugBundleDir()
+	if dir == "" {
+		return
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		logger.Debug("debug", fmt.Sprintf("Failed to create debug bundle directory: %v", err))
+		return
+	}
+
+	bundle.CapturedAt = time.Now()
+	path := filepath.Join(dir, fmt.Sprintf("pub-failure-%s.json", bundle.CapturedAt.Format("20060102-150405")))
+
+	content, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		logger.Debug("debug", fmt.Sprintf("Failed to encode debug bundle: %v", err))
+		return
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		logger.Debug("debug", fmt.Sprintf("Failed to write debug bundle: %v", err))
+		return
+	}
+
+	logger.Info("debug", fmt.Sprintf("Saved debug bundle: %s (replay with 'flutter-pm replay %s')", path, path))
+}
+
+// LoadDebugBundle reads a saved debug bundle from disk
+func LoadDebugBundle(path string) (*DebugBundle, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read debug bundle: %w", err)
+	}
+
+	var bundle DebugBundle
+	if err := json.Unmarshal(content, &bundle); err != nil {
+		return nil, fmt.Errorf("failed to parse debug bundle: %w", err)
+	}
+
+	return &bundle, nil
+}
+
+// ReplayDebugBundle re-runs conflict analysis against a saved bundle,
+// returning both the bundle (with its recorded analysis) and the fresh
+// analysis from the current parser
+func ReplayDebugBundle(logger *Logger, path string) (*DebugBundle, ConflictAnalysis, error) {
+	bundle, err := LoadDebugBundle(path)
+	if err != nil {
+		return nil, ConflictAnalysis{}, err
+	}
+
+	var cmdErr error
+	if bundle.ExitError != "" {
+		cmdErr = errors.New(bundle.ExitError)
+	}
+
+	logger.Debug("debug", fmt.Sprintf("Replaying conflict analysis for bundle captured at %s", bundle.CapturedAt.Format(time.RFC3339)))
+	analysis := analyzeDependencyConflict(bundle.Output, cmdErr)
+	return bundle, analysis, nil
+}
@@ -0,0 +1,164 @@
+// Package core/diagnostics.go - Environment Diagnostics
+//
+// This file implements the environment diagnostics behind `flutter-pm doctor`
+// and the TUI "Environment" screen. Beyond the basic tool presence covered by
+// prerequisites.go, it checks git/dart/flutter versions, gh authentication
+// state, network reachability to github.com and pub.dev, and write permission
+// in the project directory - each failed check carrying an actionable remedy
+// the caller can print verbatim.
+
+package core
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// DiagnosticCheck is one environment check with its outcome and, on failure,
+// a concrete remediation step
+type DiagnosticCheck struct {
+	Name   string `json:"name"`
+	OK     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+	Remedy string `json:"remedy,omitempty"`
+}
+
+// RunEnvironmentDiagnostics runs all environment checks in order. It never
+// fails as a whole - every check reports its own outcome so the full picture
+// is available even on a badly broken machine.
+func RunEnvironmentDiagnostics(logger *Logger, projectPath string) []DiagnosticCheck {
+	checks := []DiagnosticCheck{
+		checkGitVersion(),
+		checkDartFlutter(),
+		checkGhAuth(),
+		checkReachable("Network: github.com", "https://github.com", "GitHub listing and cloning need github.com - check your connection, VPN or proxy settings"),
+		checkReachable("Network: pub.dev", "https://pub.dev", "pub add/get resolve hosted packages from pub.dev - check your connection, VPN or proxy settings"),
+		checkWritePermission(projectPath),
+	}
+
+	for _, check := range checks {
+		logger.Debug("diagnostics", fmt.Sprintf("%s: ok=%t (%s)", check.Name, check.OK, check.Detail))
+	}
+	return checks
+}
+
+// installRemedy renders the preferred install command for a prerequisite on
+// the current platform
+func installRemedy(name string) string {
+	prereq := findPrerequisite(name)
+	if prereq == nil {
+		return ""
+	}
+	if cmds, ok := prereq.InstallCmds[runtime.GOOS]; ok && len(cmds) > 0 {
+		return fmt.Sprintf("Install %s: %s (or run 'flutter-pm install')", name, cmds[0])
+	}
+	return fmt.Sprintf("Install %s: visit %s", name, prereq.InstallURL)
+}
+
+// checkGitVersion verifies git is present and reports its version
+func checkGitVersion() DiagnosticCheck {
+	check := DiagnosticCheck{Name: "Git"}
+
+	output, err := exec.Command("git", "--version").Output()
+	if err != nil {
+		check.Remedy = installRemedy("Git")
+		check.Detail = "git not found in PATH - all repository operations depend on it"
+		return check
+	}
+
+	check.OK = true
+	check.Detail = firstLine(string(output))
+	return check
+}
+
+// checkDartFlutter verifies at least one SDK is present and reports versions
+func checkDartFlutter() DiagnosticCheck {
+	check := DiagnosticCheck{Name: "Dart/Flutter SDK"}
+
+	var versions []string
+	for _, tool := range []string{"flutter", "dart"} {
+		if output, err := exec.Command(tool, "--version").CombinedOutput(); err == nil {
+			versions = append(versions, firstLine(string(output)))
+		}
+	}
+
+	if len(versions) == 0 {
+		check.Detail = "neither flutter nor dart found in PATH - pub commands cannot run"
+		check.Remedy = installRemedy("Flutter")
+		return check
+	}
+
+	check.OK = true
+	check.Detail = strings.Join(versions, "; ")
+	return check
+}
+
+// checkGhAuth verifies the GitHub CLI is present and authenticated
+func checkGhAuth() DiagnosticCheck {
+	check := DiagnosticCheck{Name: "GitHub CLI auth"}
+
+	if _, err := exec.LookPath("gh"); err != nil {
+		check.Detail = "gh not found in PATH - GitHub browsing falls back to stored tokens"
+		check.Remedy = installRemedy("GitHub CLI")
+		return check
+	}
+
+	if output, err := exec.Command("gh", "auth", "status").CombinedOutput(); err != nil {
+		check.Detail = "gh is installed but not signed in"
+		check.Remedy = "Run 'gh auth login' to authenticate with GitHub"
+		if line := firstLine(string(output)); line != "" {
+			check.Detail = fmt.Sprintf("gh is installed but not signed in (%s)", line)
+		}
+		return check
+	}
+
+	check.OK = true
+	check.Detail = "gh is installed and authenticated"
+	return check
+}
+
+// checkReachable verifies an HTTPS endpoint answers within a short timeout.
+// Any HTTP response counts as reachable - only transport errors fail.
+func checkReachable(name, url, remedy string) DiagnosticCheck {
+	check := DiagnosticCheck{Name: name}
+
+	client := &http.Client{Timeout: 5 * time.Second}
+	resp, err := client.Head(url)
+	if err != nil {
+		check.Detail = fmt.Sprintf("%s is not reachable: %v", url, err)
+		check.Remedy = remedy
+		return check
+	}
+	resp.Body.Close()
+
+	check.OK = true
+	check.Detail = fmt.Sprintf("%s reachable (HTTP %d)", url, resp.StatusCode)
+	return check
+}
+
+// checkWritePermission verifies the project directory accepts new files,
+// which backups and pubspec edits both require
+func checkWritePermission(projectPath string) DiagnosticCheck {
+	check := DiagnosticCheck{Name: "Write permission"}
+	if projectPath == "" {
+		projectPath = "."
+	}
+
+	probe, err := os.CreateTemp(projectPath, ".flutter-pm-write-check-*")
+	if err != nil {
+		check.Detail = fmt.Sprintf("cannot write in %s: %v", projectPath, err)
+		check.Remedy = "Run flutter-pm from a directory you own, or fix the directory permissions"
+		return check
+	}
+	probe.Close()
+	os.Remove(probe.Name())
+
+	check.OK = true
+	check.Detail = fmt.Sprintf("%s is writable", projectPath)
+	return check
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestCheckGitVersion(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	check := checkGitVersion()
+	if !check.OK {
+		t.Fatalf("git check failed with git on PATH: %+v", check)
+	}
+	if !strings.Contains(check.Detail, "git version") {
+		t.Errorf("detail should carry the version line, got %q", check.Detail)
+	}
+}
+
+func TestCheckReachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	check := checkReachable("Network: test", server.URL, "fix the network")
+	if !check.OK {
+		t.Fatalf("local test server should be reachable: %+v", check)
+	}
+
+	// A closed server fails with the remedy attached
+	dead := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	deadURL := dead.URL
+	dead.Close()
+
+	check = checkReachable("Network: test", deadURL, "fix the network")
+	if check.OK {
+		t.Fatal("closed server should not be reachable")
+	}
+	if check.Remedy != "fix the network" {
+		t.Errorf("remedy = %q, want the caller's remediation", check.Remedy)
+	}
+}
+
+func TestCheckWritePermission(t *testing.T) {
+	check := checkWritePermission(t.TempDir())
+	if !check.OK {
+		t.Fatalf("temp dir should be writable: %+v", check)
+	}
+
+	check = checkWritePermission("/definitely/not/a/real/directory")
+	if check.OK {
+		t.Fatal("nonexistent directory should fail the write check")
+	}
+	if check.Remedy == "" {
+		t.Error("failed write check should carry a remedy")
+	}
+}
@@ -0,0 +1,192 @@
+// Package core/diffpreview.go - Pubspec Change Preview
+//
+// This file computes the exact pubspec.yaml change a set of package specs
+// will produce, before anything is written. The current file is loaded into
+// the comment-preserving YAML editor (see core/pubspec_editor.go), the
+// dependencies are applied in memory, and the result is rendered as a
+// unified diff. The confirmation screen shows this diff so the user sees
+// precisely what will be written, not just a package list.
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// PreviewPubspecDiff returns the unified diff pubspec.yaml would undergo if
+// the given specs were applied. An empty string means no textual change.
+//
+// The preview uses the YAML editor rather than `pub add` itself, so the
+// exact byte layout may differ slightly from what pub writes - but the
+// structural change (which dependencies appear with which url/ref/subdir)
+// is the same.
+func PreviewPubspecDiff(projectPath string, specs []PkgSpec) (string, error) {
+	if len(specs) == 0 {
+		return "", nil
+	}
+
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	original, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read pubspec.yaml: %w", err)
+	}
+
+	editor, err := NewPubspecEditorFromBytes(original)
+	if err != nil {
+		return "", err
+	}
+	for _, spec := range specs {
+		editor.SetGitDependency(spec)
+	}
+
+	modified, err := editor.Bytes()
+	if err != nil {
+		return "", err
+	}
+
+	return UnifiedDiff("pubspec.yaml", original, modified), nil
+}
+
+// diffOp is one line of a computed diff: ' ' context, '-' removal, '+' addition
+type diffOp struct {
+	kind byte
+	text string
+}
+
+// UnifiedDiff renders a standard unified diff (3 lines of context) between
+// two file contents. Identical contents return an empty string.
+func UnifiedDiff(name string, original, modified []byte) string {
+	if string(original) == string(modified) {
+		return ""
+	}
+
+	ops := diffLines(splitDiffLines(original), splitDiffLines(modified))
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("--- %s (current)\n", name))
+	b.WriteString(fmt.Sprintf("+++ %s (after)\n", name))
+
+	const context = 3
+	origLine, modLine := 1, 1
+	i := 0
+	for i < len(ops) {
+		// Skip unchanged runs between hunks
+		if ops[i].kind == ' ' {
+			origLine++
+			modLine++
+			i++
+			continue
+		}
+
+		// Found a change: the hunk starts up to `context` lines earlier and
+		// extends until `context` unchanged lines separate it from the next
+		// change
+		start := i
+		for back := 0; back < context && start > 0 && ops[start-1].kind == ' '; back++ {
+			start--
+			origLine--
+			modLine--
+		}
+
+		end := i
+		unchanged := 0
+		for j := i; j < len(ops); j++ {
+			if ops[j].kind == ' ' {
+				unchanged++
+				if unchanged > context*2 {
+					break
+				}
+			} else {
+				unchanged = 0
+				end = j
+			}
+		}
+		stop := end + 1
+		for trail := 0; trail < context && stop < len(ops) && ops[stop].kind == ' '; trail++ {
+			stop++
+		}
+
+		origCount, modCount := 0, 0
+		for _, op := range ops[start:stop] {
+			if op.kind != '+' {
+				origCount++
+			}
+			if op.kind != '-' {
+				modCount++
+			}
+		}
+
+		b.WriteString(fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", origLine, origCount, modLine, modCount))
+		for _, op := range ops[start:stop] {
+			b.WriteString(string(op.kind) + op.text + "\n")
+			if op.kind != '+' {
+				origLine++
+			}
+			if op.kind != '-' {
+				modLine++
+			}
+		}
+
+		i = stop
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// splitDiffLines splits file content into lines without a trailing phantom
+// line for the final newline
+func splitDiffLines(content []byte) []string {
+	lines := strings.Split(string(content), "\n")
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes a line-level diff via the classic LCS table. Pubspec
+// files are small, so the quadratic table is perfectly fine here.
+func diffLines(original, modified []string) []diffOp {
+	n, m := len(original), len(modified)
+	lcs := make([][]int, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if original[i] == modified[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var ops []diffOp
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case original[i] == modified[j]:
+			ops = append(ops, diffOp{' ', original[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{'-', original[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', modified[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		ops = append(ops, diffOp{'-', original[i]})
+	}
+	for ; j < m; j++ {
+		ops = append(ops, diffOp{'+', modified[j]})
+	}
+	return ops
+}
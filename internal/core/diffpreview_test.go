@@ -0,0 +1,90 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	original := []byte("name: app\ndependencies:\n  flutter:\n    sdk: flutter\n")
+	modified := []byte("name: app\ndependencies:\n  flutter:\n    sdk: flutter\n  my_pkg:\n    git:\n      url: https://github.com/owner/my_pkg.git\n")
+
+	diff := UnifiedDiff("pubspec.yaml", original, modified)
+	for _, want := range []string{
+		"--- pubspec.yaml (current)",
+		"+++ pubspec.yaml (after)",
+		"+  my_pkg:",
+		"+      url: https://github.com/owner/my_pkg.git",
+	} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+	if strings.Contains(diff, "-name: app") {
+		t.Errorf("unchanged line marked as removed:\n%s", diff)
+	}
+
+	// Identical contents produce no diff at all
+	if diff := UnifiedDiff("pubspec.yaml", original, original); diff != "" {
+		t.Errorf("identical contents should diff empty, got:\n%s", diff)
+	}
+}
+
+func TestUnifiedDiffContext(t *testing.T) {
+	var orig, mod strings.Builder
+	for i := 0; i < 20; i++ {
+		line := "line" + string(rune('a'+i)) + "\n"
+		orig.WriteString(line)
+		mod.WriteString(line)
+	}
+	mod.WriteString("extra\n")
+
+	diff := UnifiedDiff("file", []byte(orig.String()), []byte(mod.String()))
+
+	// Only the 3 context lines before the addition appear, not the whole file
+	if strings.Contains(diff, " linea") {
+		t.Errorf("far-away context should be omitted:\n%s", diff)
+	}
+	for _, want := range []string{" liner", " lines", " linet", "+extra"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+}
+
+func TestPreviewPubspecDiff(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, `name: test_app
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  flutter:
+    sdk: flutter
+`)
+
+	specs := []PkgSpec{{
+		Name: "my_pkg",
+		URL:  "https://github.com/owner/my_pkg.git",
+		Ref:  "v1.0.0",
+	}}
+
+	diff, err := PreviewPubspecDiff(projectPath, specs)
+	if err != nil {
+		t.Fatalf("PreviewPubspecDiff failed: %v", err)
+	}
+	for _, want := range []string{"+  my_pkg:", "ref: v1.0.0"} {
+		if !strings.Contains(diff, want) {
+			t.Errorf("diff missing %q:\n%s", want, diff)
+		}
+	}
+
+	// No specs means no change
+	diff, err = PreviewPubspecDiff(projectPath, nil)
+	if err != nil {
+		t.Fatalf("PreviewPubspecDiff failed: %v", err)
+	}
+	if diff != "" {
+		t.Errorf("no specs should produce no diff, got:\n%s", diff)
+	}
+}
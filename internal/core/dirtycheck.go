@@ -0,0 +1,80 @@
+// Package core/dirtycheck.go - Uncommitted Pubspec Change Detection
+//
+// A pub add overwrites pubspec.yaml; if the user was in the middle of a
+// manual edit, those changes silently merge with (or get clobbered by) the
+// tool's write. This file checks the target project's git status for
+// uncommitted pubspec.yaml/pubspec.lock changes before modification, so the
+// confirmation screen can warn and offer a stash instead of losing edits.
+//
+// Key features:
+// - PubspecDirtyFiles: Tracked pubspec files with uncommitted changes
+// - StashPubspecChanges: git stash push limited to the pubspec files
+// - Non-git projects report clean - there is nothing to lose track of
+
+package core
+
+import (
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// PubspecDirtyFiles returns the pubspec files (pubspec.yaml, pubspec.lock)
+// that have uncommitted changes in the project's git work tree. Untracked
+// files and non-git projects report clean: only tracked edits can be lost
+// to a dependency write.
+func PubspecDirtyFiles(projectPath string) []string {
+	cmd := exec.Command("git", "-C", projectPath, "status", "--porcelain", "--", "pubspec.yaml", "pubspec.lock")
+	output, err := cmd.Output()
+	if err != nil {
+		return nil
+	}
+
+	var dirty []string
+	for _, line := range strings.Split(string(output), "\n") {
+		if len(line) < 4 || strings.HasPrefix(line, "??") {
+			continue
+		}
+		dirty = append(dirty, strings.TrimSpace(line[3:]))
+	}
+	return dirty
+}
+
+// StashPubspecChanges stashes the uncommitted pubspec changes so the
+// dependency write starts from the committed state. The stash message names
+// this tool, and the result tells the user how to get the edits back.
+func StashPubspecChanges(logger *Logger, cfg *Config, projectPath string) ActionResult {
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: "Would stash uncommitted pubspec changes",
+		}
+	}
+
+	// Only pass the files that are actually dirty: git refuses a pathspec
+	// that matches nothing (e.g. a project without a pubspec.lock yet)
+	dirty := PubspecDirtyFiles(projectPath)
+	if len(dirty) == 0 {
+		return ActionResult{
+			OK:      true,
+			Message: "No uncommitted pubspec changes to stash",
+		}
+	}
+
+	args := append([]string{"-C", projectPath, "stash", "push",
+		"-m", "flutter-pm: manual pubspec edits before dependency change", "--"}, dirty...)
+	cmd := exec.Command("git", args...)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("git stash failed: %v: %s", err, strings.TrimSpace(string(output))),
+		}
+	}
+
+	logger.Info("dirtycheck", fmt.Sprintf("Stashed pubspec changes in %s", projectPath))
+	return ActionResult{
+		OK:      true,
+		Message: "Stashed manual pubspec edits - restore them later with 'git stash pop'",
+	}
+}
@@ -0,0 +1,99 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// initDirtyCheckRepo creates a git repo with a committed pubspec.yaml
+func initDirtyCheckRepo(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, output)
+		}
+	}
+	run("init")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: app\n"), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+	run("add", "pubspec.yaml")
+	run("commit", "-m", "initial")
+	return dir
+}
+
+func TestPubspecDirtyFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := initDirtyCheckRepo(t)
+
+	// Clean work tree reports nothing
+	if dirty := PubspecDirtyFiles(dir); len(dirty) != 0 {
+		t.Errorf("clean repo reported dirty files: %v", dirty)
+	}
+
+	// A modified pubspec.yaml is reported
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: app\nversion: 1.0.0\n"), 0644); err != nil {
+		t.Fatalf("failed to modify pubspec.yaml: %v", err)
+	}
+	dirty := PubspecDirtyFiles(dir)
+	if len(dirty) != 1 || dirty[0] != "pubspec.yaml" {
+		t.Errorf("expected [pubspec.yaml], got %v", dirty)
+	}
+
+	// An untracked pubspec.lock is not an edit that can be lost
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.lock"), []byte("packages: {}\n"), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.lock: %v", err)
+	}
+	dirty = PubspecDirtyFiles(dir)
+	if len(dirty) != 1 || dirty[0] != "pubspec.yaml" {
+		t.Errorf("untracked pubspec.lock should not be reported, got %v", dirty)
+	}
+}
+
+func TestPubspecDirtyFilesNonGit(t *testing.T) {
+	if dirty := PubspecDirtyFiles(t.TempDir()); len(dirty) != 0 {
+		t.Errorf("non-git directory reported dirty files: %v", dirty)
+	}
+}
+
+func TestStashPubspecChanges(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := initDirtyCheckRepo(t)
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: app\nversion: 1.0.0\n"), 0644); err != nil {
+		t.Fatalf("failed to modify pubspec.yaml: %v", err)
+	}
+
+	logger := NewLogger(&Config{Quiet: true})
+
+	// Dry run reports without touching the work tree
+	result := StashPubspecChanges(logger, &Config{Quiet: true, DryRun: true}, dir)
+	if !result.OK || !strings.Contains(result.Message, "Would stash") {
+		t.Errorf("unexpected dry run result: %+v", result)
+	}
+	if dirty := PubspecDirtyFiles(dir); len(dirty) != 1 {
+		t.Errorf("dry run changed the work tree: %v", dirty)
+	}
+
+	// Real stash leaves the work tree clean
+	result = StashPubspecChanges(logger, &Config{Quiet: true}, dir)
+	if !result.OK {
+		t.Fatalf("stash failed: %s", result.Err)
+	}
+	if dirty := PubspecDirtyFiles(dir); len(dirty) != 0 {
+		t.Errorf("work tree still dirty after stash: %v", dirty)
+	}
+}
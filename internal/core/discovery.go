@@ -7,9 +7,11 @@
 // Key features:
 // - NearestPubspec: Walk up directory tree to find closest pubspec.yaml
 // - ScanCommonRoots: Scan typical development directories for projects
-// - Concurrent scanning with proper error handling and timeouts
+// - Concurrent scanning with bounded worker pools, error handling and timeouts
+// - ScanProgress: Live scanned-directory counters for the TUI spinner
+// - Early skipping of known-heavy directories (node_modules, Library, Pods, ...)
+// - .gitignore-aware pruning of simple ignored-directory patterns
 // - Cross-platform support (Windows, macOS, Linux)
-// - Performance optimization with early termination
 // - Shell script parity for project detection logic
 //
 // The discovery logic is designed to be fast and reliable, providing the same
@@ -25,9 +27,87 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 )
 
+// ScanProgress tracks a running scan with atomic counters so the TUI can
+// show live feedback while the worker pool walks large directory trees. It
+// also accumulates the projects themselves, so the scan screen can stream
+// results into view as they are found instead of waiting for the full walk.
+// All methods are nil-safe, so callers that don't care about progress can
+// simply pass nil.
+type ScanProgress struct {
+	dirsScanned   atomic.Int64
+	projectsFound atomic.Int64
+
+	mu       sync.Mutex
+	projects []Project
+}
+
+// addDir records one scanned directory
+func (p *ScanProgress) addDir() {
+	if p == nil {
+		return
+	}
+	p.dirsScanned.Add(1)
+}
+
+// addFound records newly discovered projects for incremental display
+func (p *ScanProgress) addFound(projects []Project) {
+	if p == nil || len(projects) == 0 {
+		return
+	}
+	p.projectsFound.Add(int64(len(projects)))
+	p.mu.Lock()
+	p.projects = append(p.projects, projects...)
+	p.mu.Unlock()
+}
+
+// Found returns a copy of the projects discovered so far; safe to call
+// while the scan workers are still running
+func (p *ScanProgress) Found() []Project {
+	if p == nil {
+		return nil
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Project(nil), p.projects...)
+}
+
+// DirsScanned returns the number of directories scanned so far
+func (p *ScanProgress) DirsScanned() int64 {
+	if p == nil {
+		return 0
+	}
+	return p.dirsScanned.Load()
+}
+
+// ProjectsFound returns the number of projects discovered so far
+func (p *ScanProgress) ProjectsFound() int64 {
+	if p == nil {
+		return 0
+	}
+	return p.projectsFound.Load()
+}
+
+// skipDirNames are directories that never contain Flutter projects but can
+// hold enormous file counts (dependency caches, OS data, build output).
+// Skipping them before recursing is the single biggest scan speedup on
+// large home directories and network drives. Dot-prefixed directories are
+// skipped separately.
+var skipDirNames = map[string]bool{
+	"node_modules": true,
+	"build":        true,
+	"vendor":       true,
+	"Pods":         true,
+	"DerivedData":  true,
+	"target":       true,
+	"__pycache__":  true,
+	"Library":      true, // macOS user library (caches, app containers)
+	"AppData":      true, // Windows roaming/local app data
+}
+
 // NearestPubspec walks up from the current directory to find the nearest pubspec.yaml
 // This mirrors the shell script's behavior of detecting nested directory projects
 func NearestPubspec(startDir string) (*Project, error) {
@@ -109,7 +189,7 @@ func FindPubspecNearCurrent() (*Project, error) {
 	}
 
 	// If not found above, search DOWN from current directory (up to 3 levels)
-	projects, err := scanDirectoryForProjectsWithContext(context.Background(), cwd, 3)
+	projects, err := scanDirectoryForProjectsWithContext(context.Background(), cwd, 3, nil)
 	if err == nil && len(projects) > 0 {
 		// Return the first project found
 		return &projects[0], nil
@@ -129,7 +209,26 @@ func ScanCommonRoots() ([]Project, error) {
 
 // ScanCommonRootsWithContext scans with context for cancellation and timeout
 func ScanCommonRootsWithContext(ctx context.Context) ([]Project, error) {
-	roots := CommonRoots()
+	return ScanCommonRootsWithProgress(ctx, nil)
+}
+
+// ScanCommonRootsWithProgress scans with context cancellation and reports
+// live directory counts through progress (may be nil)
+func ScanCommonRootsWithProgress(ctx context.Context, progress *ScanProgress) ([]Project, error) {
+	return ScanRootsWithProgress(ctx, CommonRoots(), 3, progress)
+}
+
+// ScanRootsWithProgress scans an explicit set of root directories to the
+// given depth, so the configure-search screen's paths and depth apply
+// instead of the built-in defaults. Roots and depth fall back to
+// CommonRoots() and 3 levels when unset.
+func ScanRootsWithProgress(ctx context.Context, roots []string, maxDepth int, progress *ScanProgress) ([]Project, error) {
+	if len(roots) == 0 {
+		roots = CommonRoots()
+	}
+	if maxDepth <= 0 {
+		maxDepth = 3
+	}
 	numWorkers := runtime.NumCPU() // Use all available CPU cores
 	if numWorkers > len(roots) {
 		numWorkers = len(roots) // Don't use more workers than roots
@@ -164,7 +263,7 @@ func ScanCommonRootsWithContext(ctx context.Context) ([]Project, error) {
 						continue
 					}
 
-					rootProjects, err := scanDirectoryForProjectsWithContext(ctx, root, 3)
+					rootProjects, err := scanDirectoryForProjectsWithContext(ctx, root, maxDepth, progress)
 					if err != nil {
 						select {
 						case errorChan <- err:
@@ -255,7 +354,7 @@ func CommonRoots() []string {
 // scanDirectoryForProjects recursively scans a directory for Flutter projects with optimized I/O
 
 // scanDirectoryForProjectsWithContext recursively scans with context cancellation
-func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDepth int) ([]Project, error) {
+func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDepth int, progress *ScanProgress) ([]Project, error) {
 	var projects []Project
 
 	if maxDepth <= 0 {
@@ -268,6 +367,8 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 		return nil, fmt.Errorf("failed to read directory %s: %w", dir, err)
 	}
 
+	progress.addDir()
+
 	// First check if this directory itself is a Flutter project
 	pubspecPath := filepath.Join(dir, "pubspec.yaml")
 	if _, err := os.Stat(pubspecPath); err == nil {
@@ -282,9 +383,21 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 		}
 
 		projects = append(projects, project)
+
+		// Monorepo roots carry their member packages too, so the TUI can
+		// target an individual workspace package (see core/workspace.go)
+		if ws, err := DetectWorkspace(dir); err == nil && ws != nil {
+			projects = append(projects, ws.Packages...)
+		}
+
+		progress.addFound(projects)
 		return projects, nil // Don't scan subdirectories if this is already a project
 	}
 
+	// A directory's own .gitignore is a good signal for what not to walk
+	// (generated output, local tool caches) beyond the built-in skip list
+	ignorePatterns := loadGitignoreSkips(dir)
+
 	// Pre-filter directories to avoid unnecessary recursive calls
 	var validDirs []string
 	for _, entry := range entries {
@@ -292,16 +405,13 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 			continue
 		}
 
-		// Skip hidden directories and common non-project directories
+		// Skip hidden directories and known-heavy non-project directories
+		// before recursing (see skipDirNames)
 		name := entry.Name()
-		if strings.HasPrefix(name, ".") ||
-			name == "node_modules" ||
-			name == "build" ||
-			name == ".git" ||
-			name == "vendor" ||
-			name == ".dart_tool" ||
-			name == ".vscode" ||
-			name == ".idea" {
+		if strings.HasPrefix(name, ".") || skipDirNames[name] {
+			continue
+		}
+		if matchesGitignore(ignorePatterns, name) {
 			continue
 		}
 
@@ -317,7 +427,7 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 
 	// Process valid directories concurrently if there weis enough of them
 	if len(validDirs) > 4 && maxDepth > 1 {
-		return scanDirectoriesConcurrentWithContext(ctx, dir, validDirs, maxDepth-1)
+		return scanDirectoriesConcurrentWithContext(ctx, dir, validDirs, maxDepth-1, progress)
 	}
 
 	// Scan subdirectories sequentially for small numbers
@@ -329,7 +439,7 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 		}
 
 		subDir := filepath.Join(dir, name)
-		subProjects, err := scanDirectoryForProjectsWithContext(ctx, subDir, maxDepth-1)
+		subProjects, err := scanDirectoryForProjectsWithContext(ctx, subDir, maxDepth-1, progress)
 		if err != nil {
 			// Continue with other directories on error
 			continue
@@ -344,7 +454,7 @@ func scanDirectoryForProjectsWithContext(ctx context.Context, dir string, maxDep
 // scanDirectoriesConcurrent scans multiple directories concurrently for better performance
 
 // scanDirectoriesConcurrentWithContext scans with proper context handling
-func scanDirectoriesConcurrentWithContext(ctx context.Context, baseDir string, dirNames []string, maxDepth int) ([]Project, error) {
+func scanDirectoriesConcurrentWithContext(ctx context.Context, baseDir string, dirNames []string, maxDepth int, progress *ScanProgress) ([]Project, error) {
 	type result struct {
 		projects []Project
 		err      error
@@ -373,7 +483,7 @@ func scanDirectoriesConcurrentWithContext(ctx context.Context, baseDir string, d
 						return
 					}
 					subDir := filepath.Join(baseDir, dirName)
-					subProjects, err := scanDirectoryForProjectsWithContext(ctx, subDir, maxDepth)
+					subProjects, err := scanDirectoryForProjectsWithContext(ctx, subDir, maxDepth, progress)
 
 					select {
 					case resultChan <- result{projects: subProjects, err: err}:
@@ -426,6 +536,44 @@ func scanDirectoriesConcurrentWithContext(ctx context.Context, baseDir string, d
 	return allProjects, nil
 }
 
+// loadGitignoreSkips parses a directory's .gitignore for patterns that can
+// safely prune the walk. Only simple, whole-directory patterns are honored:
+// a plain name or "name/" entry skips that subdirectory, and shell globs
+// without a slash are matched against entry names. Negations and nested
+// paths are ignored - the cost of scanning an extra directory is far lower
+// than the cost of wrongly skipping a project.
+func loadGitignoreSkips(dir string) []string {
+	content, err := os.ReadFile(filepath.Join(dir, ".gitignore"))
+	if err != nil {
+		return nil
+	}
+
+	var patterns []string
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "!") {
+			continue
+		}
+		line = strings.TrimSuffix(line, "/")
+		if line == "" || strings.Contains(line, "/") {
+			continue
+		}
+		patterns = append(patterns, line)
+	}
+	return patterns
+}
+
+// matchesGitignore reports whether a directory name matches any of the
+// simple patterns collected by loadGitignoreSkips
+func matchesGitignore(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, err := filepath.Match(pattern, name); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
 // extractProjectName extracts the project name from pubspec.yaml
 func extractProjectName(pubspecPath string) (string, error) {
 	return extractProjectNameOptimized(pubspecPath)
@@ -0,0 +1,79 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTestPubspec drops a minimal pubspec.yaml into dir
+func writeTestPubspec(t *testing.T, dir, name string) {
+	t.Helper()
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: "+name+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestScanHonorsGitignore verifies directories matched by a .gitignore are
+// pruned while unlisted siblings are still scanned
+func TestScanHonorsGitignore(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, ".gitignore"), []byte("# comment\nignored_dir/\n*.log\nexamples\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	writeTestPubspec(t, filepath.Join(root, "ignored_dir", "app"), "hidden_app")
+	writeTestPubspec(t, filepath.Join(root, "examples"), "example_app")
+	writeTestPubspec(t, filepath.Join(root, "kept"), "kept_app")
+
+	projects, err := scanDirectoryForProjectsWithContext(context.Background(), root, 3, nil)
+	if err != nil {
+		t.Fatalf("scan failed: %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "kept_app" {
+		t.Errorf("expected only kept_app, got %+v", projects)
+	}
+}
+
+// TestScanRootsWithProgressStreams verifies results accumulate in the
+// progress tracker for incremental display
+func TestScanRootsWithProgressStreams(t *testing.T) {
+	root := t.TempDir()
+	writeTestPubspec(t, filepath.Join(root, "app_a"), "app_a")
+	writeTestPubspec(t, filepath.Join(root, "app_b"), "app_b")
+
+	progress := &ScanProgress{}
+	projects, err := ScanRootsWithProgress(context.Background(), []string{root}, 2, progress)
+	if err != nil {
+		t.Fatalf("ScanRootsWithProgress failed: %v", err)
+	}
+	if len(projects) != 2 {
+		t.Fatalf("expected 2 projects, got %d", len(projects))
+	}
+	if found := progress.Found(); len(found) != 2 {
+		t.Errorf("progress.Found() = %d projects, want 2", len(found))
+	}
+	if progress.ProjectsFound() != 2 {
+		t.Errorf("ProjectsFound() = %d, want 2", progress.ProjectsFound())
+	}
+}
+
+// TestMatchesGitignore covers the simple pattern subset we honor
+func TestMatchesGitignore(t *testing.T) {
+	patterns := []string{"build", "*.tmp", "out?"}
+	cases := map[string]bool{
+		"build":  true,
+		"a.tmp":  true,
+		"out1":   true,
+		"outs2":  false,
+		"source": false,
+	}
+	for name, want := range cases {
+		if got := matchesGitignore(patterns, name); got != want {
+			t.Errorf("matchesGitignore(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
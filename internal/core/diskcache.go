@@ -0,0 +1,211 @@
+// Package core/diskcache.go - Persistent Cache for Remote Lookups
+//
+// The in-memory GitHubCache and GitLsRemoteCache vanish with the process,
+// so every launch refetched everything. This file persists both to
+// ~/.cache/flutter-pm/cache with TTL metadata: the repository listing is
+// reused across launches (and revalidated cheaply via ETag/If-None-Match
+// on the REST path), and recent ls-remote answers skip the network
+// entirely. All persistence is best-effort - a missing or corrupt cache
+// file never blocks an operation. The `flutter-pm cache` CLI command
+// exposes stats and clearing on top of these helpers.
+//
+// Key features:
+// - loadRepoCache / saveRepoCache: repository listing with SavedAt + ETag
+// - loadLsRemoteSHA / saveLsRemoteSHA: per-URL#ref SHA entries with TTL
+// - GetDiskCacheStats / ClearDiskCache: backing for `flutter-pm cache`
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// RepoDiskCacheMaxAge is how long a persisted repository listing is
+	// served without refetching (the REST path still revalidates stale
+	// listings cheaply via ETag)
+	RepoDiskCacheMaxAge = 1 * time.Hour
+
+	// LsRemoteDiskCacheMaxAge is how long a persisted ls-remote SHA is
+	// trusted; refs move often, so this stays short
+	LsRemoteDiskCacheMaxAge = 10 * time.Minute
+
+	repoCacheFileName     = "github_repos.json"
+	lsRemoteCacheFileName = "lsremote.json"
+)
+
+// repoCacheFile is the on-disk representation of the repository listing
+type repoCacheFile struct {
+	SavedAt time.Time       `json:"saved_at"`
+	ETag    string          `json:"etag,omitempty"` // From the REST listing; empty for gh CLI results
+	Repos   []RepoCandidate `json:"repos"`
+}
+
+// lsRemoteEntry is one cached URL#ref -> SHA answer
+type lsRemoteEntry struct {
+	SHA     string    `json:"sha"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// lsRemoteCacheFile is the on-disk representation of the ls-remote cache
+type lsRemoteCacheFile struct {
+	Entries map[string]lsRemoteEntry `json:"entries"`
+}
+
+// diskCacheMu serializes read-modify-write cycles on the cache files
+var diskCacheMu sync.Mutex
+
+// diskCacheDir returns the persistent cache directory
+// (~/.cache/flutter-pm/cache on Linux)
+func diskCacheDir() (string, error) {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot resolve cache directory: %w", err)
+	}
+	return filepath.Join(base, "flutter-pm", "cache"), nil
+}
+
+// writeCacheFile atomically writes a JSON cache file (temp file + rename,
+// so a crash never leaves a truncated cache behind)
+func writeCacheFile(name string, value interface{}) error {
+	dir, err := diskCacheDir()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, name)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// readCacheFile reads a JSON cache file into value; ok is false when the
+// file is missing or unreadable
+func readCacheFile(name string, value interface{}) bool {
+	dir, err := diskCacheDir()
+	if err != nil {
+		return false
+	}
+	data, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, value) == nil
+}
+
+// loadRepoCache returns the persisted repository listing, or nil when none
+// exists. Staleness is the caller's concern: the REST path revalidates a
+// stale listing with its ETag instead of discarding it.
+func loadRepoCache() *repoCacheFile {
+	var cached repoCacheFile
+	if !readCacheFile(repoCacheFileName, &cached) || len(cached.Repos) == 0 {
+		return nil
+	}
+	return &cached
+}
+
+// saveRepoCache persists the repository listing, best-effort
+func saveRepoCache(repos []RepoCandidate, etag string) {
+	if len(repos) == 0 {
+		return
+	}
+	_ = writeCacheFile(repoCacheFileName, repoCacheFile{
+		SavedAt: time.Now(),
+		ETag:    etag,
+		Repos:   repos,
+	})
+}
+
+// loadLsRemoteSHA returns a persisted ls-remote answer that is still
+// within its TTL
+func loadLsRemoteSHA(key string) (string, bool) {
+	diskCacheMu.Lock()
+	defer diskCacheMu.Unlock()
+
+	var cached lsRemoteCacheFile
+	if !readCacheFile(lsRemoteCacheFileName, &cached) {
+		return "", false
+	}
+	entry, exists := cached.Entries[key]
+	if !exists || time.Since(entry.SavedAt) > LsRemoteDiskCacheMaxAge {
+		return "", false
+	}
+	return entry.SHA, true
+}
+
+// saveLsRemoteSHA persists one ls-remote answer, pruning expired entries
+// while the file is rewritten anyway; best-effort
+func saveLsRemoteSHA(key, sha string) {
+	diskCacheMu.Lock()
+	defer diskCacheMu.Unlock()
+
+	var cached lsRemoteCacheFile
+	readCacheFile(lsRemoteCacheFileName, &cached)
+	if cached.Entries == nil {
+		cached.Entries = make(map[string]lsRemoteEntry)
+	}
+	for entryKey, entry := range cached.Entries {
+		if time.Since(entry.SavedAt) > LsRemoteDiskCacheMaxAge {
+			delete(cached.Entries, entryKey)
+		}
+	}
+	cached.Entries[key] = lsRemoteEntry{SHA: sha, SavedAt: time.Now()}
+	_ = writeCacheFile(lsRemoteCacheFileName, cached)
+}
+
+// DiskCacheStats summarizes the persistent cache for `flutter-pm cache stats`
+type DiskCacheStats struct {
+	Dir             string    `json:"dir"`
+	RepoCount       int       `json:"repo_count"`
+	RepoSavedAt     time.Time `json:"repo_saved_at,omitempty"`
+	RepoHasETag     bool      `json:"repo_has_etag"`
+	LsRemoteEntries int       `json:"ls_remote_entries"`
+}
+
+// GetDiskCacheStats inspects the persistent cache files
+func GetDiskCacheStats() DiskCacheStats {
+	stats := DiskCacheStats{}
+	if dir, err := diskCacheDir(); err == nil {
+		stats.Dir = dir
+	}
+
+	if cached := loadRepoCache(); cached != nil {
+		stats.RepoCount = len(cached.Repos)
+		stats.RepoSavedAt = cached.SavedAt
+		stats.RepoHasETag = cached.ETag != ""
+	}
+
+	var lsCache lsRemoteCacheFile
+	if readCacheFile(lsRemoteCacheFileName, &lsCache) {
+		stats.LsRemoteEntries = len(lsCache.Entries)
+	}
+	return stats
+}
+
+// ClearDiskCache removes the persistent cache files and resets the
+// in-memory caches so the next lookup hits the network
+func ClearDiskCache() error {
+	githubCache.InvalidateCache()
+
+	dir, err := diskCacheDir()
+	if err != nil {
+		return err
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("failed to clear cache: %w", err)
+	}
+	return nil
+}
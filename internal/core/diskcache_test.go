@@ -0,0 +1,64 @@
+package core
+
+import (
+	"testing"
+	"time"
+)
+
+// TestRepoCacheRoundTrip verifies the repository listing survives a save/load
+func TestRepoCacheRoundTrip(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if cached := loadRepoCache(); cached != nil {
+		t.Fatalf("expected empty cache, got %d repos", len(cached.Repos))
+	}
+
+	repos := []RepoCandidate{
+		{Owner: "owner", Name: "repo_a", URL: "https://github.com/owner/repo_a.git"},
+		{Owner: "owner", Name: "repo_b", URL: "https://github.com/owner/repo_b.git"},
+	}
+	saveRepoCache(repos, `W/"abc123"`)
+
+	cached := loadRepoCache()
+	if cached == nil {
+		t.Fatal("expected cached listing after save")
+	}
+	if len(cached.Repos) != 2 || cached.Repos[0].Name != "repo_a" {
+		t.Errorf("unexpected repos: %+v", cached.Repos)
+	}
+	if cached.ETag != `W/"abc123"` {
+		t.Errorf("ETag not preserved: %q", cached.ETag)
+	}
+	if time.Since(cached.SavedAt) > time.Minute {
+		t.Errorf("SavedAt not recent: %v", cached.SavedAt)
+	}
+
+	stats := GetDiskCacheStats()
+	if stats.RepoCount != 2 || !stats.RepoHasETag {
+		t.Errorf("unexpected stats: %+v", stats)
+	}
+}
+
+// TestLsRemoteCachePersistence verifies SHA entries round-trip and that
+// clearing removes them
+func TestLsRemoteCachePersistence(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	key := "https://github.com/owner/repo.git#main"
+	if _, ok := loadLsRemoteSHA(key); ok {
+		t.Fatal("expected miss on empty cache")
+	}
+
+	saveLsRemoteSHA(key, "abc123")
+	sha, ok := loadLsRemoteSHA(key)
+	if !ok || sha != "abc123" {
+		t.Errorf("loadLsRemoteSHA = (%q, %v), want (abc123, true)", sha, ok)
+	}
+
+	if err := ClearDiskCache(); err != nil {
+		t.Fatalf("ClearDiskCache failed: %v", err)
+	}
+	if _, ok := loadLsRemoteSHA(key); ok {
+		t.Error("expected miss after clearing the cache")
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 // Config holds the application configuration
@@ -35,10 +36,80 @@ type Config struct {
 	Jobs       int
 	Explain    bool
 
+	// TUI color theme: "auto" (default), "dark", "light" or "none";
+	// see tui/theme
+	Theme string
+
+	// Plain sequential-prompt mode for screen readers, dumb terminals and
+	// CI logs: no alt-screen, spinners, emoji or color (see tui/plain.go)
+	Plain bool
+
+	// Shallow/partial clone settings for faster source checkout of big
+	// repositories (see buildCloneArgs in core/git.go)
+	CloneDepth   int    // --depth N: shallow clone with N commits of history
+	CloneFilter  string // --filter spec, e.g. blob:none for a blobless clone
+	SingleBranch bool   // --single-branch: skip fetching other branches
+
+	// Retry policy for transient git/network failures on idempotent
+	// operations (see core/retry.go); zero values use the defaults
+	RetryAttempts int           // --retry-attempts N: total attempts, 1 disables retrying
+	RetryBackoff  time.Duration // --retry-backoff D: initial delay, doubled per retry
+
+	// Main menu auto-select countdown in seconds; 0 disables it entirely,
+	// which is safer on shared terminals (see tui/models/main_menu_model.go)
+	MenuTimeout int // --menu-timeout N
+
+	// Transcript mode: append plain-language state change descriptions to
+	// this file (or stdout with "-"); see core/transcript.go
+	TranscriptPath string
+
+	// Session log file override (default: timestamped file under
+	// ~/.cache/flutter-pm/logs); see core/sessionlog.go
+	LogFilePath string
+
+	// Auto-commit of pubspec.yaml/pubspec.lock after a successful run,
+	// optionally on a dedicated branch (see core/autocommit.go)
+	AutoCommit       bool
+	AutoCommitBranch string
+
 	// Command line args
 	Command     string
 	CLICommand  string
+	CLIArgs     []string // Positional arguments after the CLI command (e.g. owner/repo for `add`)
 	ShowVersion bool
+
+	// Flags for the `add` command
+	GitRef      string // --ref: branch, tag or commit to pin the dependency to
+	Subdir      string // --subdir: package path inside a monorepo
+	PkgName     string // --name: override the package name (skips pubspec.yaml lookup)
+	Bundle      string // --bundle: install a named bundle from the config (see core/bundles.go)
+	AllPackages bool   // --all-packages: apply to every workspace package
+
+	// Flags for the `rollback` command
+	RollbackList bool // --list: print the rollback journal instead of restoring
+
+	// Flags for the `update` command
+	LatestTag bool // --latest-tag: re-pin to the highest version tag
+	CreatePR  bool // --pr: push the update on a branch and open a pull request
+
+	// Flags for the `drift` command
+	FailOnStale bool // --fail-on-stale: exit non-zero when a git dependency is behind
+
+	// Policy enforcement bypass: proceed despite pinning policy violations,
+	// downgrading them to warnings (see core/policy.go)
+	Force bool // --force
+
+	// Flags for the `hook` command
+	PrePush bool // --pre-push: install a pre-push hook instead of pre-commit
+
+	// Flags for the `reco` command
+	RecoFailOn string // --fail-on warn|error: exit non-zero when findings at/above this severity exist
+
+	// Flags for the `logs` command
+	LogsLast bool // --last: print the latest session log instead of listing
+
+	// Flags for the `report` command
+	ReportHTML bool // --html: export the run report as HTML instead of markdown
 }
 
 // LogLevel represents the logging level
@@ -53,9 +124,11 @@ const (
 
 // Logger provides structured logging
 type Logger struct {
-	cfg     *Config
-	level   LogLevel
-	entries []LogEntry
+	cfg        *Config
+	level      LogLevel
+	entries    []LogEntry
+	transcript *Transcript
+	session    *SessionLog
 }
 
 // LogEntry represents a single log entry
@@ -77,11 +150,33 @@ func ParseEnv() Config {
 		JSONOutput: parseBool(os.Getenv("FLUTTER_PM_JSON")),
 		Jobs:       parseInt(os.Getenv("FLUTTER_PM_JOBS"), 4),
 		Explain:    parseBool(os.Getenv("FLUTTER_PM_EXPLAIN")),
+		Theme:      os.Getenv("FLUTTER_PM_THEME"),
+		Plain:      parseBool(os.Getenv("FLUTTER_PM_PLAIN")),
+
+		CloneDepth:   parseInt(os.Getenv("FLUTTER_PM_CLONE_DEPTH"), 0),
+		CloneFilter:  os.Getenv("FLUTTER_PM_CLONE_FILTER"),
+		SingleBranch: parseBool(os.Getenv("FLUTTER_PM_SINGLE_BRANCH")),
+
+		RetryAttempts: parseInt(os.Getenv("FLUTTER_PM_RETRY_ATTEMPTS"), 0),
+		RetryBackoff:  parseDuration(os.Getenv("FLUTTER_PM_RETRY_BACKOFF"), 0),
+
+		MenuTimeout: parseInt(os.Getenv("FLUTTER_PM_MENU_TIMEOUT"), 60),
+
+		TranscriptPath: os.Getenv("FLUTTER_PM_TRANSCRIPT"),
+		LogFilePath:    os.Getenv("FLUTTER_PM_LOG_FILE"),
+
+		AutoCommit:       parseBool(os.Getenv("FLUTTER_PM_AUTO_COMMIT")),
+		AutoCommitBranch: os.Getenv("FLUTTER_PM_AUTO_COMMIT_BRANCH"),
 	}
 
 	// Parse command line arguments
 	args := os.Args[1:]
 	for i, arg := range args {
+		// Skip values already consumed by a value-taking flag
+		if i > 0 && isValueFlag(args[i-1]) {
+			continue
+		}
+
 		switch arg {
 		case "--version", "-v":
 			cfg.ShowVersion = true
@@ -103,14 +198,117 @@ func ParseEnv() Config {
 			if i+1 < len(args) {
 				cfg.Jobs = parseInt(args[i+1], 4)
 			}
-		case "add", "sync", "status", "reco", "autotest":
-			cfg.CLICommand = arg
+		case "--ref":
+			if i+1 < len(args) {
+				cfg.GitRef = args[i+1]
+			}
+		case "--subdir":
+			if i+1 < len(args) {
+				cfg.Subdir = args[i+1]
+			}
+		case "--name":
+			if i+1 < len(args) {
+				cfg.PkgName = args[i+1]
+			}
+		case "--bundle":
+			if i+1 < len(args) {
+				cfg.Bundle = args[i+1]
+			}
+		case "--theme":
+			if i+1 < len(args) {
+				cfg.Theme = args[i+1]
+			}
+		case "--plain":
+			cfg.Plain = true
+		case "--depth":
+			if i+1 < len(args) {
+				cfg.CloneDepth = parseInt(args[i+1], 0)
+			}
+		case "--filter":
+			if i+1 < len(args) {
+				cfg.CloneFilter = args[i+1]
+			}
+		case "--single-branch":
+			cfg.SingleBranch = true
+		case "--retry-attempts":
+			if i+1 < len(args) {
+				cfg.RetryAttempts = parseInt(args[i+1], 0)
+			}
+		case "--retry-backoff":
+			if i+1 < len(args) {
+				cfg.RetryBackoff = parseDuration(args[i+1], 0)
+			}
+		case "--menu-timeout":
+			if i+1 < len(args) {
+				cfg.MenuTimeout = parseInt(args[i+1], 60)
+			}
+		case "--transcript":
+			if i+1 < len(args) {
+				cfg.TranscriptPath = args[i+1]
+			}
+		case "--log-file":
+			if i+1 < len(args) {
+				cfg.LogFilePath = args[i+1]
+			}
+		case "--all-packages":
+			cfg.AllPackages = true
+		case "--list":
+			cfg.RollbackList = true
+		case "--last":
+			// Default rollback behavior; for `logs` and `report` it selects
+			// the latest session log file
+			cfg.LogsLast = true
+		case "--html":
+			cfg.ReportHTML = true
+		case "--latest-tag":
+			cfg.LatestTag = true
+		case "--pr":
+			cfg.CreatePR = true
+		case "--fail-on-stale":
+			cfg.FailOnStale = true
+		case "--force":
+			cfg.Force = true
+		case "--pre-push":
+			cfg.PrePush = true
+		case "--fail-on":
+			if i+1 < len(args) {
+				cfg.RecoFailOn = args[i+1]
+			}
+		case "--auto-commit":
+			cfg.AutoCommit = true
+		case "--commit-branch":
+			if i+1 < len(args) {
+				cfg.AutoCommit = true
+				cfg.AutoCommitBranch = args[i+1]
+			}
+		case "add", "remove", "update", "sync", "sync-all", "status", "drift", "plan", "doctor", "rollback", "migrate", "apply", "export", "install", "sdk", "lint-deps", "assert", "hook", "overrides", "reco", "replay", "autotest", "logs", "licenses", "audit", "cache":
+			// First command word wins; later ones are subcommand arguments
+			// (e.g. `sdk install 3.19.0` must not become the install command)
+			if cfg.CLICommand == "" {
+				cfg.CLICommand = arg
+			} else {
+				cfg.CLIArgs = append(cfg.CLIArgs, arg)
+			}
+		default:
+			// Positional argument for a CLI command (e.g. owner/repo for `add`)
+			if cfg.CLICommand != "" && !strings.HasPrefix(arg, "-") {
+				cfg.CLIArgs = append(cfg.CLIArgs, arg)
+			}
 		}
 	}
 
 	return cfg
 }
 
+// isValueFlag reports whether a flag consumes the following argument as its value
+func isValueFlag(arg string) bool {
+	switch arg {
+	case "--root", "--jobs", "--ref", "--subdir", "--name", "--bundle", "--theme", "--transcript", "--log-file", "--fail-on", "--depth", "--filter", "--commit-branch", "--retry-attempts", "--retry-backoff", "--menu-timeout":
+		return true
+	}
+	return false
+}
+
 // NewLogger creates a new logger instance
 func NewLogger(cfg *Config) *Logger {
 	level := LogLevelInfo
@@ -120,19 +318,55 @@ func NewLogger(cfg *Config) *Logger {
 		level = LogLevelError
 	}
 
+	// Transcript mode is best-effort: a broken path must not block startup
+	var transcript *Transcript
+	if cfg.TranscriptPath != "" {
+		var err error
+		transcript, err = OpenTranscript(cfg.TranscriptPath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "⚠️ %v\n", err)
+		}
+	}
+
 	return &Logger{
-		cfg:     cfg,
-		level:   level,
-		entries: make([]LogEntry, 0),
+		cfg:        cfg,
+		level:      level,
+		entries:    make([]LogEntry, 0),
+		transcript: transcript,
 	}
 }
 
-// Log adds a log entry
-func (l *Logger) Log(level LogLevel, component, message string, data map[string]interface{}) {
-	if level > l.level {
-		return
+// Narrate appends a plain-language state change description to the
+// transcript (see core/transcript.go). It is a no-op unless transcript
+// mode is enabled, so screens can narrate unconditionally.
+func (l *Logger) Narrate(message string) {
+	l.transcript.Say(message)
+}
+
+// AttachSessionLog opens the per-session log file (see core/sessionlog.go)
+// and routes every subsequent entry to it, regardless of the console level.
+// Returns the file path for display.
+func (l *Logger) AttachSessionLog(path string) (string, error) {
+	session, err := OpenSessionLog(path)
+	if err != nil {
+		return "", err
 	}
+	l.session = session
+	return session.Path(), nil
+}
 
+// SessionLogPath returns the active session log file path, or ""
+func (l *Logger) SessionLogPath() string {
+	return l.session.Path()
+}
+
+// CloseSessionLog closes the session log file, if one is attached
+func (l *Logger) CloseSessionLog() {
+	l.session.Close()
+}
+
+// Log adds a log entry
+func (l *Logger) Log(level LogLevel, component, message string, data map[string]interface{}) {
 	entry := LogEntry{
 		Level:     logLevelString(level),
 		Message:   message,
@@ -140,6 +374,14 @@ func (l *Logger) Log(level LogLevel, component, message string, data map[string]
 		Data:      data,
 	}
 
+	// The session log captures everything for post-mortem debugging, even
+	// entries below the console level
+	l.session.Write(entry)
+
+	if level > l.level {
+		return
+	}
+
 	l.entries = append(l.entries, entry)
 
 	if l.cfg.JSONOutput {
@@ -177,11 +419,14 @@ func (l *Logger) GetLevel() LogLevel {
 	return l.level
 }
 
-// LogCommand logs a command execution
+// LogCommand logs a command execution. The command line always reaches the
+// session log; the console only sees it in explain mode.
 func (l *Logger) LogCommand(component, command string, args []string) {
+	fullCmd := command + " " + strings.Join(args, " ")
 	if l.cfg.Explain {
-		fullCmd := command + " " + strings.Join(args, " ")
 		l.Info(component, "executing: "+fullCmd)
+	} else {
+		l.session.Write(LogEntry{Level: "info", Component: component, Message: "executing: " + fullCmd})
 	}
 }
 
@@ -230,6 +475,17 @@ func parseInt(s string, defaultVal int) int {
 	return i
 }
 
+func parseDuration(s string, defaultVal time.Duration) time.Duration {
+	if s == "" {
+		return defaultVal
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return defaultVal
+	}
+	return d
+}
+
 func logLevelString(level LogLevel) string {
 	switch level {
 	case LogLevelError:
@@ -0,0 +1,217 @@
+// Package core/favorites.go - Frequently Added Packages and Team Bundles
+//
+// The same handful of packages tends to get added to every new project.
+// This file counts successful adds per package in
+// ~/.config/flutter-pm/favorites.json so the repo selection screen can
+// surface a "Quick add" section of the most-used ones, and reads a
+// config-defined list of team-standard packages that can be selected as a
+// bundle in one keystroke.
+//
+// Key features:
+// - RecordFavoriteAdd: Bump a package's add counter (upsert by URL)
+// - TopFavoritePackages: The most frequently added packages, capped
+// - LoadTeamPackages: Team-standard bundle from the global config
+// - Unused entries rotate out beyond MaxFavoritePackages
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxFavoritePackages caps how many entries the favorites file keeps; the
+// least-used entries rotate out beyond it
+const MaxFavoritePackages = 20
+
+// FavoritePackage is one counted entry in the persisted favorites list
+type FavoritePackage struct {
+	Name      string    `json:"name"`
+	URL       string    `json:"url"`
+	Ref       string    `json:"ref,omitempty"`
+	Count     int       `json:"count"`
+	LastAdded time.Time `json:"last_added"`
+}
+
+// favoritePackagesPath returns the favorites file path, or "" when the
+// home directory cannot be determined. It lives next to config.yaml like
+// recent.json, so clearing caches does not forget usage history.
+func favoritePackagesPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".config", "flutter-pm", "favorites.json")
+}
+
+// LoadFavoritePackages reads the persisted list, most frequently added
+// first with recency as the tie-breaker. Returns nil when nothing is saved
+// or the file is unreadable - the screen simply shows no quick-add section.
+func LoadFavoritePackages() []FavoritePackage {
+	path := favoritePackagesPath()
+	if path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var entries []FavoritePackage
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil
+	}
+
+	sortFavoritePackages(entries)
+	return entries
+}
+
+// TopFavoritePackages returns the n most frequently added packages
+func TopFavoritePackages(n int) []FavoritePackage {
+	entries := LoadFavoritePackages()
+	if len(entries) > n {
+		entries = entries[:n]
+	}
+	return entries
+}
+
+// RecordFavoriteAdd bumps the add counter for a package after a successful
+// add, keyed by git URL so renamed packages still aggregate. Best-effort:
+// persistence failures are returned but safe to ignore.
+func RecordFavoriteAdd(spec PkgSpec) error {
+	entries := LoadFavoritePackages()
+
+	updated := false
+	for i := range entries {
+		if entries[i].URL == spec.URL {
+			entries[i].Name = spec.Name
+			entries[i].Ref = spec.Ref
+			entries[i].Count++
+			entries[i].LastAdded = time.Now()
+			updated = true
+			break
+		}
+	}
+	if !updated {
+		entries = append(entries, FavoritePackage{
+			Name:      spec.Name,
+			URL:       spec.URL,
+			Ref:       spec.Ref,
+			Count:     1,
+			LastAdded: time.Now(),
+		})
+	}
+
+	// Trim the least-used entries from the bottom (list is sorted, so the
+	// rarely added ones are last)
+	sortFavoritePackages(entries)
+	if len(entries) > MaxFavoritePackages {
+		entries = entries[:MaxFavoritePackages]
+	}
+
+	return saveFavoritePackages(entries)
+}
+
+// sortFavoritePackages orders entries by add count, then by most recent add
+func sortFavoritePackages(entries []FavoritePackage) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].LastAdded.After(entries[j].LastAdded)
+	})
+}
+
+// saveFavoritePackages writes the list atomically (write-then-rename), so
+// a crash mid-save never leaves a truncated file behind
+func saveFavoritePackages(entries []FavoritePackage) error {
+	path := favoritePackagesPath()
+	if path == "" {
+		return fmt.Errorf("cannot determine config directory for favorites")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	content, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize favorites: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write favorites: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace favorites: %w", err)
+	}
+	return nil
+}
+
+// teamPackagesYAML models the `team_packages:` section of the global
+// config, e.g.:
+//
+//	team_packages:
+//	  - name: shared_ui
+//	    url: https://github.com/acme/shared_ui.git
+//	    ref: main
+type teamPackagesYAML struct {
+	TeamPackages []struct {
+		Name   string `yaml:"name"`
+		URL    string `yaml:"url"`
+		Ref    string `yaml:"ref"`
+		Subdir string `yaml:"subdir"`
+	} `yaml:"team_packages"`
+}
+
+// LoadTeamPackages reads the team-standard package bundle from the global
+// config. Entries without a URL are skipped; a missing name falls back to
+// the repository name from the URL. Returns nil when the config is missing
+// or has no team_packages section - the bundle shortcut then stays hidden.
+func LoadTeamPackages() []PkgSpec {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return nil
+	}
+
+	var parsed teamPackagesYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+
+	var specs []PkgSpec
+	for _, entry := range parsed.TeamPackages {
+		if entry.URL == "" {
+			continue
+		}
+		name := entry.Name
+		if name == "" {
+			name = teamPackageNameFromURL(entry.URL)
+		}
+		specs = append(specs, PkgSpec{
+			Name:   name,
+			URL:    entry.URL,
+			Ref:    entry.Ref,
+			Subdir: entry.Subdir,
+		})
+	}
+	return specs
+}
+
+// teamPackageNameFromURL derives a fallback package name from a git URL's
+// last path segment ("https://github.com/acme/shared_ui.git" -> shared_ui)
+func teamPackageNameFromURL(url string) string {
+	trimmed := strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git")
+	if idx := strings.LastIndex(trimmed, "/"); idx >= 0 {
+		trimmed = trimmed[idx+1:]
+	}
+	return trimmed
+}
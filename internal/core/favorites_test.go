@@ -0,0 +1,110 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// TestFavoritePackagesRoundTrip verifies add counting, frequency ordering
+// and the entry cap
+func TestFavoritePackagesRoundTrip(t *testing.T) {
+	// Redirect os.UserHomeDir so the test never touches the real config
+	t.Setenv("HOME", t.TempDir())
+
+	if got := LoadFavoritePackages(); len(got) != 0 {
+		t.Fatalf("expected empty list, got %d entries", len(got))
+	}
+
+	once := PkgSpec{Name: "once", URL: "https://github.com/acme/once.git"}
+	often := PkgSpec{Name: "often", URL: "https://github.com/acme/often.git", Ref: "main"}
+	if err := RecordFavoriteAdd(once); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := RecordFavoriteAdd(often); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries := LoadFavoritePackages()
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].Name != "often" || entries[0].Count != 3 {
+		t.Errorf("expected the most added package first with count 3, got %+v", entries[0])
+	}
+	if entries[0].Ref != "main" {
+		t.Errorf("expected the last-used ref persisted, got %q", entries[0].Ref)
+	}
+
+	// TopFavoritePackages caps the result
+	if top := TopFavoritePackages(1); len(top) != 1 || top[0].Name != "often" {
+		t.Errorf("expected only the top entry, got %+v", top)
+	}
+
+	// The cap drops the least-used entries
+	for i := 0; i < MaxFavoritePackages+3; i++ {
+		spec := PkgSpec{
+			Name: "filler",
+			URL:  "https://github.com/acme/filler-" + string(rune('a'+i)) + ".git",
+		}
+		if err := RecordFavoriteAdd(spec); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if entries := LoadFavoritePackages(); len(entries) != MaxFavoritePackages {
+		t.Errorf("expected the list capped at %d, got %d", MaxFavoritePackages, len(entries))
+	}
+}
+
+// TestSortFavoritePackagesTieBreak verifies recency orders equal counts
+func TestSortFavoritePackagesTieBreak(t *testing.T) {
+	now := time.Now()
+	entries := []FavoritePackage{
+		{Name: "old", Count: 2, LastAdded: now.Add(-time.Hour)},
+		{Name: "new", Count: 2, LastAdded: now},
+	}
+	sortFavoritePackages(entries)
+	if entries[0].Name != "new" {
+		t.Errorf("expected the most recently added entry first, got %+v", entries)
+	}
+}
+
+// TestLoadTeamPackages verifies parsing of the team_packages config
+// section, including the URL-derived name fallback
+func TestLoadTeamPackages(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if got := LoadTeamPackages(); got != nil {
+		t.Fatalf("expected nil without a config, got %+v", got)
+	}
+
+	configDir := filepath.Join(home, ".config", "flutter-pm")
+	if err := os.MkdirAll(configDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	config := `team_packages:
+  - name: shared_ui
+    url: https://github.com/acme/ui-kit.git
+    ref: main
+  - url: https://github.com/acme/analytics.git
+  - name: missing_url
+`
+	if err := os.WriteFile(filepath.Join(configDir, "config.yaml"), []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	specs := LoadTeamPackages()
+	if len(specs) != 2 {
+		t.Fatalf("expected 2 specs (entry without URL skipped), got %d", len(specs))
+	}
+	if specs[0].Name != "shared_ui" || specs[0].Ref != "main" {
+		t.Errorf("unexpected first spec: %+v", specs[0])
+	}
+	if specs[1].Name != "analytics" {
+		t.Errorf("expected the name derived from the URL, got %q", specs[1].Name)
+	}
+}
@@ -0,0 +1,77 @@
+// Package core/fvm.go - FVM Toolchain Pinning Awareness
+//
+// This file detects projects pinned to a specific Flutter SDK through FVM
+// (.fvm/version or .fvm/fvm_config.json) and routes pub commands through
+// `fvm flutter` so the pinned SDK is used instead of the global one. When a
+// pin exists but fvm itself is not installed, the conflict is surfaced in
+// the main menu header rather than silently running the wrong SDK.
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// FVMInfo describes a project's FVM pin
+type FVMInfo struct {
+	Version string // Pinned Flutter SDK version
+}
+
+// DetectFVM returns the project's FVM pin, or nil when the project has none.
+// FVM 3 writes .fvm/version as plain text; older versions (and FVM 3, for
+// backwards compatibility) write .fvm/fvm_config.json with flutterSdkVersion.
+func DetectFVM(projectPath string) *FVMInfo {
+	if content, err := os.ReadFile(filepath.Join(projectPath, ".fvm", "version")); err == nil {
+		if version := strings.TrimSpace(string(content)); version != "" {
+			return &FVMInfo{Version: version}
+		}
+	}
+
+	content, err := os.ReadFile(filepath.Join(projectPath, ".fvm", "fvm_config.json"))
+	if err != nil {
+		return nil
+	}
+	var config struct {
+		FlutterSdkVersion string `json:"flutterSdkVersion"`
+	}
+	if err := json.Unmarshal(content, &config); err != nil || config.FlutterSdkVersion == "" {
+		return nil
+	}
+	return &FVMInfo{Version: config.FlutterSdkVersion}
+}
+
+// FindPubToolFor picks the pub tool for a specific project. A project pinned
+// via FVM runs pub through `fvm flutter` - the returned prefix goes in front
+// of the pub arguments - so the pinned SDK is used; everything else falls
+// back to the global tool detection of FindPubTool.
+func FindPubToolFor(projectPath string) (string, []string, error) {
+	if info := DetectFVM(projectPath); info != nil {
+		if _, err := exec.LookPath("fvm"); err == nil {
+			return "fvm", []string{"flutter"}, nil
+		}
+		// Pin exists but fvm is missing: fall through to the global SDK,
+		// the mismatch is flagged by ActiveSDKDescription
+	}
+
+	tool, err := FindPubTool()
+	return tool, nil, err
+}
+
+// ActiveSDKDescription describes which SDK pub commands will use in a
+// project, with a warning when the FVM pin cannot be honoured. Projects
+// without a pin return "" - the global SDK is the unremarkable default.
+func ActiveSDKDescription(projectPath string) string {
+	info := DetectFVM(projectPath)
+	if info == nil {
+		return ""
+	}
+	if _, err := exec.LookPath("fvm"); err != nil {
+		return fmt.Sprintf("⚠️ FVM pins Flutter %s but fvm is not installed - the global SDK will be used", info.Version)
+	}
+	return fmt.Sprintf("Flutter %s via FVM (pinned)", info.Version)
+}
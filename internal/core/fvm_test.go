@@ -0,0 +1,93 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDetectFVM(t *testing.T) {
+	// No .fvm directory at all
+	if info := DetectFVM(t.TempDir()); info != nil {
+		t.Errorf("expected nil for project without FVM, got %+v", info)
+	}
+
+	// FVM 3 style: plain-text .fvm/version
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".fvm"), 0755); err != nil {
+		t.Fatalf("failed to create .fvm: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".fvm", "version"), []byte("3.19.0\n"), 0644); err != nil {
+		t.Fatalf("failed to write version: %v", err)
+	}
+	info := DetectFVM(dir)
+	if info == nil || info.Version != "3.19.0" {
+		t.Errorf("unexpected pin from .fvm/version: %+v", info)
+	}
+
+	// Legacy style: .fvm/fvm_config.json
+	dir = t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".fvm"), 0755); err != nil {
+		t.Fatalf("failed to create .fvm: %v", err)
+	}
+	config := `{"flutterSdkVersion": "2.10.5", "flavors": {}}`
+	if err := os.WriteFile(filepath.Join(dir, ".fvm", "fvm_config.json"), []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	info = DetectFVM(dir)
+	if info == nil || info.Version != "2.10.5" {
+		t.Errorf("unexpected pin from fvm_config.json: %+v", info)
+	}
+
+	// Malformed config is treated as no pin
+	if err := os.WriteFile(filepath.Join(dir, ".fvm", "fvm_config.json"), []byte("not json"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if err := os.Remove(filepath.Join(dir, ".fvm", "version")); err != nil && !os.IsNotExist(err) {
+		t.Fatalf("failed to remove version: %v", err)
+	}
+	if info := DetectFVM(dir); info != nil {
+		t.Errorf("malformed config should yield no pin, got %+v", info)
+	}
+}
+
+func TestFindPubToolForWithoutPin(t *testing.T) {
+	// Without a pin the result must match the global detection exactly
+	globalTool, globalErr := FindPubTool()
+
+	tool, prefix, err := FindPubToolFor(t.TempDir())
+	if tool != globalTool || (err == nil) != (globalErr == nil) {
+		t.Errorf("unpinned project should use global tool %q, got %q (err %v)", globalTool, tool, err)
+	}
+	if len(prefix) != 0 {
+		t.Errorf("unpinned project should have no prefix, got %v", prefix)
+	}
+}
+
+func TestActiveSDKDescription(t *testing.T) {
+	// No pin: nothing to show
+	if desc := ActiveSDKDescription(t.TempDir()); desc != "" {
+		t.Errorf("expected empty description without a pin, got %q", desc)
+	}
+
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, ".fvm"), 0755); err != nil {
+		t.Fatalf("failed to create .fvm: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, ".fvm", "version"), []byte("3.19.0"), 0644); err != nil {
+		t.Fatalf("failed to write version: %v", err)
+	}
+
+	desc := ActiveSDKDescription(dir)
+	if !strings.Contains(desc, "3.19.0") {
+		t.Errorf("description should name the pinned version: %q", desc)
+	}
+	if _, err := exec.LookPath("fvm"); err != nil {
+		// Pin without fvm installed must be flagged as a conflict
+		if !strings.Contains(desc, "not installed") {
+			t.Errorf("missing fvm should be flagged: %q", desc)
+		}
+	}
+}
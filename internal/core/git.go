@@ -21,13 +21,17 @@ package core
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -49,6 +53,16 @@ var (
 		timers: make(map[string]*time.Timer),
 		ttl:    2 * time.Minute, // Cache git ls-remote for 2 minutes
 	}
+
+	// packageNameCache memoizes FetchPackageNameFromGit answers keyed by
+	// URL+ref(+subdir). Names change far less often than branch heads, so a
+	// longer TTL is safe, and the configuration screen's concurrent prefetch
+	// never hits the gh/HTTP fallback chain twice for the same repository.
+	packageNameCache = &GitLsRemoteCache{
+		cache:  make(map[string]string),
+		timers: make(map[string]*time.Timer),
+		ttl:    10 * time.Minute,
+	}
 )
 
 // GitLsRemote gets the SHA for a specific ref from a git repository with caching
@@ -59,17 +73,44 @@ func GitLsRemote(url, ref string) (string, error) {
 	gitLsRemoteCache.mu.RLock()
 	if cached, exists := gitLsRemoteCache.cache[cacheKey]; exists {
 		gitLsRemoteCache.mu.RUnlock()
+		RecordCacheLookup("ls-remote", true)
 		return cached, nil
 	}
 	gitLsRemoteCache.mu.RUnlock()
 
-	cmd := exec.Command("git", "ls-remote", url, ref)
-	output, err := cmd.Output()
+	// A recent answer persisted by an earlier run skips the network
+	// (see core/diskcache.go)
+	if sha, ok := loadLsRemoteSHA(cacheKey); ok {
+		gitLsRemoteCache.mu.Lock()
+		gitLsRemoteCache.cache[cacheKey] = sha
+		gitLsRemoteCache.mu.Unlock()
+		go gitLsRemoteCache.cleanupAfterTTL(cacheKey)
+		RecordCacheLookup("ls-remote", true)
+		return sha, nil
+	}
+	RecordCacheLookup("ls-remote", false)
+
+	// ls-remote is read-only, so transient network failures are retried
+	// under the default policy (see core/retry.go)
+	started := time.Now()
+	output, err := retryTransient(nil, DefaultRetryPolicy(), "ls-remote", "git ls-remote "+url, func() (string, error) {
+		cmd := exec.Command("git", "ls-remote", url, ref)
+		ApplySSHIdentity(nil, cmd, url)
+		out, runErr := cmd.Output()
+		if runErr != nil {
+			if exitErr, ok := runErr.(*exec.ExitError); ok {
+				return string(exitErr.Stderr), runErr
+			}
+			return "", runErr
+		}
+		return string(out), nil
+	})
 	if err != nil {
 		return "", fmt.Errorf("failed to run git ls-remote: %w", err)
 	}
+	RecordOperationTiming("git ls-remote", time.Since(started))
 
-	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	lines := strings.Split(strings.TrimSpace(output), "\n")
 	for _, line := range lines {
 		parts := strings.Fields(line)
 		if len(parts) >= 2 && (parts[1] == ref || parts[1] == "refs/heads/"+ref || parts[1] == "refs/tags/"+ref) {
@@ -82,6 +123,9 @@ func GitLsRemote(url, ref string) (string, error) {
 			// Start cleanup timer if this is the first entry
 			go gitLsRemoteCache.cleanupAfterTTL(cacheKey)
 
+			// Persist for later runs (see core/diskcache.go)
+			saveLsRemoteSHA(cacheKey, sha)
+
 			return sha, nil
 		}
 	}
@@ -91,39 +135,126 @@ func GitLsRemote(url, ref string) (string, error) {
 
 // GitClone clones a repository to a local directory
 func GitClone(logger *Logger, cfg *Config, url, dir, ref string) ActionResult {
-	args := []string{"clone"}
+	return GitCloneContext(context.Background(), logger, cfg, url, dir, ref)
+}
+
+// GitCloneContext clones a repository to a local directory. The clone is
+// killed if the context is cancelled; a default timeout applies when the
+// caller hasn't set a deadline.
+func GitCloneContext(ctx context.Context, logger *Logger, cfg *Config, url, dir, ref string) ActionResult {
+	ctx, cancel := withDefaultTimeout(ctx, DefaultGitTimeout)
+	defer cancel()
+
+	args := buildCloneArgs(cfg, url, dir, ref)
+
+	logger.LogCommand("git", "git", args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would clone %s to %s", url, dir),
+			Logs:    []string{fmt.Sprintf("DRY RUN: git %s", strings.Join(args, " "))},
+		}
+	}
+
+	reportProgress("clone", -1, fmt.Sprintf("Cloning %s", url))
+	// A failed clone removes the directory git created, so retrying the
+	// whole command is safe; only transient network failures are retried
+	// (see core/retry.go)
+	started := time.Now()
+	output, err := retryTransient(logger, RetryPolicyFromConfig(cfg), "clone", "clone of "+url, func() (string, error) {
+		cmd := exec.CommandContext(ctx, "git", args...)
+		ApplySSHIdentity(logger, cmd, url)
+		out, runErr := runCommandStreamingProgress(cmd, "clone")
+		return string(out), runErr
+	})
+	logs := []string{strings.TrimSpace(output)}
+
+	if err == nil {
+		RecordOperationTiming("git clone", time.Since(started))
+	}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  contextFailure(ctx, fmt.Sprintf("clone of %s", url), err),
+			Logs: logs,
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Successfully cloned %s", url),
+		Logs:    logs,
+	}
+}
+
+// buildCloneArgs assembles the git clone argument list from the config's
+// shallow/partial clone settings. --progress makes git emit transfer
+// percentages even without a TTY, feeding per-byte clone progress to the
+// reporter (see core/progress.go).
+func buildCloneArgs(cfg *Config, url, dir, ref string) []string {
+	args := []string{"clone", "--progress"}
+
+	// Shallow and partial clone options for faster source checkout of big
+	// repositories (--depth, --filter, --single-branch)
+	if cfg.CloneDepth > 0 {
+		args = append(args, "--depth", strconv.Itoa(cfg.CloneDepth))
+	}
+	if cfg.CloneFilter != "" {
+		args = append(args, "--filter="+cfg.CloneFilter)
+	}
+	if cfg.SingleBranch {
+		args = append(args, "--single-branch")
+	}
 
 	if ref != "" && ref != "main" && ref != "master" {
 		args = append(args, "--branch", ref)
 	}
 
-	args = append(args, url, dir)
+	return append(args, url, dir)
+}
 
+// GitUnshallow converts a shallow clone back into a full one by fetching
+// the missing history, for when the user later needs full history (e.g.
+// blame or bisect) in a project cloned with --depth
+func GitUnshallow(logger *Logger, cfg *Config, dir string) ActionResult {
+	args := []string{"fetch", "--unshallow", "--progress"}
 	logger.LogCommand("git", "git", args)
 
 	if cfg.DryRun {
 		return ActionResult{
 			OK:      true,
-			Message: fmt.Sprintf("Would clone %s to %s", url, dir),
+			Message: fmt.Sprintf("Would fetch full history in %s", dir),
 			Logs:    []string{fmt.Sprintf("DRY RUN: git %s", strings.Join(args, " "))},
 		}
 	}
 
 	cmd := exec.Command("git", args...)
-	output, err := cmd.CombinedOutput()
+	cmd.Dir = dir
+	output, err := runCommandStreamingProgress(cmd, "unshallow")
 	logs := []string{strings.TrimSpace(string(output))}
 
 	if err != nil {
+		// A repository that is already complete is not an error worth
+		// failing on; git reports it as "on a complete repository"
+		if strings.Contains(string(output), "complete repository") {
+			return ActionResult{
+				OK:      true,
+				Message: "Repository already has full history",
+				Logs:    logs,
+			}
+		}
 		return ActionResult{
 			OK:   false,
-			Err:  err.Error(),
+			Err:  fmt.Sprintf("unshallow failed: %v", err),
 			Logs: logs,
 		}
 	}
 
 	return ActionResult{
 		OK:      true,
-		Message: fmt.Sprintf("Successfully cloned %s", url),
+		Message: "Fetched full history",
 		Logs:    logs,
 	}
 }
@@ -139,6 +270,15 @@ type GitHubRepo struct {
 	Owner       struct {
 		Login string `json:"login"`
 	} `json:"owner"`
+	RepositoryTopics []struct {
+		Name string `json:"name"`
+	} `json:"repositoryTopics"`
+	StargazerCount  int       `json:"stargazerCount"`
+	PushedAt        time.Time `json:"pushedAt"`
+	IsTemplate      bool      `json:"isTemplate"`
+	PrimaryLanguage struct {
+		Name string `json:"name"`
+	} `json:"primaryLanguage"`
 }
 
 // GitHubCache provides intelligent caching for GitHub API responses
@@ -159,35 +299,75 @@ var (
 // ListGitHubRepos uses gh CLI to list user repositories with intelligent caching
 // This mirrors the shell script's GitHub integration but optimized for performance
 func ListGitHubRepos(logger *Logger) ([]RepoCandidate, error) {
+	return ListGitHubReposContext(context.Background(), logger)
+}
+
+// ListGitHubReposContext lists user repositories, honouring the context for
+// both the gh CLI invocation and the REST fallback. A default timeout
+// applies when the caller hasn't set a deadline.
+func ListGitHubReposContext(ctx context.Context, logger *Logger) ([]RepoCandidate, error) {
+	ctx, cancel := withDefaultTimeout(ctx, DefaultListTimeout)
+	defer cancel()
+
 	// Check cache first
 	if cached := githubCache.Get(); cached != nil {
 		logger.Info("github", "Using cached repository list")
 		return cached, nil
 	}
 
-	// Check if gh is available
+	// A fresh enough listing persisted by an earlier run saves the whole
+	// round trip (see core/diskcache.go)
+	if cached := loadRepoCache(); cached != nil && time.Since(cached.SavedAt) < RepoDiskCacheMaxAge {
+		logger.Info("github", "Using disk-cached repository list")
+		githubCache.Set(cached.Repos)
+		return cached.Repos, nil
+	}
+
+	// Check if gh is available; without it, fall back to the native REST
+	// client when a token is configured (see core/github_api.go)
 	if _, err := exec.LookPath("gh"); err != nil {
-		return nil, fmt.Errorf("GitHub CLI (gh) not found. Please install: https://cli.github.com/")
+		if token := GitHubToken(); token != "" {
+			logger.Info("github", "GitHub CLI not found - using REST API with configured token")
+			return listGitHubReposAPIContext(ctx, logger, token)
+		}
+		return nil, fmt.Errorf("GitHub CLI (gh) not found and no token configured: %w", ErrGitHubAuthRequired)
 	}
 
-	// Check if authenticated
-	cmd := exec.Command("gh", "auth", "status")
+	// Check if authenticated; an unauthenticated gh can still be bypassed
+	// with a configured token
+	cmd := exec.CommandContext(ctx, "gh", "auth", "status")
 	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("GitHub CLI not authenticated. Please run 'gh auth login'")
+		if token := GitHubToken(); token != "" {
+			logger.Info("github", "GitHub CLI not authenticated - using REST API with configured token")
+			return listGitHubReposAPIContext(ctx, logger, token)
+		}
+		return nil, fmt.Errorf("GitHub CLI not authenticated: %w", ErrGitHubAuthRequired)
+	}
+
+	// While backing off from an earlier rate-limit error, fail fast with
+	// the reset time instead of burning another request (see ratelimit.go)
+	if IsGitHubRateLimited() {
+		return nil, fmt.Errorf("%s", GitHubRateLimitMessage())
 	}
 
 	logger.Info("github", "Fetching repositories from GitHub")
 
 	// Get repositories as JSON with increased limit for better UX
-	cmd = exec.Command("gh", "repo", "list",
-		"--json", "name,nameWithOwner,description,isPrivate,url,sshUrl,owner",
+	cmd = exec.CommandContext(ctx, "gh", "repo", "list",
+		"--json", "name,nameWithOwner,description,isPrivate,url,sshUrl,owner,repositoryTopics,stargazerCount,pushedAt,primaryLanguage,isTemplate",
 		"--limit", "200") // Increased from 100 for better coverage
 
-	var stdout bytes.Buffer
+	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
 
 	if err := cmd.Run(); err != nil {
-		return nil, fmt.Errorf("failed to list repositories: %w", err)
+		// A rate-limited listing reads as a clear "until HH:MM" message
+		// rather than the raw gh error (see ratelimit.go)
+		if NoteGitHubRateLimitError(stderr.String()) {
+			return nil, fmt.Errorf("%s", GitHubRateLimitMessage())
+		}
+		return nil, fmt.Errorf("failed to list repositories: %s", contextFailure(ctx, "repository listing", err))
 	}
 
 	var repos []GitHubRepo
@@ -209,17 +389,30 @@ func ListGitHubRepos(logger *Logger) ([]RepoCandidate, error) {
 			gitURL += ".git"
 		}
 
+		var topics []string
+		for _, topic := range repo.RepositoryTopics {
+			if topic.Name != "" {
+				topics = append(topics, topic.Name)
+			}
+		}
+
 		candidates = append(candidates, RepoCandidate{
-			Owner:   repo.Owner.Login,
-			Name:    repo.Name,
-			URL:     gitURL,
-			Privacy: privacy,
-			Desc:    repo.Description,
+			Owner:      repo.Owner.Login,
+			Name:       repo.Name,
+			URL:        gitURL,
+			Privacy:    privacy,
+			Desc:       repo.Description,
+			Topics:     topics,
+			Stars:      repo.StargazerCount,
+			PushedAt:   repo.PushedAt,
+			Language:   repo.PrimaryLanguage.Name,
+			IsTemplate: repo.IsTemplate,
 		})
 	}
 
-	// Cache the results
+	// Cache the results in memory and on disk (the gh CLI exposes no ETag)
 	githubCache.Set(candidates)
+	saveRepoCache(candidates, "")
 
 	logger.Info("github", fmt.Sprintf("Found %d repositories", len(candidates)))
 	return candidates, nil
@@ -292,6 +485,116 @@ func (c *GitLsRemoteCache) cleanupAfterTTL(key string) {
 	})
 }
 
+// ForkInfo describes a repository's fork relationship and how far the chosen
+// ref has diverged from the upstream repository's default branch
+type ForkInfo struct {
+	IsFork        bool   `json:"is_fork"`
+	UpstreamOwner string `json:"upstream_owner,omitempty"`
+	UpstreamName  string `json:"upstream_name,omitempty"`
+	UpstreamURL   string `json:"upstream_url,omitempty"`
+	DefaultBranch string `json:"default_branch,omitempty"` // Upstream default branch used for comparison
+	AheadBy       int    `json:"ahead_by"`
+	BehindBy      int    `json:"behind_by"`
+}
+
+// ghRepoDetails models the subset of `gh api repos/owner/repo` we need for
+// fork detection
+type ghRepoDetails struct {
+	Fork   bool `json:"fork"`
+	Parent struct {
+		FullName string `json:"full_name"`
+		HTMLURL  string `json:"html_url"`
+		Owner    struct {
+			Login string `json:"login"`
+		} `json:"owner"`
+		Name          string `json:"name"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"parent"`
+}
+
+// ghCompareResult models the subset of the GitHub compare API response
+type ghCompareResult struct {
+	AheadBy  int `json:"ahead_by"`
+	BehindBy int `json:"behind_by"`
+}
+
+// CheckForkStatus checks whether a repository is a fork and, if so, compares
+// the chosen ref against the upstream repository's default branch. Teammates
+// frequently add stale personal forks by accident, so the TUI uses this to
+// show the upstream repo with ahead/behind counts and offer it as an
+// alternative.
+//
+// Requires the GitHub CLI (gh); returns an error when it is unavailable so
+// callers can skip the check gracefully.
+func CheckForkStatus(logger *Logger, owner, name, ref string) (*ForkInfo, error) {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return nil, fmt.Errorf("GitHub CLI (gh) not found, skipping fork detection")
+	}
+
+	// Fetch repository details including the parent (upstream) repo
+	cmd := exec.Command("gh", "api", fmt.Sprintf("repos/%s/%s", owner, name))
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("gh api failed: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to run gh api: %w", err)
+	}
+
+	var details ghRepoDetails
+	if err := json.Unmarshal(output, &details); err != nil {
+		return nil, fmt.Errorf("failed to parse repository details: %w", err)
+	}
+
+	if !details.Fork || details.Parent.FullName == "" {
+		return &ForkInfo{IsFork: false}, nil
+	}
+
+	info := &ForkInfo{
+		IsFork:        true,
+		UpstreamOwner: details.Parent.Owner.Login,
+		UpstreamName:  details.Parent.Name,
+		UpstreamURL:   details.Parent.HTMLURL,
+		DefaultBranch: details.Parent.DefaultBranch,
+	}
+	if !strings.HasSuffix(info.UpstreamURL, ".git") && info.UpstreamURL != "" {
+		info.UpstreamURL += ".git"
+	}
+
+	logger.Info("git", fmt.Sprintf("%s/%s is a fork of %s", owner, name, details.Parent.FullName))
+
+	// Compare the chosen ref on the fork against the upstream default branch
+	// using the cross-repo compare API: base...forkOwner:ref
+	branch := ref
+	if branch == "" {
+		branch = info.DefaultBranch
+	}
+	comparePath := fmt.Sprintf("repos/%s/compare/%s...%s:%s",
+		details.Parent.FullName, info.DefaultBranch, owner, branch)
+
+	cmd = exec.Command("gh", "api", comparePath)
+	output, err = cmd.Output()
+	if err != nil {
+		// Comparison can fail (e.g. unrelated histories) - still report the fork
+		logger.Debug("git", fmt.Sprintf("Fork comparison failed for %s/%s: %v", owner, name, err))
+		return info, nil
+	}
+
+	var compare ghCompareResult
+	if err := json.Unmarshal(output, &compare); err != nil {
+		logger.Debug("git", fmt.Sprintf("Failed to parse compare result: %v", err))
+		return info, nil
+	}
+
+	info.AheadBy = compare.AheadBy
+	info.BehindBy = compare.BehindBy
+
+	logger.Info("git", fmt.Sprintf("%s/%s@%s is %d ahead / %d behind %s",
+		owner, name, branch, info.AheadBy, info.BehindBy, details.Parent.FullName))
+
+	return info, nil
+}
+
 // GetGitVersion returns the git version string
 func GetGitVersion() (string, error) {
 	cmd := exec.Command("git", "--version")
@@ -322,10 +625,30 @@ type GitHubPackageNameResult struct {
 // 2. Fallback 1: Direct HTTP GET from raw.githubusercontent.com (public repos only)
 // 3. Fallback 2: Try alternative branch names (main, master, develop)
 // 4. Final fallback: Use repository name as package name
+//
+// Non-GitHub hosts skip the content fast paths and read pubspec.yaml from a
+// shallow clone instead - slower, but works anywhere git itself can reach.
 func FetchPackageNameFromGit(logger *Logger, gitURL string, ref string, subdir string) (string, error) {
-	// Only supports GitHub repos
+	// Non-GitHub hosts: fall back to git directly (no contents API to lean on)
 	if !strings.Contains(gitURL, "github.com") {
-		return "", fmt.Errorf("non-GitHub repos not yet supported for automatic name detection")
+		branch := ref
+		if branch == "" {
+			branch = "main"
+		}
+		cacheKey := strings.TrimSuffix(strings.TrimSuffix(gitURL, ".git"), "/") + "#" + branch + "#" + subdir
+		packageNameCache.mu.RLock()
+		if cached, exists := packageNameCache.cache[cacheKey]; exists {
+			packageNameCache.mu.RUnlock()
+			logger.Debug("git", fmt.Sprintf("Package name for %s served from cache: %s", gitURL, cached))
+			return cached, nil
+		}
+		packageNameCache.mu.RUnlock()
+
+		packageName, err := fetchPackageNameViaGitClone(logger, gitURL, ref, subdir)
+		if err != nil {
+			return "", err
+		}
+		return cachePackageName(cacheKey, packageName), nil
 	}
 
 	// Extract owner/repo from URL
@@ -355,10 +678,21 @@ func FetchPackageNameFromGit(logger *Logger, gitURL string, ref string, subdir s
 		branch = "main"
 	}
 
+	// Answer from the memo cache when a recent lookup already resolved this
+	// exact URL+ref+subdir combination
+	cacheKey := gitURL + "#" + branch + "#" + subdir
+	packageNameCache.mu.RLock()
+	if cached, exists := packageNameCache.cache[cacheKey]; exists {
+		packageNameCache.mu.RUnlock()
+		logger.Debug("git", fmt.Sprintf("Package name for %s served from cache: %s", ownerRepo, cached))
+		return cached, nil
+	}
+	packageNameCache.mu.RUnlock()
+
 	// METHOD 1: Try gh api (best method - works for public and private repos)
 	if packageName, err := fetchPackageNameViaGhAPI(logger, ownerRepo, pubspecPath); err == nil {
 		logger.Info("git", fmt.Sprintf("✓ Found package name via gh api: %s", packageName))
-		return packageName, nil
+		return cachePackageName(cacheKey, packageName), nil
 	} else {
 		logger.Info("git", fmt.Sprintf("✗ gh api method failed: %s", err.Error()))
 	}
@@ -366,7 +700,7 @@ func FetchPackageNameFromGit(logger *Logger, gitURL string, ref string, subdir s
 	// METHOD 2: Try raw.githubusercontent.com with specified branch (works for public repos)
 	if packageName, err := fetchPackageNameViaHTTP(logger, ownerRepo, pubspecPath, branch); err == nil {
 		logger.Info("git", fmt.Sprintf("✓ Found package name via HTTP (branch: %s): %s", branch, packageName))
-		return packageName, nil
+		return cachePackageName(cacheKey, packageName), nil
 	} else {
 		logger.Info("git", fmt.Sprintf("✗ HTTP method failed for branch '%s': %s", branch, err.Error()))
 	}
@@ -379,11 +713,12 @@ func FetchPackageNameFromGit(logger *Logger, gitURL string, ref string, subdir s
 		}
 		if packageName, err := fetchPackageNameViaHTTP(logger, ownerRepo, pubspecPath, altBranch); err == nil {
 			logger.Info("git", fmt.Sprintf("✓ Found package name via HTTP (alternative branch: %s): %s", altBranch, packageName))
-			return packageName, nil
+			return cachePackageName(cacheKey, packageName), nil
 		}
 	}
 
-	// METHOD 4: Final fallback - use repository name
+	// METHOD 4: Final fallback - use repository name. Deliberately not cached:
+	// a later attempt (e.g. after gh auth login) may resolve the real name.
 	repoName := ownerRepo
 	if slashIdx := strings.LastIndex(ownerRepo, "/"); slashIdx != -1 {
 		repoName = ownerRepo[slashIdx+1:]
@@ -392,6 +727,16 @@ func FetchPackageNameFromGit(logger *Logger, gitURL string, ref string, subdir s
 	return repoName, nil
 }
 
+// cachePackageName stores a resolved package name in packageNameCache and
+// returns it unchanged so call sites can cache and return in one expression
+func cachePackageName(key, name string) string {
+	packageNameCache.mu.Lock()
+	packageNameCache.cache[key] = name
+	packageNameCache.mu.Unlock()
+	go packageNameCache.cleanupAfterTTL(key)
+	return name
+}
+
 // fetchPackageNameViaGhAPI uses GitHub CLI to fetch pubspec.yaml (works for public and private repos)
 func fetchPackageNameViaGhAPI(logger *Logger, ownerRepo string, pubspecPath string) (string, error) {
 	// Build gh api command to fetch pubspec.yaml contents
@@ -472,6 +817,49 @@ func fetchPackageNameViaHTTP(logger *Logger, ownerRepo string, pubspecPath strin
 	return packageName, nil
 }
 
+// fetchPackageNameViaGitClone shallow-clones the repository into a temporary
+// directory and reads pubspec.yaml from the working tree. Much slower than
+// the GitHub content fast paths, but host-agnostic: any URL git can clone
+// (GitLab, Bitbucket, self-hosted, SSH remotes) works.
+func fetchPackageNameViaGitClone(logger *Logger, gitURL string, ref string, subdir string) (string, error) {
+	tmpDir, err := os.MkdirTemp("", "flutter-pm-name-*")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Depth-1 single-branch keeps the transfer minimal; default branches are
+	// not passed explicitly so the host's own default wins (matches
+	// buildCloneArgs above)
+	args := []string{"clone", "--depth", "1", "--single-branch"}
+	if ref != "" && ref != "main" && ref != "master" {
+		args = append(args, "--branch", ref)
+	}
+	args = append(args, gitURL, tmpDir)
+
+	logger.Info("git", fmt.Sprintf("Shallow-cloning %s to read pubspec.yaml", gitURL))
+	cmd := exec.Command("git", args...)
+	ApplySSHIdentity(logger, cmd, gitURL)
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("shallow clone failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	pubspecFile := filepath.Join(tmpDir, "pubspec.yaml")
+	if subdir != "" {
+		pubspecFile = filepath.Join(tmpDir, subdir, "pubspec.yaml")
+	}
+	content, err := os.ReadFile(pubspecFile)
+	if err != nil {
+		return "", fmt.Errorf("repository has no %s: %w", strings.TrimPrefix(pubspecFile, tmpDir+string(os.PathSeparator)), err)
+	}
+
+	packageName := extractPackageNameFromYAML(string(content))
+	if packageName == "" {
+		return "", fmt.Errorf("could not find 'name:' field in pubspec.yaml")
+	}
+	return packageName, nil
+}
+
 // extractPackageNameFromYAML extracts the package name from pubspec.yaml content using proper YAML parsing
 func extractPackageNameFromYAML(content string) string {
 	// Define a minimal structure to extract just the name field
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestBuildCloneArgs verifies shallow/partial clone flags reach git
+func TestBuildCloneArgs(t *testing.T) {
+	cases := []struct {
+		name string
+		cfg  Config
+		ref  string
+		want []string
+	}{
+		{
+			name: "defaults",
+			cfg:  Config{},
+			want: []string{"clone", "--progress", "url", "dir"},
+		},
+		{
+			name: "non-default branch",
+			cfg:  Config{},
+			ref:  "develop",
+			want: []string{"clone", "--progress", "--branch", "develop", "url", "dir"},
+		},
+		{
+			name: "shallow blobless single-branch",
+			cfg:  Config{CloneDepth: 1, CloneFilter: "blob:none", SingleBranch: true},
+			want: []string{"clone", "--progress", "--depth", "1", "--filter=blob:none", "--single-branch", "url", "dir"},
+		},
+	}
+
+	for _, tc := range cases {
+		got := buildCloneArgs(&tc.cfg, "url", "dir", tc.ref)
+		if strings.Join(got, " ") != strings.Join(tc.want, " ") {
+			t.Errorf("%s: buildCloneArgs = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+// TestPackageNameCache verifies a memoized name short-circuits the fallback
+// chain, including URL normalization of the cache key
+func TestPackageNameCache(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+
+	key := "https://github.com/owner/widgets#main#"
+	got := cachePackageName(key, "cool_widgets")
+	if got != "cool_widgets" {
+		t.Errorf("cachePackageName returned %q, want cool_widgets", got)
+	}
+
+	// The .git suffix and empty ref normalize to the same key
+	name, err := FetchPackageNameFromGit(logger, "https://github.com/owner/widgets.git", "", "")
+	if err != nil {
+		t.Fatalf("FetchPackageNameFromGit failed: %v", err)
+	}
+	if name != "cool_widgets" {
+		t.Errorf("FetchPackageNameFromGit = %q, want cached cool_widgets", name)
+	}
+}
+
+// TestPackageNameCacheNonGitHub verifies the non-GitHub path consults the
+// same memo cache before reaching for a shallow clone
+func TestPackageNameCacheNonGitHub(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+
+	cachePackageName("https://gitlab.com/owner/widgets#main#", "gitlab_widgets")
+
+	name, err := FetchPackageNameFromGit(logger, "https://gitlab.com/owner/widgets.git", "", "")
+	if err != nil {
+		t.Fatalf("FetchPackageNameFromGit failed: %v", err)
+	}
+	if name != "gitlab_widgets" {
+		t.Errorf("FetchPackageNameFromGit = %q, want cached gitlab_widgets", name)
+	}
+}
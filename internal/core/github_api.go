@@ -0,0 +1,519 @@
+// Package core/github_api.go - Native GitHub REST Client and Device Flow
+//
+// This file removes the hard dependency on the gh binary for browsing
+// repositories. ListGitHubRepos falls back to the GitHub REST API when gh
+// is missing or unauthenticated, using a personal access token resolved
+// from the environment (GITHUB_TOKEN / GH_TOKEN), the OS keychain (see
+// core/keychain.go) or the global config
+// (~/.config/flutter-pm/config.yaml, `github: token:`). For users with
+// neither, the OAuth device flow lets them sign in from the TUI: GitHub
+// shows a short code, the user confirms it in the browser, and the granted
+// token is stored in the global config for later runs.
+//
+// Key features:
+// - GitHubToken: Resolve a PAT from env, the OS keychain or the global config
+// - ListGitHubReposAPI: Repository listing via the REST API (with topics)
+// - StreamGitHubRepos: Page-by-page listing for instant first results
+// - StartGitHubDeviceFlow / PollGitHubDeviceFlow: OAuth device-flow login
+// - SaveGitHubToken: Persist a granted token to the global config
+
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	githubAPIBase = "https://api.github.com"
+
+	// OAuth app client ID used for the device flow. This is the GitHub
+	// CLI's public client ID - device flow client IDs are not secrets and
+	// reusing it grants the same repo scopes users already trust gh with.
+	githubDeviceClientID = "178c6fc778ccc68e1d6a"
+)
+
+// ErrGitHubAuthRequired signals that neither gh nor a token is available,
+// so the TUI should offer the device-flow sign-in instead of a plain error
+var ErrGitHubAuthRequired = fmt.Errorf("no GitHub authentication available")
+
+// githubTokenYAML models the `github:` section of the global config
+type githubTokenYAML struct {
+	GitHub struct {
+		Token string `yaml:"token"`
+	} `yaml:"github"`
+}
+
+// GitHubToken resolves a personal access token from the environment
+// (GITHUB_TOKEN, then GH_TOKEN), the OS keychain (see core/keychain.go)
+// or the global config; empty when none is configured
+func GitHubToken() string {
+	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
+		return token
+	}
+	if token := os.Getenv("GH_TOKEN"); token != "" {
+		return token
+	}
+	if token := LoadKeychainToken("github.com"); token != "" {
+		return token
+	}
+
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return ""
+	}
+	var parsed githubTokenYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return ""
+	}
+	return strings.TrimSpace(parsed.GitHub.Token)
+}
+
+// SaveGitHubToken persists a token to the global config, preserving any
+// other sections the file already has
+func SaveGitHubToken(token string) error {
+	path := GlobalConfigPath()
+	if path == "" {
+		return fmt.Errorf("could not determine global config path")
+	}
+
+	// Merge into the existing config rather than clobbering it
+	config := make(map[string]interface{})
+	if content, err := os.ReadFile(path); err == nil {
+		if err := yaml.Unmarshal(content, &config); err != nil {
+			return fmt.Errorf("global config %s is not valid YAML: %w", path, err)
+		}
+	}
+
+	github, _ := config["github"].(map[string]interface{})
+	if github == nil {
+		github = make(map[string]interface{})
+	}
+	github["token"] = token
+	config["github"] = github
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return fmt.Errorf("failed to serialize config: %w", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	// The file now holds a credential, so keep it private
+	if err := os.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	return nil
+}
+
+// githubRepoAPIJSON models the relevant parts of the REST repository object
+type githubRepoAPIJSON struct {
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Private     bool      `json:"private"`
+	HTMLURL     string    `json:"html_url"`
+	Topics      []string  `json:"topics"`
+	Stars       int       `json:"stargazers_count"`
+	PushedAt    time.Time `json:"pushed_at"`
+	Language    string    `json:"language"`
+	IsTemplate  bool      `json:"is_template"`
+	Owner       struct {
+		Login string `json:"login"`
+	} `json:"owner"`
+}
+
+// ListGitHubReposAPI lists the authenticated user's repositories via the
+// REST API, mirroring the gh CLI listing (owned and collaborator repos, up
+// to 200, topics included)
+func ListGitHubReposAPI(logger *Logger, token string) ([]RepoCandidate, error) {
+	return listGitHubReposAPIContext(context.Background(), logger, token)
+}
+
+// listGitHubReposAPIContext is the context-aware REST listing; in-flight
+// requests are cancelled along with the context
+func listGitHubReposAPIContext(ctx context.Context, logger *Logger, token string) ([]RepoCandidate, error) {
+	client := &http.Client{
+		Timeout: 15 * time.Second,
+	}
+
+	// Revalidate a persisted listing cheaply: if GitHub answers the first
+	// page with 304 Not Modified for the stored ETag, the whole listing is
+	// still current (see core/diskcache.go)
+	diskCached := loadRepoCache()
+	etag := ""
+	if diskCached != nil {
+		etag = diskCached.ETag
+	}
+
+	var candidates []RepoCandidate
+	var listingETag string
+	for page := 1; page <= 2 && len(candidates) < 200; page++ {
+		pageETag := ""
+		if page == 1 {
+			pageETag = etag
+		}
+		repos, newETag, notModified, err := fetchGitHubRepoPage(ctx, client, token, page, 100, pageETag)
+		if err != nil {
+			return nil, err
+		}
+		if notModified && diskCached != nil {
+			logger.Info("github", "Repository list unchanged upstream (ETag match) - using disk cache")
+			saveRepoCache(diskCached.Repos, diskCached.ETag) // Refresh the TTL
+			githubCache.Set(diskCached.Repos)
+			return diskCached.Repos, nil
+		}
+		if page == 1 {
+			listingETag = newETag
+		}
+
+		for _, repo := range repos {
+			candidates = append(candidates, apiRepoToCandidate(repo))
+		}
+
+		// Short page means there is no next one
+		if len(repos) < 100 {
+			break
+		}
+	}
+
+	// Cache the results alongside the gh-based listing, keeping the ETag
+	// for the next launch's revalidation
+	githubCache.Set(candidates)
+	saveRepoCache(candidates, listingETag)
+
+	logger.Info("github", fmt.Sprintf("Found %d repositories via REST API", len(candidates)))
+	return candidates, nil
+}
+
+// apiRepoToCandidate converts one REST repository object into the shared
+// RepoCandidate shape used by the selection flows
+func apiRepoToCandidate(repo githubRepoAPIJSON) RepoCandidate {
+	privacy := "public"
+	if repo.Private {
+		privacy = "private"
+	}
+
+	// Use HTTPS URL and add .git suffix for consistency
+	gitURL := repo.HTMLURL
+	if !strings.HasSuffix(gitURL, ".git") {
+		gitURL += ".git"
+	}
+
+	return RepoCandidate{
+		Owner:      repo.Owner.Login,
+		Name:       repo.Name,
+		URL:        gitURL,
+		Privacy:    privacy,
+		Desc:       repo.Description,
+		Topics:     repo.Topics,
+		Stars:      repo.Stars,
+		PushedAt:   repo.PushedAt,
+		Language:   repo.Language,
+		IsTemplate: repo.IsTemplate,
+	}
+}
+
+// fetchGitHubRepoPage fetches one page of the /user/repos listing. A
+// non-empty etag is sent as If-None-Match; notModified reports a 304
+// answer, and newETag carries the response ETag for the next revalidation.
+func fetchGitHubRepoPage(ctx context.Context, client *http.Client, token string, page, perPage int, etag string) (repos []githubRepoAPIJSON, newETag string, notModified bool, err error) {
+	reqURL := fmt.Sprintf("%s/user/repos?per_page=%d&page=%d&sort=updated", githubAPIBase, perPage, page)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, "", false, err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", false, fmt.Errorf("GitHub API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		return nil, etag, true, nil
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, "", false, fmt.Errorf("GitHub token was rejected (HTTP 401) - generate a new token or sign in again")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", false, fmt.Errorf("GitHub API returned HTTP %d", resp.StatusCode)
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(&repos); err != nil {
+		return nil, "", false, fmt.Errorf("failed to parse GitHub API response: %w", err)
+	}
+	return repos, resp.Header.Get("ETag"), false, nil
+}
+
+// RepoPage is one batch of a streaming repository listing. Repos holds
+// only the repositories on this page; Done marks the final page (which may
+// be empty when a later page fails after partial results).
+type RepoPage struct {
+	Repos []RepoCandidate
+	Done  bool
+	Err   error
+}
+
+const (
+	// repoStreamPageSize keeps the first page small enough that users with
+	// many repositories see results instantly
+	repoStreamPageSize = 50
+
+	// repoStreamMaxPages caps the streamed listing at the same 200
+	// repositories the blocking gh listing fetches
+	repoStreamMaxPages = 4
+)
+
+// StreamGitHubRepos lists the user's repositories page by page: the first
+// page arrives immediately and subsequent pages stream in the background.
+// Cache hits (memory or disk) deliver the whole listing as a single final
+// page. The channel is closed after the Done page.
+func StreamGitHubRepos(logger *Logger) <-chan RepoPage {
+	pages := make(chan RepoPage, repoStreamMaxPages)
+	go func() {
+		defer close(pages)
+		streamGitHubRepoPages(logger, pages)
+	}()
+	return pages
+}
+
+// streamGitHubRepoPages drives the paged fetch, mirroring the cache and
+// auth fallback chain of ListGitHubReposContext
+func streamGitHubRepoPages(logger *Logger, pages chan<- RepoPage) {
+	// Cache hits skip the round trips entirely
+	if cached := githubCache.Get(); cached != nil {
+		logger.Info("github", "Using cached repository list")
+		pages <- RepoPage{Repos: cached, Done: true}
+		return
+	}
+	if cached := loadRepoCache(); cached != nil && time.Since(cached.SavedAt) < RepoDiskCacheMaxAge {
+		logger.Info("github", "Using disk-cached repository list")
+		githubCache.Set(cached.Repos)
+		pages <- RepoPage{Repos: cached.Repos, Done: true}
+		return
+	}
+
+	ctx, cancel := withDefaultTimeout(context.Background(), DefaultListTimeout)
+	defer cancel()
+
+	fetch, err := repoPageFetcher(ctx, logger)
+	if err != nil {
+		pages <- RepoPage{Err: err, Done: true}
+		return
+	}
+
+	var all []RepoCandidate
+	finish := func(batch []RepoCandidate) {
+		githubCache.Set(all)
+		saveRepoCache(all, "")
+		logger.Info("github", fmt.Sprintf("Found %d repositories", len(all)))
+		pages <- RepoPage{Repos: batch, Done: true}
+	}
+
+	for page := 1; page <= repoStreamMaxPages; page++ {
+		raw, err := fetch(page)
+		if err != nil {
+			if len(all) == 0 {
+				pages <- RepoPage{Err: err, Done: true}
+				return
+			}
+			// A later page failing still leaves a usable partial listing
+			logger.Debug("github", fmt.Sprintf("Repository page %d failed: %v - continuing with %d repositories", page, err, len(all)))
+			finish(nil)
+			return
+		}
+
+		batch := make([]RepoCandidate, 0, len(raw))
+		for _, repo := range raw {
+			batch = append(batch, apiRepoToCandidate(repo))
+		}
+		all = append(all, batch...)
+
+		// A short page means there is no next one
+		if len(raw) < repoStreamPageSize || page == repoStreamMaxPages {
+			finish(batch)
+			return
+		}
+		pages <- RepoPage{Repos: batch}
+	}
+}
+
+// repoPageFetcher picks the page fetch backend following the same chain as
+// the blocking listing: gh when available and authenticated, the REST API
+// with a configured token otherwise
+func repoPageFetcher(ctx context.Context, logger *Logger) (func(page int) ([]githubRepoAPIJSON, error), error) {
+	if _, err := exec.LookPath("gh"); err == nil {
+		if exec.CommandContext(ctx, "gh", "auth", "status").Run() == nil {
+			// While backing off from an earlier rate-limit error, fail fast
+			// with the reset time (see ratelimit.go)
+			if IsGitHubRateLimited() {
+				return nil, fmt.Errorf("%s", GitHubRateLimitMessage())
+			}
+			logger.Info("github", "Fetching repositories from GitHub")
+			return func(page int) ([]githubRepoAPIJSON, error) {
+				return fetchGitHubRepoPageCLI(ctx, page)
+			}, nil
+		}
+	}
+
+	if token := GitHubToken(); token != "" {
+		logger.Info("github", "Using REST API with configured token")
+		client := &http.Client{
+			Timeout: 15 * time.Second,
+		}
+		return func(page int) ([]githubRepoAPIJSON, error) {
+			repos, _, _, err := fetchGitHubRepoPage(ctx, client, token, page, repoStreamPageSize, "")
+			return repos, err
+		}, nil
+	}
+
+	return nil, fmt.Errorf("GitHub CLI (gh) not found and no token configured: %w", ErrGitHubAuthRequired)
+}
+
+// fetchGitHubRepoPageCLI fetches one REST listing page through `gh api`,
+// so paging rides on gh's own authentication
+func fetchGitHubRepoPageCLI(ctx context.Context, page int) ([]githubRepoAPIJSON, error) {
+	cmd := exec.CommandContext(ctx, "gh", "api",
+		fmt.Sprintf("user/repos?per_page=%d&page=%d&sort=updated", repoStreamPageSize, page))
+
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if NoteGitHubRateLimitError(stderr.String()) {
+			return nil, fmt.Errorf("%s", GitHubRateLimitMessage())
+		}
+		return nil, fmt.Errorf("failed to list repositories: %s", contextFailure(ctx, "repository listing", err))
+	}
+
+	var repos []githubRepoAPIJSON
+	if err := json.Unmarshal(stdout.Bytes(), &repos); err != nil {
+		return nil, fmt.Errorf("failed to parse repository JSON: %w", err)
+	}
+	return repos, nil
+}
+
+// DeviceAuth holds the state of a pending device-flow authorization
+type DeviceAuth struct {
+	DeviceCode      string `json:"device_code"`
+	UserCode        string `json:"user_code"`
+	VerificationURI string `json:"verification_uri"`
+	ExpiresIn       int    `json:"expires_in"`
+	Interval        int    `json:"interval"`
+}
+
+// StartGitHubDeviceFlow requests a device/user code pair from GitHub. The
+// user enters UserCode at VerificationURI; PollGitHubDeviceFlow then waits
+// for the grant.
+func StartGitHubDeviceFlow() (*DeviceAuth, error) {
+	form := url.Values{
+		"client_id": {githubDeviceClientID},
+		"scope":     {"repo"},
+	}
+
+	var auth DeviceAuth
+	if err := postGitHubForm("https://github.com/login/device/code", form, &auth); err != nil {
+		return nil, err
+	}
+	if auth.DeviceCode == "" || auth.UserCode == "" {
+		return nil, fmt.Errorf("GitHub did not return a device code")
+	}
+	if auth.Interval < 5 {
+		auth.Interval = 5
+	}
+	return &auth, nil
+}
+
+// deviceTokenResponse models the access token poll response
+type deviceTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	Error       string `json:"error"`
+	Interval    int    `json:"interval"`
+}
+
+// PollGitHubDeviceFlow blocks until the user approves the device code,
+// the code expires, or access is denied, and returns the granted token
+func PollGitHubDeviceFlow(auth *DeviceAuth) (string, error) {
+	form := url.Values{
+		"client_id":   {githubDeviceClientID},
+		"device_code": {auth.DeviceCode},
+		"grant_type":  {"urn:ietf:params:oauth:grant-type:device_code"},
+	}
+
+	interval := time.Duration(auth.Interval) * time.Second
+	deadline := time.Now().Add(time.Duration(auth.ExpiresIn) * time.Second)
+
+	for time.Now().Before(deadline) {
+		time.Sleep(interval)
+
+		var token deviceTokenResponse
+		if err := postGitHubForm("https://github.com/login/oauth/access_token", form, &token); err != nil {
+			return "", err
+		}
+
+		switch token.Error {
+		case "":
+			if token.AccessToken != "" {
+				return token.AccessToken, nil
+			}
+		case "authorization_pending":
+			// Keep waiting
+		case "slow_down":
+			interval += 5 * time.Second
+		case "expired_token":
+			return "", fmt.Errorf("the sign-in code expired - start over")
+		case "access_denied":
+			return "", fmt.Errorf("sign-in was denied in the browser")
+		default:
+			return "", fmt.Errorf("GitHub device flow failed: %s", token.Error)
+		}
+	}
+
+	return "", fmt.Errorf("the sign-in code expired - start over")
+}
+
+// postGitHubForm posts a form to a GitHub OAuth endpoint and decodes the
+// JSON response into out
+func postGitHubForm(endpoint string, form url.Values, out interface{}) error {
+	client := &http.Client{
+		Timeout: 15 * time.Second,
+	}
+
+	req, err := http.NewRequest(http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("GitHub request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GitHub returned HTTP %d", resp.StatusCode)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to parse GitHub response: %w", err)
+	}
+	return nil
+}
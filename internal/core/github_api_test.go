@@ -0,0 +1,67 @@
+// Package core/github_api_test.go - Tests for the GitHub listing helpers
+//
+// Covers the streamed repository listing's cache fast path and the REST
+// object conversion shared between the blocking and streamed listings.
+
+package core
+
+import (
+	"testing"
+)
+
+// TestStreamGitHubReposFromCache verifies a memory cache hit delivers the
+// whole listing as a single final page without any network round trips
+func TestStreamGitHubReposFromCache(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+
+	cached := []RepoCandidate{
+		{Owner: "u", Name: "one", URL: "https://github.com/u/one.git"},
+		{Owner: "u", Name: "two", URL: "https://github.com/u/two.git"},
+	}
+	githubCache.Set(cached)
+	defer githubCache.InvalidateCache()
+
+	var pages []RepoPage
+	for page := range StreamGitHubRepos(logger) {
+		pages = append(pages, page)
+	}
+
+	if len(pages) != 1 {
+		t.Fatalf("expected a single page from the cache, got %d", len(pages))
+	}
+	if !pages[0].Done {
+		t.Error("expected the cache page to be marked done")
+	}
+	if len(pages[0].Repos) != 2 {
+		t.Errorf("expected 2 cached repos, got %d", len(pages[0].Repos))
+	}
+	if pages[0].Err != nil {
+		t.Errorf("unexpected error: %v", pages[0].Err)
+	}
+}
+
+// TestAPIRepoToCandidate verifies the REST repository object converts to
+// the shared candidate shape, including the .git URL normalization
+func TestAPIRepoToCandidate(t *testing.T) {
+	repo := githubRepoAPIJSON{
+		Name:        "pkg",
+		Description: "a package",
+		Private:     true,
+		HTMLURL:     "https://github.com/u/pkg",
+		Topics:      []string{"flutter"},
+		Stars:       7,
+		Language:    "Dart",
+	}
+	repo.Owner.Login = "u"
+
+	got := apiRepoToCandidate(repo)
+	if got.URL != "https://github.com/u/pkg.git" {
+		t.Errorf("expected .git suffix on URL, got %s", got.URL)
+	}
+	if got.Privacy != "private" {
+		t.Errorf("expected private, got %s", got.Privacy)
+	}
+	if got.Owner != "u" || got.Name != "pkg" || got.Language != "Dart" || got.Stars != 7 {
+		t.Errorf("unexpected candidate fields: %+v", got)
+	}
+}
@@ -0,0 +1,266 @@
+// Package core/hooks.go - Dependency Hygiene Assertions and Git Hooks
+//
+// This file implements `flutter-pm assert` - a fast, non-mutating set of
+// dependency hygiene checks (lock consistency, pinning policy, leftover
+// local path switches) designed to
+// run inside git hooks - and the generator that installs a pre-commit or
+// pre-push hook calling it. The checks only read pubspec.yaml and
+// pubspec.lock (no network), so a hook adds milliseconds, not seconds,
+// to each commit.
+//
+// Key features:
+// - AssertProject: Run all hygiene checks, one ActionResult per check
+// - InstallGitHook: Write a pre-commit/pre-push hook calling flutter-pm assert
+// - Generated hooks carry a marker so reinstalls never clobber user hooks
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// hookMarker identifies hooks written by this tool, so installs can safely
+// overwrite our own hook but never a user's
+const hookMarker = "# generated by flutter-pm"
+
+// AssertProject runs the dependency hygiene checks against a project and
+// returns one result per check. All checks are local file reads; failures
+// come with actionable messages suitable for hook output.
+func AssertProject(logger *Logger, projectPath string) []ActionResult {
+	var results []ActionResult
+
+	deps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return []ActionResult{{
+			OK:  false,
+			Err: fmt.Sprintf("lock consistency: cannot read pubspec.yaml: %v", err),
+		}}
+	}
+
+	results = append(results, assertLockConsistency(projectPath, deps))
+	results = append(results, assertPolicy(deps)...)
+	results = append(results, assertNoPathSwitches(projectPath))
+
+	for _, result := range results {
+		if result.OK {
+			logger.Debug("assert", result.Message)
+		} else {
+			logger.Debug("assert", result.Err)
+		}
+	}
+	return results
+}
+
+// assertLockConsistency checks that pubspec.lock exists, is newer than
+// pubspec.yaml (an older lock means edits were never resolved) and covers
+// every git dependency
+func assertLockConsistency(projectPath string, deps []PkgSpec) ActionResult {
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	lockPath := filepath.Join(projectPath, "pubspec.lock")
+
+	lockInfo, err := os.Stat(lockPath)
+	if os.IsNotExist(err) {
+		return ActionResult{
+			OK:  false,
+			Err: "lock consistency: pubspec.lock is missing - run 'dart pub get' (or flutter-pm sync) before committing",
+		}
+	}
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("lock consistency: cannot stat pubspec.lock: %v", err),
+		}
+	}
+
+	if pubspecInfo, err := os.Stat(pubspecPath); err == nil && pubspecInfo.ModTime().After(lockInfo.ModTime()) {
+		return ActionResult{
+			OK:  false,
+			Err: "lock consistency: pubspec.yaml was modified after pubspec.lock - run 'dart pub get' to resolve before committing",
+		}
+	}
+
+	lock, err := parsePubspecLock(lockPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("lock consistency: cannot parse pubspec.lock: %v", err),
+		}
+	}
+
+	var missing []string
+	for _, dep := range deps {
+		if locked, ok := lock.Dependencies[dep.Name]; !ok || locked.Source != "git" {
+			missing = append(missing, dep.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return ActionResult{
+			OK: false,
+			Err: fmt.Sprintf("lock consistency: git dependencies not resolved in pubspec.lock: %s - run 'dart pub get'",
+				strings.Join(missing, ", ")),
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: "lock consistency: pubspec.lock is present and covers all git dependencies",
+	}
+}
+
+// assertNoPathSwitches warns when dependencies are still switched to a
+// local path (see core/pathswitch.go) - committing those would break every
+// other checkout of the project
+func assertNoPathSwitches(projectPath string) ActionResult {
+	switches, err := ListPathSwitches(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("path switches: cannot read pubspec.yaml: %v", err),
+		}
+	}
+	if len(switches) > 0 {
+		names := make([]string, 0, len(switches))
+		for _, sw := range switches {
+			names = append(names, sw.Name)
+		}
+		return ActionResult{
+			OK: false,
+			Err: fmt.Sprintf("path switches: %s still resolve(s) from a local path - switch back to git before committing",
+				strings.Join(names, ", ")),
+		}
+	}
+	return ActionResult{
+		OK:      true,
+		Message: "path switches: no dependencies are switched to a local path",
+	}
+}
+
+// assertPolicy checks every git dependency against the pinning policy
+// (see core/policy.go); compliant projects get a single passing result
+func assertPolicy(deps []PkgSpec) []ActionResult {
+	policy := LoadPolicyConfig()
+
+	var results []ActionResult
+	for _, dep := range deps {
+		for _, violation := range policy.Check(dep) {
+			results = append(results, ActionResult{
+				OK:  false,
+				Err: "policy: " + violation,
+			})
+		}
+	}
+	if len(results) == 0 {
+		results = append(results, ActionResult{
+			OK:      true,
+			Message: "policy: all git dependencies satisfy the pinning policy",
+		})
+	}
+	return results
+}
+
+// InstallGitHook writes a hook script calling `flutter-pm assert` into the
+// project's .git/hooks directory. hookName is "pre-commit" or "pre-push".
+// An existing hook not written by this tool is left untouched.
+func InstallGitHook(logger *Logger, cfg *Config, projectPath, hookName string) ActionResult {
+	if hookName != "pre-commit" && hookName != "pre-push" {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("unsupported hook %q (use pre-commit or pre-push)", hookName),
+		}
+	}
+
+	hooksDir, err := gitHooksDir(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+	hookPath := filepath.Join(hooksDir, hookName)
+
+	// Never clobber a hook the user wrote themselves
+	if existing, err := os.ReadFile(hookPath); err == nil && !strings.Contains(string(existing), hookMarker) {
+		return ActionResult{
+			OK: false,
+			Err: fmt.Sprintf("a %s hook already exists at %s - merge 'flutter-pm assert' into it manually",
+				hookName, hookPath),
+		}
+	}
+
+	action := "Commit"
+	if hookName == "pre-push" {
+		action = "Push"
+	}
+	script := fmt.Sprintf(`#!/bin/sh
+%s - dependency hygiene checks
+# Reinstall with: flutter-pm hook install
+flutter-pm assert || {
+  echo ""
+  echo "%s blocked: dependency hygiene checks failed."
+  echo "Fix the issues above, or bypass once with --no-verify."
+  exit 1
+}
+`, hookMarker, action)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would install %s hook at %s", hookName, hookPath),
+		}
+	}
+
+	if err := os.MkdirAll(hooksDir, 0755); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to create hooks directory: %v", err),
+		}
+	}
+	if err := os.WriteFile(hookPath, []byte(script), 0755); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to write hook: %v", err),
+		}
+	}
+
+	logger.Info("hooks", fmt.Sprintf("Installed %s hook at %s", hookName, hookPath))
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Installed %s hook running 'flutter-pm assert'", hookName),
+		Data: map[string]interface{}{
+			"hook_path": hookPath,
+		},
+	}
+}
+
+// gitHooksDir resolves the hooks directory for a project, following the
+// `gitdir:` pointer worktrees and submodules use instead of a .git folder
+func gitHooksDir(projectPath string) (string, error) {
+	gitPath := filepath.Join(projectPath, ".git")
+	info, err := os.Stat(gitPath)
+	if err != nil {
+		return "", fmt.Errorf("%s is not a git repository (no .git)", projectPath)
+	}
+
+	if info.IsDir() {
+		return filepath.Join(gitPath, "hooks"), nil
+	}
+
+	// .git file: "gitdir: <path>"
+	content, err := os.ReadFile(gitPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read .git file: %w", err)
+	}
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(line, "gitdir:") {
+			dir := strings.TrimSpace(strings.TrimPrefix(line, "gitdir:"))
+			if !filepath.IsAbs(dir) {
+				dir = filepath.Join(projectPath, dir)
+			}
+			return filepath.Join(dir, "hooks"), nil
+		}
+	}
+	return "", fmt.Errorf("cannot resolve git directory from %s", gitPath)
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// TestAssertLockConsistencyMissingLock verifies a missing pubspec.lock
+// fails with a pub get hint
+func TestAssertLockConsistencyMissingLock(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, "name: test_app\n")
+
+	result := assertLockConsistency(projectPath, nil)
+	if result.OK {
+		t.Fatal("expected failure for missing pubspec.lock")
+	}
+	if !strings.Contains(result.Err, "pub get") {
+		t.Errorf("error should hint at pub get: %s", result.Err)
+	}
+}
+
+// TestAssertLockConsistencyStaleLock verifies a lock older than
+// pubspec.yaml fails as an unresolved state
+func TestAssertLockConsistencyStaleLock(t *testing.T) {
+	projectPath := t.TempDir()
+	lockPath := filepath.Join(projectPath, "pubspec.lock")
+	if err := os.WriteFile(lockPath, []byte("packages: {}\n"), 0644); err != nil {
+		t.Fatalf("failed to write lock: %v", err)
+	}
+	old := time.Now().Add(-time.Hour)
+	if err := os.Chtimes(lockPath, old, old); err != nil {
+		t.Fatalf("failed to age lock: %v", err)
+	}
+	writePubspecFixture(t, projectPath, "name: test_app\n")
+
+	result := assertLockConsistency(projectPath, nil)
+	if result.OK {
+		t.Fatal("expected failure for lock older than pubspec.yaml")
+	}
+	if !strings.Contains(result.Err, "modified after pubspec.lock") {
+		t.Errorf("error should explain the unresolved state: %s", result.Err)
+	}
+}
+
+// TestAssertLockConsistencyUnresolvedDep verifies git dependencies
+// missing from the lock are reported by name
+func TestAssertLockConsistencyUnresolvedDep(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, "name: test_app\n")
+	lockContent := `packages:
+  other_pkg:
+    source: hosted
+    version: "1.0.0"
+`
+	if err := os.WriteFile(filepath.Join(projectPath, "pubspec.lock"), []byte(lockContent), 0644); err != nil {
+		t.Fatalf("failed to write lock: %v", err)
+	}
+
+	deps := []PkgSpec{{Name: "my_git_pkg", URL: "https://github.com/owner/my_git_pkg.git"}}
+	result := assertLockConsistency(projectPath, deps)
+	if result.OK {
+		t.Fatal("expected failure for unresolved git dependency")
+	}
+	if !strings.Contains(result.Err, "my_git_pkg") {
+		t.Errorf("error should name the unresolved package: %s", result.Err)
+	}
+}
+
+// TestInstallGitHook verifies hook installation, the marker-based
+// overwrite rule and refusal to clobber user hooks
+func TestInstallGitHook(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(projectPath, ".git", "hooks"), 0755); err != nil {
+		t.Fatalf("failed to create .git: %v", err)
+	}
+
+	result := InstallGitHook(logger, &cfg, projectPath, "pre-commit")
+	if !result.OK {
+		t.Fatalf("InstallGitHook failed: %s", result.Err)
+	}
+
+	hookPath := filepath.Join(projectPath, ".git", "hooks", "pre-commit")
+	content, err := os.ReadFile(hookPath)
+	if err != nil {
+		t.Fatalf("hook not written: %v", err)
+	}
+	for _, want := range []string{hookMarker, "flutter-pm assert", "--no-verify"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("hook missing %q:\n%s", want, content)
+		}
+	}
+
+	// Reinstalling over our own hook is fine
+	if result := InstallGitHook(logger, &cfg, projectPath, "pre-commit"); !result.OK {
+		t.Errorf("reinstall over own hook failed: %s", result.Err)
+	}
+
+	// A user hook must never be overwritten
+	userHook := "#!/bin/sh\necho custom\n"
+	if err := os.WriteFile(hookPath, []byte(userHook), 0755); err != nil {
+		t.Fatalf("failed to write user hook: %v", err)
+	}
+	result = InstallGitHook(logger, &cfg, projectPath, "pre-commit")
+	if result.OK {
+		t.Fatal("expected refusal to overwrite a user hook")
+	}
+	if content, _ := os.ReadFile(hookPath); string(content) != userHook {
+		t.Error("user hook was modified")
+	}
+}
+
+// TestInstallGitHookUnsupportedName verifies only pre-commit/pre-push
+// are accepted
+func TestInstallGitHookUnsupportedName(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	result := InstallGitHook(logger, &cfg, t.TempDir(), "post-merge")
+	if result.OK {
+		t.Fatal("expected failure for unsupported hook name")
+	}
+}
@@ -0,0 +1,219 @@
+// Package core/impact.go - Dependency Impact Analysis
+//
+// This file answers "what breaks if I bump this?" before a dependency is
+// updated. The resolved dependency graph is read from `pub deps --json` and
+// inverted, giving the set of packages that depend on the target directly
+// plus the transitive closure above them. The ref-switch screen shows the
+// result as an impact panel before the user picks a new ref, and the same
+// context is fed into the conflict analyzer so a post-update resolution
+// failure names the packages that pulled the conflict in.
+//
+// Key features:
+// - AnalyzeDependencyImpact: Invert the pub deps graph around one package
+// - PanelLines: Render the impact as indented panel lines for the TUI
+// - EnrichConflictAnalysis: Add dependent context to a ConflictAnalysis
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DependencyImpact describes which packages are affected when one
+// dependency changes
+type DependencyImpact struct {
+	Package              string            // The package being bumped
+	DirectDependents     []string          // Packages that depend on it directly
+	TransitiveDependents []string          // Packages above the direct dependents
+	Versions             map[string]string // Resolved version per affected package
+	RootConstraint       string            // The project's own declared constraint, if hosted
+}
+
+// Affected reports whether any other package depends on the target
+func (impact *DependencyImpact) Affected() int {
+	return len(impact.DirectDependents) + len(impact.TransitiveDependents)
+}
+
+// PanelLines renders the impact as display lines for the TUI panel. The
+// list is capped so a deeply shared package doesn't flood the screen.
+func (impact *DependencyImpact) PanelLines() []string {
+	if impact.Affected() == 0 {
+		return []string{fmt.Sprintf("No other packages depend on %s - low bump risk", impact.Package)}
+	}
+
+	var lines []string
+	lines = append(lines, fmt.Sprintf("%d package(s) depend on %s:", impact.Affected(), impact.Package))
+
+	const maxListed = 8
+	listed := 0
+	describe := func(names []string, label string) {
+		for _, name := range names {
+			if listed >= maxListed {
+				return
+			}
+			line := fmt.Sprintf("  • %s (%s)", name, label)
+			if version := impact.Versions[name]; version != "" {
+				line = fmt.Sprintf("  • %s %s (%s)", name, version, label)
+			}
+			lines = append(lines, line)
+			listed++
+		}
+	}
+	describe(impact.DirectDependents, "direct")
+	describe(impact.TransitiveDependents, "transitive")
+	if remaining := impact.Affected() - listed; remaining > 0 {
+		lines = append(lines, fmt.Sprintf("  ... and %d more", remaining))
+	}
+
+	if impact.RootConstraint != "" {
+		lines = append(lines, fmt.Sprintf("Project constraint: %s", impact.RootConstraint))
+	}
+	return lines
+}
+
+// AnalyzeDependencyImpact computes the reverse-dependency closure of one
+// package from the project's resolved graph. It requires a successful prior
+// resolution (`pub deps` reads pubspec.lock/.dart_tool); projects that have
+// never run pub get return an error and the caller skips the panel.
+func AnalyzeDependencyImpact(logger *Logger, projectPath, pkgName string) (*DependencyImpact, error) {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(toolArgs, "pub", "deps", "--json")
+	logger.Debug("impact", fmt.Sprintf("Running %s %s", tool, strings.Join(args, " ")))
+
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("pub deps failed (run pub get first): %w", err)
+	}
+
+	impact, err := impactFromDepsJSON(output, pkgName)
+	if err != nil {
+		return nil, err
+	}
+
+	// The project's own declared constraint is the first thing that conflicts
+	// with a bump, so surface it alongside the dependent list
+	if constraint, err := declaredConstraint(projectPath, pkgName); err == nil {
+		impact.RootConstraint = constraint
+	}
+	return impact, nil
+}
+
+// pubDepsGraphJSON models the full dependency graph of `pub deps --json`
+// (conflict_strategies.go reads a narrower slice of the same output)
+type pubDepsGraphJSON struct {
+	Root     string `json:"root"`
+	Packages []struct {
+		Name         string   `json:"name"`
+		Version      string   `json:"version"`
+		Kind         string   `json:"kind"`
+		Dependencies []string `json:"dependencies"`
+	} `json:"packages"`
+}
+
+// impactFromDepsJSON inverts the dependency graph around pkgName. The root
+// package is excluded from the dependent lists - the project depending on
+// its own dependency is a given, not an impact.
+func impactFromDepsJSON(data []byte, pkgName string) (*DependencyImpact, error) {
+	var deps pubDepsGraphJSON
+	if err := json.Unmarshal(data, &deps); err != nil {
+		return nil, fmt.Errorf("failed to parse pub deps output: %w", err)
+	}
+
+	// Reverse adjacency: for each edge A -> B record B is depended on by A
+	dependents := make(map[string][]string)
+	versions := make(map[string]string)
+	rootName := deps.Root
+	for _, pkg := range deps.Packages {
+		versions[pkg.Name] = pkg.Version
+		if pkg.Kind == "root" {
+			rootName = pkg.Name
+			continue
+		}
+		for _, dep := range pkg.Dependencies {
+			dependents[dep] = append(dependents[dep], pkg.Name)
+		}
+	}
+
+	impact := &DependencyImpact{
+		Package:  pkgName,
+		Versions: versions,
+	}
+
+	// BFS over the reverse edges: level one is direct, everything beyond is
+	// transitive
+	direct := dependents[pkgName]
+	seen := map[string]bool{pkgName: true, rootName: true}
+	var transitive []string
+	queue := append([]string(nil), direct...)
+	for _, name := range direct {
+		seen[name] = true
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, parent := range dependents[current] {
+			if seen[parent] {
+				continue
+			}
+			seen[parent] = true
+			transitive = append(transitive, parent)
+			queue = append(queue, parent)
+		}
+	}
+
+	sort.Strings(direct)
+	sort.Strings(transitive)
+	impact.DirectDependents = direct
+	impact.TransitiveDependents = transitive
+	return impact, nil
+}
+
+// declaredConstraint reads the project's own version constraint on pkgName
+// from pubspec.yaml. Git and path dependencies have no constraint and
+// return "".
+func declaredConstraint(projectPath, pkgName string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return "", err
+	}
+	var pubspec pubspecYAML
+	if err := yaml.Unmarshal(content, &pubspec); err != nil {
+		return "", err
+	}
+	if constraint, ok := pubspec.Dependencies[pkgName].(string); ok {
+		return constraint, nil
+	}
+	return "", nil
+}
+
+// EnrichConflictAnalysis folds the impact context into a conflict analysis:
+// the user message names how widely the package is depended on and the
+// dependents are appended as a resolution hint. A nil impact (e.g. pub deps
+// unavailable mid-conflict) leaves the analysis unchanged.
+func EnrichConflictAnalysis(analysis *ConflictAnalysis, impact *DependencyImpact) {
+	if impact == nil || impact.Affected() == 0 {
+		return
+	}
+
+	affected := append(append([]string(nil), impact.DirectDependents...), impact.TransitiveDependents...)
+	analysis.UserMessage += fmt.Sprintf(" %d package(s) in this project depend on %s.",
+		impact.Affected(), impact.Package)
+	analysis.ResolutionSteps = append(analysis.ResolutionSteps,
+		fmt.Sprintf("Check the constraints of the packages depending on %s: %s",
+			impact.Package, strings.Join(affected, ", ")))
+}
@@ -0,0 +1,105 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// depsJSONFixture models a project where http is used by dio directly and
+// by app_ui through dio, while yaml has no dependents at all
+const depsJSONFixture = `{
+  "root": "app",
+  "packages": [
+    {"name": "app", "version": "1.0.0", "kind": "root", "dependencies": ["dio", "app_ui", "yaml"]},
+    {"name": "dio", "version": "5.4.0", "kind": "direct", "dependencies": ["http"]},
+    {"name": "app_ui", "version": "2.1.0", "kind": "direct", "dependencies": ["dio"]},
+    {"name": "http", "version": "1.2.0", "kind": "transitive", "dependencies": []},
+    {"name": "yaml", "version": "3.1.2", "kind": "direct", "dependencies": []}
+  ]
+}`
+
+func TestImpactFromDepsJSON(t *testing.T) {
+	impact, err := impactFromDepsJSON([]byte(depsJSONFixture), "http")
+	if err != nil {
+		t.Fatalf("impactFromDepsJSON failed: %v", err)
+	}
+
+	if len(impact.DirectDependents) != 1 || impact.DirectDependents[0] != "dio" {
+		t.Errorf("DirectDependents = %v, want [dio]", impact.DirectDependents)
+	}
+	if len(impact.TransitiveDependents) != 1 || impact.TransitiveDependents[0] != "app_ui" {
+		t.Errorf("TransitiveDependents = %v, want [app_ui]", impact.TransitiveDependents)
+	}
+	if impact.Affected() != 2 {
+		t.Errorf("Affected() = %d, want 2", impact.Affected())
+	}
+	if version := impact.Versions["dio"]; version != "5.4.0" {
+		t.Errorf("Versions[dio] = %q, want 5.4.0", version)
+	}
+
+	// The root project never counts as a dependent
+	for _, name := range append(impact.DirectDependents, impact.TransitiveDependents...) {
+		if name == "app" {
+			t.Errorf("root project should be excluded from dependents: %v", impact)
+		}
+	}
+}
+
+func TestImpactNoDependents(t *testing.T) {
+	impact, err := impactFromDepsJSON([]byte(depsJSONFixture), "yaml")
+	if err != nil {
+		t.Fatalf("impactFromDepsJSON failed: %v", err)
+	}
+	if impact.Affected() != 0 {
+		t.Errorf("yaml should have no dependents, got %v", impact)
+	}
+
+	lines := impact.PanelLines()
+	if len(lines) != 1 || !strings.Contains(lines[0], "low bump risk") {
+		t.Errorf("expected a low-risk panel line, got %v", lines)
+	}
+}
+
+func TestImpactPanelLines(t *testing.T) {
+	impact, err := impactFromDepsJSON([]byte(depsJSONFixture), "http")
+	if err != nil {
+		t.Fatalf("impactFromDepsJSON failed: %v", err)
+	}
+	impact.RootConstraint = "^1.2.0"
+
+	panel := strings.Join(impact.PanelLines(), "\n")
+	for _, want := range []string{"2 package(s) depend on http", "dio 5.4.0 (direct)", "app_ui 2.1.0 (transitive)", "^1.2.0"} {
+		if !strings.Contains(panel, want) {
+			t.Errorf("panel missing %q:\n%s", want, panel)
+		}
+	}
+}
+
+func TestEnrichConflictAnalysis(t *testing.T) {
+	impact, err := impactFromDepsJSON([]byte(depsJSONFixture), "http")
+	if err != nil {
+		t.Fatalf("impactFromDepsJSON failed: %v", err)
+	}
+
+	analysis := ConflictAnalysis{
+		ConflictType:   "version",
+		ConflictingPkg: "http",
+		UserMessage:    "Version conflict detected.",
+	}
+	EnrichConflictAnalysis(&analysis, impact)
+
+	if !strings.Contains(analysis.UserMessage, "2 package(s)") {
+		t.Errorf("user message missing dependent count: %s", analysis.UserMessage)
+	}
+	steps := strings.Join(analysis.ResolutionSteps, "\n")
+	if !strings.Contains(steps, "dio") || !strings.Contains(steps, "app_ui") {
+		t.Errorf("resolution steps missing dependents: %s", steps)
+	}
+
+	// A nil impact leaves the analysis untouched
+	before := analysis
+	EnrichConflictAnalysis(&analysis, nil)
+	if analysis.UserMessage != before.UserMessage || len(analysis.ResolutionSteps) != len(before.ResolutionSteps) {
+		t.Errorf("nil impact should be a no-op")
+	}
+}
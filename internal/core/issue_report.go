@@ -0,0 +1,173 @@
+// Package core/issue_report.go - Automatic Issue Template Generation
+//
+// When the same operation keeps failing with the same error category, the
+// results screen offers to generate a pre-filled bug report so systematic
+// failures actually get reported. The report contains sanitized logs (home
+// directory, credentials and tokens stripped), tool and platform versions,
+// and reproduction steps, plus a prefilled new-issue URL. Reports target
+// this tool's GitHub repository by default; teams can point them at an
+// internal tracker with `issue_tracker:` in the global config
+// (~/.config/flutter-pm/config.yaml).
+//
+// Key features:
+// - RecordFailure: Count repeated (operation, error category) failures
+// - ShouldOfferIssueReport: Threshold check for offering a report
+// - BuildIssueReport: Markdown body and prefilled new-issue URL
+// - WriteIssueReport: Save the report under ~/.config/flutter-pm/reports/
+
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultIssueTrackerURL is this tool's public issue tracker
+const DefaultIssueTrackerURL = "https://github.com/daslaller/GoFlutterGithubPackageManager/issues/new"
+
+// issueFailureThreshold is how many identical failures trigger the offer
+const issueFailureThreshold = 3
+
+// failureCounts tracks repeated failures per (operation, category) for the
+// lifetime of the process
+var failureCounts = struct {
+	sync.Mutex
+	counts map[string]int
+}{counts: make(map[string]int)}
+
+// IssueReport is a ready-to-file bug report
+type IssueReport struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+	URL   string `json:"url"` // Prefilled new-issue URL for the configured tracker
+}
+
+// issueConfigYAML is the issue_tracker section of the global config file
+type issueConfigYAML struct {
+	IssueTracker string `yaml:"issue_tracker"`
+}
+
+// RecordFailure counts a failed operation and returns how often this exact
+// (operation, category) pair has failed so far in this process
+func RecordFailure(operation, category string) int {
+	failureCounts.Lock()
+	defer failureCounts.Unlock()
+
+	key := operation + "/" + category
+	failureCounts.counts[key]++
+	return failureCounts.counts[key]
+}
+
+// FailureCount returns the current count without incrementing
+func FailureCount(operation, category string) int {
+	failureCounts.Lock()
+	defer failureCounts.Unlock()
+	return failureCounts.counts[operation+"/"+category]
+}
+
+// ShouldOfferIssueReport reports whether the same failure has repeated often
+// enough that a bug report is worth offering
+func ShouldOfferIssueReport(operation, category string) bool {
+	return FailureCount(operation, category) >= issueFailureThreshold
+}
+
+// BuildIssueReport assembles a pre-filled bug report for a repeated failure
+func BuildIssueReport(cfg *Config, operation, category string, logs []string) IssueReport {
+	count := FailureCount(operation, category)
+
+	title := fmt.Sprintf("flutter-pm: repeated %s failure (%s)", operation, category)
+
+	var b strings.Builder
+	b.WriteString("## What happened\n\n")
+	b.WriteString(fmt.Sprintf("The `%s` operation failed %d times with the same error category (`%s`).\n\n", operation, count, category))
+
+	b.WriteString("## Versions\n\n")
+	b.WriteString(fmt.Sprintf("- flutter-pm: %s\n", CurrentVersion))
+	b.WriteString(fmt.Sprintf("- go runtime: %s\n", runtime.Version()))
+	b.WriteString(fmt.Sprintf("- platform: %s/%s\n\n", runtime.GOOS, runtime.GOARCH))
+
+	b.WriteString("## Reproduction steps\n\n")
+	b.WriteString("1. Run `flutter-pm` in a Flutter project\n")
+	b.WriteString(fmt.Sprintf("2. Perform the %s operation described below\n", operation))
+	b.WriteString("3. Observe the failure in the logs\n\n")
+
+	b.WriteString("## Sanitized logs\n\n```\n")
+	for _, line := range logs {
+		b.WriteString(SanitizeLogLine(line) + "\n")
+	}
+	b.WriteString("```\n")
+
+	if cfg != nil && cfg.DryRun {
+		b.WriteString("\nNote: the tool was running in dry-run mode.\n")
+	}
+
+	body := b.String()
+	return IssueReport{
+		Title: title,
+		Body:  body,
+		URL:   fmt.Sprintf("%s?title=%s&body=%s", issueTrackerURL(), url.QueryEscape(title), url.QueryEscape(body)),
+	}
+}
+
+// WriteIssueReport saves the report as markdown under
+// ~/.config/flutter-pm/reports/ and returns the file path
+func WriteIssueReport(report IssueReport) (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+
+	dir := filepath.Join(homeDir, ".config", "flutter-pm", "reports")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create reports directory: %w", err)
+	}
+
+	path := filepath.Join(dir, fmt.Sprintf("issue-%s.md", time.Now().Format("20060102-150405")))
+	content := fmt.Sprintf("# %s\n\n%s\n\nFile this report at:\n%s\n", report.Title, report.Body, report.URL)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("failed to write issue report: %w", err)
+	}
+
+	return path, nil
+}
+
+// Credential patterns that must never leave the machine in a report
+var (
+	tokenPattern   = regexp.MustCompile(`(ghp_|gho_|github_pat_)[A-Za-z0-9_]+`)
+	urlCredPattern = regexp.MustCompile(`(https?://)[^/\s@]+:[^/\s@]+@`)
+)
+
+// SanitizeLogLine strips the user's home directory, access tokens and URL
+// credentials from a log line before it goes into a report
+func SanitizeLogLine(line string) string {
+	if homeDir, err := os.UserHomeDir(); err == nil && homeDir != "" {
+		line = strings.ReplaceAll(line, homeDir, "~")
+	}
+	line = tokenPattern.ReplaceAllString(line, "[REDACTED]")
+	line = urlCredPattern.ReplaceAllString(line, "${1}[REDACTED]@")
+	return line
+}
+
+// issueTrackerURL returns the configured tracker, falling back to this
+// tool's GitHub repository
+func issueTrackerURL() string {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return DefaultIssueTrackerURL
+	}
+
+	var config issueConfigYAML
+	if err := yaml.Unmarshal(content, &config); err != nil || config.IssueTracker == "" {
+		return DefaultIssueTrackerURL
+	}
+	return config.IssueTracker
+}
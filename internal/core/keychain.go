@@ -0,0 +1,157 @@
+// Package core/keychain.go - OS Keychain Credential Storage
+//
+// Tokens previously had to live in environment variables or the global
+// config file on disk. This file stores them in the operating system's
+// credential store instead, shelling out to the native tooling the same
+// way git/gh operations do: the macOS Keychain via `security`, libsecret
+// via `secret-tool` on Linux, and the Windows Credential Manager via
+// PowerShell's PasswordVault. Secrets are keyed per host (github.com,
+// gitlab.example.com, ...) under a single flutter-pm service entry, and
+// token resolution in the providers consults the keychain automatically
+// after the environment.
+//
+// Key features:
+// - StoreKeychainToken / LoadKeychainToken / DeleteKeychainToken: per-host
+//   secret storage in the OS keychain
+// - KeychainBackend: name of the available store, for `auth status`
+// - Backs the `flutter-pm auth login/logout/status` commands (cmd/root.go)
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// keychainService is the service/label all flutter-pm secrets are filed
+// under in the OS credential store
+const keychainService = "flutter-pm"
+
+// KeychainBackend returns the name of the credential store available on
+// this system, or "" when none is usable
+func KeychainBackend() string {
+	switch runtime.GOOS {
+	case "darwin":
+		if _, err := exec.LookPath("security"); err == nil {
+			return "macOS Keychain"
+		}
+	case "windows":
+		if _, err := exec.LookPath("powershell"); err == nil {
+			return "Windows Credential Manager"
+		}
+	default:
+		if _, err := exec.LookPath("secret-tool"); err == nil {
+			return "libsecret"
+		}
+	}
+	return ""
+}
+
+// StoreKeychainToken saves a token for a host in the OS keychain,
+// replacing any existing entry for that host
+func StoreKeychainToken(host, token string) error {
+	if KeychainBackend() == "" {
+		return fmt.Errorf("no OS keychain available on this system")
+	}
+	if host == "" || token == "" {
+		return fmt.Errorf("host and token are required")
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		// -U updates in place when the entry already exists
+		cmd := exec.Command("security", "add-generic-password",
+			"-a", host, "-s", keychainService, "-w", token, "-U")
+		return runKeychainCommand(cmd, "store")
+	case "windows":
+		// The token travels via the environment rather than the script text
+		script := fmt.Sprintf(`[void][Windows.Security.Credentials.PasswordVault,Windows.Security.Credentials,ContentType=WindowsRuntime]
+$vault = New-Object Windows.Security.Credentials.PasswordVault
+try { $vault.Remove($vault.Retrieve(%[1]q, %[2]q)) } catch {}
+$vault.Add((New-Object Windows.Security.Credentials.PasswordCredential(%[1]q, %[2]q, $env:FLUTTER_PM_SECRET)))`,
+			keychainService, host)
+		cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+		cmd.Env = append(os.Environ(), "FLUTTER_PM_SECRET="+token)
+		return runKeychainCommand(cmd, "store")
+	default:
+		cmd := exec.Command("secret-tool", "store",
+			"--label", fmt.Sprintf("%s (%s)", keychainService, host),
+			"service", keychainService, "host", host)
+		cmd.Stdin = strings.NewReader(token)
+		return runKeychainCommand(cmd, "store")
+	}
+}
+
+// LoadKeychainToken reads the token stored for a host; a missing entry (or
+// missing keychain) returns "" without error, so callers can fall through
+// to the next token source
+func LoadKeychainToken(host string) string {
+	if KeychainBackend() == "" || host == "" {
+		return ""
+	}
+
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("security", "find-generic-password",
+			"-a", host, "-s", keychainService, "-w")
+	case "windows":
+		script := fmt.Sprintf(`[void][Windows.Security.Credentials.PasswordVault,Windows.Security.Credentials,ContentType=WindowsRuntime]
+$cred = (New-Object Windows.Security.Credentials.PasswordVault).Retrieve(%q, %q)
+$cred.RetrievePassword()
+Write-Output $cred.Password`, keychainService, host)
+		cmd = exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+	default:
+		cmd = exec.Command("secret-tool", "lookup", "service", keychainService, "host", host)
+	}
+
+	output, err := cmd.Output()
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(output))
+}
+
+// DeleteKeychainToken removes the stored token for a host; deleting an
+// entry that does not exist is not an error
+func DeleteKeychainToken(host string) error {
+	if KeychainBackend() == "" {
+		return fmt.Errorf("no OS keychain available on this system")
+	}
+	if LoadKeychainToken(host) == "" {
+		return nil
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		cmd := exec.Command("security", "delete-generic-password",
+			"-a", host, "-s", keychainService)
+		return runKeychainCommand(cmd, "delete")
+	case "windows":
+		script := fmt.Sprintf(`[void][Windows.Security.Credentials.PasswordVault,Windows.Security.Credentials,ContentType=WindowsRuntime]
+$vault = New-Object Windows.Security.Credentials.PasswordVault
+$vault.Remove($vault.Retrieve(%q, %q))`, keychainService, host)
+		cmd := exec.Command("powershell", "-NoProfile", "-NonInteractive", "-Command", script)
+		return runKeychainCommand(cmd, "delete")
+	default:
+		cmd := exec.Command("secret-tool", "clear", "service", keychainService, "host", host)
+		return runKeychainCommand(cmd, "delete")
+	}
+}
+
+// runKeychainCommand runs a keychain tool invocation, turning its stderr
+// into the error message without ever echoing the secret itself
+func runKeychainCommand(cmd *exec.Cmd, action string) error {
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		detail := strings.TrimSpace(string(output))
+		if detail == "" {
+			detail = err.Error()
+		}
+		return fmt.Errorf("keychain %s failed: %s", action, detail)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package core
+
+import (
+	"testing"
+)
+
+func TestLoadKeychainTokenEmptyHost(t *testing.T) {
+	if token := LoadKeychainToken(""); token != "" {
+		t.Errorf("empty host should never resolve a token, got %q", token)
+	}
+}
+
+func TestKeychainUnavailableDegradesGracefully(t *testing.T) {
+	if KeychainBackend() != "" {
+		t.Skip("an OS keychain is available on this system")
+	}
+
+	if token := LoadKeychainToken("github.com"); token != "" {
+		t.Errorf("load without a keychain should return empty, got %q", token)
+	}
+	if err := StoreKeychainToken("github.com", "token"); err == nil {
+		t.Error("store without a keychain should fail with a clear error")
+	}
+	if err := DeleteKeychainToken("github.com"); err == nil {
+		t.Error("delete without a keychain should fail with a clear error")
+	}
+}
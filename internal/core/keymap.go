@@ -0,0 +1,47 @@
+// Package core/keymap.go - Custom Key Binding Configuration
+//
+// The TUI ships with default key bindings (arrows plus vim-style j/k, esc
+// to go back, space to toggle). This file reads the `keymap:` section of
+// the global config (~/.config/flutter-pm/config.yaml) so users can remap
+// actions to their own keys, e.g.:
+//
+//	keymap:
+//	  up: ["up"]        # arrows-only, free k for something else
+//	  down: ["down"]
+//	  back: ["esc", "h"]
+//
+// The TUI layer (tui/models/keymap.go) applies these overrides on top of
+// its defaults; this file only parses the config.
+
+package core
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// keymapYAML models the `keymap:` section of the global config
+type keymapYAML struct {
+	Keymap map[string][]string `yaml:"keymap"`
+}
+
+// LoadKeymapOverrides reads custom key bindings from the global config,
+// keyed by action name (up, down, select, back, toggle, filter, help,
+// quit). Returns nil when the config is missing, unreadable or has no
+// keymap section - the defaults then apply unchanged.
+func LoadKeymapOverrides() map[string][]string {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return nil
+	}
+
+	var parsed keymapYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+	if len(parsed.Keymap) == 0 {
+		return nil
+	}
+	return parsed.Keymap
+}
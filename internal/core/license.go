@@ -0,0 +1,275 @@
+// Package core/license.go - Dependency License Scanner
+//
+// This file inspects the license of every git dependency in a project and
+// classifies it into an SPDX-style identifier. Git dependencies bypass
+// pub.dev's license display, so a copyleft package (GPL/AGPL/LGPL) can slip
+// into an application unnoticed - and distributing an app that links such
+// code carries source-disclosure obligations. The scanner backs both the
+// license report screen and the `flutter-pm licenses` CLI command.
+//
+// Lookup chain (mirrors the pubspec probing in git.go):
+// 1. GitHub license API (repos/owner/repo/license) - returns the SPDX id
+//    GitHub detected, works for private repos when a token is configured
+// 2. Raw LICENSE file from raw.githubusercontent.com (main, then master),
+//    classified by text markers
+// 3. "unknown" - the scan never fails outright over a single package
+//
+// Key features:
+// - ScanDependencyLicenses: Classify the license of each git dependency
+// - LookupLicense: Resolve one dependency's license with caching
+// - classifyLicenseText: Heuristic SPDX classification of LICENSE content
+// - IsCopyleftLicense: Flag licenses with copyleft obligations
+// - IsAppProject: Detect application projects (publish_to: none)
+
+package core
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LicenseInfo describes the license resolved for one git dependency
+type LicenseInfo struct {
+	Package  string `json:"package"`
+	URL      string `json:"url"`
+	License  string `json:"license"`  // SPDX-style id, or "unknown"
+	Source   string `json:"source"`   // "github-api", "raw-file" or "none"
+	Copyleft bool   `json:"copyleft"` // License carries copyleft obligations
+}
+
+// licenseCache avoids re-querying the same repository within a session;
+// licenses change rarely, so a generous TTL is safe
+var licenseCache = struct {
+	mu    sync.RWMutex
+	cache map[string]LicenseInfo // normalized repo URL -> resolved info
+	times map[string]time.Time
+}{
+	cache: make(map[string]LicenseInfo),
+	times: make(map[string]time.Time),
+}
+
+const licenseCacheTTL = 30 * time.Minute
+
+// ScanDependencyLicenses resolves the license of every git dependency in
+// the project. Individual lookup failures degrade to "unknown" entries so
+// one unreachable repository never sinks the whole report.
+func ScanDependencyLicenses(logger *Logger, projectPath string) ([]LicenseInfo, error) {
+	deps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]LicenseInfo, 0, len(deps))
+	for _, spec := range deps {
+		infos = append(infos, LookupLicense(logger, spec))
+	}
+	return infos, nil
+}
+
+// LookupLicense resolves one dependency's license through the fallback
+// chain, caching the result per repository
+func LookupLicense(logger *Logger, spec PkgSpec) LicenseInfo {
+	key := normalizeRepoURL(spec.URL)
+
+	licenseCache.mu.RLock()
+	cachedAt, exists := licenseCache.times[key]
+	cached := licenseCache.cache[key]
+	licenseCache.mu.RUnlock()
+	if exists && time.Since(cachedAt) <= licenseCacheTTL {
+		cached.Package = spec.Name
+		cached.URL = spec.URL
+		return cached
+	}
+
+	info := LicenseInfo{
+		Package: spec.Name,
+		URL:     spec.URL,
+		License: "unknown",
+		Source:  "none",
+	}
+
+	if gitURLHost(spec.URL) == "github.com" {
+		owner, name := parseGitURLPath(spec.URL)
+		if owner != "" && name != "" {
+			// Primary: the GitHub license API already classified the file
+			if id, err := fetchGitHubLicense(owner, name); err == nil && id != "" {
+				info.License = id
+				info.Source = "github-api"
+			} else {
+				if err != nil {
+					logger.Debug("license", fmt.Sprintf("GitHub license API for %s/%s failed: %v", owner, name, err))
+				}
+				// Fallback: fetch the raw LICENSE file and classify it
+				if id := fetchRawLicense(owner, name); id != "" {
+					info.License = id
+					info.Source = "raw-file"
+				}
+			}
+		}
+	}
+
+	info.Copyleft = IsCopyleftLicense(info.License)
+
+	licenseCache.mu.Lock()
+	licenseCache.cache[key] = info
+	licenseCache.times[key] = time.Now()
+	licenseCache.mu.Unlock()
+
+	return info
+}
+
+// fetchGitHubLicense asks the GitHub license API for the repository's SPDX
+// id, classifying the file content ourselves when GitHub reports NOASSERTION
+func fetchGitHubLicense(owner, name string) (string, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/repos/%s/%s/license", owner, name), nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := GitHubToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", nil // Repository exists but has no detectable license file
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GitHub API returned HTTP %d for %s/%s", resp.StatusCode, owner, name)
+	}
+
+	var parsed struct {
+		Content string `json:"content"`
+		License struct {
+			SPDXID string `json:"spdx_id"`
+		} `json:"license"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return "", err
+	}
+
+	if parsed.License.SPDXID != "" && parsed.License.SPDXID != "NOASSERTION" {
+		return parsed.License.SPDXID, nil
+	}
+
+	// GitHub could not match the text - try our own markers on the content
+	if decoded, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(parsed.Content, "\n", "")); err == nil {
+		if id := classifyLicenseText(string(decoded)); id != "unknown" {
+			return id, nil
+		}
+	}
+	return "", nil
+}
+
+// fetchRawLicense downloads the LICENSE file from raw.githubusercontent.com
+// and classifies it, trying the common branch and filename combinations
+func fetchRawLicense(owner, name string) string {
+	client := &http.Client{Timeout: 10 * time.Second}
+
+	for _, branch := range []string{"main", "master"} {
+		for _, file := range []string{"LICENSE", "LICENSE.md"} {
+			url := fmt.Sprintf("https://raw.githubusercontent.com/%s/%s/%s/%s", owner, name, branch, file)
+			resp, err := client.Get(url)
+			if err != nil {
+				continue
+			}
+			if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+				continue
+			}
+			content, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+			if err != nil {
+				continue
+			}
+			if id := classifyLicenseText(string(content)); id != "unknown" {
+				return id
+			}
+		}
+	}
+	return ""
+}
+
+// classifyLicenseText matches the distinctive phrases of the common open
+// source licenses against the LICENSE file content
+func classifyLicenseText(content string) string {
+	text := strings.ToUpper(content)
+
+	switch {
+	case strings.Contains(text, "GNU AFFERO GENERAL PUBLIC LICENSE"):
+		return "AGPL-3.0"
+	case strings.Contains(text, "GNU LESSER GENERAL PUBLIC LICENSE"):
+		return "LGPL-3.0"
+	case strings.Contains(text, "GNU GENERAL PUBLIC LICENSE"):
+		if strings.Contains(text, "VERSION 2") {
+			return "GPL-2.0"
+		}
+		return "GPL-3.0"
+	case strings.Contains(text, "MOZILLA PUBLIC LICENSE"):
+		return "MPL-2.0"
+	case strings.Contains(text, "APACHE LICENSE"):
+		return "Apache-2.0"
+	case strings.Contains(text, "MIT LICENSE"),
+		strings.Contains(text, "PERMISSION IS HEREBY GRANTED, FREE OF CHARGE"):
+		return "MIT"
+	case strings.Contains(text, "REDISTRIBUTION AND USE IN SOURCE AND BINARY FORMS"):
+		if strings.Contains(text, "NEITHER THE NAME") {
+			return "BSD-3-Clause"
+		}
+		return "BSD-2-Clause"
+	case strings.Contains(text, "THIS IS FREE AND UNENCUMBERED SOFTWARE"):
+		return "Unlicense"
+	default:
+		return "unknown"
+	}
+}
+
+// IsCopyleftLicense reports whether the license obliges distributors to
+// release source code (the GPL family). MPL's file-level copyleft is not
+// flagged - it does not extend to the application linking it.
+func IsCopyleftLicense(id string) bool {
+	for _, prefix := range []string{"GPL-", "AGPL-", "LGPL-"} {
+		if strings.HasPrefix(id, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// appPubspecYAML models the field that distinguishes applications from
+// publishable packages
+type appPubspecYAML struct {
+	PublishTo string `yaml:"publish_to"`
+}
+
+// IsAppProject reports whether the project is an application rather than a
+// publishable package. Flutter app templates set `publish_to: none`, which
+// is the signal that the project will be distributed as a binary - where
+// copyleft dependencies matter most.
+func IsAppProject(projectPath string) bool {
+	content, err := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return false
+	}
+	var parsed appPubspecYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return false
+	}
+	return parsed.PublishTo == "none"
+}
@@ -0,0 +1,39 @@
+package core
+
+import "testing"
+
+// TestClassifyLicenseText verifies the text-marker classification
+func TestClassifyLicenseText(t *testing.T) {
+	cases := map[string]string{
+		"MIT License\n\nPermission is hereby granted, free of charge":            "MIT",
+		"Apache License\nVersion 2.0, January 2004":                              "Apache-2.0",
+		"GNU GENERAL PUBLIC LICENSE\nVersion 3, 29 June 2007":                    "GPL-3.0",
+		"GNU GENERAL PUBLIC LICENSE\nVersion 2, June 1991":                       "GPL-2.0",
+		"GNU LESSER GENERAL PUBLIC LICENSE\nVersion 3":                           "LGPL-3.0",
+		"GNU AFFERO GENERAL PUBLIC LICENSE\nVersion 3":                           "AGPL-3.0",
+		"Mozilla Public License Version 2.0":                                     "MPL-2.0",
+		"Redistribution and use in source and binary forms are permitted":        "BSD-2-Clause",
+		"Some proprietary text with no recognizable license":                     "unknown",
+		"This is free and unencumbered software released into the public domain": "Unlicense",
+	}
+
+	for content, want := range cases {
+		if got := classifyLicenseText(content); got != want {
+			t.Errorf("classifyLicenseText(%q) = %q, want %q", content, got, want)
+		}
+	}
+}
+
+// TestIsCopyleftLicense verifies the copyleft flagging
+func TestIsCopyleftLicense(t *testing.T) {
+	for _, id := range []string{"GPL-3.0", "GPL-2.0", "AGPL-3.0", "LGPL-3.0"} {
+		if !IsCopyleftLicense(id) {
+			t.Errorf("expected %s to be flagged as copyleft", id)
+		}
+	}
+	for _, id := range []string{"MIT", "Apache-2.0", "BSD-3-Clause", "MPL-2.0", "unknown", ""} {
+		if IsCopyleftLicense(id) {
+			t.Errorf("did not expect %s to be flagged as copyleft", id)
+		}
+	}
+}
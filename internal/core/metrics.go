@@ -0,0 +1,215 @@
+// Package core/metrics.go - Telemetry-Free Local Operation Metrics
+//
+// Slow paths (a cold clone, a pub add that resolves for ages, a cache that
+// never hits) are invisible once a run is over. This file records
+// per-operation timings and cache hit rates into a local JSON file under
+// the user's cache directory - nothing ever leaves the machine - and
+// aggregates them as count/average/max per operation. The `stats` CLI
+// command and the Stats screen render the same summary, so both users and
+// maintainers can spot where the time goes.
+//
+// Key features:
+// - RecordOperationTiming: Fold one operation duration into the aggregates
+// - RecordCacheLookup: Count cache hits and misses per cache
+// - LoadMetrics / ResetMetrics: Read and clear the local metrics file
+// - MetricsLines: Shared text rendering for the CLI and the TUI
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// OperationStats aggregates the recorded timings of one operation kind
+type OperationStats struct {
+	Count   int   `json:"count"`
+	TotalMs int64 `json:"total_ms"`
+	MaxMs   int64 `json:"max_ms"`
+}
+
+// AvgMs returns the mean duration in milliseconds
+func (s OperationStats) AvgMs() int64 {
+	if s.Count == 0 {
+		return 0
+	}
+	return s.TotalMs / int64(s.Count)
+}
+
+// CacheStats counts lookups against one cache
+type CacheStats struct {
+	Hits   int `json:"hits"`
+	Misses int `json:"misses"`
+}
+
+// HitRate returns the hit percentage (0-100)
+func (s CacheStats) HitRate() int {
+	total := s.Hits + s.Misses
+	if total == 0 {
+		return 0
+	}
+	return s.Hits * 100 / total
+}
+
+// MetricsData is the on-disk shape of the local metrics file
+type MetricsData struct {
+	Operations map[string]OperationStats `json:"operations"`
+	Caches     map[string]CacheStats     `json:"caches"`
+}
+
+// metricsMu serializes the read-modify-write cycles on the metrics file;
+// recordings are rare (per clone / per pub add), so synchronous persistence
+// is cheap enough
+var metricsMu sync.Mutex
+
+// metricsFilePath returns the local metrics file location
+// (~/.cache/flutter-pm/metrics.json; FLUTTER_PM_METRICS_FILE overrides,
+// mainly for tests)
+func metricsFilePath() string {
+	if path := os.Getenv("FLUTTER_PM_METRICS_FILE"); path != "" {
+		return path
+	}
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "flutter-pm", "metrics.json")
+}
+
+// LoadMetrics reads the metrics file; a missing or unreadable file yields
+// empty aggregates rather than an error
+func LoadMetrics() *MetricsData {
+	data := &MetricsData{
+		Operations: make(map[string]OperationStats),
+		Caches:     make(map[string]CacheStats),
+	}
+	path := metricsFilePath()
+	if path == "" {
+		return data
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return data
+	}
+	_ = json.Unmarshal(content, data)
+	if data.Operations == nil {
+		data.Operations = make(map[string]OperationStats)
+	}
+	if data.Caches == nil {
+		data.Caches = make(map[string]CacheStats)
+	}
+	return data
+}
+
+// saveMetrics writes the aggregates back; persistence failures are
+// swallowed - metrics must never break the operation being measured
+func saveMetrics(data *MetricsData) {
+	path := metricsFilePath()
+	if path == "" {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return
+	}
+	content, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(path, content, 0o644)
+}
+
+// RecordOperationTiming folds one operation duration into the local
+// aggregates (count, total, max)
+func RecordOperationTiming(operation string, d time.Duration) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	data := LoadMetrics()
+	stats := data.Operations[operation]
+	stats.Count++
+	stats.TotalMs += d.Milliseconds()
+	if d.Milliseconds() > stats.MaxMs {
+		stats.MaxMs = d.Milliseconds()
+	}
+	data.Operations[operation] = stats
+	saveMetrics(data)
+}
+
+// RecordCacheLookup counts one hit or miss against a named cache
+func RecordCacheLookup(cache string, hit bool) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	data := LoadMetrics()
+	stats := data.Caches[cache]
+	if hit {
+		stats.Hits++
+	} else {
+		stats.Misses++
+	}
+	data.Caches[cache] = stats
+	saveMetrics(data)
+}
+
+// ResetMetrics removes the local metrics file
+func ResetMetrics() error {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+
+	path := metricsFilePath()
+	if path == "" {
+		return nil
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove metrics file: %w", err)
+	}
+	return nil
+}
+
+// MetricsLines renders the aggregates as display lines, shared by the
+// `stats` CLI command and the Stats screen
+func MetricsLines(data *MetricsData) []string {
+	var lines []string
+
+	if len(data.Operations) == 0 && len(data.Caches) == 0 {
+		return []string{"No metrics recorded yet - run some operations first."}
+	}
+
+	if len(data.Operations) > 0 {
+		lines = append(lines, "Operation timings:")
+		names := make([]string, 0, len(data.Operations))
+		for name := range data.Operations {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			stats := data.Operations[name]
+			lines = append(lines, fmt.Sprintf("  %-24s %4d runs  avg %6dms  max %6dms",
+				name, stats.Count, stats.AvgMs(), stats.MaxMs))
+		}
+	}
+
+	if len(data.Caches) > 0 {
+		if len(lines) > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, "Cache hit rates:")
+		names := make([]string, 0, len(data.Caches))
+		for name := range data.Caches {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			stats := data.Caches[name]
+			lines = append(lines, fmt.Sprintf("  %-24s %3d%% (%d hits, %d misses)",
+				name, stats.HitRate(), stats.Hits, stats.Misses))
+		}
+	}
+
+	return lines
+}
@@ -0,0 +1,123 @@
+// Package core/metrics_test.go - Tests for the local metrics aggregates
+//
+// Verifies that operation timings and cache lookups fold into the local
+// metrics file correctly (count, average, max, hit rate), that a missing
+// file yields empty aggregates, that reset clears the file, and that the
+// shared text rendering covers the recorded entries.
+
+package core
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// useTempMetricsFile points the metrics file at a per-test location so
+// tests never touch the user's real aggregates
+func useTempMetricsFile(t *testing.T) {
+	t.Helper()
+	t.Setenv("FLUTTER_PM_METRICS_FILE", filepath.Join(t.TempDir(), "metrics.json"))
+}
+
+func TestRecordOperationTimingAggregates(t *testing.T) {
+	useTempMetricsFile(t)
+
+	RecordOperationTiming("git clone", 100*time.Millisecond)
+	RecordOperationTiming("git clone", 300*time.Millisecond)
+
+	data := LoadMetrics()
+	stats, ok := data.Operations["git clone"]
+	if !ok {
+		t.Fatal("expected aggregates for 'git clone'")
+	}
+	if stats.Count != 2 {
+		t.Errorf("expected count 2, got %d", stats.Count)
+	}
+	if stats.AvgMs() != 200 {
+		t.Errorf("expected avg 200ms, got %dms", stats.AvgMs())
+	}
+	if stats.MaxMs != 300 {
+		t.Errorf("expected max 300ms, got %dms", stats.MaxMs)
+	}
+}
+
+func TestRecordCacheLookupHitRate(t *testing.T) {
+	useTempMetricsFile(t)
+
+	RecordCacheLookup("ls-remote", true)
+	RecordCacheLookup("ls-remote", true)
+	RecordCacheLookup("ls-remote", true)
+	RecordCacheLookup("ls-remote", false)
+
+	data := LoadMetrics()
+	stats, ok := data.Caches["ls-remote"]
+	if !ok {
+		t.Fatal("expected aggregates for 'ls-remote' cache")
+	}
+	if stats.Hits != 3 || stats.Misses != 1 {
+		t.Errorf("expected 3 hits and 1 miss, got %d/%d", stats.Hits, stats.Misses)
+	}
+	if stats.HitRate() != 75 {
+		t.Errorf("expected 75%% hit rate, got %d%%", stats.HitRate())
+	}
+}
+
+func TestLoadMetricsMissingFile(t *testing.T) {
+	useTempMetricsFile(t)
+
+	data := LoadMetrics()
+	if data == nil {
+		t.Fatal("expected empty aggregates, got nil")
+	}
+	if len(data.Operations) != 0 || len(data.Caches) != 0 {
+		t.Errorf("expected empty aggregates, got %d operations and %d caches",
+			len(data.Operations), len(data.Caches))
+	}
+}
+
+func TestResetMetrics(t *testing.T) {
+	useTempMetricsFile(t)
+
+	RecordOperationTiming("pub get", time.Second)
+	if err := ResetMetrics(); err != nil {
+		t.Fatalf("reset failed: %v", err)
+	}
+
+	data := LoadMetrics()
+	if len(data.Operations) != 0 {
+		t.Errorf("expected no operations after reset, got %d", len(data.Operations))
+	}
+
+	// Resetting with no file present must also succeed
+	if err := ResetMetrics(); err != nil {
+		t.Errorf("reset of missing file failed: %v", err)
+	}
+}
+
+func TestMetricsLines(t *testing.T) {
+	useTempMetricsFile(t)
+
+	empty := MetricsLines(LoadMetrics())
+	if len(empty) != 1 || !strings.Contains(empty[0], "No metrics recorded yet") {
+		t.Errorf("expected empty-state line, got %v", empty)
+	}
+
+	RecordOperationTiming("pub add", 500*time.Millisecond)
+	RecordCacheLookup("ls-remote", true)
+
+	lines := strings.Join(MetricsLines(LoadMetrics()), "\n")
+	if !strings.Contains(lines, "Operation timings:") {
+		t.Error("expected operation timings section")
+	}
+	if !strings.Contains(lines, "pub add") {
+		t.Error("expected 'pub add' entry")
+	}
+	if !strings.Contains(lines, "Cache hit rates:") {
+		t.Error("expected cache hit rates section")
+	}
+	if !strings.Contains(lines, "ls-remote") {
+		t.Error("expected 'ls-remote' entry")
+	}
+}
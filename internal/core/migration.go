@@ -0,0 +1,240 @@
+// Package core/migration.go - Guided Migration from the Shell Script Version
+//
+// The original shell-based Flutter Package Manager stored its settings as
+// KEY=VALUE pairs in ~/.flutter-pm.conf. This file detects that legacy
+// config, translates the recognized keys (search roots, depth, full disk
+// search, default ref) into the global config system
+// (~/.config/flutter-pm/config.yaml), and produces a compatibility report
+// describing behaviors that differ between the two implementations.
+//
+// Key features:
+// - DetectLegacyConfig: Find and parse the shell script's config file
+// - MigrateLegacyConfig: Write recognized settings into the global config
+// - CompatibilityReport: Plain-language list of behavioral differences
+// - LoadSearchSettings: Read the (possibly migrated) search settings back
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// LegacyConfigFileName is the shell script's config file in the home directory
+const LegacyConfigFileName = ".flutter-pm.conf"
+
+// LegacyConfig holds the settings parsed from the shell script's config file
+type LegacyConfig struct {
+	Path           string   // Where the legacy file was found
+	SearchDirs     []string // SEARCH_DIRS (colon-separated in the file)
+	SearchDepth    int      // SEARCH_DEPTH
+	FullDiskSearch bool     // FULL_DISK_SEARCH
+	DefaultRef     string   // DEFAULT_REF
+	Unrecognized   []string // Keys with no equivalent in this version
+}
+
+// SearchSettings is the search section of the global config, consumed by
+// the TUI's scan and search-config screens
+type SearchSettings struct {
+	Dirs     []string `yaml:"dirs"`
+	Depth    int      `yaml:"depth"`
+	FullDisk bool     `yaml:"full_disk"`
+}
+
+// globalConfigYAML is the subset of the global config this file touches
+type globalConfigYAML struct {
+	Search             *SearchSettings `yaml:"search,omitempty"`
+	DefaultRef         string          `yaml:"default_ref,omitempty"`
+	ShellMigrationDone bool            `yaml:"shell_migration_done,omitempty"`
+}
+
+// DetectLegacyConfig looks for the shell script's config file. homeDir may
+// be empty, in which case the user's home directory is used. A missing file
+// returns (nil, nil) - nothing to migrate.
+func DetectLegacyConfig(homeDir string) (*LegacyConfig, error) {
+	if homeDir == "" {
+		var err error
+		homeDir, err = os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve home directory: %w", err)
+		}
+	}
+
+	path := filepath.Join(homeDir, LegacyConfigFileName)
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read legacy config: %w", err)
+	}
+
+	legacy := &LegacyConfig{Path: path}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, "=")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(key)
+		value = strings.Trim(strings.TrimSpace(value), "\"'")
+
+		switch key {
+		case "SEARCH_DIRS":
+			for _, dir := range strings.Split(value, ":") {
+				if dir != "" {
+					legacy.SearchDirs = append(legacy.SearchDirs, dir)
+				}
+			}
+		case "SEARCH_DEPTH":
+			if depth, err := strconv.Atoi(value); err == nil && depth > 0 {
+				legacy.SearchDepth = depth
+			}
+		case "FULL_DISK_SEARCH":
+			legacy.FullDiskSearch = value == "1" || strings.EqualFold(value, "true") || strings.EqualFold(value, "yes")
+		case "DEFAULT_REF":
+			legacy.DefaultRef = value
+		default:
+			legacy.Unrecognized = append(legacy.Unrecognized, key)
+		}
+	}
+
+	sort.Strings(legacy.Unrecognized)
+	return legacy, nil
+}
+
+// MigrateLegacyConfig writes the recognized legacy settings into the global
+// config, preserving any sections already there, and marks the migration as
+// done so it is not offered again
+func MigrateLegacyConfig(logger *Logger, legacy *LegacyConfig) ActionResult {
+	if legacy == nil {
+		return ActionResult{OK: false, Err: "no legacy config to migrate"}
+	}
+
+	configPath := GlobalConfigPath()
+
+	// Merge into the existing file as a generic map so unrelated sections
+	// (ssh, issue_tracker, ...) survive the rewrite
+	config := make(map[string]interface{})
+	if content, err := os.ReadFile(configPath); err == nil {
+		if err := yaml.Unmarshal(content, &config); err != nil {
+			return ActionResult{OK: false, Err: fmt.Sprintf("existing global config is not valid YAML: %s", err)}
+		}
+	}
+
+	migrated := 0
+	if len(legacy.SearchDirs) > 0 || legacy.SearchDepth > 0 || legacy.FullDiskSearch {
+		search := map[string]interface{}{}
+		if len(legacy.SearchDirs) > 0 {
+			search["dirs"] = legacy.SearchDirs
+			migrated++
+		}
+		if legacy.SearchDepth > 0 {
+			search["depth"] = legacy.SearchDepth
+			migrated++
+		}
+		if legacy.FullDiskSearch {
+			search["full_disk"] = true
+			migrated++
+		}
+		config["search"] = search
+	}
+	if legacy.DefaultRef != "" {
+		config["default_ref"] = legacy.DefaultRef
+		migrated++
+	}
+	config["shell_migration_done"] = true
+
+	content, err := yaml.Marshal(config)
+	if err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to encode global config: %s", err)}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to create config directory: %s", err)}
+	}
+	if err := os.WriteFile(configPath, content, 0644); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to write global config: %s", err)}
+	}
+
+	if logger != nil {
+		logger.Info("migrate", fmt.Sprintf("Migrated %d setting(s) from %s to %s", migrated, legacy.Path, configPath))
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Migrated %d setting(s) from %s", migrated, legacy.Path),
+		Data: map[string]interface{}{
+			"legacy_path":  legacy.Path,
+			"config_path":  configPath,
+			"migrated":     migrated,
+			"unrecognized": legacy.Unrecognized,
+		},
+	}
+}
+
+// CompatibilityReport lists behaviors that differ from the shell script, so
+// long-time users know what changed beyond the settings themselves
+func CompatibilityReport(legacy *LegacyConfig) []string {
+	report := []string{
+		"pubspec.yaml is modified through `dart pub add`/`dart pub remove` instead of direct file edits",
+		"Stale detection adds precise SHA comparison on top of the shell script's 24-hour heuristic",
+		"Backups are journaled and undoable with `flutter-pm rollback` (the shell script only created copies)",
+	}
+
+	if legacy == nil {
+		return report
+	}
+
+	if legacy.FullDiskSearch {
+		report = append(report, "FULL_DISK_SEARCH was enabled: full disk search is off by default here and must be toggled under Configure search")
+	}
+	for _, key := range legacy.Unrecognized {
+		report = append(report, fmt.Sprintf("%s has no equivalent in this version and was not migrated", key))
+	}
+
+	return report
+}
+
+// ShellMigrationDone reports whether a legacy migration has already run
+func ShellMigrationDone() bool {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if err != nil {
+		return false
+	}
+
+	var config globalConfigYAML
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return false
+	}
+	return config.ShellMigrationDone
+}
+
+// LoadSearchSettings reads the search section of the global config,
+// including values migrated from the shell script. A missing file or
+// section returns (nil, nil).
+func LoadSearchSettings() (*SearchSettings, error) {
+	content, err := os.ReadFile(GlobalConfigPath())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read global config: %w", err)
+	}
+
+	var config globalConfigYAML
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse global config: %w", err)
+	}
+	return config.Search, nil
+}
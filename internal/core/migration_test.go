@@ -0,0 +1,76 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestDetectLegacyConfig verifies parsing of the shell script's KEY=VALUE file
+func TestDetectLegacyConfig(t *testing.T) {
+	homeDir := t.TempDir()
+	legacyPath := filepath.Join(homeDir, LegacyConfigFileName)
+	content := `# flutter-pm shell config
+SEARCH_DIRS="$HOME/dev:/opt/projects"
+SEARCH_DEPTH=4
+FULL_DISK_SEARCH=1
+DEFAULT_REF=main
+COLOR_OUTPUT=always
+`
+	if err := os.WriteFile(legacyPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write legacy config: %v", err)
+	}
+
+	legacy, err := DetectLegacyConfig(homeDir)
+	if err != nil {
+		t.Fatalf("DetectLegacyConfig failed: %v", err)
+	}
+	if legacy == nil {
+		t.Fatal("legacy config not detected")
+	}
+
+	if len(legacy.SearchDirs) != 2 || legacy.SearchDirs[1] != "/opt/projects" {
+		t.Errorf("search dirs = %v, want 2 entries ending /opt/projects", legacy.SearchDirs)
+	}
+	if legacy.SearchDepth != 4 {
+		t.Errorf("search depth = %d, want 4", legacy.SearchDepth)
+	}
+	if !legacy.FullDiskSearch {
+		t.Error("full disk search not detected")
+	}
+	if legacy.DefaultRef != "main" {
+		t.Errorf("default ref = %q, want main", legacy.DefaultRef)
+	}
+	if len(legacy.Unrecognized) != 1 || legacy.Unrecognized[0] != "COLOR_OUTPUT" {
+		t.Errorf("unrecognized = %v, want [COLOR_OUTPUT]", legacy.Unrecognized)
+	}
+}
+
+// TestDetectLegacyConfigMissing verifies a missing file means nothing to do
+func TestDetectLegacyConfigMissing(t *testing.T) {
+	legacy, err := DetectLegacyConfig(t.TempDir())
+	if err != nil {
+		t.Fatalf("DetectLegacyConfig failed: %v", err)
+	}
+	if legacy != nil {
+		t.Errorf("expected nil for missing config, got %+v", legacy)
+	}
+}
+
+// TestCompatibilityReport verifies the behavior notes include the
+// full-disk-search difference and unmigratable keys
+func TestCompatibilityReport(t *testing.T) {
+	legacy := &LegacyConfig{
+		FullDiskSearch: true,
+		Unrecognized:   []string{"COLOR_OUTPUT"},
+	}
+
+	report := CompatibilityReport(legacy)
+	joined := strings.Join(report, "\n")
+	for _, want := range []string{"dart pub add", "full disk search", "COLOR_OUTPUT"} {
+		if !strings.Contains(joined, want) {
+			t.Errorf("report missing %q:\n%s", want, joined)
+		}
+	}
+}
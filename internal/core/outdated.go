@@ -0,0 +1,175 @@
+// Package core/outdated.go - Hosted Dependency Outdated Report
+//
+// This file wraps `dart pub outdated --json` so the tool can manage hosted
+// dependencies with the same care it gives git ones. The pub output is
+// parsed into typed structs carrying the four version columns pub reports:
+// current (locked), upgradable (within the pubspec constraint), resolvable
+// (reachable by also bumping other constraints) and latest (newest
+// published). Upgrades go through `pub upgrade` - with --major-versions
+// when the user asks to cross constraint boundaries, which edits
+// pubspec.yaml and therefore gets a tracked backup first.
+//
+// Key features:
+// - ListOutdatedPackages: Run `pub outdated --json` and parse the report
+// - UpgradeHostedPackages: Upgrade a set of packages, optionally across majors
+// - OutdatedPackage: Typed current/upgradable/resolvable/latest columns
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// OutdatedPackage describes one dependency from the pub outdated report
+type OutdatedPackage struct {
+	Name           string `json:"package"`
+	Current        string `json:"current,omitempty"`    // Locked version (empty when not yet resolved)
+	Upgradable     string `json:"upgradable,omitempty"` // Newest version within the pubspec constraint
+	Resolvable     string `json:"resolvable,omitempty"` // Newest version reachable by widening constraints
+	Latest         string `json:"latest,omitempty"`     // Newest published version
+	IsDiscontinued bool   `json:"is_discontinued,omitempty"`
+}
+
+// UpToDate reports whether the locked version already is the latest release
+func (p OutdatedPackage) UpToDate() bool {
+	return p.Current != "" && p.Current == p.Latest
+}
+
+// pubOutdatedVersion models one version object in the pub outdated JSON
+type pubOutdatedVersion struct {
+	Version string `json:"version"`
+}
+
+// pubOutdatedJSON models the relevant parts of `pub outdated --json`
+type pubOutdatedJSON struct {
+	Packages []struct {
+		Package        string              `json:"package"`
+		IsDiscontinued bool                `json:"isDiscontinued"`
+		Current        *pubOutdatedVersion `json:"current"`
+		Upgradable     *pubOutdatedVersion `json:"upgradable"`
+		Resolvable     *pubOutdatedVersion `json:"resolvable"`
+		Latest         *pubOutdatedVersion `json:"latest"`
+	} `json:"packages"`
+}
+
+// outdatedVersion unwraps an optional version object ("" when pub sent null)
+func outdatedVersion(v *pubOutdatedVersion) string {
+	if v == nil {
+		return ""
+	}
+	return v.Version
+}
+
+// ListOutdatedPackages runs `pub outdated --json` in the project and parses
+// the report. The command is read-only, so it also runs in dry-run mode.
+func ListOutdatedPackages(logger *Logger, cfg *Config, projectPath string) ([]OutdatedPackage, error) {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	args := append(toolArgs, "pub", "outdated", "--json")
+	logger.LogCommand("outdated", tool, args)
+
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("pub outdated failed: %w", err)
+	}
+
+	var parsed pubOutdatedJSON
+	if err := json.Unmarshal(output, &parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse pub outdated output: %w", err)
+	}
+
+	packages := make([]OutdatedPackage, 0, len(parsed.Packages))
+	for _, entry := range parsed.Packages {
+		packages = append(packages, OutdatedPackage{
+			Name:           entry.Package,
+			Current:        outdatedVersion(entry.Current),
+			Upgradable:     outdatedVersion(entry.Upgradable),
+			Resolvable:     outdatedVersion(entry.Resolvable),
+			Latest:         outdatedVersion(entry.Latest),
+			IsDiscontinued: entry.IsDiscontinued,
+		})
+	}
+
+	logger.Info("outdated", fmt.Sprintf("pub outdated reports %d packages", len(packages)))
+	return packages, nil
+}
+
+// UpgradeHostedPackages upgrades the named packages via `pub upgrade`.
+// Within constraints only pubspec.lock moves; with majorVersions pub also
+// rewrites the pubspec.yaml constraints, so a tracked backup is created
+// first and `flutter-pm rollback` can undo the change.
+func UpgradeHostedPackages(logger *Logger, cfg *Config, projectPath string, names []string, majorVersions bool) ActionResult {
+	if len(names) == 0 {
+		return ActionResult{
+			OK:  false,
+			Err: "no packages selected for upgrade",
+		}
+	}
+
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+
+	args := append(toolArgs, "pub", "upgrade")
+	if majorVersions {
+		args = append(args, "--major-versions")
+	}
+	args = append(args, names...)
+	logger.LogCommand("outdated", tool, args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: %s %s", tool, strings.Join(args, " ")),
+		}
+	}
+
+	// --major-versions edits pubspec.yaml, so it gets the same safety net
+	// as the add and remove flows
+	if majorVersions {
+		if backup, err := CreateTrackedBackup(logger, projectPath, "upgrade"); err != nil {
+			logger.Error("backup", err)
+		} else {
+			logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+		}
+	}
+
+	logger.Narrate(fmt.Sprintf("Upgrading %d hosted package(s)", len(names)))
+
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	output, err := runCommandStreaming(cmd)
+	logs := []string{strings.TrimSpace(string(output))}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("pub upgrade failed: %v", err),
+			Logs: logs,
+		}
+	}
+
+	staleCache.InvalidateProject(projectPath)
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Upgraded %s", strings.Join(names, ", ")),
+		Logs:    logs,
+	}
+}
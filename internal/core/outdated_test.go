@@ -0,0 +1,26 @@
+package core
+
+import "testing"
+
+// TestOutdatedUpToDate verifies the latest-version comparison
+func TestOutdatedUpToDate(t *testing.T) {
+	if !(OutdatedPackage{Name: "dio", Current: "5.4.0", Latest: "5.4.0"}).UpToDate() {
+		t.Error("expected a package at the latest version to be up to date")
+	}
+	if (OutdatedPackage{Name: "dio", Current: "5.3.0", Latest: "5.4.0"}).UpToDate() {
+		t.Error("expected a package behind the latest version to be outdated")
+	}
+	if (OutdatedPackage{Name: "dio", Latest: "5.4.0"}).UpToDate() {
+		t.Error("expected a package without a locked version to be outdated")
+	}
+}
+
+// TestOutdatedVersion verifies the optional version unwrapping
+func TestOutdatedVersion(t *testing.T) {
+	if got := outdatedVersion(nil); got != "" {
+		t.Errorf("expected empty string for null version, got %q", got)
+	}
+	if got := outdatedVersion(&pubOutdatedVersion{Version: "1.2.3"}); got != "1.2.3" {
+		t.Errorf("expected 1.2.3, got %q", got)
+	}
+}
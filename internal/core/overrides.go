@@ -0,0 +1,266 @@
+// Package core/overrides.go - dependency_overrides Inspection and Cleanup
+//
+// Conflict resolution injects dependency_overrides through the inline pub
+// add syntax (see pub.go), but nothing showed those overrides afterwards
+// or cleaned them up once the conflict they papered over was gone. This
+// file lists the dependency_overrides section of pubspec.yaml, joins each
+// entry with a small per-project journal recording why it was written
+// (which package's add triggered it and what kind of conflict), and
+// removes stale overrides through the comment-preserving editor with the
+// usual backup + pub get safety net.
+//
+// Key features:
+// - RecordOverrideOrigin: journal why an override was written (.flutter-pm-overrides.json)
+// - ListDependencyOverrides: pubspec.yaml overrides joined with their origins
+// - RemoveDependencyOverride: backup, edit out the override, verify with pub get
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OverrideJournalName is the per-project origin journal, stored next to
+// pubspec.yaml like the rollback journal
+const OverrideJournalName = ".flutter-pm-overrides.json"
+
+// OverrideOrigin records why an override was written to pubspec.yaml
+type OverrideOrigin struct {
+	Package      string    `json:"package"`       // The overridden package
+	AddedFor     string    `json:"added_for"`     // The package whose add triggered the override
+	ConflictType string    `json:"conflict_type"` // e.g. "version", "transitive", "manual"
+	OverrideArg  string    `json:"override_arg"`  // The inline override argument used
+	Timestamp    time.Time `json:"timestamp"`
+}
+
+// DependencyOverride is one entry from dependency_overrides, joined with
+// its journaled origin when one exists
+type DependencyOverride struct {
+	Name   string          `json:"name"`
+	Source string          `json:"source"` // "git", "hosted" or "path"
+	Detail string          `json:"detail"` // Constraint, url@ref or path
+	Origin *OverrideOrigin `json:"origin,omitempty"`
+}
+
+// Why renders a human-readable explanation of an override's existence,
+// used by both the TUI screen and the CLI table
+func (o DependencyOverride) Why() string {
+	if o.Origin == nil {
+		return "unknown (added outside flutter-pm)"
+	}
+	when := o.Origin.Timestamp.Format("2006-01-02")
+	if o.Origin.ConflictType == "manual" {
+		return fmt.Sprintf("manual conflict resolution while adding %s (%s)", o.Origin.AddedFor, when)
+	}
+	return fmt.Sprintf("%s conflict while adding %s (%s)", o.Origin.ConflictType, o.Origin.AddedFor, when)
+}
+
+// RecordOverrideOrigin journals why an override was written. Like the
+// rollback journal, failures are logged but never block the operation
+// that created the override.
+func RecordOverrideOrigin(logger *Logger, projectPath string, origin OverrideOrigin) {
+	origins, err := loadOverrideJournal(projectPath)
+	if err == nil {
+		err = saveOverrideJournal(projectPath, append(origins, origin))
+	}
+	if err != nil && logger != nil {
+		logger.Debug("overrides", fmt.Sprintf("Failed to journal override origin: %s", err))
+	}
+}
+
+// ListDependencyOverrides parses the dependency_overrides section of
+// pubspec.yaml and attaches the most recent journaled origin per package.
+// An empty (or missing) section returns an empty list, not an error.
+func ListDependencyOverrides(projectPath string) ([]DependencyOverride, error) {
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	content, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pubspec.yaml: %w", err)
+	}
+
+	var pubspec pubspecYAML
+	if err := yaml.Unmarshal(content, &pubspec); err != nil {
+		return nil, fmt.Errorf("failed to parse pubspec.yaml: %w", err)
+	}
+
+	// Origins keyed by package, newest entry winning
+	origins := make(map[string]OverrideOrigin)
+	if journal, err := loadOverrideJournal(projectPath); err == nil {
+		for _, origin := range journal {
+			origins[origin.Package] = origin
+		}
+	}
+
+	var overrides []DependencyOverride
+	for name, entry := range pubspec.DependencyOverrides {
+		override := DependencyOverride{Name: name}
+
+		switch v := entry.(type) {
+		case string:
+			// Plain constraint override: package: ^1.2.3 (or any)
+			override.Source = "hosted"
+			override.Detail = v
+		case nil:
+			override.Source = "hosted"
+			override.Detail = "any"
+		case map[string]interface{}:
+			switch {
+			case v["git"] != nil:
+				override.Source = "git"
+				switch git := v["git"].(type) {
+				case string:
+					override.Detail = git
+				case map[string]interface{}:
+					if url, ok := git["url"].(string); ok {
+						override.Detail = url
+					}
+					if ref, ok := git["ref"].(string); ok {
+						override.Detail += "@" + ref
+					}
+				}
+			case v["path"] != nil:
+				override.Source = "path"
+				if path, ok := v["path"].(string); ok {
+					override.Detail = path
+				}
+			default:
+				override.Source = "hosted"
+				if version, ok := v["version"].(string); ok {
+					override.Detail = version
+				}
+			}
+		default:
+			continue // Unrecognized override shape
+		}
+
+		if origin, ok := origins[name]; ok {
+			originCopy := origin
+			override.Origin = &originCopy
+		}
+		overrides = append(overrides, override)
+	}
+
+	// Map iteration order is random; sort for a stable display
+	sort.Slice(overrides, func(i, j int) bool {
+		return overrides[i].Name < overrides[j].Name
+	})
+
+	return overrides, nil
+}
+
+// RemoveDependencyOverride removes one entry from dependency_overrides via
+// the comment-preserving editor and verifies the project still resolves
+// with pub get. If resolution fails the override was still load-bearing:
+// pubspec.yaml is restored from the backup and the failure reported.
+func RemoveDependencyOverride(logger *Logger, cfg *Config, projectPath, packageName string) ActionResult {
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would remove override for %s and re-run pub get", packageName),
+		}
+	}
+
+	backup, err := CreateTrackedBackup(logger, projectPath, "remove-override")
+	if err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+
+	editor, err := LoadPubspecEditor(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+	if !editor.RemoveOverride(packageName) {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("%s is not listed under dependency_overrides", packageName),
+		}
+	}
+	if err := editor.Save(); err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+
+	// Verify the project still resolves without the override
+	syncResult := Sync(logger, cfg, projectPath)
+	if !syncResult.OK {
+		if restoreErr := RestoreBackup(projectPath, backup.BackupPath); restoreErr != nil {
+			logger.Error("overrides", restoreErr)
+		}
+		return ActionResult{
+			OK: false,
+			Err: fmt.Sprintf("override for %s is still needed: pub get failed after removal - pubspec.yaml restored (%s)",
+				packageName, syncResult.Err),
+			Logs: syncResult.Logs,
+		}
+	}
+
+	// The origin record has served its purpose
+	dropOverrideOrigin(logger, projectPath, packageName)
+
+	logger.Info("overrides", fmt.Sprintf("Removed override for %s", packageName))
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Removed override for %s (project still resolves)", packageName),
+		Logs:    syncResult.Logs,
+		Data: map[string]interface{}{
+			"package":     packageName,
+			"backup_path": backup.BackupPath,
+		},
+	}
+}
+
+// dropOverrideOrigin removes all journal entries for a package once its
+// override is gone; best-effort like the rest of the journal handling
+func dropOverrideOrigin(logger *Logger, projectPath, packageName string) {
+	origins, err := loadOverrideJournal(projectPath)
+	if err != nil || len(origins) == 0 {
+		return
+	}
+	kept := origins[:0]
+	for _, origin := range origins {
+		if origin.Package != packageName {
+			kept = append(kept, origin)
+		}
+	}
+	if err := saveOverrideJournal(projectPath, kept); err != nil && logger != nil {
+		logger.Debug("overrides", fmt.Sprintf("Failed to update override journal: %s", err))
+	}
+}
+
+// loadOverrideJournal reads the journal; a missing file is an empty journal
+func loadOverrideJournal(projectPath string) ([]OverrideOrigin, error) {
+	journalPath := filepath.Join(projectPath, OverrideJournalName)
+	content, err := os.ReadFile(journalPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read override journal: %w", err)
+	}
+
+	var origins []OverrideOrigin
+	if err := json.Unmarshal(content, &origins); err != nil {
+		return nil, fmt.Errorf("failed to parse override journal: %w", err)
+	}
+	return origins, nil
+}
+
+// saveOverrideJournal writes the journal back to disk
+func saveOverrideJournal(projectPath string, origins []OverrideOrigin) error {
+	content, err := json.MarshalIndent(origins, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode override journal: %w", err)
+	}
+
+	journalPath := filepath.Join(projectPath, OverrideJournalName)
+	if err := os.WriteFile(journalPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write override journal: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// overridesFixture is a pubspec with a mixed dependency_overrides section
+const overridesFixture = `name: test_app
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  http: ^1.2.0
+
+dependency_overrides:
+  http: any
+  my_fork:
+    git:
+      url: https://github.com/owner/my_fork.git
+      ref: fix-branch
+`
+
+// TestListDependencyOverrides verifies override parsing and the join with
+// the origin journal
+func TestListDependencyOverrides(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, overridesFixture)
+
+	RecordOverrideOrigin(logger, projectPath, OverrideOrigin{
+		Package:      "http",
+		AddedFor:     "my_pkg",
+		ConflictType: "version",
+		OverrideArg:  "override:http:any",
+		Timestamp:    time.Now(),
+	})
+
+	overrides, err := ListDependencyOverrides(projectPath)
+	if err != nil {
+		t.Fatalf("ListDependencyOverrides failed: %v", err)
+	}
+	if len(overrides) != 2 {
+		t.Fatalf("expected 2 overrides, got %d", len(overrides))
+	}
+
+	// Sorted by name: http before my_fork
+	if overrides[0].Name != "http" || overrides[1].Name != "my_fork" {
+		t.Errorf("overrides not sorted by name: %v", overrides)
+	}
+
+	if overrides[0].Source != "hosted" || overrides[0].Detail != "any" {
+		t.Errorf("hosted override misparsed: %+v", overrides[0])
+	}
+	if overrides[0].Origin == nil {
+		t.Fatal("journaled origin not attached")
+	}
+	if !strings.Contains(overrides[0].Why(), "version conflict while adding my_pkg") {
+		t.Errorf("Why should explain the conflict: %s", overrides[0].Why())
+	}
+
+	if overrides[1].Source != "git" || overrides[1].Detail != "https://github.com/owner/my_fork.git@fix-branch" {
+		t.Errorf("git override misparsed: %+v", overrides[1])
+	}
+	if overrides[1].Origin != nil {
+		t.Error("unjournaled override should have no origin")
+	}
+	if !strings.Contains(overrides[1].Why(), "unknown") {
+		t.Errorf("Why for unjournaled override should say unknown: %s", overrides[1].Why())
+	}
+}
+
+// TestListDependencyOverridesEmpty verifies a pubspec without the section
+// yields an empty list, not an error
+func TestListDependencyOverridesEmpty(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, "name: test_app\ndependencies:\n  http: ^1.2.0\n")
+
+	overrides, err := ListDependencyOverrides(projectPath)
+	if err != nil {
+		t.Fatalf("ListDependencyOverrides failed: %v", err)
+	}
+	if len(overrides) != 0 {
+		t.Errorf("expected no overrides, got %v", overrides)
+	}
+}
+
+// TestRemoveDependencyOverrideMissing verifies removal of an unknown
+// package fails before touching the project
+func TestRemoveDependencyOverrideMissing(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, overridesFixture)
+
+	result := RemoveDependencyOverride(logger, &cfg, projectPath, "not_there")
+	if result.OK {
+		t.Fatal("expected failure for a package without an override")
+	}
+	if !strings.Contains(result.Err, "not listed under dependency_overrides") {
+		t.Errorf("error should name the missing override: %s", result.Err)
+	}
+}
+
+// TestDropOverrideOrigin verifies journal entries disappear with their
+// override
+func TestDropOverrideOrigin(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	RecordOverrideOrigin(logger, projectPath, OverrideOrigin{Package: "http", AddedFor: "a"})
+	RecordOverrideOrigin(logger, projectPath, OverrideOrigin{Package: "my_fork", AddedFor: "b"})
+
+	dropOverrideOrigin(logger, projectPath, "http")
+
+	origins, err := loadOverrideJournal(projectPath)
+	if err != nil {
+		t.Fatalf("loadOverrideJournal failed: %v", err)
+	}
+	if len(origins) != 1 || origins[0].Package != "my_fork" {
+		t.Errorf("expected only my_fork to remain, got %v", origins)
+	}
+}
@@ -0,0 +1,340 @@
+// Package core/pathswitch.go - Git/Path Dependency Switching
+//
+// When a dependency's repository is cloned locally, iterating through git
+// pushes is painful: every change needs a commit, a push and a pub upgrade.
+// This file rewrites a git dependency into a `path:` reference pointing at
+// the local clone (and back), so edits in the clone are picked up
+// immediately. The original git source is preserved in a marker comment on
+// the rewritten entry, which is how the switch back restores the exact
+// url/ref/path and how `flutter-pm assert` warns when a path version is
+// about to be committed.
+//
+// Key features:
+// - SwitchDependencyToPath / SwitchDependencyToGit: The two toggle halves
+// - ListPathSwitches: Dependencies currently pointing at a local path
+// - FindLocalClone: Locate the dependency's clone in the search paths
+// - Marker comment survives the yaml.v3 round-trip; no sidecar state file
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// pathSwitchMarker tags a path-switched dependency's entry with its
+// original git source, e.g.:
+//
+//	my_pkg:
+//	  path: ../my_pkg # flutter-pm:git url=https://... ref=main
+const pathSwitchMarker = "flutter-pm:git"
+
+// PathSwitch describes one dependency currently rewritten to a local path
+type PathSwitch struct {
+	Name      string  // Dependency name
+	LocalPath string  // The path: value it currently points at
+	Spec      PkgSpec // Original git source recovered from the marker
+}
+
+// ListPathSwitches returns the dependencies in a project's pubspec.yaml
+// that were switched to a local path by SwitchDependencyToPath. Hand-written
+// path dependencies without the marker are not reported - they were never
+// git dependencies, so there is nothing to switch back to.
+func ListPathSwitches(projectPath string) ([]PathSwitch, error) {
+	editor, err := LoadPubspecEditor(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return nil, err
+	}
+
+	deps := findMapping(editor.root(), "dependencies")
+	var switches []PathSwitch
+	for i := 0; i+1 < len(deps.Content); i += 2 {
+		name := deps.Content[i].Value
+		value := deps.Content[i+1]
+		if value.Kind != yaml.MappingNode {
+			continue
+		}
+		for j := 0; j+1 < len(value.Content); j += 2 {
+			if value.Content[j].Value != "path" {
+				continue
+			}
+			pathNode := value.Content[j+1]
+			spec, ok := parsePathSwitchMarker(name, pathNode.LineComment)
+			if !ok {
+				continue
+			}
+			switches = append(switches, PathSwitch{
+				Name:      name,
+				LocalPath: pathNode.Value,
+				Spec:      spec,
+			})
+		}
+	}
+	return switches, nil
+}
+
+// SwitchDependencyToPath rewrites a git dependency to a path: reference at
+// localPath, keeping the original git source in the marker comment. The
+// local clone's pubspec must declare the same package name - pointing a
+// dependency at the wrong directory would fail pub get with a confusing
+// name mismatch.
+func SwitchDependencyToPath(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, localPath string) ActionResult {
+	packageDir := localPath
+	if spec.Subdir != "" {
+		packageDir = filepath.Join(localPath, spec.Subdir)
+	}
+	localPubspec := filepath.Join(packageDir, "pubspec.yaml")
+	if name, err := extractProjectName(localPubspec); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("no pubspec.yaml found at %s: %v", packageDir, err),
+		}
+	} else if name != spec.Name {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("%s declares package %q, not %q - wrong clone?", localPubspec, name, spec.Name),
+		}
+	}
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would switch %s to local path %s", spec.Name, packageDir),
+		}
+	}
+
+	if backup, err := CreateTrackedBackup(logger, projectPath, "path-switch"); err != nil {
+		logger.Error("pathswitch", err)
+	} else {
+		logger.Info("pathswitch", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+	}
+
+	edit := func(editor *PubspecEditor) error {
+		deps := findOrCreateMapping(editor.root(), "dependencies")
+		upsertMappingKey(deps, spec.Name, pathDependencyNode(packageDir, spec))
+		return nil
+	}
+	if result := applyPathSwitchEdit(logger, cfg, projectPath, edit); !result.OK {
+		return result
+	}
+
+	logger.Info("pathswitch", fmt.Sprintf("Switched %s to local path %s", spec.Name, packageDir))
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("%s now resolves from %s - remember to switch back before committing", spec.Name, packageDir),
+		Data: map[string]interface{}{
+			"package":    spec.Name,
+			"local_path": packageDir,
+		},
+	}
+}
+
+// SwitchDependencyToGit restores a path-switched dependency to the git
+// source recorded in its marker comment
+func SwitchDependencyToGit(logger *Logger, cfg *Config, projectPath, depName string) ActionResult {
+	switches, err := ListPathSwitches(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to read pubspec.yaml: %v", err),
+		}
+	}
+
+	var sw *PathSwitch
+	for i := range switches {
+		if switches[i].Name == depName {
+			sw = &switches[i]
+			break
+		}
+	}
+	if sw == nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("%s is not switched to a local path", depName),
+		}
+	}
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would restore %s to git source %s", depName, sw.Spec.URL),
+		}
+	}
+
+	if backup, err := CreateTrackedBackup(logger, projectPath, "path-switch"); err != nil {
+		logger.Error("pathswitch", err)
+	} else {
+		logger.Info("pathswitch", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+	}
+
+	edit := func(editor *PubspecEditor) error {
+		editor.SetGitDependency(sw.Spec)
+		return nil
+	}
+	if result := applyPathSwitchEdit(logger, cfg, projectPath, edit); !result.OK {
+		return result
+	}
+
+	logger.Info("pathswitch", fmt.Sprintf("Restored %s to git source %s", depName, sw.Spec.URL))
+	message := fmt.Sprintf("%s restored to %s", depName, sw.Spec.URL)
+	if sw.Spec.Ref != "" {
+		message += fmt.Sprintf(" (ref: %s)", sw.Spec.Ref)
+	}
+	return ActionResult{
+		OK:      true,
+		Message: message,
+		Data: map[string]interface{}{
+			"package": depName,
+			"url":     sw.Spec.URL,
+			"ref":     sw.Spec.Ref,
+		},
+	}
+}
+
+// FindLocalClone scans the configured search paths for a local clone of the
+// dependency's repository: a project whose git remote matches the spec URL,
+// falling back to a pubspec declaring the same package name. Returns an
+// error when no candidate is found.
+func FindLocalClone(logger *Logger, searchPaths []string, maxDepth int, spec PkgSpec) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	projects, err := ScanRootsWithProgress(ctx, searchPaths, maxDepth, nil)
+	if err != nil {
+		return "", fmt.Errorf("scan for local clones failed: %w", err)
+	}
+
+	nameMatch := ""
+	for _, project := range projects {
+		// The clone of a monorepo dependency holds the package in a subdir;
+		// discovery finds the package directory, so step back up to the root
+		clonePath := project.Path
+		if spec.Subdir != "" && strings.HasSuffix(filepath.ToSlash(clonePath), "/"+filepath.ToSlash(spec.Subdir)) {
+			clonePath = clonePath[:len(clonePath)-len(spec.Subdir)-1]
+		}
+
+		if remote, err := gitRemoteURL(clonePath); err == nil && urlsReferToSameRepo(remote, spec.URL) {
+			logger.Debug("pathswitch", fmt.Sprintf("Local clone of %s found by remote: %s", spec.URL, clonePath))
+			return clonePath, nil
+		}
+
+		if nameMatch == "" {
+			if name, err := extractProjectName(project.PubspecPath); err == nil && name == spec.Name {
+				nameMatch = clonePath
+			}
+		}
+	}
+
+	if nameMatch != "" {
+		logger.Debug("pathswitch", fmt.Sprintf("Local clone of %s found by package name: %s", spec.Name, nameMatch))
+		return nameMatch, nil
+	}
+	return "", fmt.Errorf("no local clone of %s found in the search paths", spec.Name)
+}
+
+// applyPathSwitchEdit runs one pubspec edit followed by a pub get,
+// restoring the original file when resolution fails so a broken reference
+// never sticks
+func applyPathSwitchEdit(logger *Logger, cfg *Config, projectPath string, edit func(*PubspecEditor) error) ActionResult {
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	original, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to read pubspec.yaml: %v", err),
+		}
+	}
+
+	editor, err := LoadPubspecEditor(pubspecPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to parse pubspec.yaml: %v", err),
+		}
+	}
+	if err := edit(editor); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+	if err := editor.Save(); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to write pubspec.yaml: %v", err),
+		}
+	}
+
+	if syncResult := Sync(logger, cfg, projectPath); !syncResult.OK {
+		if restoreErr := os.WriteFile(pubspecPath, original, 0644); restoreErr != nil {
+			logger.Error("pathswitch", fmt.Errorf("failed to restore pubspec.yaml: %w", restoreErr))
+		}
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("pub get failed after the switch (pubspec.yaml restored): %s", syncResult.Err),
+		}
+	}
+	return ActionResult{OK: true}
+}
+
+// pathDependencyNode builds the `{path: <dir>}` mapping carrying the
+// marker comment that records the original git source
+func pathDependencyNode(packageDir string, spec PkgSpec) *yaml.Node {
+	marker := fmt.Sprintf("%s url=%s", pathSwitchMarker, spec.URL)
+	if spec.Ref != "" {
+		marker += " ref=" + spec.Ref
+	}
+	if spec.Subdir != "" {
+		marker += " subdir=" + spec.Subdir
+	}
+
+	dep := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	dep.Content = append(dep.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "path"},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: filepath.ToSlash(packageDir), LineComment: "# " + marker})
+	return dep
+}
+
+// parsePathSwitchMarker recovers the original git spec from a marker
+// comment; ok is false for comments that are not ours
+func parsePathSwitchMarker(name, comment string) (PkgSpec, bool) {
+	comment = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(comment), "#"))
+	if !strings.HasPrefix(comment, pathSwitchMarker) {
+		return PkgSpec{}, false
+	}
+
+	spec := PkgSpec{Name: name}
+	for _, field := range strings.Fields(strings.TrimPrefix(comment, pathSwitchMarker)) {
+		key, value, found := strings.Cut(field, "=")
+		if !found {
+			continue
+		}
+		switch key {
+		case "url":
+			spec.URL = value
+		case "ref":
+			spec.Ref = value
+		case "subdir":
+			spec.Subdir = value
+		}
+	}
+	if spec.URL == "" {
+		return PkgSpec{}, false
+	}
+	return spec, true
+}
+
+// gitRemoteURL reads a directory's origin remote via the git CLI
+func gitRemoteURL(dir string) (string, error) {
+	output, err := exec.Command("git", "-C", dir, "remote", "get-url", "origin").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(output)), nil
+}
@@ -0,0 +1,189 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writePathSwitchProject creates a project whose pubspec has one git
+// dependency, returning its directory
+func writePathSwitchProject(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	pubspec := `name: host_app
+environment:
+  sdk: ">=3.0.0 <4.0.0"
+
+dependencies:
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+      ref: main
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+	return dir
+}
+
+func TestPathSwitchMarkerRoundTrip(t *testing.T) {
+	dir := writePathSwitchProject(t)
+	pubspecPath := filepath.Join(dir, "pubspec.yaml")
+
+	// Rewrite the dependency to a path reference carrying the marker
+	editor, err := LoadPubspecEditor(pubspecPath)
+	if err != nil {
+		t.Fatalf("failed to load editor: %v", err)
+	}
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/my_pkg.git", Ref: "main"}
+	deps := findOrCreateMapping(editor.root(), "dependencies")
+	upsertMappingKey(deps, spec.Name, pathDependencyNode("../my_pkg", spec))
+	if err := editor.Save(); err != nil {
+		t.Fatalf("failed to save pubspec.yaml: %v", err)
+	}
+
+	// The switch should be discoverable with the original spec intact
+	switches, err := ListPathSwitches(dir)
+	if err != nil {
+		t.Fatalf("ListPathSwitches failed: %v", err)
+	}
+	if len(switches) != 1 {
+		t.Fatalf("expected 1 path switch, got %d", len(switches))
+	}
+	sw := switches[0]
+	if sw.Name != "my_pkg" || sw.LocalPath != "../my_pkg" {
+		t.Errorf("unexpected switch: %+v", sw)
+	}
+	if sw.Spec.URL != spec.URL || sw.Spec.Ref != spec.Ref {
+		t.Errorf("original spec not recovered: %+v", sw.Spec)
+	}
+
+	// The marker comment must survive the yaml round-trip in the file
+	content, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		t.Fatalf("failed to read pubspec.yaml: %v", err)
+	}
+	if !strings.Contains(string(content), pathSwitchMarker) {
+		t.Errorf("marker comment missing from pubspec.yaml:\n%s", content)
+	}
+}
+
+func TestListPathSwitchesIgnoresHandWrittenPaths(t *testing.T) {
+	dir := t.TempDir()
+	pubspec := `name: host_app
+
+dependencies:
+  local_pkg:
+    path: ../local_pkg
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+
+	switches, err := ListPathSwitches(dir)
+	if err != nil {
+		t.Fatalf("ListPathSwitches failed: %v", err)
+	}
+	if len(switches) != 0 {
+		t.Errorf("hand-written path dependency reported as switch: %+v", switches)
+	}
+}
+
+func TestSwitchDependencyToPathRejectsWrongClone(t *testing.T) {
+	dir := writePathSwitchProject(t)
+
+	// A clone declaring a different package name must be refused
+	clone := t.TempDir()
+	if err := os.WriteFile(filepath.Join(clone, "pubspec.yaml"), []byte("name: other_pkg\n"), 0644); err != nil {
+		t.Fatalf("failed to write clone pubspec: %v", err)
+	}
+
+	logger := NewLogger(&Config{Quiet: true})
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/my_pkg.git", Ref: "main"}
+	result := SwitchDependencyToPath(logger, &Config{Quiet: true}, dir, spec, clone)
+	if result.OK {
+		t.Fatal("expected switch to wrong clone to fail")
+	}
+	if !strings.Contains(result.Err, "other_pkg") {
+		t.Errorf("error should name the mismatched package: %s", result.Err)
+	}
+}
+
+func TestSwitchDependencyToPathDryRun(t *testing.T) {
+	dir := writePathSwitchProject(t)
+
+	clone := t.TempDir()
+	if err := os.WriteFile(filepath.Join(clone, "pubspec.yaml"), []byte("name: my_pkg\n"), 0644); err != nil {
+		t.Fatalf("failed to write clone pubspec: %v", err)
+	}
+
+	logger := NewLogger(&Config{Quiet: true})
+	cfg := &Config{Quiet: true, DryRun: true}
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/my_pkg.git", Ref: "main"}
+	result := SwitchDependencyToPath(logger, cfg, dir, spec, clone)
+	if !result.OK {
+		t.Fatalf("dry run failed: %s", result.Err)
+	}
+	if !strings.Contains(result.Message, "Would switch") {
+		t.Errorf("dry run should describe the switch: %s", result.Message)
+	}
+
+	// Dry run must leave the pubspec untouched
+	switches, err := ListPathSwitches(dir)
+	if err != nil {
+		t.Fatalf("ListPathSwitches failed: %v", err)
+	}
+	if len(switches) != 0 {
+		t.Errorf("dry run modified pubspec.yaml: %+v", switches)
+	}
+}
+
+func TestAssertNoPathSwitches(t *testing.T) {
+	dir := writePathSwitchProject(t)
+
+	if result := assertNoPathSwitches(dir); !result.OK {
+		t.Errorf("clean project should pass: %s", result.Err)
+	}
+
+	// Switch the dependency and expect the assertion to flag it
+	editor, err := LoadPubspecEditor(filepath.Join(dir, "pubspec.yaml"))
+	if err != nil {
+		t.Fatalf("failed to load editor: %v", err)
+	}
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/my_pkg.git"}
+	deps := findOrCreateMapping(editor.root(), "dependencies")
+	upsertMappingKey(deps, spec.Name, pathDependencyNode("../my_pkg", spec))
+	if err := editor.Save(); err != nil {
+		t.Fatalf("failed to save pubspec.yaml: %v", err)
+	}
+
+	result := assertNoPathSwitches(dir)
+	if result.OK {
+		t.Fatal("path-switched project should fail the assertion")
+	}
+	if !strings.Contains(result.Err, "my_pkg") {
+		t.Errorf("assertion should name the dependency: %s", result.Err)
+	}
+}
+
+func TestParsePathSwitchMarker(t *testing.T) {
+	spec, ok := parsePathSwitchMarker("my_pkg", "# flutter-pm:git url=https://github.com/o/r.git ref=dev subdir=packages/core")
+	if !ok {
+		t.Fatal("valid marker not recognized")
+	}
+	if spec.Name != "my_pkg" || spec.URL != "https://github.com/o/r.git" || spec.Ref != "dev" || spec.Subdir != "packages/core" {
+		t.Errorf("unexpected spec: %+v", spec)
+	}
+
+	if _, ok := parsePathSwitchMarker("my_pkg", "# just a comment"); ok {
+		t.Error("unrelated comment parsed as marker")
+	}
+	if _, ok := parsePathSwitchMarker("my_pkg", ""); ok {
+		t.Error("empty comment parsed as marker")
+	}
+	if _, ok := parsePathSwitchMarker("my_pkg", "# flutter-pm:git ref=dev"); ok {
+		t.Error("marker without url accepted")
+	}
+}
@@ -0,0 +1,219 @@
+// Package core/planner.go - Lockfile-Aware Update Planner
+//
+// This file builds a structured update plan from pubspec.lock. Locked git
+// SHAs are compared against the remote ref (GitLsRemote) and locked hosted
+// versions against the latest pub.dev release, producing one UpdatePlanItem
+// per outdated direct dependency with a breaking-risk estimate. The plan is
+// surfaced in the "Update plan" TUI screen and the `flutter-pm plan` CLI
+// command; it never modifies the project.
+//
+// Key features:
+// - BuildUpdatePlan: Compare locked state against upstream for direct deps
+// - Breaking-risk classification from semver distance (major/minor/patch)
+// - Git dependencies planned by SHA comparison (risk unknown without semver)
+
+package core
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Breaking-risk levels for an update, ordered from safest to riskiest
+const (
+	RiskPatch   = "patch"   // patch bump, fixes only
+	RiskMinor   = "minor"   // minor bump, additive changes
+	RiskMajor   = "major"   // major bump, expect breaking changes
+	RiskUnknown = "unknown" // git SHA move or unparsable versions
+)
+
+// UpdatePlanItem describes one outdated dependency and its update target
+type UpdatePlanItem struct {
+	Package      string `json:"package"`
+	Source       string `json:"source"` // "git" or "hosted"
+	Current      string `json:"current"`
+	Target       string `json:"target"`
+	BreakingRisk string `json:"breaking_risk"`
+	Ref          string `json:"ref,omitempty"` // git ref the target SHA was read from
+}
+
+// UpdatePlan is the full update plan for a project
+type UpdatePlan struct {
+	Project     string           `json:"project"`
+	GeneratedAt time.Time        `json:"generated_at"`
+	Items       []UpdatePlanItem `json:"items"`
+	UpToDate    int              `json:"up_to_date"` // direct deps already current
+}
+
+// BuildUpdatePlan reads pubspec.lock and compares every direct dependency
+// against upstream: git deps via ls-remote SHA, hosted deps via the latest
+// pub.dev version. Transitive dependencies are skipped because pub resolves
+// them from the direct constraints.
+func BuildUpdatePlan(logger *Logger, projectPath string) (*UpdatePlan, error) {
+	lockPath := filepath.Join(projectPath, "pubspec.lock")
+	lockDeps, err := parsePubspecLock(lockPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse pubspec.lock: %w", err)
+	}
+
+	// Map git deps to their pubspec.yaml URL/ref (lock URLs can be stale)
+	gitDeps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list git dependencies: %w", err)
+	}
+	urlMap := make(map[string]string)
+	refMap := make(map[string]string)
+	for _, dep := range gitDeps {
+		urlMap[dep.Name] = dep.URL
+		refMap[dep.Name] = dep.Ref
+	}
+
+	plan := &UpdatePlan{
+		Project:     projectPath,
+		GeneratedAt: time.Now(),
+	}
+
+	for name, lockDep := range lockDeps.Dependencies {
+		if !strings.HasPrefix(lockDep.Dependency, "direct") {
+			continue // transitive deps follow the direct constraints
+		}
+
+		switch lockDep.Source {
+		case "git":
+			item, ok := planGitUpdate(logger, name, lockDep, urlMap[name], refMap[name])
+			if !ok {
+				plan.UpToDate++
+				continue
+			}
+			plan.Items = append(plan.Items, item)
+
+		case "hosted":
+			item, ok := planHostedUpdate(logger, name, lockDep)
+			if !ok {
+				plan.UpToDate++
+				continue
+			}
+			plan.Items = append(plan.Items, item)
+		}
+	}
+
+	logger.Info("plan", fmt.Sprintf("Update plan: %d outdated, %d up to date", len(plan.Items), plan.UpToDate))
+	return plan, nil
+}
+
+// planGitUpdate compares the locked SHA against the remote ref. The second
+// return value is false when the dependency is already current (or upstream
+// is unreachable, in which case there is nothing actionable to plan).
+func planGitUpdate(logger *Logger, name string, lockDep PubspecLockDep, url, ref string) (UpdatePlanItem, bool) {
+	if url == "" {
+		url = lockDep.URL
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+
+	upstreamSHA, err := GitLsRemote(url, ref)
+	if err != nil {
+		logger.Debug("plan", fmt.Sprintf("Failed to get upstream SHA for %s: %v", name, err))
+		return UpdatePlanItem{}, false
+	}
+
+	currentSHA := shortPlanSHA(lockDep.ResolvedRef)
+	targetSHA := shortPlanSHA(upstreamSHA)
+	if currentSHA == targetSHA {
+		return UpdatePlanItem{}, false
+	}
+
+	// A SHA move carries no semver information, so the risk stays unknown
+	return UpdatePlanItem{
+		Package:      name,
+		Source:       "git",
+		Current:      currentSHA,
+		Target:       targetSHA,
+		BreakingRisk: RiskUnknown,
+		Ref:          ref,
+	}, true
+}
+
+// planHostedUpdate compares the locked version against the latest pub.dev
+// release and classifies the semver distance as the breaking risk
+func planHostedUpdate(logger *Logger, name string, lockDep PubspecLockDep) (UpdatePlanItem, bool) {
+	info, err := FetchPubDevInfo(logger, name)
+	if err != nil || info == nil || info.LatestVersion == "" {
+		logger.Debug("plan", fmt.Sprintf("Failed to get pub.dev version for %s: %v", name, err))
+		return UpdatePlanItem{}, false
+	}
+
+	if lockDep.Version == info.LatestVersion {
+		return UpdatePlanItem{}, false
+	}
+
+	return UpdatePlanItem{
+		Package:      name,
+		Source:       "hosted",
+		Current:      lockDep.Version,
+		Target:       info.LatestVersion,
+		BreakingRisk: classifyBreakingRisk(lockDep.Version, info.LatestVersion),
+	}, true
+}
+
+// classifyBreakingRisk maps the semver distance between two versions to a
+// risk level. Pre-1.0 minor bumps count as major because pub treats them as
+// breaking (^0.x pins the minor).
+func classifyBreakingRisk(current, target string) string {
+	curMajor, curMinor, curOK := parseSemverPrefix(current)
+	tgtMajor, tgtMinor, tgtOK := parseSemverPrefix(target)
+	if !curOK || !tgtOK {
+		return RiskUnknown
+	}
+
+	switch {
+	case tgtMajor != curMajor:
+		return RiskMajor
+	case tgtMinor != curMinor:
+		if curMajor == 0 {
+			return RiskMajor
+		}
+		return RiskMinor
+	default:
+		return RiskPatch
+	}
+}
+
+// parseSemverPrefix extracts the major and minor components of a version,
+// tolerating build/prerelease suffixes ("2.1.0-beta.1" -> 2, 1)
+func parseSemverPrefix(version string) (major, minor int, ok bool) {
+	parts := strings.SplitN(version, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	// Strip prerelease/build metadata from the minor component
+	minorStr := parts[1]
+	if idx := strings.IndexAny(minorStr, "-+"); idx >= 0 {
+		minorStr = minorStr[:idx]
+	}
+	minor, err = strconv.Atoi(minorStr)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
+
+// shortPlanSHA truncates a SHA to 7 characters for display and comparison,
+// matching the convention in stale.go
+func shortPlanSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
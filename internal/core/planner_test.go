@@ -0,0 +1,93 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestClassifyBreakingRisk verifies the semver distance classification,
+// including the pre-1.0 rule where minor bumps count as breaking
+func TestClassifyBreakingRisk(t *testing.T) {
+	cases := []struct {
+		name    string
+		current string
+		target  string
+		want    string
+	}{
+		{"patch bump", "5.4.0", "5.4.3", RiskPatch},
+		{"minor bump", "5.4.0", "5.5.0", RiskMinor},
+		{"major bump", "5.4.0", "6.0.0", RiskMajor},
+		{"pre-1.0 minor counts as major", "0.18.1", "0.19.0", RiskMajor},
+		{"pre-1.0 patch stays patch", "0.18.1", "0.18.2", RiskPatch},
+		{"prerelease target", "2.0.0", "2.1.0-beta.1", RiskMinor},
+		{"unparsable current", "abc1234", "6.0.0", RiskUnknown},
+		{"unparsable target", "5.4.0", "main", RiskUnknown},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := classifyBreakingRisk(tc.current, tc.target); got != tc.want {
+				t.Errorf("classifyBreakingRisk(%q, %q) = %q, want %q", tc.current, tc.target, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestParsePubspecLockVersionFields verifies that the lock parser captures
+// the dependency kind and version fields the planner relies on
+func TestParsePubspecLockVersionFields(t *testing.T) {
+	lockPath := writeTempLockFile(t, `# Generated by pub
+packages:
+  dio:
+    dependency: "direct main"
+    description:
+      name: dio
+      url: "https://pub.dev"
+    source: hosted
+    version: "5.4.0"
+  collection:
+    dependency: transitive
+    description:
+      name: collection
+      url: "https://pub.dev"
+    source: hosted
+    version: "1.18.0"
+sdks:
+  dart: ">=3.0.0 <4.0.0"
+`)
+
+	lock, err := parsePubspecLock(lockPath)
+	if err != nil {
+		t.Fatalf("parsePubspecLock failed: %v", err)
+	}
+
+	dio, ok := lock.Dependencies["dio"]
+	if !ok {
+		t.Fatal("expected dio in parsed lock file")
+	}
+	if dio.Dependency != "direct main" {
+		t.Errorf("dio.Dependency = %q, want %q", dio.Dependency, "direct main")
+	}
+	if dio.Version != "5.4.0" {
+		t.Errorf("dio.Version = %q, want %q", dio.Version, "5.4.0")
+	}
+
+	collection, ok := lock.Dependencies["collection"]
+	if !ok {
+		t.Fatal("expected collection in parsed lock file")
+	}
+	if collection.Dependency != "transitive" {
+		t.Errorf("collection.Dependency = %q, want %q", collection.Dependency, "transitive")
+	}
+}
+
+// writeTempLockFile writes a pubspec.lock fixture and returns its path
+func writeTempLockFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "pubspec.lock")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write lock fixture: %v", err)
+	}
+	return path
+}
@@ -0,0 +1,221 @@
+// Package core/plugins.go - External Executable Plugin Hooks
+//
+// This file implements a simple plugin mechanism that lets teams extend the
+// Flutter Package Manager without forking it. Executables placed in
+// ~/.config/flutter-pm/plugins/ are discovered at startup and registered as
+// menu actions. An optional JSON manifest next to the executable
+// (<executable>.json) provides the title and description shown in the menu.
+//
+// Plugin contract:
+// - The plugin receives the current project context as JSON on stdin
+//   (see PluginContext)
+// - Stdout is captured; if it parses as an ActionResult JSON object it is
+//   used directly, otherwise the raw output is shown as logs
+// - A non-zero exit code marks the result as failed
+//
+// Key features:
+// - DiscoverPlugins: Find executables and their manifests in the plugin dir
+// - RunPlugin: Execute a plugin with project context and collect the result
+// - Cross-platform executable detection (mode bits on Unix, extension on Windows)
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// PluginManifest describes a plugin's menu registration
+type PluginManifest struct {
+	Name        string `json:"name"`
+	Title       string `json:"title,omitempty"`       // Menu entry title (falls back to Name)
+	Description string `json:"description,omitempty"` // Menu entry description
+	Version     string `json:"version,omitempty"`
+}
+
+// Plugin represents a discovered external plugin executable
+type Plugin struct {
+	Manifest PluginManifest `json:"manifest"`
+	Path     string         `json:"path"` // Absolute path to the executable
+}
+
+// PluginContext is the JSON document passed to plugins on stdin
+type PluginContext struct {
+	ProjectPath string `json:"project_path,omitempty"`
+	ProjectName string `json:"project_name,omitempty"`
+	PubspecPath string `json:"pubspec_path,omitempty"`
+	DryRun      bool   `json:"dry_run"`
+	Version     string `json:"flutter_pm_version"`
+}
+
+// PluginDir returns the directory scanned for plugin executables
+func PluginDir() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".config", "flutter-pm", "plugins")
+}
+
+// DiscoverPlugins finds plugin executables in the plugin directory.
+// A missing plugin directory is not an error - it simply means no plugins.
+func DiscoverPlugins(logger *Logger) ([]Plugin, error) {
+	dir := PluginDir()
+	if dir == "" {
+		return nil, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil // No plugin directory, no plugins
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plugin directory %s: %w", dir, err)
+	}
+
+	var plugins []Plugin
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		if !isExecutableFile(path, entry.Name()) {
+			continue
+		}
+
+		plugin := Plugin{
+			Path: path,
+			Manifest: PluginManifest{
+				Name:  strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())),
+				Title: strings.TrimSuffix(entry.Name(), filepath.Ext(entry.Name())),
+			},
+		}
+
+		// Optional sidecar manifest: <executable>.json registers title/description
+		manifestPath := path + ".json"
+		if content, err := os.ReadFile(manifestPath); err == nil {
+			var manifest PluginManifest
+			if err := json.Unmarshal(content, &manifest); err != nil {
+				logger.Debug("plugins", fmt.Sprintf("Ignoring invalid manifest %s: %v", manifestPath, err))
+			} else {
+				if manifest.Name == "" {
+					manifest.Name = plugin.Manifest.Name
+				}
+				if manifest.Title == "" {
+					manifest.Title = manifest.Name
+				}
+				plugin.Manifest = manifest
+			}
+		}
+
+		plugins = append(plugins, plugin)
+	}
+
+	if len(plugins) > 0 {
+		logger.Debug("plugins", fmt.Sprintf("Discovered %d plugins in %s", len(plugins), dir))
+	}
+
+	return plugins, nil
+}
+
+// RunPlugin executes a plugin with the current project context on stdin and
+// returns its result. Plugins that print an ActionResult JSON object get it
+// passed through verbatim; any other output is wrapped in logs.
+func RunPlugin(logger *Logger, cfg *Config, plugin Plugin, project *Project) ActionResult {
+	context := PluginContext{
+		DryRun:  cfg.DryRun,
+		Version: CurrentVersion,
+	}
+	if project != nil {
+		context.ProjectPath = project.Path
+		context.ProjectName = project.Name
+		context.PubspecPath = project.PubspecPath
+	}
+
+	contextJSON, err := json.Marshal(context)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to encode plugin context: %v", err),
+		}
+	}
+
+	logger.LogCommand("plugins", plugin.Path, nil)
+	logger.Info("plugins", fmt.Sprintf("Running plugin: %s", plugin.Manifest.Name))
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would run plugin %s", plugin.Manifest.Name),
+			Logs:    []string{fmt.Sprintf("DRY RUN: %s", plugin.Path)},
+		}
+	}
+
+	cmd := exec.Command(plugin.Path)
+	cmd.Stdin = bytes.NewReader(contextJSON)
+	if project != nil {
+		cmd.Dir = project.Path
+	}
+
+	startTime := time.Now()
+	output, err := cmd.CombinedOutput()
+	duration := time.Since(startTime)
+	outputStr := strings.TrimSpace(string(output))
+
+	logger.Debug("plugins", fmt.Sprintf("Plugin %s finished in %s", plugin.Manifest.Name, duration))
+
+	// If the plugin printed an ActionResult JSON object, pass it through
+	var result ActionResult
+	if outputStr != "" && json.Unmarshal([]byte(outputStr), &result) == nil && (result.OK || result.Err != "" || result.Message != "") {
+		if err != nil && result.Err == "" {
+			result.OK = false
+			result.Err = err.Error()
+		}
+		return result
+	}
+
+	logs := []string{}
+	if outputStr != "" {
+		logs = strings.Split(outputStr, "\n")
+	}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("plugin %s failed: %v", plugin.Manifest.Name, err),
+			Logs: logs,
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Plugin %s completed", plugin.Manifest.Name),
+		Logs:    logs,
+	}
+}
+
+// isExecutableFile reports whether a file should be treated as a plugin
+// executable. On Windows this is extension-based; on Unix the mode bits decide.
+func isExecutableFile(path, name string) bool {
+	if runtime.GOOS == "windows" {
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".exe", ".bat", ".cmd", ".ps1":
+			return true
+		}
+		return false
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return info.Mode()&0111 != 0
+}
@@ -0,0 +1,260 @@
+// Package core/policy.go - Dependency Source Pinning Policy
+//
+// This file implements policy enforcement for new git dependencies. Teams
+// can require that dependencies from certain orgs are pinned to a tag (or
+// commit SHA) instead of a moving branch, or that only an allowlisted set
+// of subdirectories may be pulled from a monorepo. Policies are declared
+// per org in the global config (~/.config/flutter-pm/config.yaml) and
+// checked before any pub command runs, so a violation never reaches
+// pubspec.yaml.
+//
+// Example config:
+//
+//	policy:
+//	  require_tag: true              # team-wide default for every org
+//	  no_overrides_branches:         # dependency_overrides forbidden here
+//	    - main
+//	  orgs:
+//	    acme:
+//	      require_tag: true
+//	      allowed_subdirs:
+//	        - packages/ui
+//	        - packages/data
+//
+// Key features:
+// - LoadPolicyConfig: Parse the policy section of the global config
+// - CheckSpecPolicy: Validate a package spec, returning actionable violations
+// - CheckProjectPolicy: Lint a whole project (deps + overrides) - backs the
+//   `flutter-pm lint-deps` command and the confirmation screen
+// - AddGitDependency refuses specs with violations before running pub;
+//   --force downgrades violations to warnings on the confirmation screen
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OrgPolicy is the pinning policy for one org/owner
+type OrgPolicy struct {
+	// RequireTag refuses branch refs: the dependency must be pinned to a
+	// tag or a commit SHA
+	RequireTag bool `yaml:"require_tag" json:"require_tag,omitempty"`
+
+	// AllowedSubdirs restricts which monorepo subdirectories may be used;
+	// empty means any (including none)
+	AllowedSubdirs []string `yaml:"allowed_subdirs" json:"allowed_subdirs,omitempty"`
+}
+
+// PolicyConfig holds the team-wide policy plus per-org overrides keyed by
+// org/owner name
+type PolicyConfig struct {
+	// RequireTag is the team-wide default: every git dependency must be
+	// pinned to a tag or commit SHA, never a branch
+	RequireTag bool `yaml:"require_tag" json:"require_tag,omitempty"`
+
+	// NoOverridesBranches lists git branches on which dependency_overrides
+	// are forbidden (typically main); empty disables the rule
+	NoOverridesBranches []string `yaml:"no_overrides_branches" json:"no_overrides_branches,omitempty"`
+
+	Orgs map[string]OrgPolicy `yaml:"orgs" json:"orgs,omitempty"`
+}
+
+// policyFileYAML models a config file containing a `policy:` section
+type policyFileYAML struct {
+	Policy PolicyConfig `yaml:"policy"`
+}
+
+// LoadPolicyConfig parses the policy section of the global config; a
+// missing file or section returns an empty config without error
+func LoadPolicyConfig() PolicyConfig {
+	return loadPolicyConfig(GlobalConfigPath())
+}
+
+func loadPolicyConfig(path string) PolicyConfig {
+	if path == "" {
+		return PolicyConfig{}
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return PolicyConfig{}
+	}
+	var parsed policyFileYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return PolicyConfig{}
+	}
+	return parsed.Policy
+}
+
+// CheckSpecPolicy validates a package spec against the configured org
+// policies and returns actionable violation messages; an empty slice means
+// the spec is allowed
+func CheckSpecPolicy(spec PkgSpec) []string {
+	return LoadPolicyConfig().Check(spec)
+}
+
+// Check validates a package spec against this config (exposed separately
+// from CheckSpecPolicy so tests and batch checks can load the config once)
+func (p PolicyConfig) Check(spec PkgSpec) []string {
+	owner, _ := parseGitURLPath(spec.URL)
+
+	var orgPolicy OrgPolicy
+	hasOrgPolicy := false
+	if owner != "" {
+		orgPolicy, hasOrgPolicy = p.Orgs[owner]
+	}
+
+	var violations []string
+
+	// The team-wide rule applies everywhere; an org policy can only add to it
+	if (p.RequireTag || (hasOrgPolicy && orgPolicy.RequireTag)) && !refIsPinned(spec.Ref) {
+		ref := spec.Ref
+		if ref == "" {
+			ref = "the default branch"
+		}
+		scope := "the team policy"
+		if hasOrgPolicy && orgPolicy.RequireTag {
+			scope = fmt.Sprintf("org %q", owner)
+		}
+		violations = append(violations, fmt.Sprintf(
+			"%s: %s requires tag pinning, but ref is %s - pin to a release tag (e.g. --ref v1.2.3) or a commit SHA",
+			spec.Name, scope, ref))
+	}
+
+	if !hasOrgPolicy {
+		return violations
+	}
+
+	if len(orgPolicy.AllowedSubdirs) > 0 && !subdirAllowed(spec.Subdir, orgPolicy.AllowedSubdirs) {
+		allowed := append([]string(nil), orgPolicy.AllowedSubdirs...)
+		sort.Strings(allowed)
+		subdir := spec.Subdir
+		if subdir == "" {
+			subdir = "the repository root"
+		}
+		violations = append(violations, fmt.Sprintf(
+			"%s: org %q only allows subdirs [%s], but %s was requested - use --subdir with an allowed path",
+			spec.Name, owner, strings.Join(allowed, ", "), subdir))
+	}
+
+	return violations
+}
+
+// refIsPinned reports whether a ref is a tag or commit SHA rather than a
+// moving branch. Tags are recognized by version shape (v1.2.3 or 1.2.3),
+// SHAs by being long hex strings; everything else (including an empty ref,
+// which means the default branch) counts as a branch.
+func refIsPinned(ref string) bool {
+	if ref == "" {
+		return false
+	}
+
+	// Commit SHA: 7-40 hex characters
+	if len(ref) >= 7 && len(ref) <= 40 && isHexRef(ref) {
+		return true
+	}
+
+	// Version tag: optional v prefix, digits with at least one dot
+	candidate := strings.TrimPrefix(ref, "v")
+	if !strings.Contains(candidate, ".") {
+		return false
+	}
+	for _, r := range candidate {
+		if (r < '0' || r > '9') && r != '.' && r != '+' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// isHexRef reports whether a string is entirely hex digits
+func isHexRef(s string) bool {
+	for _, r := range s {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') && (r < 'A' || r > 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+// subdirAllowed reports whether a subdir matches the allowlist; entries
+// match exactly or as a path prefix (e.g. "packages" allows "packages/ui")
+func subdirAllowed(subdir string, allowed []string) bool {
+	if subdir == "" {
+		return false
+	}
+	normalized := strings.Trim(subdir, "/")
+	for _, entry := range allowed {
+		prefix := strings.Trim(entry, "/")
+		if normalized == prefix || strings.HasPrefix(normalized, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckProjectPolicy lints a whole project against the policy: every git
+// dependency is checked for pinning violations, and dependency_overrides
+// are flagged when the project sits on a branch where the policy forbids
+// them. This backs `flutter-pm lint-deps` and the pre-commit assertions.
+func CheckProjectPolicy(projectPath string) []string {
+	policy := LoadPolicyConfig()
+
+	var violations []string
+
+	if deps, err := ListGitDependencies(projectPath); err == nil {
+		for _, dep := range deps {
+			violations = append(violations, policy.Check(dep)...)
+		}
+	}
+
+	violations = append(violations, policy.checkOverridesBranch(projectPath)...)
+
+	return violations
+}
+
+// checkOverridesBranch flags dependency_overrides when the project's current
+// git branch is one the policy forbids them on
+func (p PolicyConfig) checkOverridesBranch(projectPath string) []string {
+	if len(p.NoOverridesBranches) == 0 {
+		return nil
+	}
+
+	output, err := exec.Command("git", "-C", projectPath, "rev-parse", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		// Not a git repository - the branch rule has nothing to anchor to
+		return nil
+	}
+	branch := strings.TrimSpace(string(output))
+
+	forbidden := false
+	for _, name := range p.NoOverridesBranches {
+		if branch == name {
+			forbidden = true
+			break
+		}
+	}
+	if !forbidden {
+		return nil
+	}
+
+	overrides, err := ListDependencyOverrides(projectPath)
+	if err != nil || len(overrides) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(overrides))
+	for _, override := range overrides {
+		names = append(names, override.Name)
+	}
+	sort.Strings(names)
+	return []string{fmt.Sprintf(
+		"dependency_overrides are forbidden on branch %q by the team policy, but [%s] are overridden - remove them or move the work to a feature branch",
+		branch, strings.Join(names, ", "))}
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestPolicyCheckRequireTag verifies branch refs are refused when the org
+// requires tag pinning, while tags and SHAs pass
+func TestPolicyCheckRequireTag(t *testing.T) {
+	policy := PolicyConfig{
+		Orgs: map[string]OrgPolicy{
+			"acme": {RequireTag: true},
+		},
+	}
+
+	tests := []struct {
+		ref       string
+		violation bool
+	}{
+		{"", true},
+		{"main", true},
+		{"feature/new-thing", true},
+		{"v1.2.3", false},
+		{"1.2.3", false},
+		{"a1b2c3d4e5f60718293a4b5c6d7e8f9012345678", false},
+		{"a1b2c3d", false},
+	}
+
+	for _, tt := range tests {
+		spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/acme/my_pkg.git", Ref: tt.ref}
+		violations := policy.Check(spec)
+		if tt.violation && len(violations) == 0 {
+			t.Errorf("ref %q: expected a violation, got none", tt.ref)
+		}
+		if !tt.violation && len(violations) > 0 {
+			t.Errorf("ref %q: unexpected violations %v", tt.ref, violations)
+		}
+	}
+}
+
+// TestPolicyCheckSubdirAllowlist verifies the subdir allowlist matches
+// exactly or by path prefix
+func TestPolicyCheckSubdirAllowlist(t *testing.T) {
+	policy := PolicyConfig{
+		Orgs: map[string]OrgPolicy{
+			"acme": {AllowedSubdirs: []string{"packages/ui", "packages/data"}},
+		},
+	}
+
+	tests := []struct {
+		subdir    string
+		violation bool
+	}{
+		{"packages/ui", false},
+		{"packages/ui/widgets", false},
+		{"packages/data", false},
+		{"packages/internal", true},
+		{"", true},
+	}
+
+	for _, tt := range tests {
+		spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/acme/monorepo.git", Ref: "v1.0.0", Subdir: tt.subdir}
+		violations := policy.Check(spec)
+		if tt.violation && len(violations) == 0 {
+			t.Errorf("subdir %q: expected a violation, got none", tt.subdir)
+		}
+		if !tt.violation && len(violations) > 0 {
+			t.Errorf("subdir %q: unexpected violations %v", tt.subdir, violations)
+		}
+	}
+}
+
+// TestPolicyCheckOtherOrg verifies specs from orgs without a policy pass
+func TestPolicyCheckOtherOrg(t *testing.T) {
+	policy := PolicyConfig{
+		Orgs: map[string]OrgPolicy{
+			"acme": {RequireTag: true},
+		},
+	}
+
+	spec := PkgSpec{Name: "other_pkg", URL: "https://github.com/otherorg/other_pkg.git", Ref: "main"}
+	if violations := policy.Check(spec); len(violations) > 0 {
+		t.Errorf("unexpected violations for unpoliced org: %v", violations)
+	}
+}
+
+// TestPolicyViolationMessage verifies the message is actionable
+func TestPolicyViolationMessage(t *testing.T) {
+	policy := PolicyConfig{
+		Orgs: map[string]OrgPolicy{
+			"acme": {RequireTag: true},
+		},
+	}
+
+	spec := PkgSpec{Name: "my_pkg", URL: "git@github.com:acme/my_pkg.git", Ref: "develop"}
+	violations := policy.Check(spec)
+	if len(violations) != 1 {
+		t.Fatalf("violations = %v, want exactly 1", violations)
+	}
+	for _, want := range []string{"my_pkg", "acme", "develop", "--ref"} {
+		if !strings.Contains(violations[0], want) {
+			t.Errorf("violation missing %q: %s", want, violations[0])
+		}
+	}
+}
+
+// TestPolicyTeamWideRequireTag verifies the top-level require_tag applies
+// to every org, not just ones with an explicit policy
+func TestPolicyTeamWideRequireTag(t *testing.T) {
+	policy := PolicyConfig{RequireTag: true}
+
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/anyone/my_pkg.git", Ref: "main"}
+	violations := policy.Check(spec)
+	if len(violations) != 1 || !strings.Contains(violations[0], "team policy") {
+		t.Errorf("expected a team policy violation, got %v", violations)
+	}
+
+	spec.Ref = "v1.0.0"
+	if violations := policy.Check(spec); len(violations) > 0 {
+		t.Errorf("pinned ref should pass the team policy, got %v", violations)
+	}
+}
+
+// TestPolicyCheckOverridesBranch verifies dependency_overrides are flagged
+// only on forbidden branches
+func TestPolicyCheckOverridesBranch(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, output)
+		}
+	}
+	run("init", "-b", "main")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+
+	pubspec := `name: app
+dependencies:
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+dependency_overrides:
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+      ref: dev
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+	run("add", "pubspec.yaml")
+	run("commit", "-m", "initial")
+
+	policy := PolicyConfig{NoOverridesBranches: []string{"main"}}
+	violations := policy.checkOverridesBranch(dir)
+	if len(violations) != 1 || !strings.Contains(violations[0], "my_pkg") {
+		t.Fatalf("expected an overrides violation on main, got %v", violations)
+	}
+
+	// A feature branch is fine
+	run("checkout", "-b", "feature/work")
+	if violations := policy.checkOverridesBranch(dir); len(violations) > 0 {
+		t.Errorf("feature branch should pass, got %v", violations)
+	}
+
+	// Rule disabled entirely
+	if violations := (PolicyConfig{}).checkOverridesBranch(dir); len(violations) > 0 {
+		t.Errorf("empty rule should pass, got %v", violations)
+	}
+}
@@ -0,0 +1,319 @@
+// Package core/prcreate.go - Pull Request Creation for Dependency Updates
+//
+// Team workflows review dependency changes instead of landing them on the
+// default branch directly. This file packages a finished update into a PR:
+// commit the pubspec files on a fresh branch, push it, and open a GitHub
+// pull request with a templated body listing each changed dependency and
+// its old/new refs. The GitHub CLI is tried first; without gh the REST API
+// with a stored token is used, and with neither the pushed branch plus a
+// compare hint is returned so the PR can be opened manually.
+//
+// Key features:
+// - CreateUpdatePR: Branch, commit, push and open the pull request
+// - DependencyChangesFromResults: Extract old/new refs from run results
+// - buildPRBody: Markdown table of changed dependencies
+// - Reachable from the TUI results screen ('p') and `flutter-pm update --pr`
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// DependencyChange is one row of the PR body: a dependency and the refs it
+// moved between
+type DependencyChange struct {
+	Name   string
+	OldRef string
+	NewRef string
+	OldSHA string
+	NewSHA string
+}
+
+// DependencyChangesFromResults extracts dependency changes from successful
+// run results. Update results carry old/new refs; add results only a
+// target ref - both render meaningfully in the PR body.
+func DependencyChangesFromResults(results []ActionResult) []DependencyChange {
+	var changes []DependencyChange
+	for _, result := range results {
+		if !result.OK || result.Data == nil {
+			continue
+		}
+		name, ok := result.Data["package"].(string)
+		if !ok || name == "" {
+			continue
+		}
+		change := DependencyChange{Name: name}
+		if value, ok := result.Data["old_ref"].(string); ok {
+			change.OldRef = value
+		}
+		if value, ok := result.Data["new_ref"].(string); ok {
+			change.NewRef = value
+		}
+		if value, ok := result.Data["old_sha"].(string); ok {
+			change.OldSHA = value
+		}
+		if value, ok := result.Data["new_sha"].(string); ok {
+			change.NewSHA = value
+		}
+		// Add results record the pinned ref under "ref"
+		if change.NewRef == "" {
+			if value, ok := result.Data["ref"].(string); ok {
+				change.NewRef = value
+			}
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// CreateUpdatePR commits the project's pubspec changes on a new branch,
+// pushes it and opens a pull request against the branch the project was on.
+// The PR URL (or the pushed branch when no PR could be opened) is returned
+// in Data.
+func CreateUpdatePR(logger *Logger, cfg *Config, projectPath string, changes []DependencyChange) ActionResult {
+	if len(changes) == 0 {
+		return ActionResult{
+			OK:  false,
+			Err: "no successful dependency changes to put in a pull request",
+		}
+	}
+
+	git := func(args ...string) (string, error) {
+		output, err := exec.Command("git", append([]string{"-C", projectPath}, args...)...).CombinedOutput()
+		return strings.TrimSpace(string(output)), err
+	}
+
+	baseBranch, err := git("rev-parse", "--abbrev-ref", "HEAD")
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("%s is not a git repository", projectPath),
+		}
+	}
+
+	branch := "flutter-pm/deps-" + time.Now().Format("20060102-150405")
+	title := prTitle(changes)
+	body := buildPRBody(changes)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would push branch %s and open PR %q against %s", branch, title, baseBranch),
+		}
+	}
+
+	if output, err := git("checkout", "-b", branch); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to create branch %s: %s", branch, output),
+		}
+	}
+	// Whatever happens from here, leave the user on the branch they came from
+	defer func() {
+		if output, err := git("checkout", baseBranch); err != nil {
+			logger.Error("prcreate", fmt.Errorf("failed to return to branch %s: %s", baseBranch, output))
+		}
+	}()
+
+	if _, err := git("add", "--", "pubspec.yaml", "pubspec.lock"); err != nil {
+		// pubspec.lock may not exist yet; retry with pubspec.yaml alone
+		if output, err := git("add", "--", "pubspec.yaml"); err != nil {
+			return ActionResult{
+				OK:  false,
+				Err: fmt.Sprintf("failed to stage pubspec files: %s", output),
+			}
+		}
+	}
+	if _, err := git("diff", "--cached", "--quiet"); err == nil {
+		return ActionResult{
+			OK:  false,
+			Err: "pubspec files are unchanged - nothing to put in a pull request",
+		}
+	}
+	if output, err := git("commit", "-m", title, "-m", body); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("git commit failed: %s", output),
+		}
+	}
+
+	logger.Info("prcreate", fmt.Sprintf("Committed dependency changes on %s", branch))
+
+	if output, err := git("push", "-u", "origin", branch); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("failed to push %s: %s", branch, output),
+		}
+	}
+
+	// Primary: GitHub CLI. Fallback: REST API with a stored token. Last
+	// resort: report the pushed branch for a manual PR.
+	if _, err := exec.LookPath("gh"); err == nil {
+		cmd := exec.Command("gh", "pr", "create",
+			"--title", title, "--body", body,
+			"--head", branch, "--base", baseBranch)
+		cmd.Dir = projectPath
+		if output, err := cmd.CombinedOutput(); err == nil {
+			url := lastNonEmptyLine(string(output))
+			logger.Info("prcreate", fmt.Sprintf("Opened pull request: %s", url))
+			return ActionResult{
+				OK:      true,
+				Message: fmt.Sprintf("Opened pull request: %s", url),
+				Data: map[string]interface{}{
+					"pr_url": url,
+					"branch": branch,
+				},
+			}
+		} else {
+			logger.Debug("prcreate", fmt.Sprintf("gh pr create failed, trying REST: %s", string(output)))
+		}
+	}
+
+	if remote, err := git("remote", "get-url", "origin"); err == nil {
+		if url, err := createPRViaREST(remote, branch, baseBranch, title, body); err == nil {
+			logger.Info("prcreate", fmt.Sprintf("Opened pull request: %s", url))
+			return ActionResult{
+				OK:      true,
+				Message: fmt.Sprintf("Opened pull request: %s", url),
+				Data: map[string]interface{}{
+					"pr_url": url,
+					"branch": branch,
+				},
+			}
+		} else {
+			logger.Debug("prcreate", fmt.Sprintf("REST PR creation failed: %v", err))
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Pushed branch %s - open the pull request manually (gh unavailable and no API token)", branch),
+		Data: map[string]interface{}{
+			"branch": branch,
+		},
+	}
+}
+
+// prTitle builds the PR/commit subject from the change list
+func prTitle(changes []DependencyChange) string {
+	if len(changes) == 1 {
+		change := changes[0]
+		target := change.NewRef
+		if target == "" && change.NewSHA != "" {
+			target = shortSHA(change.NewSHA)
+		}
+		if target != "" {
+			return fmt.Sprintf("Update git dependency %s to %s", change.Name, target)
+		}
+		return "Update git dependency " + change.Name
+	}
+	return fmt.Sprintf("Update %d git dependencies", len(changes))
+}
+
+// buildPRBody renders the templated markdown body listing each changed
+// dependency with its old and new refs
+func buildPRBody(changes []DependencyChange) string {
+	var b strings.Builder
+	b.WriteString("Automated dependency update by flutter-pm.\n\n")
+	b.WriteString("| Dependency | Old | New |\n")
+	b.WriteString("|---|---|---|\n")
+	for _, change := range changes {
+		b.WriteString(fmt.Sprintf("| %s | %s | %s |\n",
+			change.Name,
+			describeChangeRef(change.OldRef, change.OldSHA),
+			describeChangeRef(change.NewRef, change.NewSHA)))
+	}
+	return b.String()
+}
+
+// describeChangeRef formats one side of a change as "ref (sha)" with
+// whatever is known
+func describeChangeRef(ref, sha string) string {
+	sha = shortSHA(sha)
+	switch {
+	case ref != "" && sha != "":
+		return fmt.Sprintf("%s (%s)", ref, sha)
+	case ref != "":
+		return ref
+	case sha != "":
+		return sha
+	}
+	return "—"
+}
+
+// shortSHA trims a SHA to the usual 7 characters
+func shortSHA(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
+
+// lastNonEmptyLine returns the final non-blank line of command output -
+// gh pr create prints the PR URL last
+func lastNonEmptyLine(output string) string {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// createPRViaREST opens the pull request through the GitHub REST API using
+// the stored token (see core/github_api.go)
+func createPRViaREST(remoteURL, head, base, title, body string) (string, error) {
+	token := GitHubToken()
+	if token == "" {
+		return "", fmt.Errorf("no GitHub token available")
+	}
+
+	normalized := normalizeRepoURL(remoteURL)
+	parts := strings.SplitN(strings.TrimPrefix(normalized, "github.com/"), "/", 2)
+	if !strings.HasPrefix(normalized, "github.com/") || len(parts) != 2 {
+		return "", fmt.Errorf("origin %s is not a GitHub repository", remoteURL)
+	}
+
+	payload, err := json.Marshal(map[string]string{
+		"title": title,
+		"head":  head,
+		"base":  base,
+		"body":  body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	endpoint := fmt.Sprintf("%s/repos/%s/%s/pulls", githubAPIBase, parts[0], parts[1])
+	req, err := http.NewRequest("POST", endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/vnd.github+json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusCreated {
+		return "", fmt.Errorf("GitHub API returned %s", resp.Status)
+	}
+	var created struct {
+		HTMLURL string `json:"html_url"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&created); err != nil {
+		return "", err
+	}
+	return created.HTMLURL, nil
+}
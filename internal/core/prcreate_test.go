@@ -0,0 +1,110 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDependencyChangesFromResults(t *testing.T) {
+	results := []ActionResult{
+		{
+			OK: true,
+			Data: map[string]interface{}{
+				"package": "my_pkg",
+				"old_ref": "v1.0.0",
+				"new_ref": "v2.0.0",
+				"old_sha": "aaaaaaaaaaaa",
+				"new_sha": "bbbbbbbbbbbb",
+			},
+		},
+		{
+			// Add-style result: only "ref"
+			OK: true,
+			Data: map[string]interface{}{
+				"package": "other_pkg",
+				"ref":     "main",
+			},
+		},
+		{OK: false, Data: map[string]interface{}{"package": "failed_pkg"}},
+		{OK: true}, // No data at all
+	}
+
+	changes := DependencyChangesFromResults(results)
+	if len(changes) != 2 {
+		t.Fatalf("expected 2 changes, got %d: %+v", len(changes), changes)
+	}
+	if changes[0].Name != "my_pkg" || changes[0].OldRef != "v1.0.0" || changes[0].NewRef != "v2.0.0" {
+		t.Errorf("unexpected first change: %+v", changes[0])
+	}
+	if changes[1].Name != "other_pkg" || changes[1].NewRef != "main" {
+		t.Errorf("add result should map ref to NewRef: %+v", changes[1])
+	}
+}
+
+func TestBuildPRBody(t *testing.T) {
+	body := buildPRBody([]DependencyChange{
+		{Name: "my_pkg", OldRef: "v1.0.0", OldSHA: "aaaaaaaaaaaa", NewRef: "v2.0.0", NewSHA: "bbbbbbbbbbbb"},
+		{Name: "other_pkg", NewRef: "main"},
+	})
+
+	for _, want := range []string{
+		"| Dependency | Old | New |",
+		"| my_pkg | v1.0.0 (aaaaaaa) | v2.0.0 (bbbbbbb) |",
+		"| other_pkg | — | main |",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("body missing %q:\n%s", want, body)
+		}
+	}
+}
+
+func TestPRTitle(t *testing.T) {
+	title := prTitle([]DependencyChange{{Name: "my_pkg", NewRef: "v2.0.0"}})
+	if title != "Update git dependency my_pkg to v2.0.0" {
+		t.Errorf("unexpected title: %q", title)
+	}
+
+	title = prTitle([]DependencyChange{{Name: "a"}, {Name: "b"}})
+	if title != "Update 2 git dependencies" {
+		t.Errorf("unexpected title: %q", title)
+	}
+}
+
+func TestCreateUpdatePRDryRun(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+	dir := t.TempDir()
+	run := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-C", dir}, args...)...)
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v failed: %v: %s", args, err, output)
+		}
+	}
+	run("init")
+	run("config", "user.email", "test@example.com")
+	run("config", "user.name", "test")
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte("name: app\n"), 0644); err != nil {
+		t.Fatalf("failed to write pubspec.yaml: %v", err)
+	}
+	run("add", "pubspec.yaml")
+	run("commit", "-m", "initial")
+
+	logger := NewLogger(&Config{Quiet: true})
+	changes := []DependencyChange{{Name: "my_pkg", NewRef: "v2.0.0"}}
+
+	result := CreateUpdatePR(logger, &Config{Quiet: true, DryRun: true}, dir, changes)
+	if !result.OK || !strings.Contains(result.Message, "Would push") {
+		t.Errorf("unexpected dry run result: %+v", result)
+	}
+
+	// Empty change list is refused before touching git
+	result = CreateUpdatePR(logger, &Config{Quiet: true}, dir, nil)
+	if result.OK {
+		t.Error("empty change list should fail")
+	}
+}
@@ -2,7 +2,10 @@
 //
 // This file provides comprehensive prerequisite checking and auto-installation
 // functionality for the Flutter Package Manager. It validates that all required
-// tools (git, dart/flutter, gh) are available and provides guidance for installation.
+// tools (git, dart/flutter, gh) are available and can install the missing ones
+// through the platform's package manager (winget/choco on Windows, brew on
+// macOS, apt/snap on Linux), verifying each tool afterwards so `flutter-pm
+// install` can bootstrap a fresh developer machine.
 
 package core
 
@@ -21,24 +24,24 @@ type Prerequisite struct {
 	Required    bool
 	Description string
 	InstallURL  string
-	InstallCmds map[string]string // OS -> command
+	InstallCmds map[string][]string // OS -> install commands, in preference order
 }
 
 // PrerequisiteResult represents the result of checking a prerequisite
 type PrerequisiteResult struct {
-	Name       string
-	Available  bool
-	Version    string
-	Error      string
-	InstallCmd string
+	Name       string `json:"name"`
+	Available  bool   `json:"available"`
+	Version    string `json:"version,omitempty"`
+	Error      string `json:"error,omitempty"`
+	InstallCmd string `json:"install_cmd,omitempty"`
 }
 
 // PrerequisiteCheck contains the overall prerequisite check results
 type PrerequisiteCheck struct {
-	AllMet   bool
-	Results  []PrerequisiteResult
-	Missing  []string
-	Warnings []string
+	AllMet   bool                 `json:"all_met"`
+	Results  []PrerequisiteResult `json:"results"`
+	Missing  []string             `json:"missing,omitempty"`
+	Warnings []string             `json:"warnings,omitempty"`
 }
 
 // GetPrerequisites returns the list of all prerequisites
@@ -51,10 +54,10 @@ func GetPrerequisites() []Prerequisite {
 			Required:    true,
 			Description: "Git version control system for repository operations",
 			InstallURL:  "https://git-scm.com/downloads",
-			InstallCmds: map[string]string{
-				"windows": "winget install Git.Git",
-				"darwin":  "brew install git",
-				"linux":   "sudo apt-get install git",
+			InstallCmds: map[string][]string{
+				"windows": {"winget install --accept-source-agreements --accept-package-agreements Git.Git", "choco install -y git"},
+				"darwin":  {"brew install git"},
+				"linux":   {"sudo apt-get install -y git", "sudo snap install git-ubuntu --classic"},
 			},
 		},
 		{
@@ -64,10 +67,10 @@ func GetPrerequisites() []Prerequisite {
 			Required:    false, // Either Flutter or Dart is sufficient
 			Description: "Flutter SDK for Flutter project management",
 			InstallURL:  "https://flutter.dev/docs/get-started/install",
-			InstallCmds: map[string]string{
-				"windows": "winget install Google.Flutter",
-				"darwin":  "brew install --cask flutter",
-				"linux":   "snap install flutter --classic",
+			InstallCmds: map[string][]string{
+				"windows": {"winget install --accept-source-agreements --accept-package-agreements Google.Flutter", "choco install -y flutter"},
+				"darwin":  {"brew install --cask flutter"},
+				"linux":   {"sudo snap install flutter --classic"},
 			},
 		},
 		{
@@ -77,10 +80,10 @@ func GetPrerequisites() []Prerequisite {
 			Required:    false, // Either Flutter or Dart is sufficient
 			Description: "Dart SDK for Dart project management",
 			InstallURL:  "https://dart.dev/get-dart",
-			InstallCmds: map[string]string{
-				"windows": "winget install Dart.DartSDK",
-				"darwin":  "brew install dart",
-				"linux":   "sudo apt-get install dart",
+			InstallCmds: map[string][]string{
+				"windows": {"winget install --accept-source-agreements --accept-package-agreements Dart.DartSDK", "choco install -y dart-sdk"},
+				"darwin":  {"brew install dart"},
+				"linux":   {"sudo apt-get install -y dart", "sudo snap install dart --classic"},
 			},
 		},
 		{
@@ -90,10 +93,10 @@ func GetPrerequisites() []Prerequisite {
 			Required:    false, // Optional but recommended
 			Description: "GitHub CLI for repository browsing and authentication",
 			InstallURL:  "https://cli.github.com/",
-			InstallCmds: map[string]string{
-				"windows": "winget install GitHub.cli",
-				"darwin":  "brew install gh",
-				"linux":   "sudo apt-get install gh",
+			InstallCmds: map[string][]string{
+				"windows": {"winget install --accept-source-agreements --accept-package-agreements GitHub.cli", "choco install -y gh"},
+				"darwin":  {"brew install gh"},
+				"linux":   {"sudo apt-get install -y gh", "sudo snap install gh"},
 			},
 		},
 	}
@@ -162,8 +165,8 @@ func checkSinglePrerequisite(prereq Prerequisite) PrerequisiteResult {
 
 	// Get install command for current OS
 	osName := runtime.GOOS
-	if installCmd, exists := prereq.InstallCmds[osName]; exists {
-		result.InstallCmd = installCmd
+	if installCmds, exists := prereq.InstallCmds[osName]; exists && len(installCmds) > 0 {
+		result.InstallCmd = installCmds[0]
 	} else {
 		result.InstallCmd = fmt.Sprintf("Please visit: %s", prereq.InstallURL)
 	}
@@ -189,8 +192,13 @@ func checkSinglePrerequisite(prereq Prerequisite) PrerequisiteResult {
 	return result
 }
 
-// AutoInstallPrerequisites attempts to auto-install missing prerequisites (where possible)
+// AutoInstallPrerequisites installs the missing prerequisites through the
+// platform's package manager. For each tool the install candidates are tried
+// in preference order (winget before choco, apt before snap), skipping
+// candidates whose package manager is not on PATH, and every install is
+// verified by re-running the tool's version check before it counts as done.
 func AutoInstallPrerequisites(logger *Logger, missing []string, dryRun bool) ActionResult {
+	missing = expandMissingForInstall(missing)
 	if len(missing) == 0 {
 		return ActionResult{
 			OK:      true,
@@ -199,21 +207,46 @@ func AutoInstallPrerequisites(logger *Logger, missing []string, dryRun bool) Act
 	}
 
 	logs := []string{}
+	failed := []string{}
 	osName := runtime.GOOS
 
-	// For now, just provide installation guidance
-	// Auto-installation would require elevated privileges
+	for _, name := range missing {
+		prereq := findPrerequisite(name)
+		if prereq == nil {
+			continue
+		}
 
-	if dryRun {
-		for _, name := range missing {
-			prereq := findPrerequisite(name)
-			if prereq != nil {
-				if installCmd, exists := prereq.InstallCmds[osName]; exists {
-					logs = append(logs, fmt.Sprintf("Would run: %s", installCmd))
-				}
-			}
+		installCmd := firstUsableInstallCmd(prereq.InstallCmds[osName])
+		if installCmd == "" {
+			logs = append(logs, fmt.Sprintf("No usable package manager for %s - install manually: %s", name, prereq.InstallURL))
+			failed = append(failed, name)
+			continue
+		}
+
+		if dryRun {
+			logs = append(logs, fmt.Sprintf("Would run: %s", installCmd))
+			continue
 		}
 
+		logger.Info("prerequisites", fmt.Sprintf("Installing %s: %s", name, installCmd))
+		logs = append(logs, fmt.Sprintf("Running: %s", installCmd))
+		if output, err := runInstallCmd(installCmd); err != nil {
+			logger.Error("prerequisites", fmt.Errorf("failed to install %s: %w", name, err))
+			logs = append(logs, fmt.Sprintf("Install failed: %v: %s", err, strings.TrimSpace(output)))
+			failed = append(failed, name)
+			continue
+		}
+
+		// Verify the tool actually works before counting it as installed
+		if verify := checkSinglePrerequisite(*prereq); verify.Available {
+			logs = append(logs, fmt.Sprintf("✅ %s installed (%s)", name, firstLine(verify.Version)))
+		} else {
+			logs = append(logs, fmt.Sprintf("❌ %s still not available after install: %s", name, verify.Error))
+			failed = append(failed, name)
+		}
+	}
+
+	if dryRun {
 		return ActionResult{
 			OK:      true,
 			Message: fmt.Sprintf("Would attempt to install %d missing prerequisites", len(missing)),
@@ -221,28 +254,74 @@ func AutoInstallPrerequisites(logger *Logger, missing []string, dryRun bool) Act
 		}
 	}
 
-	// Provide installation guidance
-	logger.Info("prerequisites", "Providing installation guidance for missing prerequisites")
-
-	for _, name := range missing {
-		prereq := findPrerequisite(name)
-		if prereq != nil {
-			if installCmd, exists := prereq.InstallCmds[osName]; exists {
-				logs = append(logs, fmt.Sprintf("To install %s: %s", name, installCmd))
-			} else {
-				logs = append(logs, fmt.Sprintf("To install %s: Visit %s", name, prereq.InstallURL))
-			}
+	if len(failed) > 0 {
+		return ActionResult{
+			OK:      false,
+			Message: fmt.Sprintf("Failed to install: %s", strings.Join(failed, ", ")),
+			Logs:    logs,
+			Err:     "Manual installation required - restart your terminal after installing so PATH changes take effect",
 		}
 	}
 
 	return ActionResult{
-		OK:      false,
-		Message: fmt.Sprintf("Cannot auto-install prerequisites. Manual installation required for: %s", strings.Join(missing, ", ")),
+		OK:      true,
+		Message: fmt.Sprintf("Installed %d prerequisites", len(missing)),
 		Logs:    logs,
-		Err:     "Manual installation required",
 	}
 }
 
+// expandMissingForInstall maps the synthetic "Flutter or Dart" entry from
+// CheckPrerequisites to a concrete tool (Flutter, since it bundles Dart)
+func expandMissingForInstall(missing []string) []string {
+	expanded := make([]string, 0, len(missing))
+	for _, name := range missing {
+		if name == "Flutter or Dart" {
+			name = "Flutter"
+		}
+		expanded = append(expanded, name)
+	}
+	return expanded
+}
+
+// firstUsableInstallCmd returns the first install candidate whose package
+// manager binary is on PATH, or "" when none are usable
+func firstUsableInstallCmd(candidates []string) string {
+	for _, candidate := range candidates {
+		fields := strings.Fields(candidate)
+		if len(fields) == 0 {
+			continue
+		}
+		manager := fields[0]
+		if manager == "sudo" && len(fields) > 1 {
+			manager = fields[1]
+		}
+		if _, err := exec.LookPath(manager); err == nil {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// runInstallCmd executes a space-separated install command and returns its
+// combined output. The commands are static data from GetPrerequisites, so
+// plain field splitting is safe - no quoting is involved.
+func runInstallCmd(installCmd string) (string, error) {
+	fields := strings.Fields(installCmd)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty install command")
+	}
+	output, err := exec.Command(fields[0], fields[1:]...).CombinedOutput()
+	return string(output), err
+}
+
+// firstLine trims a multi-line version string down to its first line
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return strings.TrimSpace(s[:i])
+	}
+	return strings.TrimSpace(s)
+}
+
 // GetInstallationGuidance returns formatted installation guidance
 func GetInstallationGuidance(check PrerequisiteCheck) []string {
 	if check.AllMet {
@@ -265,8 +344,11 @@ func GetInstallationGuidance(check PrerequisiteCheck) []string {
 			prereq := findPrerequisite(name)
 			if prereq != nil {
 				guidance = append(guidance, fmt.Sprintf("📦 %s - %s", name, prereq.Description))
-				if installCmd, exists := prereq.InstallCmds[osName]; exists {
-					guidance = append(guidance, fmt.Sprintf("   Install: %s", installCmd))
+				if installCmds, exists := prereq.InstallCmds[osName]; exists && len(installCmds) > 0 {
+					guidance = append(guidance, fmt.Sprintf("   Install: %s", installCmds[0]))
+					for _, alt := range installCmds[1:] {
+						guidance = append(guidance, fmt.Sprintf("   Fallback: %s", alt))
+					}
 				} else {
 					guidance = append(guidance, fmt.Sprintf("   Install: Visit %s", prereq.InstallURL))
 				}
@@ -283,6 +365,7 @@ func GetInstallationGuidance(check PrerequisiteCheck) []string {
 		guidance = append(guidance, "")
 	}
 
+	guidance = append(guidance, "💡 Run 'flutter-pm install' to install the missing tools automatically.")
 	guidance = append(guidance, "💡 After installation, restart your terminal and try again.")
 
 	return guidance
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"os/exec"
+	"strings"
+	"testing"
+)
+
+func TestExpandMissingForInstall(t *testing.T) {
+	expanded := expandMissingForInstall([]string{"Git", "Flutter or Dart"})
+	if len(expanded) != 2 || expanded[0] != "Git" || expanded[1] != "Flutter" {
+		t.Errorf("unexpected expansion: %v", expanded)
+	}
+}
+
+func TestFirstUsableInstallCmd(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git not available")
+	}
+
+	// The first candidate's package manager doesn't exist, so the chain
+	// falls through to the second (git stands in for a real manager here)
+	cmd := firstUsableInstallCmd([]string{
+		"definitely-not-a-package-manager install foo",
+		"git install foo",
+	})
+	if cmd != "git install foo" {
+		t.Errorf("expected fallback candidate, got %q", cmd)
+	}
+
+	// sudo-prefixed candidates check the binary after sudo
+	cmd = firstUsableInstallCmd([]string{"sudo definitely-not-a-package-manager install foo"})
+	if cmd != "" {
+		t.Errorf("expected no usable candidate, got %q", cmd)
+	}
+}
+
+func TestAutoInstallPrerequisitesDryRun(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+
+	result := AutoInstallPrerequisites(logger, nil, true)
+	if !result.OK || !strings.Contains(result.Message, "already available") {
+		t.Errorf("empty missing list should be a no-op: %+v", result)
+	}
+
+	// Dry run never executes anything, so this is safe even when the
+	// host has a real package manager for Git
+	result = AutoInstallPrerequisites(logger, []string{"Git"}, true)
+	if !result.OK {
+		t.Fatalf("dry run failed: %+v", result)
+	}
+	for _, log := range result.Logs {
+		if strings.HasPrefix(log, "Running:") {
+			t.Errorf("dry run must not execute installs: %q", log)
+		}
+	}
+}
+
+func TestFirstLine(t *testing.T) {
+	if got := firstLine("git version 2.43.0\nmore"); got != "git version 2.43.0" {
+		t.Errorf("firstLine = %q", got)
+	}
+	if got := firstLine("single"); got != "single" {
+		t.Errorf("firstLine = %q", got)
+	}
+}
@@ -0,0 +1,127 @@
+// Package core/progress.go - Progress Event Bus
+//
+// Core functions return only final ActionResults, so progress bars had to
+// fake their step counts. This file adds a small event bus: long-running
+// core operations report (stage, percent, message) events to a registered
+// ProgressReporter, giving consumers accurate per-byte clone progress and
+// per-package feedback. A package-level registry (like the output sink in
+// stream.go) keeps the core command signatures unchanged; when nothing is
+// registered every report is a no-op.
+//
+// Key features:
+// - ProgressReporter: interface consumed by both the TUI and the CLI
+// - SetProgressReporter / ClearProgressReporter: reporter registry
+// - ProgressFunc: function adapter for inline reporters
+// - PrintProgress: plain stderr progress lines for CLI commands
+// - parseProgressLine: extracts "Stage: NN%" from git --progress output
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ProgressReporter receives live progress events from long-running core
+// operations. percent is 0-100, or -1 when the share of completed work is
+// unknown (indeterminate stages).
+type ProgressReporter interface {
+	Progress(stage string, percent int, message string)
+}
+
+// ProgressFunc adapts a plain function to the ProgressReporter interface
+type ProgressFunc func(stage string, percent int, message string)
+
+// Progress implements ProgressReporter
+func (f ProgressFunc) Progress(stage string, percent int, message string) {
+	f(stage, percent, message)
+}
+
+// progressReporter holds the registered reporter, if any. Reports must not
+// block: reporters forwarding to a UI should drop events rather than stall
+// the operation.
+var progressReporter struct {
+	mu sync.RWMutex
+	r  ProgressReporter
+}
+
+// SetProgressReporter registers the reporter that receives progress events
+// from subsequent core operations
+func SetProgressReporter(r ProgressReporter) {
+	progressReporter.mu.Lock()
+	defer progressReporter.mu.Unlock()
+	progressReporter.r = r
+}
+
+// ClearProgressReporter deregisters the progress reporter. After it
+// returns no further events are delivered.
+func ClearProgressReporter() {
+	progressReporter.mu.Lock()
+	defer progressReporter.mu.Unlock()
+	progressReporter.r = nil
+}
+
+// reportProgress delivers one event to the registered reporter, if any
+func reportProgress(stage string, percent int, message string) {
+	progressReporter.mu.RLock()
+	defer progressReporter.mu.RUnlock()
+	if progressReporter.r != nil {
+		progressReporter.r.Progress(stage, percent, message)
+	}
+}
+
+// PrintProgress writes plain single-line progress to stderr, for CLI
+// commands (stdout stays reserved for command output and --json results)
+type PrintProgress struct{}
+
+// Progress implements ProgressReporter
+func (PrintProgress) Progress(stage string, percent int, message string) {
+	if percent >= 0 {
+		fmt.Fprintf(os.Stderr, "[%s] %3d%% %s\n", stage, percent, message)
+	} else {
+		fmt.Fprintf(os.Stderr, "[%s] %s\n", stage, message)
+	}
+}
+
+// combinedGitPercent folds git's successive --progress phases, which each
+// run 0-100% on their own, into one monotonic 0-100 clone scale. The
+// weights roughly match where the time goes: object transfer dominates,
+// delta resolution and checkout are short tails.
+func combinedGitPercent(phase string, percent int) int {
+	switch {
+	case strings.HasPrefix(phase, "Enumerating"), strings.HasPrefix(phase, "Counting"):
+		return percent * 5 / 100
+	case strings.HasPrefix(phase, "Compressing"):
+		return 5 + percent*10/100
+	case strings.HasPrefix(phase, "Receiving"):
+		return 15 + percent*65/100
+	case strings.HasPrefix(phase, "Resolving"):
+		return 80 + percent*15/100
+	case strings.HasPrefix(phase, "Updating files"), strings.HasPrefix(phase, "Checking out"):
+		return 95 + percent*5/100
+	default:
+		return percent
+	}
+}
+
+// progressLineRe matches the machine-readable progress git emits with
+// --progress, e.g. "Receiving objects:  42% (123/290), 1.2 MiB | 3.4 MiB/s"
+var progressLineRe = regexp.MustCompile(`([A-Za-z][A-Za-z -]*):\s+(\d+)%`)
+
+// parseProgressLine extracts the phase name and percentage from one git
+// progress segment; ok is false for lines without a percentage
+func parseProgressLine(line string) (phase string, percent int, ok bool) {
+	match := progressLineRe.FindStringSubmatch(line)
+	if match == nil {
+		return "", 0, false
+	}
+	percent, err := strconv.Atoi(match[2])
+	if err != nil || percent > 100 {
+		return "", 0, false
+	}
+	return strings.TrimSpace(match[1]), percent, true
+}
@@ -0,0 +1,76 @@
+package core
+
+import "testing"
+
+// TestParseProgressLine verifies extraction of git --progress percentages
+func TestParseProgressLine(t *testing.T) {
+	cases := []struct {
+		line    string
+		phase   string
+		percent int
+		ok      bool
+	}{
+		{"Receiving objects:  42% (123/290), 1.2 MiB | 3.4 MiB/s", "Receiving objects", 42, true},
+		{"Resolving deltas: 100% (50/50), done.", "Resolving deltas", 100, true},
+		{"Cloning into 'repo'...", "", 0, false},
+		{"", "", 0, false},
+	}
+	for _, tc := range cases {
+		phase, percent, ok := parseProgressLine(tc.line)
+		if ok != tc.ok || phase != tc.phase || percent != tc.percent {
+			t.Errorf("parseProgressLine(%q) = (%q, %d, %v), want (%q, %d, %v)",
+				tc.line, phase, percent, ok, tc.phase, tc.percent, tc.ok)
+		}
+	}
+}
+
+// TestCombinedGitPercent verifies the phase folding is monotonic across a
+// typical clone: each phase starts where the previous one ended
+func TestCombinedGitPercent(t *testing.T) {
+	sequence := []struct {
+		phase   string
+		percent int
+	}{
+		{"Enumerating objects", 100},
+		{"Counting objects", 100},
+		{"Compressing objects", 100},
+		{"Receiving objects", 0},
+		{"Receiving objects", 50},
+		{"Receiving objects", 100},
+		{"Resolving deltas", 0},
+		{"Resolving deltas", 100},
+		{"Updating files", 100},
+	}
+	last := 0
+	for _, step := range sequence {
+		combined := combinedGitPercent(step.phase, step.percent)
+		if combined < last {
+			t.Errorf("%s %d%% mapped to %d, below previous %d", step.phase, step.percent, combined, last)
+		}
+		if combined < 0 || combined > 100 {
+			t.Errorf("%s %d%% mapped outside 0-100: %d", step.phase, step.percent, combined)
+		}
+		last = combined
+	}
+	if last != 100 {
+		t.Errorf("completed clone mapped to %d, want 100", last)
+	}
+}
+
+// TestProgressReporterRegistry verifies events reach a registered reporter
+// and stop after it is cleared
+func TestProgressReporterRegistry(t *testing.T) {
+	var got []string
+	SetProgressReporter(ProgressFunc(func(stage string, percent int, message string) {
+		got = append(got, stage)
+	}))
+	defer ClearProgressReporter()
+
+	reportProgress("clone", 50, "halfway")
+	ClearProgressReporter()
+	reportProgress("clone", 100, "done")
+
+	if len(got) != 1 || got[0] != "clone" {
+		t.Errorf("expected exactly one event before clearing, got %v", got)
+	}
+}
@@ -0,0 +1,123 @@
+// Package core/projectenv.go - Project-Level Environment Variable Injection
+//
+// This file implements per-project environment configuration via a
+// .flutter-pm.yaml file next to pubspec.yaml. Variables declared under the
+// `env:` key (e.g. PUB_HOSTED_URL, GIT_SSH_COMMAND, HTTPS_PROXY) are injected
+// into every git/dart/gh command spawned for that project, so different
+// clients can use different registries and SSH identities without touching
+// the global shell environment.
+//
+// Example .flutter-pm.yaml:
+//
+//	env:
+//	  PUB_HOSTED_URL: https://pub.mycorp.internal
+//	  GIT_SSH_COMMAND: ssh -i ~/.ssh/id_client_a
+//
+// Key features:
+// - LoadProjectEnv: Parse the env map from .flutter-pm.yaml (missing file is fine)
+// - ApplyProjectEnv: Inject the project env into an exec.Cmd based on its Dir
+// - Modification-time cache so repeated spawns don't re-read the file
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectEnvFileName is the per-project configuration file read for env vars
+const ProjectEnvFileName = ".flutter-pm.yaml"
+
+// projectConfigYAML models the parts of .flutter-pm.yaml we read here
+type projectConfigYAML struct {
+	Env map[string]string `yaml:"env"`
+}
+
+// projectEnvCache avoids re-reading .flutter-pm.yaml on every spawned
+// command; entries are invalidated when the file's modification time changes
+type projectEnvCache struct {
+	mu    sync.RWMutex
+	cache map[string]cachedProjectEnv
+}
+
+// cachedProjectEnv is one cached env map keyed by the file's mod time
+type cachedProjectEnv struct {
+	env     map[string]string
+	modTime time.Time
+}
+
+var projEnvCache = &projectEnvCache{
+	cache: make(map[string]cachedProjectEnv),
+}
+
+// LoadProjectEnv reads the env map from .flutter-pm.yaml in projectPath.
+// A missing file returns nil without error; projects without one simply
+// inherit the process environment.
+func LoadProjectEnv(projectPath string) (map[string]string, error) {
+	configPath := filepath.Join(projectPath, ProjectEnvFileName)
+
+	info, err := os.Stat(configPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to stat %s: %w", ProjectEnvFileName, err)
+	}
+
+	// Serve from cache while the file is unchanged
+	projEnvCache.mu.RLock()
+	cached, ok := projEnvCache.cache[configPath]
+	projEnvCache.mu.RUnlock()
+	if ok && cached.modTime.Equal(info.ModTime()) {
+		return cached.env, nil
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", ProjectEnvFileName, err)
+	}
+
+	var config projectConfigYAML
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", ProjectEnvFileName, err)
+	}
+
+	projEnvCache.mu.Lock()
+	projEnvCache.cache[configPath] = cachedProjectEnv{env: config.Env, modTime: info.ModTime()}
+	projEnvCache.mu.Unlock()
+
+	return config.Env, nil
+}
+
+// ApplyProjectEnv injects the project-level env vars into a command based
+// on its working directory. Commands without a Dir, or in projects without
+// a .flutter-pm.yaml, are left untouched. A broken config file is logged
+// and skipped rather than blocking the command.
+func ApplyProjectEnv(logger *Logger, cmd *exec.Cmd) {
+	if cmd.Dir == "" {
+		return
+	}
+
+	env, err := LoadProjectEnv(cmd.Dir)
+	if err != nil {
+		logger.Debug("projectenv", fmt.Sprintf("Skipping project env: %v", err))
+		return
+	}
+	if len(env) == 0 {
+		return
+	}
+
+	// Start from the inherited environment; project entries override it
+	// because later entries win in exec.Cmd.Env
+	cmd.Env = os.Environ()
+	for key, value := range env {
+		cmd.Env = append(cmd.Env, key+"="+value)
+		logger.Debug("projectenv", fmt.Sprintf("Injecting %s for %s", key, cmd.Dir))
+	}
+}
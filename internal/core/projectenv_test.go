@@ -0,0 +1,78 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+// TestLoadProjectEnv verifies parsing of the env map from .flutter-pm.yaml
+// and that a missing file is not an error
+func TestLoadProjectEnv(t *testing.T) {
+	projectPath := t.TempDir()
+
+	// Missing file: projects without config inherit the process environment
+	env, err := LoadProjectEnv(projectPath)
+	if err != nil {
+		t.Fatalf("LoadProjectEnv on missing file failed: %v", err)
+	}
+	if env != nil {
+		t.Errorf("expected nil env for missing config, got %v", env)
+	}
+
+	config := `# Per-project overrides
+env:
+  PUB_HOSTED_URL: https://pub.mycorp.internal
+  GIT_SSH_COMMAND: ssh -i ~/.ssh/id_client_a
+`
+	configPath := filepath.Join(projectPath, ProjectEnvFileName)
+	if err := os.WriteFile(configPath, []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write config fixture: %v", err)
+	}
+
+	env, err = LoadProjectEnv(projectPath)
+	if err != nil {
+		t.Fatalf("LoadProjectEnv failed: %v", err)
+	}
+	if env["PUB_HOSTED_URL"] != "https://pub.mycorp.internal" {
+		t.Errorf("PUB_HOSTED_URL = %q, want %q", env["PUB_HOSTED_URL"], "https://pub.mycorp.internal")
+	}
+	if env["GIT_SSH_COMMAND"] != "ssh -i ~/.ssh/id_client_a" {
+		t.Errorf("GIT_SSH_COMMAND = %q, want %q", env["GIT_SSH_COMMAND"], "ssh -i ~/.ssh/id_client_a")
+	}
+}
+
+// TestApplyProjectEnv verifies that the project env is injected into a
+// command's environment and that commands without a Dir are untouched
+func TestApplyProjectEnv(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	config := "env:\n  PUB_HOSTED_URL: https://pub.mycorp.internal\n"
+	if err := os.WriteFile(filepath.Join(projectPath, ProjectEnvFileName), []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write config fixture: %v", err)
+	}
+
+	cmd := exec.Command("git", "--version")
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	found := false
+	for _, entry := range cmd.Env {
+		if entry == "PUB_HOSTED_URL=https://pub.mycorp.internal" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expected PUB_HOSTED_URL to be injected, env: %d entries", len(cmd.Env))
+	}
+
+	// No Dir: env must stay nil so the process environment is inherited
+	noDir := exec.Command("git", "--version")
+	ApplyProjectEnv(logger, noDir)
+	if noDir.Env != nil {
+		t.Errorf("expected untouched env for command without Dir, got %d entries", len(noDir.Env))
+	}
+}
@@ -0,0 +1,95 @@
+// Package core/projectinfo.go - Discovered Project Metadata
+//
+// This file enriches scan results with the details the project selection
+// screen shows next to each discovered project: Flutter vs pure Dart, the
+// SDK constraint, how many git dependencies the pubspec declares, when it
+// was last touched, and whether the git work tree has uncommitted changes.
+//
+// Key features:
+// - ProjectInfo: Display metadata for one discovered project
+// - InspectProject: Best-effort probe (missing data never fails the scan)
+// - Git state read via the git CLI, consistent with the rest of the codebase
+
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectInfo carries display metadata for a discovered project. All fields
+// are best-effort: a project that fails to parse simply shows less detail.
+type ProjectInfo struct {
+	IsFlutter     bool      // Depends on the Flutter SDK
+	SDKConstraint string    // environment.sdk from pubspec.yaml
+	GitDepCount   int       // Declared git-sourced dependencies
+	Modified      time.Time // pubspec.yaml modification time
+	HasGit        bool      // Project directory is inside a git work tree
+	Dirty         bool      // Uncommitted changes (meaningful when HasGit)
+}
+
+// projectInfoYAML models the pubspec fields InspectProject reads
+type projectInfoYAML struct {
+	Environment struct {
+		SDK     string `yaml:"sdk"`
+		Flutter string `yaml:"flutter"`
+	} `yaml:"environment"`
+	Dependencies map[string]interface{} `yaml:"dependencies"`
+}
+
+// InspectProject probes one discovered project for display metadata.
+// Never returns an error - the selection screen shows whatever could be
+// determined and leaves the rest blank.
+func InspectProject(project Project) ProjectInfo {
+	var info ProjectInfo
+
+	pubspecPath := project.PubspecPath
+	if pubspecPath == "" {
+		pubspecPath = filepath.Join(project.Path, "pubspec.yaml")
+	}
+
+	if stat, err := os.Stat(pubspecPath); err == nil {
+		info.Modified = stat.ModTime()
+	}
+
+	if content, err := os.ReadFile(pubspecPath); err == nil {
+		var pubspec projectInfoYAML
+		if err := yaml.Unmarshal(content, &pubspec); err == nil {
+			info.SDKConstraint = strings.TrimSpace(pubspec.Environment.SDK)
+
+			// A flutter SDK dependency or a flutter environment constraint
+			// both mark a Flutter project; everything else is pure Dart
+			if pubspec.Environment.Flutter != "" {
+				info.IsFlutter = true
+			}
+			if _, ok := pubspec.Dependencies["flutter"]; ok {
+				info.IsFlutter = true
+			}
+
+			for _, dep := range pubspec.Dependencies {
+				if depMap, ok := dep.(map[string]interface{}); ok {
+					if _, hasGit := depMap["git"]; hasGit {
+						info.GitDepCount++
+					}
+				}
+			}
+		}
+	}
+
+	// Git state via the git CLI, matching the rest of the codebase
+	cmd := exec.Command("git", "-C", project.Path, "rev-parse", "--is-inside-work-tree")
+	if output, err := cmd.Output(); err == nil && strings.TrimSpace(string(output)) == "true" {
+		info.HasGit = true
+		statusCmd := exec.Command("git", "-C", project.Path, "status", "--porcelain")
+		if statusOutput, err := statusCmd.Output(); err == nil {
+			info.Dirty = strings.TrimSpace(string(statusOutput)) != ""
+		}
+	}
+
+	return info
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestInspectProject verifies the metadata probe reads kind, SDK constraint
+// and git dependency count from pubspec.yaml
+func TestInspectProject(t *testing.T) {
+	dir := t.TempDir()
+	pubspec := `name: testapp
+environment:
+  sdk: ">=3.0.0 <4.0.0"
+dependencies:
+  flutter:
+    sdk: flutter
+  http: ^1.0.0
+  my_pkg:
+    git:
+      url: https://github.com/owner/my_pkg.git
+      ref: main
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	info := InspectProject(Project{Path: dir})
+	if !info.IsFlutter {
+		t.Error("expected IsFlutter for a project with a flutter dependency")
+	}
+	if info.SDKConstraint != ">=3.0.0 <4.0.0" {
+		t.Errorf("SDKConstraint = %q, want >=3.0.0 <4.0.0", info.SDKConstraint)
+	}
+	if info.GitDepCount != 1 {
+		t.Errorf("GitDepCount = %d, want 1", info.GitDepCount)
+	}
+	if info.Modified.IsZero() {
+		t.Error("expected a pubspec modification time")
+	}
+}
+
+// TestInspectProjectPureDart verifies a pubspec without flutter markers is
+// reported as pure Dart, and that a missing pubspec degrades gracefully
+func TestInspectProjectPureDart(t *testing.T) {
+	dir := t.TempDir()
+	pubspec := "name: tool\ndependencies:\n  args: ^2.0.0\n"
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	info := InspectProject(Project{Path: dir})
+	if info.IsFlutter {
+		t.Error("expected pure Dart project")
+	}
+	if info.GitDepCount != 0 {
+		t.Errorf("GitDepCount = %d, want 0", info.GitDepCount)
+	}
+
+	empty := InspectProject(Project{Path: filepath.Join(dir, "missing")})
+	if empty.IsFlutter || empty.GitDepCount != 0 || !empty.Modified.IsZero() {
+		t.Errorf("expected zero-value info for missing project, got %+v", empty)
+	}
+}
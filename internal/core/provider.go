@@ -0,0 +1,294 @@
+// Package core/provider.go - Repository Host Provider Abstraction
+//
+// This file introduces the RepoProvider interface so repository sources other
+// than GitHub flow through the same listing/cloning code paths. The GitHub
+// provider wraps the existing gh CLI integration (ListGitHubRepos/GitClone);
+// the Bitbucket provider uses the Bitbucket 2.0 REST API for public
+// workspaces; the plain git provider wraps a single manually entered git URL.
+//
+// Key features:
+// - RepoProvider: List, Clone and ResolveDefaultBranch for any git host
+// - ProviderForURL: Pick the right provider from a repository URL
+// - Shared git ls-remote fallback for default branch resolution
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// RepoProvider abstracts a source of git repositories (GitHub, Bitbucket, or
+// a plain git URL) so host-specific logic stays out of the selection flows
+type RepoProvider interface {
+	// Name returns a short provider identifier for logging and display
+	Name() string
+
+	// List returns the repositories available from this provider
+	List(logger *Logger) ([]RepoCandidate, error)
+
+	// Clone clones a repository into dir, optionally at a specific ref.
+	// The clone is killed if the context is cancelled.
+	Clone(ctx context.Context, logger *Logger, cfg *Config, repo RepoCandidate, dir, ref string) ActionResult
+
+	// ResolveDefaultBranch returns the repository's default branch name
+	ResolveDefaultBranch(logger *Logger, repo RepoCandidate) (string, error)
+}
+
+// GitHubProvider lists and clones repositories via the gh CLI
+type GitHubProvider struct{}
+
+// Name returns the provider identifier
+func (p *GitHubProvider) Name() string { return "github" }
+
+// List returns the authenticated user's repositories (cached, via gh CLI)
+func (p *GitHubProvider) List(logger *Logger) ([]RepoCandidate, error) {
+	return ListGitHubRepos(logger)
+}
+
+// Clone clones the repository using the shared git CLI path
+func (p *GitHubProvider) Clone(ctx context.Context, logger *Logger, cfg *Config, repo RepoCandidate, dir, ref string) ActionResult {
+	return GitCloneContext(ctx, logger, cfg, repo.URL, dir, ref)
+}
+
+// ResolveDefaultBranch asks the GitHub API first, then falls back to git ls-remote
+func (p *GitHubProvider) ResolveDefaultBranch(logger *Logger, repo RepoCandidate) (string, error) {
+	if _, err := exec.LookPath("gh"); err == nil && repo.Owner != "" && repo.Name != "" {
+		cmd := exec.Command("gh", "api", fmt.Sprintf("repos/%s/%s", repo.Owner, repo.Name),
+			"--jq", ".default_branch")
+		if output, err := cmd.Output(); err == nil {
+			if branch := strings.TrimSpace(string(output)); branch != "" {
+				return branch, nil
+			}
+		}
+		logger.Debug("github", fmt.Sprintf("gh api default branch lookup failed for %s/%s, falling back to ls-remote", repo.Owner, repo.Name))
+	}
+	return resolveDefaultBranchLsRemote(repo.URL)
+}
+
+// BitbucketProvider lists public repositories of a Bitbucket workspace via
+// the Bitbucket 2.0 REST API
+type BitbucketProvider struct {
+	Workspace string // Bitbucket workspace (the owner segment of repo URLs)
+}
+
+// bitbucketRepoPage is the paged repository response from the Bitbucket API
+type bitbucketRepoPage struct {
+	Values []struct {
+		Name        string `json:"name"`
+		Slug        string `json:"slug"`
+		Description string `json:"description"`
+		IsPrivate   bool   `json:"is_private"`
+		MainBranch  struct {
+			Name string `json:"name"`
+		} `json:"mainbranch"`
+		Links struct {
+			Clone []struct {
+				Name string `json:"name"`
+				Href string `json:"href"`
+			} `json:"clone"`
+		} `json:"links"`
+	} `json:"values"`
+	Next string `json:"next"`
+}
+
+// Name returns the provider identifier
+func (p *BitbucketProvider) Name() string { return "bitbucket" }
+
+// List returns the workspace's repositories from the Bitbucket REST API
+func (p *BitbucketProvider) List(logger *Logger) ([]RepoCandidate, error) {
+	if p.Workspace == "" {
+		return nil, fmt.Errorf("bitbucket workspace not configured")
+	}
+
+	logger.Info("bitbucket", fmt.Sprintf("Fetching repositories for workspace %s", p.Workspace))
+
+	client := &http.Client{
+		Timeout: 15 * time.Second,
+	}
+
+	var candidates []RepoCandidate
+	pageURL := fmt.Sprintf("https://api.bitbucket.org/2.0/repositories/%s?pagelen=100", url.PathEscape(p.Workspace))
+
+	for pageURL != "" {
+		resp, err := client.Get(pageURL)
+		if err != nil {
+			return nil, fmt.Errorf("bitbucket request failed: %w", err)
+		}
+
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return nil, fmt.Errorf("bitbucket returned HTTP %d for workspace %s", resp.StatusCode, p.Workspace)
+		}
+
+		var page bitbucketRepoPage
+		err = json.NewDecoder(resp.Body).Decode(&page)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse bitbucket response: %w", err)
+		}
+
+		for _, repo := range page.Values {
+			privacy := "public"
+			if repo.IsPrivate {
+				privacy = "private"
+			}
+
+			// Prefer the HTTPS clone link for consistency with GitHub URLs
+			cloneURL := ""
+			for _, link := range repo.Links.Clone {
+				if link.Name == "https" {
+					cloneURL = link.Href
+					break
+				}
+			}
+			if cloneURL == "" {
+				cloneURL = fmt.Sprintf("https://bitbucket.org/%s/%s.git", p.Workspace, repo.Slug)
+			}
+
+			candidates = append(candidates, RepoCandidate{
+				Owner:   p.Workspace,
+				Name:    repo.Slug,
+				URL:     cloneURL,
+				Privacy: privacy,
+				Desc:    repo.Description,
+			})
+		}
+
+		pageURL = page.Next
+	}
+
+	logger.Info("bitbucket", fmt.Sprintf("Found %d repositories", len(candidates)))
+	return candidates, nil
+}
+
+// Clone clones the repository using the shared git CLI path
+func (p *BitbucketProvider) Clone(ctx context.Context, logger *Logger, cfg *Config, repo RepoCandidate, dir, ref string) ActionResult {
+	return GitCloneContext(ctx, logger, cfg, repo.URL, dir, ref)
+}
+
+// ResolveDefaultBranch resolves the default branch via git ls-remote
+func (p *BitbucketProvider) ResolveDefaultBranch(logger *Logger, repo RepoCandidate) (string, error) {
+	return resolveDefaultBranchLsRemote(repo.URL)
+}
+
+// PlainGitProvider wraps a single manually entered git URL so the manual URL
+// path flows through the same provider code as hosted sources
+type PlainGitProvider struct {
+	URL string // Full git URL (https or ssh)
+}
+
+// Name returns the provider identifier
+func (p *PlainGitProvider) Name() string { return "git" }
+
+// List returns the single repository candidate derived from the URL
+func (p *PlainGitProvider) List(logger *Logger) ([]RepoCandidate, error) {
+	if p.URL == "" {
+		return nil, fmt.Errorf("git URL not configured")
+	}
+
+	owner, name := parseGitURLPath(p.URL)
+	if name == "" {
+		return nil, fmt.Errorf("could not determine repository name from %s", p.URL)
+	}
+
+	return []RepoCandidate{{
+		Owner: owner,
+		Name:  name,
+		URL:   p.URL,
+	}}, nil
+}
+
+// Clone clones the repository using the shared git CLI path
+func (p *PlainGitProvider) Clone(ctx context.Context, logger *Logger, cfg *Config, repo RepoCandidate, dir, ref string) ActionResult {
+	return GitCloneContext(ctx, logger, cfg, repo.URL, dir, ref)
+}
+
+// ResolveDefaultBranch resolves the default branch via git ls-remote
+func (p *PlainGitProvider) ResolveDefaultBranch(logger *Logger, repo RepoCandidate) (string, error) {
+	return resolveDefaultBranchLsRemote(repo.URL)
+}
+
+// ProviderForURL picks the provider matching a repository URL's host.
+// Unknown hosts get the plain git provider.
+func ProviderForURL(gitURL string) RepoProvider {
+	host := gitURLHost(gitURL)
+	owner, _ := parseGitURLPath(gitURL)
+
+	switch {
+	case strings.HasSuffix(host, "github.com"):
+		return &GitHubProvider{}
+	case strings.HasSuffix(host, "bitbucket.org"):
+		return &BitbucketProvider{Workspace: owner}
+	default:
+		return &PlainGitProvider{URL: gitURL}
+	}
+}
+
+// resolveDefaultBranchLsRemote resolves the remote HEAD symref with git,
+// which works for any git host without API access
+func resolveDefaultBranchLsRemote(gitURL string) (string, error) {
+	cmd := exec.Command("git", "ls-remote", "--symref", gitURL, "HEAD")
+	ApplySSHIdentity(nil, cmd, gitURL)
+	output, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve default branch: %w", err)
+	}
+
+	// Output format: "ref: refs/heads/main\tHEAD"
+	for _, line := range strings.Split(string(output), "\n") {
+		if strings.HasPrefix(line, "ref:") {
+			fields := strings.Fields(line)
+			if len(fields) >= 2 {
+				return strings.TrimPrefix(fields[1], "refs/heads/"), nil
+			}
+		}
+	}
+
+	return "", fmt.Errorf("no HEAD symref found for %s", gitURL)
+}
+
+// gitURLHost extracts the host from an https or ssh (git@host:path) git URL
+func gitURLHost(gitURL string) string {
+	if strings.HasPrefix(gitURL, "git@") {
+		rest := strings.TrimPrefix(gitURL, "git@")
+		if idx := strings.Index(rest, ":"); idx > 0 {
+			return rest[:idx]
+		}
+		return rest
+	}
+
+	if parsed, err := url.Parse(gitURL); err == nil && parsed.Host != "" {
+		return parsed.Host
+	}
+	return ""
+}
+
+// parseGitURLPath extracts the owner and repository name segments from a git URL
+func parseGitURLPath(gitURL string) (owner, name string) {
+	path := gitURL
+	if strings.HasPrefix(gitURL, "git@") {
+		if idx := strings.Index(gitURL, ":"); idx > 0 {
+			path = gitURL[idx+1:]
+		}
+	} else if parsed, err := url.Parse(gitURL); err == nil {
+		path = parsed.Path
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	parts := strings.Split(path, "/")
+	switch len(parts) {
+	case 0:
+		return "", ""
+	case 1:
+		return "", parts[0]
+	default:
+		return parts[len(parts)-2], parts[len(parts)-1]
+	}
+}
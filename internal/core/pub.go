@@ -19,13 +19,13 @@
 package core
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"regexp"
+	"sort"
 	"strings"
-	"syscall"
 	"time"
 
 	"gopkg.in/yaml.v3"
@@ -49,13 +49,45 @@ func FindPubTool() (string, error) {
 // This follows Junie's plan to use dart/flutter pub add instead of YAML surgery
 // If autoResolve is false, conflicts will be returned without attempting resolution
 func AddGitDependency(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, autoResolve bool) ActionResult {
-	tool, err := FindPubTool()
+	return AddGitDependencyContext(context.Background(), logger, cfg, projectPath, spec, autoResolve)
+}
+
+// AddGitDependencyContext is the context-aware variant of AddGitDependency.
+// The pub child process is killed when the context is cancelled; a default
+// timeout applies when the caller hasn't set a deadline.
+func AddGitDependencyContext(ctx context.Context, logger *Logger, cfg *Config, projectPath string, spec PkgSpec, autoResolve bool) ActionResult {
+	ctx, cancel := withDefaultTimeout(ctx, DefaultPubTimeout)
+	defer cancel()
+
+	// Policy check first: a violation must never reach pubspec.yaml
+	// (see core/policy.go)
+	if violations := CheckSpecPolicy(spec); len(violations) > 0 {
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("policy violation: %s", violations[0]),
+			Logs: violations,
+		}
+	}
+
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+
+	// Pick the URL scheme matching the credentials available for this host
+	// and verify access before anything is written (see core/credentials.go).
+	// Dry runs keep the rewrite but skip the network validation.
+	preparedURL, err := PrepareDependencyURL(logger, projectPath, spec.URL, cfg.DryRun)
 	if err != nil {
 		return ActionResult{
 			OK:  false,
 			Err: err.Error(),
 		}
 	}
+	spec.URL = preparedURL
 
 	// Use the pre-fetched package name from spec.Name
 	// The package name was already fetched during configuration phase
@@ -63,23 +95,11 @@ func AddGitDependency(logger *Logger, cfg *Config, projectPath string, spec PkgS
 	actualName := spec.Name
 	logger.Debug("pub", fmt.Sprintf("Using pre-fetched package name: %s", actualName))
 
-	// Build command arguments using inline git syntax
-	// Format: "package_name:{git:{url: https://..., ref: branch, path: subdir}, version: any}"
-	// CRITICAL: Spaces after colons are REQUIRED by dart pub
-	gitSpec := fmt.Sprintf(`{git:{url: %s`, spec.URL)
-	if spec.Ref != "" {
-		gitSpec += fmt.Sprintf(`, ref: %s`, spec.Ref)
-	}
-	if spec.Subdir != "" {
-		gitSpec += fmt.Sprintf(`, path: %s`, spec.Subdir)
-	}
-	gitSpec += fmt.Sprintf(`}, version: any}`)
-
-	// Build the package argument with literal quotes (no escaping)
-	// Format: "package_name:{git:{url: ..., ref: ...}, version: any}"
-	// Note: Quotes wrap the entire package spec, not just package name
-	packageArg := fmt.Sprintf(`"%s:%s"`, actualName, gitSpec)
-	args := []string{"pub", "add", packageArg}
+	// Build the package argument using inline git syntax
+	// Note: On Windows, pubCommand wraps the entire spec in quotes when
+	// building the raw command line
+	packageArg := InlinePackageArg(spec)
+	args := append(toolArgs, "pub", "add", packageArg)
 
 	logger.LogCommand("pub", tool, args)
 
@@ -139,25 +159,22 @@ func AddGitDependency(logger *Logger, cfg *Config, projectPath string, spec PkgS
 	// INSTRUMENTATION: Record start time
 	startTime := time.Now()
 	logger.Debug("pub", fmt.Sprintf("=== EXECUTING COMMAND at %s ===", startTime.Format("15:04:05.000")))
+	reportProgress("resolve", -1, fmt.Sprintf("Resolving %s", spec.Name))
 
 	// Execute command directly (not through cmd.exe)
-	// Use SysProcAttr.CmdLine to pass the exact command line, bypassing Go's argument parsing
-	// This is necessary because Windows uses CommandLineToArgvW which doesn't handle the inline git syntax correctly
-	cmdParts := []string{tool}
-	cmdParts = append(cmdParts, args...)
-	cmdStr := strings.Join(cmdParts, " ")
-
-	// Create command with SysProcAttr for direct execution
-	cmd := exec.Command(tool)
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		CmdLine: cmdStr,
-	}
+	// pubCommand handles the platform-specific argument passing (on Windows
+	// the raw command line is used because CommandLineToArgvW doesn't handle
+	// the inline git syntax correctly)
+	cmd := pubCommandContext(ctx, tool, args)
 	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
 
 	// Ensure stdin is closed so the command doesn't wait for input
 	cmd.Stdin = nil
 
-	output, err := cmd.CombinedOutput()
+	// Stream output line-by-line to any registered sink (the execution
+	// screen's live log) while collecting it for the result logs
+	output, err := runCommandStreaming(cmd)
 	outputStr := strings.TrimSpace(string(output))
 	logs := []string{
 		fmt.Sprintf("Command: %s", fullCommand),
@@ -168,6 +185,7 @@ func AddGitDependency(logger *Logger, cfg *Config, projectPath string, spec PkgS
 	endTime := time.Now()
 	duration := endTime.Sub(startTime)
 	logger.Debug("pub", fmt.Sprintf("=== COMMAND COMPLETED at %s (duration: %s) ===", endTime.Format("15:04:05.000"), duration))
+	RecordOperationTiming("pub add", duration)
 
 	// INSTRUMENTATION: Capture pubspec.yaml state AFTER command
 	afterContent, afterErr := os.ReadFile(pubspecPath)
@@ -201,9 +219,44 @@ func AddGitDependency(logger *Logger, cfg *Config, projectPath string, spec PkgS
 		logger.Debug("pub", fmt.Sprintf("Command failed: %s", err.Error()))
 		logger.Debug("pub", fmt.Sprintf("Command output: %s", outputStr))
 
+		// A killed command produced no meaningful pub output, so skip the
+		// conflict analysis and report the abort/timeout directly
+		if ctx.Err() != nil {
+			return ActionResult{
+				OK:   false,
+				Err:  contextFailure(ctx, fmt.Sprintf("pub add for %s", actualName), err),
+				Logs: logs,
+			}
+		}
+
 		// Analyze the error and attempt intelligent recovery
 		conflictAnalysis := analyzeDependencyConflict(outputStr, err)
 
+		// Impact context: name how widely the conflicting package is
+		// depended on (see core/impact.go). Best-effort - pub deps reads
+		// the last successful resolution and may itself be unavailable.
+		if conflictAnalysis.ConflictingPkg != "" {
+			if impact, impactErr := AnalyzeDependencyImpact(logger, projectPath, conflictAnalysis.ConflictingPkg); impactErr == nil {
+				EnrichConflictAnalysis(&conflictAnalysis, impact)
+			}
+		}
+
+		// Count the failure so repeated identical errors can trigger the
+		// bug report offer (see issue_report.go)
+		RecordFailure("add", conflictAnalysis.ConflictType)
+
+		// Debug capture: save the failed command as a replayable bundle
+		// (see debug_bundle.go; no-op unless debug mode is active)
+		CaptureDebugBundle(logger, cfg, DebugBundle{
+			Tool:        tool,
+			Args:        args,
+			ProjectPath: projectPath,
+			Spec:        spec,
+			ExitError:   err.Error(),
+			Output:      outputStr,
+			Analysis:    conflictAnalysis,
+		})
+
 		// If this is a recoverable conflict, either attempt resolution or return conflict info
 		if conflictAnalysis.IsRecoverable {
 			// Notify user about the conflict
@@ -311,130 +364,6 @@ func min(a, b int) int {
 	return b
 }
 
-// ConflictAnalysis holds information about a dependency conflict
-type ConflictAnalysis struct {
-	ConflictType    string   // Type of conflict: "version", "sdk", "circular", "platform", "git_vs_hosted", "transitive", "unknown"
-	SubType         string   // More specific conflict classification
-	IsRecoverable   bool     // Whether we can attempt automatic resolution
-	SuggestedFix    string   // Human-readable description of fix strategy
-	UserMessage     string   // Clear message for the user
-	ConflictingPkg  string   // Name of conflicting package
-	SourceConflict  string   // Details about source conflict (git vs hosted)
-	ResolutionSteps []string // Step-by-step resolution approach
-}
-
-// analyzeDependencyConflict analyzes pub add output to identify conflict types with enhanced classification
-func analyzeDependencyConflict(output string, err error) ConflictAnalysis {
-	lowerOutput := strings.ToLower(output)
-	originalOutput := output
-
-	// Extract conflicting package name if possible
-	conflictingPkg := extractConflictingPackageName(originalOutput)
-
-	// SDK constraint violations (check first, as they often include "version solving failed")
-	if (strings.Contains(lowerOutput, "sdk constraint") ||
-		strings.Contains(lowerOutput, "requires sdk") ||
-		strings.Contains(lowerOutput, "dart sdk")) ||
-		(strings.Contains(lowerOutput, "current dart sdk version") && strings.Contains(lowerOutput, "requires sdk")) {
-		return ConflictAnalysis{
-			ConflictType:    "sdk",
-			SubType:         "constraint_violation",
-			IsRecoverable:   false,
-			SuggestedFix:    "Update Dart/Flutter SDK or choose compatible package version",
-			UserMessage:     "Package requires newer SDK version than project supports",
-			ConflictingPkg:  conflictingPkg,
-			ResolutionSteps: []string{"Check package SDK requirements", "Update Flutter/Dart SDK", "Or use older package version"},
-		}
-	}
-
-	// Platform constraints (check before general version conflicts)
-	if (strings.Contains(lowerOutput, "platform") &&
-		(strings.Contains(lowerOutput, "not supported") || strings.Contains(lowerOutput, "doesn't support"))) ||
-		(strings.Contains(lowerOutput, "doesn't support platform") || strings.Contains(lowerOutput, "unsupported platform")) {
-		return ConflictAnalysis{
-			ConflictType:    "platform",
-			SubType:         "incompatible_platform",
-			IsRecoverable:   false,
-			SuggestedFix:    "Choose package compatible with target platforms",
-			UserMessage:     "Package not compatible with project's target platforms",
-			ConflictingPkg:  conflictingPkg,
-			ResolutionSteps: []string{"Check package platform support", "Update pubspec.yaml platforms", "Or choose alternative package"},
-		}
-	}
-
-	// Circular dependency detection
-	if strings.Contains(lowerOutput, "circular") ||
-		strings.Contains(lowerOutput, "cycle") ||
-		strings.Contains(lowerOutput, "circular dependency") {
-		return ConflictAnalysis{
-			ConflictType:    "circular",
-			SubType:         "dependency_cycle",
-			IsRecoverable:   false,
-			SuggestedFix:    "Remove circular dependency or choose different packages",
-			UserMessage:     "Circular dependency detected between packages",
-			ConflictingPkg:  conflictingPkg,
-			ResolutionSteps: []string{"Identify circular dependency chain", "Remove one dependency", "Or restructure dependencies"},
-		}
-	}
-
-	// Git vs Hosted conflict detection (CRITICAL for the reported issue)
-	if detectGitVsHostedConflict(originalOutput) {
-		conflictDetails := analyzeGitVsHostedConflict(originalOutput)
-		return ConflictAnalysis{
-			ConflictType:    "git_vs_hosted",
-			SubType:         "source_conflict",
-			IsRecoverable:   true,
-			SuggestedFix:    "Use dependency_overrides to force git source",
-			UserMessage:     fmt.Sprintf("Package source conflict: %s", conflictDetails),
-			ConflictingPkg:  conflictingPkg,
-			SourceConflict:  conflictDetails,
-			ResolutionSteps: []string{"Add dependency_overrides section", "Force git source for conflicting package", "Retry package addition"},
-		}
-	}
-
-	// Transitive dependency conflicts (check before general version conflicts)
-	if strings.Contains(lowerOutput, "transitive") ||
-		strings.Contains(lowerOutput, "indirect dependency") ||
-		strings.Contains(lowerOutput, "transitive dependency") {
-		return ConflictAnalysis{
-			ConflictType:    "transitive",
-			SubType:         "indirect_conflict",
-			IsRecoverable:   true,
-			SuggestedFix:    "Resolve transitive dependency with dependency overrides",
-			UserMessage:     "Conflict in transitive dependencies - attempting resolution",
-			ConflictingPkg:  conflictingPkg,
-			ResolutionSteps: []string{"Run pub deps to analyze", "Add dependency_overrides if needed", "Retry package addition"},
-		}
-	}
-
-	// Version conflict patterns (check last, as this is most general)
-	if strings.Contains(lowerOutput, "version solving failed") ||
-		strings.Contains(lowerOutput, "version conflict") ||
-		strings.Contains(lowerOutput, "incompatible version") ||
-		(strings.Contains(lowerOutput, "depends on") && strings.Contains(lowerOutput, "version solving failed")) {
-		return ConflictAnalysis{
-			ConflictType:    "version",
-			SubType:         "version_mismatch",
-			IsRecoverable:   true,
-			SuggestedFix:    "Run pub get to resolve version constraints",
-			UserMessage:     "Version conflict detected - attempting automatic resolution",
-			ConflictingPkg:  conflictingPkg,
-			ResolutionSteps: []string{"Run pub get to resolve versions", "Check for constraint conflicts", "Retry package addition"},
-		}
-	}
-
-	// Default case
-	return ConflictAnalysis{
-		ConflictType:    "unknown",
-		SubType:         "unclassified",
-		IsRecoverable:   false,
-		SuggestedFix:    "Manual intervention required",
-		UserMessage:     "Unknown dependency conflict - check pub output for details",
-		ConflictingPkg:  conflictingPkg,
-		ResolutionSteps: []string{"Review full error output", "Check package compatibility", "Manual resolution required"},
-	}
-}
-
 // attemptConflictResolution tries to resolve dependency conflicts automatically with enhanced strategies
 func attemptConflictResolution(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, analysis ConflictAnalysis) ActionResult {
 	logger.Info("pub", fmt.Sprintf("🔧 Starting resolution for %s conflict (subtype: %s)", analysis.ConflictType, analysis.SubType))
@@ -442,7 +371,7 @@ func attemptConflictResolution(logger *Logger, cfg *Config, projectPath string,
 	// For all recoverable conflicts, try using inline dependency overrides in the dart pub add command
 	if analysis.IsRecoverable && analysis.ConflictingPkg != "" {
 		logger.Info("pub", fmt.Sprintf("🔄 Attempting conflict resolution using inline dependency override for %s", analysis.ConflictingPkg))
-		return resolveWithInlineOverride(logger, cfg, projectPath, spec, analysis)
+		return resolveWithInlineOverride(logger, cfg, projectPath, spec, analysis, DefaultOverrideArg(analysis.ConflictingPkg))
 	}
 
 	// Log resolution steps for debugging
@@ -462,10 +391,54 @@ func attemptConflictResolution(logger *Logger, cfg *Config, projectPath string,
 	}
 }
 
+// DefaultOverrideArg builds the default inline dependency override argument
+// for a conflicting package: override:pkg:any. The conflict resolver shows
+// this to the user as an editable starting point (e.g. replacing `any` with a
+// version constraint, or pointing the override at a git ref).
+func DefaultOverrideArg(conflictingPkg string) string {
+	return fmt.Sprintf("override:%s:any", conflictingPkg)
+}
+
+// InlinePackageArg builds the dart pub add inline git package argument for a
+// spec. Format: "package_name:{git:{url: https://..., ref: branch}, version: any}"
+// CRITICAL: Spaces after colons are REQUIRED
+func InlinePackageArg(spec PkgSpec) string {
+	gitSpec := fmt.Sprintf(`{git:{url: %s`, spec.URL)
+	if spec.Ref != "" {
+		gitSpec += fmt.Sprintf(`, ref: %s`, spec.Ref)
+	}
+	if spec.Subdir != "" {
+		gitSpec += fmt.Sprintf(`, path: %s`, spec.Subdir)
+	}
+	gitSpec += fmt.Sprintf(`}, version: any}`)
+	return fmt.Sprintf(`%s:%s`, spec.Name, gitSpec)
+}
+
+// AddGitDependencyWithOverride adds a git dependency using a user-provided
+// inline override argument (as edited in the conflict resolver), instead of
+// the default override:pkg:any
+func AddGitDependencyWithOverride(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, overrideArg string) ActionResult {
+	analysis := ConflictAnalysis{
+		ConflictType:   "manual",
+		ConflictingPkg: overridePkgName(overrideArg),
+	}
+	return resolveWithInlineOverride(logger, cfg, projectPath, spec, analysis, overrideArg)
+}
+
+// overridePkgName extracts the package name from an override argument
+// (override:pkg:constraint -> pkg)
+func overridePkgName(overrideArg string) string {
+	parts := strings.SplitN(overrideArg, ":", 3)
+	if len(parts) >= 2 && parts[0] == "override" {
+		return parts[1]
+	}
+	return ""
+}
+
 // resolveWithInlineOverride uses the dart pub add inline override syntax to resolve conflicts
 // Based on user discovery: dart pub add package:"{git: url}" override:conflicting_package:any
-func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, analysis ConflictAnalysis) ActionResult {
-	tool, err := FindPubTool()
+func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string, spec PkgSpec, analysis ConflictAnalysis, overrideArg string) ActionResult {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
 	if err != nil {
 		return ActionResult{
 			OK:  false,
@@ -478,27 +451,14 @@ func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string,
 	actualName := spec.Name
 	logger.Debug("pub", fmt.Sprintf("Using pre-fetched package name: %s", actualName))
 
-	// Build git URL specification for inline syntax
-	// Format: "package_name:{git:{url: https://..., ref: branch}, version: any}"
-	// CRITICAL: Spaces after colons are REQUIRED
-	gitSpec := fmt.Sprintf(`{git:{url: %s`, spec.URL)
-	if spec.Ref != "" {
-		gitSpec += fmt.Sprintf(`, ref: %s`, spec.Ref)
-	}
-	if spec.Subdir != "" {
-		gitSpec += fmt.Sprintf(`, path: %s`, spec.Subdir)
-	}
-	gitSpec += fmt.Sprintf(`}, version: any}`)
-
 	// Build command with inline dependency override
-	// Format: dart pub add "package_name:{git:{url: ..., ref: ...}, version: any}" override:pkg1:any pkg2:any
-	// Note: Quotes wrap the entire package spec
-	packageArg := fmt.Sprintf(`"%s:%s"`, actualName, gitSpec)
-	args := []string{"pub", "add", packageArg}
+	// Format: dart pub add package_name:{git:{url: ..., ref: ...}, version: any} override:pkg1:any pkg2:any
+	// Note: On Windows, pubCommand wraps the package spec in quotes
+	packageArg := InlinePackageArg(spec)
+	args := append(toolArgs, "pub", "add", packageArg)
 
 	// Add dependency override for the conflicting package
-	if analysis.ConflictingPkg != "" {
-		overrideArg := fmt.Sprintf("override:%s:any", analysis.ConflictingPkg)
+	if overrideArg != "" {
 		args = append(args, overrideArg)
 		logger.Info("pub", fmt.Sprintf("📋 Adding dependency override: %s", overrideArg))
 	}
@@ -523,17 +483,11 @@ func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string,
 		}
 	}
 
-	// Execute command directly (not through cmd.exe)
-	// Use SysProcAttr.CmdLine to pass the exact command line
-	cmdParts := []string{tool}
-	cmdParts = append(cmdParts, args...)
-	cmdStr := strings.Join(cmdParts, " ")
-
-	cmd := exec.Command(tool)
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		CmdLine: cmdStr,
-	}
+	// Execute command directly (not through cmd.exe), letting pubCommand
+	// handle the platform-specific argument passing
+	cmd := pubCommand(tool, args)
 	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
 	cmd.Stdin = nil
 
 	output, err := cmd.CombinedOutput()
@@ -552,6 +506,18 @@ func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string,
 
 	logger.Info("pub", fmt.Sprintf("✅ Package %s successfully installed with conflict resolution", actualName))
 
+	// Journal why the override exists so the overrides screen can explain
+	// it later (see core/overrides.go)
+	if analysis.ConflictingPkg != "" {
+		RecordOverrideOrigin(logger, projectPath, OverrideOrigin{
+			Package:      analysis.ConflictingPkg,
+			AddedFor:     actualName,
+			ConflictType: analysis.ConflictType,
+			OverrideArg:  overrideArg,
+			Timestamp:    time.Now(),
+		})
+	}
+
 	// Wait for file locks and return success with detailed resolution info
 	time.Sleep(500 * time.Millisecond)
 	return ActionResult{
@@ -569,7 +535,7 @@ func resolveWithInlineOverride(logger *Logger, cfg *Config, projectPath string,
 
 // addGitDependencyWithoutConflictResolution adds a git dependency without conflict resolution (to avoid recursion)
 func addGitDependencyWithoutConflictResolution(logger *Logger, cfg *Config, projectPath string, spec PkgSpec) ActionResult {
-	tool, err := FindPubTool()
+	tool, toolArgs, err := FindPubToolFor(projectPath)
 	if err != nil {
 		return ActionResult{
 			OK:  false,
@@ -583,7 +549,7 @@ func addGitDependencyWithoutConflictResolution(logger *Logger, cfg *Config, proj
 	logger.Debug("pub", fmt.Sprintf("Using pre-fetched package name: %s", actualName))
 
 	// Build command arguments
-	args := []string{"pub", "add", actualName, "--git-url", spec.URL}
+	args := append(toolArgs, "pub", "add", actualName, "--git-url", spec.URL)
 	if spec.Ref != "" && spec.Ref != "main" {
 		args = append(args, "--git-ref", spec.Ref)
 	}
@@ -604,6 +570,7 @@ func addGitDependencyWithoutConflictResolution(logger *Logger, cfg *Config, proj
 	// Execute the command (no conflict resolution on retry)
 	cmd := exec.Command(tool, args...)
 	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
 	cmd.Stdin = nil
 
 	output, err := cmd.CombinedOutput()
@@ -634,7 +601,17 @@ func addGitDependencyWithoutConflictResolution(logger *Logger, cfg *Config, proj
 
 // Sync runs pub get to synchronize dependencies
 func Sync(logger *Logger, cfg *Config, projectPath string) ActionResult {
-	tool, err := FindPubTool()
+	return SyncContext(context.Background(), logger, cfg, projectPath)
+}
+
+// SyncContext runs pub get to synchronize dependencies, killing the child
+// process if the context is cancelled. A default timeout applies when the
+// caller hasn't set a deadline.
+func SyncContext(ctx context.Context, logger *Logger, cfg *Config, projectPath string) ActionResult {
+	ctx, cancel := withDefaultTimeout(ctx, DefaultPubTimeout)
+	defer cancel()
+
+	tool, toolArgs, err := FindPubToolFor(projectPath)
 	if err != nil {
 		return ActionResult{
 			OK:  false,
@@ -642,7 +619,7 @@ func Sync(logger *Logger, cfg *Config, projectPath string) ActionResult {
 		}
 	}
 
-	args := []string{"pub", "get"}
+	args := append(toolArgs, "pub", "get")
 	logger.LogCommand("sync", tool, args)
 
 	if cfg.DryRun {
@@ -653,19 +630,23 @@ func Sync(logger *Logger, cfg *Config, projectPath string) ActionResult {
 		}
 	}
 
-	cmd := exec.Command(tool, args...)
+	reportProgress("resolve", -1, "Running pub get")
+	started := time.Now()
+	cmd := exec.CommandContext(ctx, tool, args...)
 	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
 
-	output, err := cmd.CombinedOutput()
+	output, err := runCommandStreaming(cmd)
 	logs := []string{strings.TrimSpace(string(output))}
 
 	if err != nil {
 		return ActionResult{
 			OK:   false,
-			Err:  err.Error(),
+			Err:  contextFailure(ctx, "pub get", err),
 			Logs: logs,
 		}
 	}
+	RecordOperationTiming("pub get", time.Since(started))
 
 	return ActionResult{
 		OK:      true,
@@ -708,13 +689,27 @@ func CreateBackup(projectPath string) (BackupInfo, error) {
 	}, nil
 }
 
-// RestoreBackup restores a backup file
+// RestoreBackup restores a backup file over the project's pubspec.yaml
+func RestoreBackup(projectPath, backupPath string) error {
+	content, err := os.ReadFile(backupPath)
+	if err != nil {
+		return fmt.Errorf("failed to read backup %s: %w", backupPath, err)
+	}
+
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	if err := os.WriteFile(pubspecPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to restore pubspec.yaml: %w", err)
+	}
+
+	return nil
+}
 
 // ValidatePubspec performs basic validation on pubspec.yaml
 
 // pubspecYAML represents the structure of pubspec.yaml for parsing git dependencies
 type pubspecYAML struct {
-	Dependencies map[string]interface{} `yaml:"dependencies"`
+	Dependencies        map[string]interface{} `yaml:"dependencies"`
+	DependencyOverrides map[string]interface{} `yaml:"dependency_overrides"`
 }
 
 // gitDependency represents a git dependency structure in pubspec.yaml
@@ -786,68 +781,125 @@ func ListGitDependencies(projectPath string) ([]PkgSpec, error) {
 	return deps, nil
 }
 
-// extractConflictingPackageName attempts to extract the conflicting package name from error output
-// Note: This function uses regex because it parses error messages from dart pub, not YAML files.
-// For YAML parsing, see ListGitDependencies which uses yaml.v3 properly.
-func extractConflictingPackageName(output string) string {
-	// Look for patterns like "because project_name depends on package_name"
-	patterns := []string{
-		// Git vs hosted specific patterns - prioritize these
-		`(\w+) from hosted is required`,
-		`depends on (\w+) from git`,
-		`depends on (\w+) from hosted`,
-		`So, because \w+ depends on (\w+) from`,
-		// General patterns
-		`depends on (\w+) [\^\~]?[\d\.]+`,
-		`package (\w+) from`,
-		`(\w+) from git`,
-		`(\w+) from hosted`,
-		// Fallback patterns
-		`depends on (\w+)`,
-	}
-
-	for _, pattern := range patterns {
-		re := regexp.MustCompile(pattern)
-		if matches := re.FindStringSubmatch(output); len(matches) > 1 {
-			pkg := matches[1]
-			// Skip common project-type words
-			if pkg != "flutter" && pkg != "sdk" && pkg != "dart" {
-				return pkg
+// ListDependencyOverview classifies every dependency in pubspec.yaml by
+// source (git, hosted, path, sdk) and flags entries that also appear under
+// dependency_overrides. The manage/remove screen renders this as badges.
+func ListDependencyOverview(projectPath string) ([]DepOverview, error) {
+	pubspecPath := filepath.Join(projectPath, "pubspec.yaml")
+	content, err := os.ReadFile(pubspecPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pubspec.yaml: %w", err)
+	}
+
+	var pubspec pubspecYAML
+	if err := yaml.Unmarshal(content, &pubspec); err != nil {
+		return nil, fmt.Errorf("failed to parse pubspec.yaml: %w", err)
+	}
+
+	var deps []DepOverview
+	for name, dep := range pubspec.Dependencies {
+		overview := DepOverview{Name: name}
+		if _, ok := pubspec.DependencyOverrides[name]; ok {
+			overview.Overridden = true
+		}
+
+		switch v := dep.(type) {
+		case string:
+			// Plain version constraint: package: ^1.2.3
+			overview.Source = "hosted"
+			overview.Constraint = v
+		case nil:
+			// Bare entry: package: (any version from pub.dev)
+			overview.Source = "hosted"
+		case map[string]interface{}:
+			switch {
+			case v["git"] != nil:
+				overview.Source = "git"
+				switch git := v["git"].(type) {
+				case string:
+					overview.URL = git
+				case map[string]interface{}:
+					if url, ok := git["url"].(string); ok {
+						overview.URL = url
+					}
+					if ref, ok := git["ref"].(string); ok {
+						overview.Ref = ref
+					}
+				}
+			case v["path"] != nil:
+				overview.Source = "path"
+				if path, ok := v["path"].(string); ok {
+					overview.Path = path
+				}
+			case v["sdk"] != nil:
+				overview.Source = "sdk"
+			default:
+				// Detailed hosted form: package: {hosted: ..., version: ...}
+				overview.Source = "hosted"
+				if version, ok := v["version"].(string); ok {
+					overview.Constraint = version
+				}
 			}
+		default:
+			continue // Unrecognized dependency shape
 		}
+
+		deps = append(deps, overview)
 	}
 
-	return ""
-}
+	// Map iteration order is random; sort for a stable display
+	sort.Slice(deps, func(i, j int) bool {
+		return deps[i].Name < deps[j].Name
+	})
 
-// detectGitVsHostedConflict detects conflicts between git and hosted package sources
-func detectGitVsHostedConflict(output string) bool {
-	lowerOutput := strings.ToLower(output)
+	return deps, nil
+}
 
-	// Look for the classic git vs hosted conflict pattern
-	hasGitSource := strings.Contains(lowerOutput, "from git")
-	hasHostedSource := strings.Contains(lowerOutput, "from hosted")
-	hasVersionSolvingFailed := strings.Contains(lowerOutput, "version solving failed")
+// RemoveDependency removes a dependency from pubspec.yaml by running
+// `dart pub remove <package>` (or flutter equivalent). Callers are expected
+// to create a backup first, matching the add flow.
+func RemoveDependency(logger *Logger, cfg *Config, projectPath, packageName string) ActionResult {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
 
-	return hasGitSource && hasHostedSource && hasVersionSolvingFailed
-}
+	args := append(toolArgs, "pub", "remove", packageName)
 
-// analyzeGitVsHostedConflict provides detailed analysis of git vs hosted conflicts
-func analyzeGitVsHostedConflict(output string) string {
-	lines := strings.Split(output, "\n")
-	var conflictLines []string
+	logger.LogCommand("remove", tool, args)
 
-	for _, line := range lines {
-		lowerLine := strings.ToLower(line)
-		if (strings.Contains(lowerLine, "from git") || strings.Contains(lowerLine, "from hosted")) &&
-			(strings.Contains(lowerLine, "depends on") || strings.Contains(lowerLine, "requires")) {
-			conflictLines = append(conflictLines, strings.TrimSpace(line))
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: %s %s", tool, strings.Join(args, " ")),
+			Logs:    []string{fmt.Sprintf("DRY RUN: %s %s", tool, strings.Join(args, " "))},
 		}
 	}
 
-	if len(conflictLines) > 0 {
-		return strings.Join(conflictLines, " | ")
+	cmd := pubCommand(tool, args)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+	cmd.Stdin = nil
+
+	output, err := cmd.CombinedOutput()
+	outputStr := strings.TrimSpace(string(output))
+	logs := []string{outputStr}
+
+	if err != nil {
+		RecordFailure("remove", "pub_remove_failed")
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("Failed to remove %s: %s", packageName, err.Error()),
+			Logs: logs,
+		}
 	}
 
-	return "Git vs hosted source conflict detected"
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Successfully removed %s", packageName),
+		Logs:    logs,
+	}
 }
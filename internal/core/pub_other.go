@@ -0,0 +1,27 @@
+//go:build !windows
+
+// Package core/pub_other.go - Unix pub command construction
+//
+// On macOS and Linux the standard exec argument passing preserves the dart
+// pub inline git syntax verbatim, so no raw command line handling is needed
+// (see pub_windows.go for why Windows differs).
+
+package core
+
+import (
+	"context"
+	"os/exec"
+)
+
+// pubCommand builds an exec.Cmd for a dart/flutter pub invocation.
+// Arguments are passed through directly; each arg (including the inline
+// package spec with spaces) arrives at the process unmodified.
+func pubCommand(tool string, args []string) *exec.Cmd {
+	return pubCommandContext(context.Background(), tool, args)
+}
+
+// pubCommandContext is the context-aware variant of pubCommand; the child
+// process is killed when the context is cancelled
+func pubCommandContext(ctx context.Context, tool string, args []string) *exec.Cmd {
+	return exec.CommandContext(ctx, tool, args...)
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writePubspecFixture writes a pubspec.yaml into a temp project directory
+func writePubspecFixture(t *testing.T, projectPath, content string) {
+	t.Helper()
+	path := filepath.Join(projectPath, "pubspec.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write pubspec fixture: %v", err)
+	}
+}
+
+// TestListDependencyOverview verifies source classification and override
+// detection across the dependency shapes that appear in real pubspecs
+func TestListDependencyOverview(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, `name: test_app
+dependencies:
+  flutter:
+    sdk: flutter
+  http: ^1.2.0
+  my_git_pkg:
+    git:
+      url: https://github.com/owner/my_git_pkg.git
+      ref: v1.2.3
+  local_pkg:
+    path: ../local_pkg
+dependency_overrides:
+  http: 1.2.1
+`)
+
+	deps, err := ListDependencyOverview(projectPath)
+	if err != nil {
+		t.Fatalf("ListDependencyOverview failed: %v", err)
+	}
+
+	byName := make(map[string]DepOverview)
+	for _, dep := range deps {
+		byName[dep.Name] = dep
+	}
+
+	if dep := byName["flutter"]; dep.Source != "sdk" {
+		t.Errorf("flutter source = %q, want sdk", dep.Source)
+	}
+	if dep := byName["http"]; dep.Source != "hosted" || dep.Constraint != "^1.2.0" || !dep.Overridden {
+		t.Errorf("http = %+v, want hosted ^1.2.0 overridden", dep)
+	}
+	if dep := byName["my_git_pkg"]; dep.Source != "git" || dep.Ref != "v1.2.3" {
+		t.Errorf("my_git_pkg = %+v, want git ref v1.2.3", dep)
+	}
+	if dep := byName["local_pkg"]; dep.Source != "path" || dep.Path != "../local_pkg" {
+		t.Errorf("local_pkg = %+v, want path ../local_pkg", dep)
+	}
+
+	// Results must be sorted by name for stable display
+	for i := 1; i < len(deps); i++ {
+		if deps[i-1].Name > deps[i].Name {
+			t.Errorf("deps not sorted: %q before %q", deps[i-1].Name, deps[i].Name)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+//go:build windows
+
+// Package core/pub_windows.go - Windows-specific pub command construction
+//
+// Windows parses the process command line with CommandLineToArgvW, which does
+// not handle the dart pub inline git syntax correctly when Go escapes the
+// arguments. We therefore bypass Go's argument handling entirely and hand the
+// raw command line to the process via SysProcAttr.CmdLine, quoting the
+// package spec ourselves (quotes must wrap the whole spec).
+
+package core
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"syscall"
+)
+
+// pubCommand builds an exec.Cmd for a dart/flutter pub invocation.
+// On Windows the exact command line is passed via SysProcAttr.CmdLine so the
+// inline git syntax (spaces after colons, braces) survives argument parsing.
+func pubCommand(tool string, args []string) *exec.Cmd {
+	return pubCommandContext(context.Background(), tool, args)
+}
+
+// pubCommandContext is the context-aware variant of pubCommand; the child
+// process is killed when the context is cancelled
+func pubCommandContext(ctx context.Context, tool string, args []string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, tool)
+
+	parts := []string{tool}
+	for _, arg := range args {
+		// Quote arguments containing spaces or braces (the inline package spec)
+		if strings.ContainsAny(arg, " {") {
+			parts = append(parts, `"`+arg+`"`)
+		} else {
+			parts = append(parts, arg)
+		}
+	}
+
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		CmdLine: strings.Join(parts, " "),
+	}
+
+	return cmd
+}
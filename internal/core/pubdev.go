@@ -0,0 +1,185 @@
+// Package core/pubdev.go - pub.dev Hosted Package Source
+//
+// This file makes pub.dev a first-class package source next to git
+// repositories. It wraps the pub.dev API endpoints needed for interactive
+// search (search, package metadata, score) and adds hosted dependencies with
+// the plain `dart pub add name:^x.y.z` syntax. Package metadata lookups share
+// the cache in reco.go.
+//
+// Key features:
+// - SearchPubDev: Query the pub.dev search API and enrich the top results
+// - FetchPubDevScore: Fetch like count and pub points for a package
+// - AddHostedDependency: Run dart/flutter pub add with a version constraint
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// PubDevSearchResult is one enriched hit from the pub.dev search API
+type PubDevSearchResult struct {
+	Name          string `json:"name"`
+	LatestVersion string `json:"latest_version,omitempty"`
+	Description   string `json:"description,omitempty"`
+	LikeCount     int    `json:"like_count,omitempty"`
+	GrantedPoints int    `json:"granted_points,omitempty"`
+	MaxPoints     int    `json:"max_points,omitempty"`
+}
+
+// pubDevSearchResponse models GET https://pub.dev/api/search?q=<query>
+type pubDevSearchResponse struct {
+	Packages []struct {
+		Package string `json:"package"`
+	} `json:"packages"`
+}
+
+// pubDevScoreResponse models GET https://pub.dev/api/packages/<name>/score
+type pubDevScoreResponse struct {
+	GrantedPoints int `json:"grantedPoints"`
+	MaxPoints     int `json:"maxPoints"`
+	LikeCount     int `json:"likeCount"`
+}
+
+// maxPubDevSearchResults caps how many search hits are enriched with
+// metadata and score (each costs two extra API calls)
+const maxPubDevSearchResults = 10
+
+// SearchPubDev queries the pub.dev search API and returns the top results
+// enriched with latest version, description and score
+func SearchPubDev(logger *Logger, query string) ([]PubDevSearchResult, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, fmt.Errorf("empty search query")
+	}
+
+	searchURL := fmt.Sprintf("https://pub.dev/api/search?q=%s", url.QueryEscape(query))
+	logger.Debug("pubdev", fmt.Sprintf("Searching pub.dev: %s", searchURL))
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(searchURL)
+	if err != nil {
+		return nil, fmt.Errorf("pub.dev search failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pub.dev search returned HTTP %d", resp.StatusCode)
+	}
+
+	var parsed pubDevSearchResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse pub.dev search response: %w", err)
+	}
+
+	count := len(parsed.Packages)
+	if count > maxPubDevSearchResults {
+		count = maxPubDevSearchResults
+	}
+
+	results := make([]PubDevSearchResult, 0, count)
+	for _, hit := range parsed.Packages[:count] {
+		result := PubDevSearchResult{Name: hit.Package}
+
+		// Enrich with metadata and score; both are best-effort
+		if info, err := FetchPubDevInfo(logger, hit.Package); err == nil && info != nil {
+			result.LatestVersion = info.LatestVersion
+		}
+		if score, err := FetchPubDevScore(logger, hit.Package); err == nil {
+			result.LikeCount = score.LikeCount
+			result.GrantedPoints = score.GrantedPoints
+			result.MaxPoints = score.MaxPoints
+		}
+
+		results = append(results, result)
+	}
+
+	logger.Info("pubdev", fmt.Sprintf("Found %d packages for %q", len(results), query))
+	return results, nil
+}
+
+// FetchPubDevScore fetches the pub.dev score (likes and pub points) for a package
+func FetchPubDevScore(logger *Logger, packageName string) (*pubDevScoreResponse, error) {
+	scoreURL := fmt.Sprintf("https://pub.dev/api/packages/%s/score", packageName)
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(scoreURL)
+	if err != nil {
+		return nil, fmt.Errorf("pub.dev score request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pub.dev score returned HTTP %d for %s", resp.StatusCode, packageName)
+	}
+
+	var parsed pubDevScoreResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse pub.dev score response: %w", err)
+	}
+
+	return &parsed, nil
+}
+
+// AddHostedDependency adds a hosted pub.dev dependency with a version
+// constraint, e.g. `dart pub add dio:^5.4.0`. An empty constraint adds the
+// latest compatible version.
+func AddHostedDependency(logger *Logger, cfg *Config, projectPath, packageName, constraint string) ActionResult {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+
+	packageArg := packageName
+	if constraint != "" {
+		packageArg = fmt.Sprintf("%s:%s", packageName, constraint)
+	}
+	args := append(toolArgs, "pub", "add", packageArg)
+
+	logger.LogCommand("pubdev", tool, args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: %s %s", tool, strings.Join(args, " ")),
+			Logs:    []string{fmt.Sprintf("DRY RUN: %s %s", tool, strings.Join(args, " "))},
+		}
+	}
+
+	cmd := pubCommand(tool, args)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+	cmd.Stdin = nil
+
+	output, err := cmd.CombinedOutput()
+	outputStr := strings.TrimSpace(string(output))
+	logs := []string{outputStr}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("Failed to add %s: %s", packageArg, err.Error()),
+			Logs: logs,
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Successfully added %s", packageArg),
+		Logs:    logs,
+	}
+}
@@ -0,0 +1,221 @@
+// Package core/pubspec_detect.go - Dart Package Detection for Repo Listings
+//
+// Most GitHub accounts mix Dart packages with unrelated repositories. This
+// file probes each candidate repository for a pubspec.yaml using the same
+// fallback chain as FetchPackageNameFromGit (gh api first, then
+// raw.githubusercontent.com), so the selection screen can mark real
+// packages and filter out the rest before the user picks them.
+//
+// Key features:
+// - DetectDartPackages: Concurrent, bounded pubspec probe over a repo list
+// - Fills in HasPubspec, PubspecChecked and PackageName on each candidate
+// - ListRepoPubspecSubdirs: Tree scan for nested pubspec.yaml (monorepos)
+// - Probes are best-effort: only a definitive hit or 404 marks a repo
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pubspecProbeWorkers bounds the concurrent pubspec lookups so large
+// accounts don't hammer the GitHub API
+const pubspecProbeWorkers = 8
+
+// DetectDartPackages probes every candidate for a pubspec.yaml and returns
+// the list (same order) with HasPubspec, PubspecChecked and PackageName
+// filled in. Candidates whose probe fails without a definitive 404 stay
+// unchecked so a later retry can still resolve them.
+func DetectDartPackages(logger *Logger, repos []RepoCandidate) []RepoCandidate {
+	out := make([]RepoCandidate, len(repos))
+	copy(out, repos)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, pubspecProbeWorkers)
+
+	for i := range out {
+		if out[i].PubspecChecked {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(idx int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			repo := &out[idx]
+			hasPubspec, pkgName, err := probeRepoPubspec(logger, repo.Owner, repo.Name)
+			if err != nil {
+				logger.Debug("pubspec_detect", fmt.Sprintf("Probe for %s/%s inconclusive: %v", repo.Owner, repo.Name, err))
+				return
+			}
+
+			repo.PubspecChecked = true
+			repo.HasPubspec = hasPubspec
+			if hasPubspec && pkgName != "" && repo.PackageName == "" {
+				repo.PackageName = pkgName
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	packages := 0
+	for _, repo := range out {
+		if repo.PubspecChecked && repo.HasPubspec {
+			packages++
+		}
+	}
+	logger.Info("pubspec_detect", fmt.Sprintf("Detected %d Dart package(s) among %d repositories", packages, len(out)))
+
+	return out
+}
+
+// probeRepoPubspec checks whether a repository has a root pubspec.yaml.
+// Returns the declared package name on a hit, (false, "", nil) on a
+// definitive 404, and an error when no method gave a conclusive answer.
+func probeRepoPubspec(logger *Logger, owner, name string) (bool, string, error) {
+	ownerRepo := owner + "/" + name
+
+	// Primary: gh api (works for private repos when authenticated)
+	if pkgName, err := fetchPackageNameViaGhAPI(logger, ownerRepo, "pubspec.yaml"); err == nil {
+		return true, pkgName, nil
+	} else if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "Not Found") {
+		return false, "", nil
+	}
+
+	// Fallback: raw.githubusercontent.com (public repos only). "HEAD"
+	// resolves the default branch without knowing its name.
+	for _, branch := range []string{"HEAD", "main", "master"} {
+		pkgName, err := fetchPackageNameViaHTTP(logger, ownerRepo, "pubspec.yaml", branch)
+		if err == nil {
+			return true, pkgName, nil
+		}
+		if strings.Contains(err.Error(), "HTTP 404") {
+			return false, "", nil
+		}
+	}
+
+	return false, "", fmt.Errorf("pubspec probe failed for %s", ownerRepo)
+}
+
+// ReposNeedPubspecProbe reports whether any candidate in the list still
+// lacks a definitive pubspec probe result
+func ReposNeedPubspecProbe(repos []RepoCandidate) bool {
+	for _, repo := range repos {
+		if !repo.PubspecChecked {
+			return true
+		}
+	}
+	return false
+}
+
+// ListRepoPubspecSubdirs scans a repository's git tree for pubspec.yaml
+// files and returns the directories that contain one, sorted, with "" for
+// the repository root. Uses gh api first and falls back to the REST API
+// (with the configured token when available) so it works without the gh
+// CLI. Monorepo packages can then be picked from real paths instead of
+// typed by hand.
+func ListRepoPubspecSubdirs(logger *Logger, owner, name string) ([]string, error) {
+	ownerRepo := owner + "/" + name
+
+	paths, err := fetchRepoTreePathsViaGh(ownerRepo)
+	if err != nil {
+		logger.Debug("pubspec_detect", fmt.Sprintf("gh tree listing for %s failed: %v", ownerRepo, err))
+		paths, err = fetchRepoTreePathsViaAPI(ownerRepo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pubspecSubdirsFromTree(paths), nil
+}
+
+// pubspecSubdirsFromTree extracts the directories containing a
+// pubspec.yaml from a flat list of tree paths
+func pubspecSubdirsFromTree(paths []string) []string {
+	var subdirs []string
+	for _, path := range paths {
+		switch {
+		case path == "pubspec.yaml":
+			subdirs = append(subdirs, "")
+		case strings.HasSuffix(path, "/pubspec.yaml"):
+			subdirs = append(subdirs, strings.TrimSuffix(path, "/pubspec.yaml"))
+		}
+	}
+	sort.Strings(subdirs)
+	return subdirs
+}
+
+// fetchRepoTreePathsViaGh lists every path in the default branch's tree
+// using the gh CLI (works for private repos when authenticated)
+func fetchRepoTreePathsViaGh(ownerRepo string) ([]string, error) {
+	cmd := exec.Command("gh", "api",
+		fmt.Sprintf("repos/%s/git/trees/HEAD?recursive=1", ownerRepo),
+		"--jq", ".tree[].path")
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("gh api failed: %s", string(exitErr.Stderr))
+		}
+		return nil, fmt.Errorf("failed to run gh api: %w", err)
+	}
+
+	var paths []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			paths = append(paths, line)
+		}
+	}
+	return paths, nil
+}
+
+// fetchRepoTreePathsViaAPI lists tree paths via the REST API, attaching
+// the configured token when one is available (see GitHubToken)
+func fetchRepoTreePathsViaAPI(ownerRepo string) ([]string, error) {
+	reqURL := fmt.Sprintf("%s/repos/%s/git/trees/HEAD?recursive=1", githubAPIBase, ownerRepo)
+	req, err := http.NewRequest(http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := GitHubToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	client := &http.Client{
+		Timeout: 15 * time.Second,
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("GitHub API request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("GitHub API returned HTTP %d for %s", resp.StatusCode, ownerRepo)
+	}
+
+	var tree struct {
+		Tree []struct {
+			Path string `json:"path"`
+		} `json:"tree"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&tree); err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub tree response: %w", err)
+	}
+
+	paths := make([]string, 0, len(tree.Tree))
+	for _, entry := range tree.Tree {
+		paths = append(paths, entry.Path)
+	}
+	return paths, nil
+}
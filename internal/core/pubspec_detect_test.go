@@ -0,0 +1,47 @@
+// Package core/pubspec_detect_test.go - Tests for Dart package detection
+//
+// Covers the pure parts of the pubspec probe: tree-path extraction for
+// monorepo subdirectory discovery and the probe-needed check. The network
+// probes themselves are exercised manually (they need GitHub access).
+
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPubspecSubdirsFromTree(t *testing.T) {
+	paths := []string{
+		"README.md",
+		"pubspec.yaml",
+		"packages/ui_kit/pubspec.yaml",
+		"packages/ui_kit/lib/main.dart",
+		"packages/analytics/pubspec.yaml",
+		"docs/pubspec.yaml.example",
+	}
+
+	got := pubspecSubdirsFromTree(paths)
+	want := []string{"", "packages/analytics", "packages/ui_kit"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pubspecSubdirsFromTree() = %v, want %v", got, want)
+	}
+}
+
+func TestPubspecSubdirsFromTreeEmpty(t *testing.T) {
+	if got := pubspecSubdirsFromTree([]string{"main.go", "go.mod"}); len(got) != 0 {
+		t.Errorf("Expected no subdirs for a non-Dart tree, got %v", got)
+	}
+}
+
+func TestReposNeedPubspecProbe(t *testing.T) {
+	unchecked := []RepoCandidate{{Name: "a", PubspecChecked: true}, {Name: "b"}}
+	if !ReposNeedPubspecProbe(unchecked) {
+		t.Error("Expected probe needed when a candidate is unchecked")
+	}
+
+	checked := []RepoCandidate{{Name: "a", PubspecChecked: true}}
+	if ReposNeedPubspecProbe(checked) {
+		t.Error("Expected no probe needed when every candidate is checked")
+	}
+}
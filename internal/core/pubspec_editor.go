@@ -0,0 +1,213 @@
+// Package core/pubspec_editor.go - Comment-Preserving pubspec.yaml Editor
+//
+// This file implements a structured editor for pubspec.yaml built on the
+// yaml.v3 node API. Unlike a plain unmarshal/marshal cycle, editing the
+// node tree preserves comments, key order and mapping structure, so a
+// round-trip only touches the entries that actually changed. The editor
+// backs the single-pass batch mode (see core/batch.go) and enables offline
+// editing and dependency_overrides management without relying on pub CLI
+// quirks.
+//
+// Key features:
+// - SetGitDependency / SetHostedDependency: Upsert dependency entries
+// - RemoveDependency: Delete an entry, reporting whether it existed
+// - SetGitOverride / RemoveOverride: Manage dependency_overrides the same way
+// - Bytes / Save: Re-encode with 2-space indent, comments intact
+
+package core
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PubspecEditor edits a pubspec.yaml document in place, preserving
+// comments, key order and formatting of untouched entries
+type PubspecEditor struct {
+	doc  yaml.Node
+	path string // Source file; empty for byte-only editors
+}
+
+// LoadPubspecEditor reads and parses a pubspec.yaml file for editing
+func LoadPubspecEditor(path string) (*PubspecEditor, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pubspec.yaml: %w", err)
+	}
+
+	editor, err := NewPubspecEditorFromBytes(content)
+	if err != nil {
+		return nil, err
+	}
+	editor.path = path
+	return editor, nil
+}
+
+// NewPubspecEditorFromBytes parses pubspec.yaml content for editing
+func NewPubspecEditorFromBytes(content []byte) (*PubspecEditor, error) {
+	editor := &PubspecEditor{}
+	if err := yaml.Unmarshal(content, &editor.doc); err != nil {
+		return nil, fmt.Errorf("failed to parse pubspec.yaml: %w", err)
+	}
+	if editor.doc.Kind != yaml.DocumentNode || len(editor.doc.Content) == 0 ||
+		editor.doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("pubspec.yaml is not a YAML mapping")
+	}
+	return editor, nil
+}
+
+// SetGitDependency upserts a git dependency entry under dependencies
+func (e *PubspecEditor) SetGitDependency(spec PkgSpec) {
+	deps := findOrCreateMapping(e.root(), "dependencies")
+	upsertMappingKey(deps, spec.Name, gitDependencyNode(spec))
+}
+
+// SetHostedDependency upserts a hosted dependency with a version
+// constraint (e.g. "^1.2.0"); an empty constraint means "any"
+func (e *PubspecEditor) SetHostedDependency(name, constraint string) {
+	if constraint == "" {
+		constraint = "any"
+	}
+	deps := findOrCreateMapping(e.root(), "dependencies")
+	upsertMappingKey(deps, name, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: constraint})
+}
+
+// RemoveDependency deletes an entry from dependencies, reporting whether
+// it was present
+func (e *PubspecEditor) RemoveDependency(name string) bool {
+	return removeMappingKey(findMapping(e.root(), "dependencies"), name)
+}
+
+// SetGitOverride upserts a git entry under dependency_overrides
+func (e *PubspecEditor) SetGitOverride(spec PkgSpec) {
+	overrides := findOrCreateMapping(e.root(), "dependency_overrides")
+	upsertMappingKey(overrides, spec.Name, gitDependencyNode(spec))
+}
+
+// RemoveOverride deletes an entry from dependency_overrides, reporting
+// whether it was present. An emptied section is removed entirely so the
+// file doesn't keep a dangling `dependency_overrides:` key.
+func (e *PubspecEditor) RemoveOverride(name string) bool {
+	overrides := findMapping(e.root(), "dependency_overrides")
+	removed := removeMappingKey(overrides, name)
+	if removed && len(overrides.Content) == 0 {
+		removeMappingKey(e.root(), "dependency_overrides")
+	}
+	return removed
+}
+
+// Bytes re-encodes the document with pub-style 2-space indentation
+func (e *PubspecEditor) Bytes() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&e.doc); err != nil {
+		return nil, fmt.Errorf("failed to encode pubspec.yaml: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode pubspec.yaml: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Save writes the edited document back to the file it was loaded from
+func (e *PubspecEditor) Save() error {
+	if e.path == "" {
+		return fmt.Errorf("editor was not loaded from a file")
+	}
+	content, err := e.Bytes()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(e.path, content, 0644)
+}
+
+// root returns the top-level mapping node
+func (e *PubspecEditor) root() *yaml.Node {
+	return e.doc.Content[0]
+}
+
+// findMapping returns the mapping value for a top-level key, or an empty
+// detached mapping when the key is missing (so lookups can't panic)
+func findMapping(root *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key && root.Content[i+1].Kind == yaml.MappingNode {
+			return root.Content[i+1]
+		}
+	}
+	return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+}
+
+// findOrCreateMapping returns the mapping value for a top-level key,
+// appending an empty mapping if the key is missing
+func findOrCreateMapping(root *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if root.Content[i].Value == key {
+			value := root.Content[i+1]
+			if value.Kind == 0 || (value.Kind == yaml.ScalarNode && value.Value == "") {
+				// An empty section (`dependencies:`) parses as a null scalar
+				*value = yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			}
+			return value
+		}
+	}
+
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	valueNode := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	root.Content = append(root.Content, keyNode, valueNode)
+	return valueNode
+}
+
+// upsertMappingKey replaces the value for a key in a mapping, or appends
+// the pair if the key is not present
+func upsertMappingKey(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	keyNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}
+	mapping.Content = append(mapping.Content, keyNode, value)
+}
+
+// removeMappingKey deletes a key/value pair from a mapping, reporting
+// whether the key was present
+func removeMappingKey(mapping *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// gitDependencyNode builds the `git: {url, ref, path}` mapping for a spec,
+// matching the shape `dart pub add` writes
+func gitDependencyNode(spec PkgSpec) *yaml.Node {
+	git := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	appendScalarPair(git, "url", spec.URL)
+	if spec.Ref != "" {
+		appendScalarPair(git, "ref", spec.Ref)
+	}
+	if spec.Subdir != "" {
+		appendScalarPair(git, "path", spec.Subdir)
+	}
+
+	dep := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	dep.Content = append(dep.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "git"},
+		git)
+	return dep
+}
+
+// appendScalarPair appends a string key/value pair to a mapping node
+func appendScalarPair(mapping *yaml.Node, key, value string) {
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value})
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// editorFixture is a realistic pubspec with comments and deliberate
+// key ordering used by the round-trip tests
+const editorFixture = `name: test_app
+description: A test application.
+# Keep in sync with the CI image
+environment:
+  sdk: ^3.0.0
+
+dependencies:
+  # networking
+  http: ^1.2.0
+  my_git_pkg:
+    git:
+      url: https://github.com/owner/my_git_pkg.git
+      ref: main
+
+dev_dependencies:
+  lints: ^3.0.0
+`
+
+// TestPubspecEditorRoundTrip verifies that an edit preserves comments,
+// untouched entries and key order
+func TestPubspecEditorRoundTrip(t *testing.T) {
+	editor, err := NewPubspecEditorFromBytes([]byte(editorFixture))
+	if err != nil {
+		t.Fatalf("NewPubspecEditorFromBytes failed: %v", err)
+	}
+
+	editor.SetGitDependency(PkgSpec{
+		Name: "new_pkg",
+		URL:  "https://github.com/owner/new_pkg.git",
+		Ref:  "v1.0.0",
+	})
+
+	output, err := editor.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	text := string(output)
+
+	for _, want := range []string{
+		"# Keep in sync with the CI image",
+		"# networking",
+		"http: ^1.2.0",
+		"url: https://github.com/owner/new_pkg.git",
+		"ref: v1.0.0",
+		"lints: ^3.0.0",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("round-trip lost %q:\n%s", want, text)
+		}
+	}
+
+	// Key order: name first, environment before dependencies, dev after
+	nameIdx := strings.Index(text, "name:")
+	envIdx := strings.Index(text, "environment:")
+	depsIdx := strings.Index(text, "dependencies:")
+	devIdx := strings.Index(text, "dev_dependencies:")
+	if !(nameIdx < envIdx && envIdx < depsIdx && depsIdx < devIdx) {
+		t.Errorf("key order not preserved:\n%s", text)
+	}
+}
+
+// TestPubspecEditorRemoveDependency verifies removal deletes only the
+// targeted entry
+func TestPubspecEditorRemoveDependency(t *testing.T) {
+	editor, err := NewPubspecEditorFromBytes([]byte(editorFixture))
+	if err != nil {
+		t.Fatalf("NewPubspecEditorFromBytes failed: %v", err)
+	}
+
+	if !editor.RemoveDependency("my_git_pkg") {
+		t.Fatal("RemoveDependency should report the entry existed")
+	}
+	if editor.RemoveDependency("not_there") {
+		t.Error("RemoveDependency should report a missing entry")
+	}
+
+	output, err := editor.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	text := string(output)
+
+	if strings.Contains(text, "my_git_pkg") {
+		t.Errorf("removed entry still present:\n%s", text)
+	}
+	if !strings.Contains(text, "http: ^1.2.0") {
+		t.Errorf("unrelated entry lost:\n%s", text)
+	}
+}
+
+// TestPubspecEditorOverrides verifies dependency_overrides management,
+// including removal of an emptied section
+func TestPubspecEditorOverrides(t *testing.T) {
+	editor, err := NewPubspecEditorFromBytes([]byte(editorFixture))
+	if err != nil {
+		t.Fatalf("NewPubspecEditorFromBytes failed: %v", err)
+	}
+
+	editor.SetGitOverride(PkgSpec{
+		Name: "http",
+		URL:  "https://github.com/owner/http_fork.git",
+		Ref:  "fix-branch",
+	})
+
+	output, err := editor.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	if !strings.Contains(string(output), "dependency_overrides:") ||
+		!strings.Contains(string(output), "http_fork.git") {
+		t.Errorf("override not written:\n%s", output)
+	}
+
+	if !editor.RemoveOverride("http") {
+		t.Fatal("RemoveOverride should report the entry existed")
+	}
+
+	output, err = editor.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	if strings.Contains(string(output), "dependency_overrides:") {
+		t.Errorf("emptied overrides section not removed:\n%s", output)
+	}
+}
+
+// TestPubspecEditorHostedDependency verifies constraint upserts
+func TestPubspecEditorHostedDependency(t *testing.T) {
+	editor, err := NewPubspecEditorFromBytes([]byte(editorFixture))
+	if err != nil {
+		t.Fatalf("NewPubspecEditorFromBytes failed: %v", err)
+	}
+
+	editor.SetHostedDependency("http", "^2.0.0") // update existing
+	editor.SetHostedDependency("collection", "") // add with no constraint
+
+	output, err := editor.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes failed: %v", err)
+	}
+	text := string(output)
+
+	if !strings.Contains(text, "http: ^2.0.0") || strings.Contains(text, "http: ^1.2.0") {
+		t.Errorf("constraint not updated:\n%s", text)
+	}
+	if !strings.Contains(text, "collection: any") {
+		t.Errorf("missing constraint should default to any:\n%s", text)
+	}
+}
@@ -0,0 +1,180 @@
+// Package core/ratelimit.go - GitHub Rate Limit Tracking and Backoff
+//
+// Heavy gh/REST usage can exhaust the GitHub API rate limit, which used to
+// surface as cryptic command failures mid-flow. This file keeps a global
+// picture of the limit: the remaining budget is refreshed from the
+// rate_limit endpoint, rate-limit errors found in command output mark the
+// client limited until the reset (with exponential backoff when the reset
+// time is unknown), background prefetching throttles itself when the
+// budget runs low, and the main menu shows the state as a status line.
+//
+// Key features:
+// - RefreshGitHubRateLimit: Read remaining/limit/reset via gh api
+// - NoteGitHubRateLimitError: Detect rate-limit wording in failures
+// - IsGitHubRateLimited / GitHubRateLimitMessage: "rate limited until HH:MM"
+// - ThrottleBackgroundGitHubWork: Gate for the cache warmer
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+)
+
+// backgroundThrottleThreshold is the remaining-request floor below which
+// background prefetching stops; interactive operations keep the rest
+const backgroundThrottleThreshold = 100
+
+// rateLimitState is the process-wide rate limit picture
+type rateLimitState struct {
+	mu           sync.RWMutex
+	remaining    int
+	limit        int
+	resetAt      time.Time
+	known        bool      // Whether a refresh has succeeded yet
+	limitedUntil time.Time // Non-zero while backing off
+	backoffCount int       // Consecutive rate-limit errors, drives backoff
+}
+
+var ghRateLimit = &rateLimitState{}
+
+// ghRateLimitJSON models the subset of the rate_limit endpoint we read
+type ghRateLimitJSON struct {
+	Resources struct {
+		Core struct {
+			Limit     int   `json:"limit"`
+			Remaining int   `json:"remaining"`
+			Reset     int64 `json:"reset"`
+		} `json:"core"`
+	} `json:"resources"`
+}
+
+// RefreshGitHubRateLimit queries the rate_limit endpoint (free - it never
+// counts against the limit) and records the current budget. Missing gh or
+// a failed call leaves the previous picture in place.
+func RefreshGitHubRateLimit(logger *Logger) error {
+	if _, err := exec.LookPath("gh"); err != nil {
+		return fmt.Errorf("gh CLI not available")
+	}
+
+	output, err := exec.Command("gh", "api", "rate_limit").Output()
+	if err != nil {
+		return fmt.Errorf("failed to query rate limit: %w", err)
+	}
+
+	var response ghRateLimitJSON
+	if err := json.Unmarshal(output, &response); err != nil {
+		return fmt.Errorf("failed to parse rate limit response: %w", err)
+	}
+
+	core := response.Resources.Core
+	ghRateLimit.mu.Lock()
+	ghRateLimit.remaining = core.Remaining
+	ghRateLimit.limit = core.Limit
+	ghRateLimit.resetAt = time.Unix(core.Reset, 0)
+	ghRateLimit.known = true
+	if core.Remaining > 0 {
+		// Budget restored: clear any backoff from earlier errors
+		ghRateLimit.limitedUntil = time.Time{}
+		ghRateLimit.backoffCount = 0
+	}
+	ghRateLimit.mu.Unlock()
+
+	logger.Debug("ratelimit", fmt.Sprintf("GitHub API budget: %d/%d, resets %s",
+		core.Remaining, core.Limit, time.Unix(core.Reset, 0).Format("15:04")))
+	return nil
+}
+
+// NoteGitHubRateLimitError inspects failed command output for rate-limit
+// wording. When found, the client is marked limited until the known reset
+// time, or with exponential backoff (1m, 2m, 4m ... capped at 1h) when the
+// reset is unknown. Returns whether the output was a rate-limit error.
+func NoteGitHubRateLimitError(output string) bool {
+	lower := strings.ToLower(output)
+	if !strings.Contains(lower, "rate limit") && !strings.Contains(lower, "abuse detection") {
+		return false
+	}
+
+	ghRateLimit.mu.Lock()
+	defer ghRateLimit.mu.Unlock()
+
+	ghRateLimit.backoffCount++
+	if ghRateLimit.known && time.Now().Before(ghRateLimit.resetAt) {
+		ghRateLimit.limitedUntil = ghRateLimit.resetAt
+	} else {
+		backoff := time.Minute << (ghRateLimit.backoffCount - 1)
+		if backoff > time.Hour {
+			backoff = time.Hour
+		}
+		ghRateLimit.limitedUntil = time.Now().Add(backoff)
+	}
+	ghRateLimit.remaining = 0
+	return true
+}
+
+// IsGitHubRateLimited reports whether GitHub calls are currently backing off
+func IsGitHubRateLimited() bool {
+	ghRateLimit.mu.RLock()
+	defer ghRateLimit.mu.RUnlock()
+	return !ghRateLimit.limitedUntil.IsZero() && time.Now().Before(ghRateLimit.limitedUntil)
+}
+
+// GitHubRateLimitMessage returns the user-facing explanation while rate
+// limited ("GitHub rate limited until HH:MM - ..."), or "" otherwise
+func GitHubRateLimitMessage() string {
+	ghRateLimit.mu.RLock()
+	defer ghRateLimit.mu.RUnlock()
+	if ghRateLimit.limitedUntil.IsZero() || time.Now().After(ghRateLimit.limitedUntil) {
+		return ""
+	}
+	return fmt.Sprintf("GitHub rate limited until %s - retry after the limit resets",
+		ghRateLimit.limitedUntil.Format("15:04"))
+}
+
+// GitHubRateLimitLine renders the status line for the main menu: nothing
+// until a refresh has run, the backoff message while limited, and a
+// warning once the budget runs low
+func GitHubRateLimitLine() string {
+	if message := GitHubRateLimitMessage(); message != "" {
+		return "⛔ " + message
+	}
+
+	ghRateLimit.mu.RLock()
+	defer ghRateLimit.mu.RUnlock()
+	if !ghRateLimit.known {
+		return ""
+	}
+	if ghRateLimit.remaining < backgroundThrottleThreshold {
+		return fmt.Sprintf("⚠️ GitHub API: %d/%d requests left (resets %s)",
+			ghRateLimit.remaining, ghRateLimit.limit, ghRateLimit.resetAt.Format("15:04"))
+	}
+	return fmt.Sprintf("GitHub API: %d/%d requests left", ghRateLimit.remaining, ghRateLimit.limit)
+}
+
+// ThrottleBackgroundGitHubWork reports whether background prefetching
+// should skip GitHub calls: always while limited, and whenever the
+// remaining budget is better spent on interactive operations
+func ThrottleBackgroundGitHubWork() bool {
+	if IsGitHubRateLimited() {
+		return true
+	}
+	ghRateLimit.mu.RLock()
+	defer ghRateLimit.mu.RUnlock()
+	return ghRateLimit.known && ghRateLimit.remaining < backgroundThrottleThreshold
+}
+
+// resetRateLimitStateForTest restores the pristine state between tests
+func resetRateLimitStateForTest() {
+	ghRateLimit.mu.Lock()
+	ghRateLimit.remaining = 0
+	ghRateLimit.limit = 0
+	ghRateLimit.resetAt = time.Time{}
+	ghRateLimit.known = false
+	ghRateLimit.limitedUntil = time.Time{}
+	ghRateLimit.backoffCount = 0
+	ghRateLimit.mu.Unlock()
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNoteGitHubRateLimitErrorDetection(t *testing.T) {
+	resetRateLimitStateForTest()
+	defer resetRateLimitStateForTest()
+
+	if NoteGitHubRateLimitError("fatal: repository not found") {
+		t.Error("ordinary failure should not be treated as a rate limit error")
+	}
+	if IsGitHubRateLimited() {
+		t.Error("should not be limited after a non-rate-limit failure")
+	}
+
+	if !NoteGitHubRateLimitError("HTTP 403: API rate limit exceeded for user") {
+		t.Error("rate limit wording should be detected")
+	}
+	if !IsGitHubRateLimited() {
+		t.Error("should be limited after a rate limit error")
+	}
+
+	message := GitHubRateLimitMessage()
+	if !strings.Contains(message, "rate limited until") {
+		t.Errorf("message should say when the limit resets, got %q", message)
+	}
+}
+
+func TestNoteGitHubRateLimitErrorBackoffGrows(t *testing.T) {
+	resetRateLimitStateForTest()
+	defer resetRateLimitStateForTest()
+
+	NoteGitHubRateLimitError("rate limit exceeded")
+	first := ghRateLimit.limitedUntil
+
+	NoteGitHubRateLimitError("rate limit exceeded")
+	second := ghRateLimit.limitedUntil
+
+	if !second.After(first) {
+		t.Errorf("backoff should grow on repeated errors: first %v, second %v", first, second)
+	}
+}
+
+func TestGitHubRateLimitLineStates(t *testing.T) {
+	resetRateLimitStateForTest()
+	defer resetRateLimitStateForTest()
+
+	if line := GitHubRateLimitLine(); line != "" {
+		t.Errorf("line should be empty before any refresh, got %q", line)
+	}
+
+	ghRateLimit.mu.Lock()
+	ghRateLimit.known = true
+	ghRateLimit.remaining = 4200
+	ghRateLimit.limit = 5000
+	ghRateLimit.resetAt = time.Now().Add(30 * time.Minute)
+	ghRateLimit.mu.Unlock()
+
+	if line := GitHubRateLimitLine(); !strings.Contains(line, "4200/5000") || strings.Contains(line, "⚠️") {
+		t.Errorf("healthy budget should render plainly, got %q", line)
+	}
+
+	ghRateLimit.mu.Lock()
+	ghRateLimit.remaining = 42
+	ghRateLimit.mu.Unlock()
+
+	if line := GitHubRateLimitLine(); !strings.Contains(line, "⚠️") || !strings.Contains(line, "resets") {
+		t.Errorf("low budget should warn with the reset time, got %q", line)
+	}
+
+	NoteGitHubRateLimitError("rate limit exceeded")
+	if line := GitHubRateLimitLine(); !strings.Contains(line, "⛔") {
+		t.Errorf("limited state should show the backoff message, got %q", line)
+	}
+}
+
+func TestThrottleBackgroundGitHubWork(t *testing.T) {
+	resetRateLimitStateForTest()
+	defer resetRateLimitStateForTest()
+
+	if ThrottleBackgroundGitHubWork() {
+		t.Error("unknown budget should not throttle background work")
+	}
+
+	ghRateLimit.mu.Lock()
+	ghRateLimit.known = true
+	ghRateLimit.remaining = backgroundThrottleThreshold + 1
+	ghRateLimit.limit = 5000
+	ghRateLimit.mu.Unlock()
+
+	if ThrottleBackgroundGitHubWork() {
+		t.Error("healthy budget should not throttle background work")
+	}
+
+	ghRateLimit.mu.Lock()
+	ghRateLimit.remaining = backgroundThrottleThreshold - 1
+	ghRateLimit.mu.Unlock()
+
+	if !ThrottleBackgroundGitHubWork() {
+		t.Error("low budget should throttle background work")
+	}
+}
@@ -0,0 +1,184 @@
+// Package core/recent.go - Recently Used Projects and Favorites
+//
+// Rescanning directories on every launch just to get back to yesterday's
+// project is wasted time. This file persists a most-recently-used list of
+// projects to ~/.config/flutter-pm/recent.json so the main menu can offer
+// them directly, plus a pin flag for favorites that should never rotate out
+// of the list.
+//
+// Key features:
+// - TouchRecentProject: Record that a project was operated on (upsert)
+// - LoadRecentProjects: Read the list, pinned first then most recent
+// - ToggleRecentPin: Flip a project's favorite flag
+// - Entries whose pubspec.yaml vanished are dropped on load
+// - Unpinned entries rotate out beyond MaxRecentProjects; pins are kept
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// MaxRecentProjects caps how many unpinned entries the MRU list keeps.
+// Pinned projects do not count against the cap.
+const MaxRecentProjects = 5
+
+// RecentProject is one entry in the persisted MRU list
+type RecentProject struct {
+	Path        string    `json:"path"`
+	Name        string    `json:"name,omitempty"`
+	PubspecPath string    `json:"pubspec_path,omitempty"`
+	Pinned      bool      `json:"pinned,omitempty"`
+	LastUsed    time.Time `json:"last_used"`
+}
+
+// recentProjectsPath returns the MRU file path, or "" when the home
+// directory cannot be determined. It lives next to config.yaml rather than
+// in the cache directory, so clearing caches does not forget favorites.
+func recentProjectsPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".config", "flutter-pm", "recent.json")
+}
+
+// LoadRecentProjects reads the persisted list, pinned entries first and the
+// rest by most recent use. Entries whose pubspec.yaml no longer exists are
+// silently dropped. Returns nil when nothing is saved or the file is
+// unreadable - the menu simply shows no recent section.
+func LoadRecentProjects() []RecentProject {
+	path := recentProjectsPath()
+	if path == "" {
+		return nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var entries []RecentProject
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil
+	}
+
+	// Drop entries pointing at projects that no longer exist
+	kept := entries[:0]
+	for _, entry := range entries {
+		pubspecPath := entry.PubspecPath
+		if pubspecPath == "" {
+			pubspecPath = filepath.Join(entry.Path, "pubspec.yaml")
+		}
+		if _, err := os.Stat(pubspecPath); err == nil {
+			kept = append(kept, entry)
+		}
+	}
+
+	sortRecentProjects(kept)
+	return kept
+}
+
+// TouchRecentProject records that a project was operated on, moving it to
+// the top of the MRU list and trimming unpinned entries beyond the cap.
+// Best-effort: persistence failures are returned but safe to ignore.
+func TouchRecentProject(project Project) error {
+	entries := LoadRecentProjects()
+
+	updated := RecentProject{
+		Path:        project.Path,
+		Name:        project.Name,
+		PubspecPath: project.PubspecPath,
+		LastUsed:    time.Now(),
+	}
+
+	// Upsert: keep the pin flag of an existing entry for the same path
+	kept := make([]RecentProject, 0, len(entries)+1)
+	for _, entry := range entries {
+		if entry.Path == project.Path {
+			updated.Pinned = entry.Pinned
+			continue
+		}
+		kept = append(kept, entry)
+	}
+	kept = append(kept, updated)
+	sortRecentProjects(kept)
+
+	// Trim unpinned overflow from the bottom (list is sorted, so the oldest
+	// unpinned entries are last)
+	unpinned := 0
+	trimmed := make([]RecentProject, 0, len(kept))
+	for _, entry := range kept {
+		if !entry.Pinned {
+			unpinned++
+			if unpinned > MaxRecentProjects {
+				continue
+			}
+		}
+		trimmed = append(trimmed, entry)
+	}
+
+	return saveRecentProjects(trimmed)
+}
+
+// ToggleRecentPin flips the favorite flag of the entry for path and reports
+// the new state; false with a nil error means the entry was not found
+func ToggleRecentPin(path string) (bool, error) {
+	entries := LoadRecentProjects()
+	pinned := false
+	found := false
+	for i := range entries {
+		if entries[i].Path == path {
+			entries[i].Pinned = !entries[i].Pinned
+			pinned = entries[i].Pinned
+			found = true
+			break
+		}
+	}
+	if !found {
+		return false, nil
+	}
+	sortRecentProjects(entries)
+	return pinned, saveRecentProjects(entries)
+}
+
+// sortRecentProjects orders pinned entries first, then by most recent use
+func sortRecentProjects(entries []RecentProject) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Pinned != entries[j].Pinned {
+			return entries[i].Pinned
+		}
+		return entries[i].LastUsed.After(entries[j].LastUsed)
+	})
+}
+
+// saveRecentProjects writes the list atomically (write-then-rename), so a
+// crash mid-save never leaves a truncated file behind
+func saveRecentProjects(entries []RecentProject) error {
+	path := recentProjectsPath()
+	if path == "" {
+		return fmt.Errorf("cannot determine config directory for recent projects")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	content, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize recent projects: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write recent projects: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace recent projects: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// makeRecentTestProject creates a real project directory so load-time
+// existence checks keep the entry
+func makeRecentTestProject(t *testing.T, root, name string) Project {
+	t.Helper()
+	dir := filepath.Join(root, name)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	pubspecPath := filepath.Join(dir, "pubspec.yaml")
+	if err := os.WriteFile(pubspecPath, []byte("name: "+name+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return Project{Path: dir, Name: name, PubspecPath: pubspecPath}
+}
+
+// TestRecentProjectsRoundTrip verifies touch, ordering, pinning and the
+// unpinned-entry cap
+func TestRecentProjectsRoundTrip(t *testing.T) {
+	// Redirect os.UserHomeDir so the test never touches the real config
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if got := LoadRecentProjects(); len(got) != 0 {
+		t.Fatalf("expected empty list, got %d entries", len(got))
+	}
+
+	first := makeRecentTestProject(t, home, "first")
+	second := makeRecentTestProject(t, home, "second")
+	if err := TouchRecentProject(first); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(10 * time.Millisecond) // Distinct LastUsed timestamps
+	if err := TouchRecentProject(second); err != nil {
+		t.Fatal(err)
+	}
+
+	entries := LoadRecentProjects()
+	if len(entries) != 2 || entries[0].Name != "second" {
+		t.Fatalf("expected most recent first, got %+v", entries)
+	}
+
+	// Pinning moves an entry to the front and survives a re-touch of others
+	if pinned, err := ToggleRecentPin(first.Path); err != nil || !pinned {
+		t.Fatalf("ToggleRecentPin = (%v, %v), want (true, nil)", pinned, err)
+	}
+	entries = LoadRecentProjects()
+	if entries[0].Name != "first" || !entries[0].Pinned {
+		t.Errorf("expected pinned entry first, got %+v", entries)
+	}
+
+	// The cap drops the oldest unpinned entries but never pinned ones
+	for i := 0; i < MaxRecentProjects+2; i++ {
+		project := makeRecentTestProject(t, home, "filler"+string(rune('a'+i)))
+		if err := TouchRecentProject(project); err != nil {
+			t.Fatal(err)
+		}
+	}
+	entries = LoadRecentProjects()
+	if len(entries) != MaxRecentProjects+1 {
+		t.Errorf("expected %d entries (cap + pinned), got %d", MaxRecentProjects+1, len(entries))
+	}
+	if entries[0].Name != "first" {
+		t.Errorf("pinned entry rotated out: %+v", entries)
+	}
+}
+
+// TestRecentProjectsDropMissing verifies entries for deleted projects are
+// silently removed on load
+func TestRecentProjectsDropMissing(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	project := makeRecentTestProject(t, home, "doomed")
+	if err := TouchRecentProject(project); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(project.Path); err != nil {
+		t.Fatal(err)
+	}
+	if entries := LoadRecentProjects(); len(entries) != 0 {
+		t.Errorf("expected missing project to be dropped, got %+v", entries)
+	}
+}
@@ -0,0 +1,514 @@
+// Package core/reco.go - Smart Recommendations System
+//
+// This file implements the smart recommendations engine that analyzes the
+// packages a user is about to add (or has just added) and surfaces helpful
+// suggestions. The first check implemented here detects git dependencies that
+// are also published on pub.dev: accidentally adding a public package as a
+// git dependency causes needless version conflicts and slow resolution, so
+// we recommend the hosted version with its latest release instead.
+//
+// Key features:
+// - FetchPubDevInfo: Query the pub.dev API for package metadata with caching
+// - CheckPubDevAlternative: Detect when a git dependency matches a hosted package
+// - GeneratePubDevRecommendations: Batch check a list of package specs
+// - GenerateFullRecommendations: Headless project analysis for the reco command
+// - Ref pinning / missing-ref checks, archived upstream detection,
+//   hosted+git duplicate detection and SDK constraint checks
+// - Mechanical findings carry a RecoFixAction so the results screen can
+//   apply them with one key (see recofix.go)
+// - URL normalization so github.com/foo/bar.git matches the published repository
+//
+// Recommendations are returned as core.Reco values and rendered by the
+// results screen, matching the shell script's "smart suggestions" behavior.
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PubDevInfo holds the subset of pub.dev package metadata used for recommendations
+type PubDevInfo struct {
+	Name          string `json:"name"`
+	LatestVersion string `json:"latest_version"`
+	RepositoryURL string `json:"repository_url,omitempty"`
+	HomepageURL   string `json:"homepage_url,omitempty"`
+}
+
+// pubDevResponse models the relevant parts of the pub.dev API response
+// GET https://pub.dev/api/packages/<name>
+type pubDevResponse struct {
+	Name   string `json:"name"`
+	Latest struct {
+		Version string `json:"version"`
+		Pubspec struct {
+			Repository string `json:"repository"`
+			Homepage   string `json:"homepage"`
+		} `json:"pubspec"`
+	} `json:"latest"`
+}
+
+// PubDevCache provides caching for pub.dev API lookups
+type PubDevCache struct {
+	mu    sync.RWMutex
+	cache map[string]*PubDevInfo // package name -> info (nil means "not published")
+	times map[string]time.Time   // package name -> time cached
+	ttl   time.Duration
+}
+
+var (
+	pubDevCache = &PubDevCache{
+		cache: make(map[string]*PubDevInfo),
+		times: make(map[string]time.Time),
+		ttl:   10 * time.Minute, // Published metadata changes rarely
+	}
+)
+
+// Get returns a cached pub.dev lookup if still valid
+// The second return value reports whether a cache entry (including a
+// negative "not published" entry) was found.
+func (c *PubDevCache) Get(packageName string) (*PubDevInfo, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cachedAt, exists := c.times[packageName]
+	if !exists || time.Since(cachedAt) > c.ttl {
+		return nil, false
+	}
+
+	return c.cache[packageName], true
+}
+
+// Set caches a pub.dev lookup result (info may be nil for "not published")
+func (c *PubDevCache) Set(packageName string, info *PubDevInfo) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.cache[packageName] = info
+	c.times[packageName] = time.Now()
+}
+
+// FetchPubDevInfo queries the pub.dev API for package metadata with caching
+// Returns (nil, nil) when the package is not published on pub.dev - that is
+// an expected outcome, not an error.
+func FetchPubDevInfo(logger *Logger, packageName string) (*PubDevInfo, error) {
+	if packageName == "" {
+		return nil, fmt.Errorf("empty package name")
+	}
+
+	// Try cache first (including cached "not published" results)
+	if info, cached := pubDevCache.Get(packageName); cached {
+		logger.Debug("reco", fmt.Sprintf("Using cached pub.dev lookup for %s", packageName))
+		return info, nil
+	}
+
+	url := fmt.Sprintf("https://pub.dev/api/packages/%s", packageName)
+	logger.Debug("reco", fmt.Sprintf("Checking pub.dev: %s", url))
+
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+	}
+
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("pub.dev request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		// Not published - cache the negative result so repeated checks are free
+		pubDevCache.Set(packageName, nil)
+		return nil, nil
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("pub.dev returned HTTP %d for %s", resp.StatusCode, packageName)
+	}
+
+	var parsed pubDevResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return nil, fmt.Errorf("failed to parse pub.dev response: %w", err)
+	}
+
+	info := &PubDevInfo{
+		Name:          parsed.Name,
+		LatestVersion: parsed.Latest.Version,
+		RepositoryURL: parsed.Latest.Pubspec.Repository,
+		HomepageURL:   parsed.Latest.Pubspec.Homepage,
+	}
+
+	pubDevCache.Set(packageName, info)
+	return info, nil
+}
+
+// CheckPubDevAlternative checks whether a git dependency is also published on
+// pub.dev and returns a recommendation suggesting the hosted version if so.
+// Returns nil when no recommendation applies.
+func CheckPubDevAlternative(logger *Logger, spec PkgSpec) *Reco {
+	info, err := FetchPubDevInfo(logger, spec.Name)
+	if err != nil {
+		// Network or API failure - skip silently, recommendations are best-effort
+		logger.Debug("reco", fmt.Sprintf("pub.dev check for %s failed: %v", spec.Name, err))
+		return nil
+	}
+	if info == nil {
+		return nil // Not published on pub.dev
+	}
+
+	// Strong match: the published package points back at the same repository
+	if urlsReferToSameRepo(spec.URL, info.RepositoryURL) || urlsReferToSameRepo(spec.URL, info.HomepageURL) {
+		return &Reco{
+			Message: fmt.Sprintf("Package '%s' is published on pub.dev (latest: %s) - consider 'dart pub add %s' instead of the git dependency",
+				spec.Name, info.LatestVersion, spec.Name),
+			Severity:  "warn",
+			Rationale: "Git dependencies of publicly published packages cause needless version conflicts and slow resolution; the hosted version resolves faster and participates in version solving",
+		}
+	}
+
+	// Weak match: same name exists on pub.dev but the repository differs
+	// (or is not declared) - still worth mentioning as it may shadow the hosted package
+	return &Reco{
+		Message: fmt.Sprintf("A package named '%s' exists on pub.dev (latest: %s) with a different repository - verify you intend to use the git version",
+			spec.Name, info.LatestVersion),
+		Severity:  "info",
+		Rationale: "A git dependency with the same name as a hosted package can conflict with transitive dependencies that require the hosted version",
+	}
+}
+
+// GeneratePubDevRecommendations checks a list of package specs against pub.dev
+// and returns recommendations for any that have a hosted alternative
+func GeneratePubDevRecommendations(logger *Logger, specs []PkgSpec) []Reco {
+	var recos []Reco
+
+	for _, spec := range specs {
+		if reco := CheckPubDevAlternative(logger, spec); reco != nil {
+			recos = append(recos, *reco)
+		}
+	}
+
+	return recos
+}
+
+// GenerateFullRecommendations analyzes a project's git dependencies
+// headlessly and returns every recommendation the results screen would
+// show: pub.dev hosted alternatives, ref pinning and missing-ref checks,
+// archived upstream repositories, hosted/git duplicates and overly broad
+// SDK constraints. This backs the `flutter-pm reco` CLI command so findings
+// can be enforced in CI.
+func GenerateFullRecommendations(logger *Logger, projectPath string) ([]Reco, error) {
+	deps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	recos := GeneratePubDevRecommendations(logger, deps)
+
+	// Unpinned refs make builds unreproducible; when the repository has
+	// releases, name the concrete tag to pin to. A missing ref is worse
+	// than a named branch, so it gets its own warning.
+	recos = append(recos, GeneratePinningRecommendations(logger, deps)...)
+
+	// An archived upstream will never ship fixes again
+	for _, spec := range deps {
+		if reco := checkArchivedRepo(logger, spec); reco != nil {
+			recos = append(recos, *reco)
+		}
+	}
+
+	// Structural checks that only need the pubspec.yaml content
+	if content, err := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml")); err == nil {
+		recos = append(recos, analyzePubspecStructure(content)...)
+	} else {
+		logger.Debug("reco", fmt.Sprintf("pubspec.yaml read failed: %v", err))
+	}
+
+	return recos, nil
+}
+
+// GeneratePinningRecommendations returns the ref pinning and missing-ref
+// findings for the given git dependency specs. When the repository has
+// version tags the finding names the concrete tag and carries a
+// machine-applicable fix (see ApplyRecoFix).
+func GeneratePinningRecommendations(logger *Logger, specs []PkgSpec) []Reco {
+	var recos []Reco
+
+	for _, spec := range specs {
+		reco := checkRefPinning(spec)
+		if spec.Ref == "" {
+			reco = checkMissingRef(spec)
+		}
+		if reco == nil {
+			continue
+		}
+		if tag, err := GitLatestTag(spec.URL); err == nil {
+			ref := spec.Ref
+			if ref == "" {
+				ref = "the default branch"
+			}
+			reco.Message = fmt.Sprintf("Package '%s' follows %s - pin to %s instead", spec.Name, ref, tag)
+			reco.Fix = fmt.Sprintf("flutter-pm update %s --latest-tag", spec.Name)
+			reco.FixAction = &RecoFixAction{
+				Kind:    RecoFixPinRef,
+				Package: spec.Name,
+				Ref:     tag,
+				Label:   fmt.Sprintf("pin %s to %s", spec.Name, tag),
+			}
+		} else {
+			logger.Debug("reco", fmt.Sprintf("tag lookup for %s failed: %v", spec.Name, err))
+		}
+		recos = append(recos, *reco)
+	}
+
+	return recos
+}
+
+// checkMissingRef flags a git dependency declared without any ref field
+func checkMissingRef(spec PkgSpec) *Reco {
+	if spec.Ref != "" {
+		return nil
+	}
+	return &Reco{
+		Message: fmt.Sprintf("Package '%s' has no ref field - it follows whatever the default branch points at",
+			spec.Name),
+		Severity:  "warn",
+		Rationale: "Without a ref every pub get may fetch different code, so CI and teammates can silently build different versions",
+		Fix:       fmt.Sprintf("flutter-pm update %s --latest-tag (or add a ref: line to the dependency)", spec.Name),
+	}
+}
+
+// checkArchivedRepo flags a git dependency whose GitHub repository has been
+// archived. Lookups are best-effort: non-GitHub hosts and API failures
+// produce no finding.
+func checkArchivedRepo(logger *Logger, spec PkgSpec) *Reco {
+	if gitURLHost(spec.URL) != "github.com" {
+		return nil
+	}
+	owner, name := parseGitURLPath(spec.URL)
+	if owner == "" || name == "" {
+		return nil
+	}
+
+	archived, err := githubRepoArchived(owner, name)
+	if err != nil {
+		logger.Debug("reco", fmt.Sprintf("archive check for %s failed: %v", spec.Name, err))
+		return nil
+	}
+	if !archived {
+		return nil
+	}
+
+	return &Reco{
+		Message:   fmt.Sprintf("Package '%s' comes from %s/%s, which is archived on GitHub", spec.Name, owner, name),
+		Severity:  "warn",
+		Rationale: "Archived repositories are read-only; no bug fixes or security patches will ever land upstream",
+		Fix:       "switch the dependency to a maintained fork, or vendor the code if no fork exists",
+	}
+}
+
+// githubRepoArchived reports whether a GitHub repository is archived, using
+// the configured token when one is available (private repos)
+func githubRepoArchived(owner, name string) (bool, error) {
+	client := &http.Client{Timeout: 10 * time.Second}
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://api.github.com/repos/%s/%s", owner, name), nil)
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if token := GitHubToken(); token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("GitHub API returned HTTP %d for %s/%s", resp.StatusCode, owner, name)
+	}
+
+	var parsed struct {
+		Archived bool `json:"archived"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return false, err
+	}
+	return parsed.Archived, nil
+}
+
+// recoPubspecYAML models the pubspec sections used by the structural checks
+type recoPubspecYAML struct {
+	Environment struct {
+		SDK string `yaml:"sdk"`
+	} `yaml:"environment"`
+	Dependencies        map[string]interface{} `yaml:"dependencies"`
+	DevDependencies     map[string]interface{} `yaml:"dev_dependencies"`
+	DependencyOverrides map[string]interface{} `yaml:"dependency_overrides"`
+}
+
+// analyzePubspecStructure runs the checks that only need the pubspec.yaml
+// content: packages declared both hosted and git, and overly broad SDK
+// constraints
+func analyzePubspecStructure(content []byte) []Reco {
+	var parsed recoPubspecYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+
+	recos := checkHostedGitDuplicates(parsed)
+	if reco := checkSDKConstraint(parsed.Environment.SDK); reco != nil {
+		recos = append(recos, *reco)
+	}
+	return recos
+}
+
+// dependencySource classifies one dependency entry as hosted, git or path
+func dependencySource(dep interface{}) string {
+	depMap, ok := dep.(map[string]interface{})
+	if !ok {
+		return "hosted" // Version string (or empty, meaning "any")
+	}
+	if _, hasGit := depMap["git"]; hasGit {
+		return "git"
+	}
+	if _, hasPath := depMap["path"]; hasPath {
+		return "path"
+	}
+	return "hosted"
+}
+
+// checkHostedGitDuplicates flags packages declared with a hosted source in
+// one pubspec section and a git source in another (most commonly a hosted
+// dependency shadowed by a git entry in dependency_overrides)
+func checkHostedGitDuplicates(parsed recoPubspecYAML) []Reco {
+	sections := []struct {
+		name string
+		deps map[string]interface{}
+	}{
+		{"dependencies", parsed.Dependencies},
+		{"dev_dependencies", parsed.DevDependencies},
+		{"dependency_overrides", parsed.DependencyOverrides},
+	}
+
+	type declaration struct {
+		section string
+		source  string
+	}
+	first := make(map[string]declaration)
+
+	var recos []Reco
+	for _, section := range sections {
+		// Sort names so findings come out in a stable order
+		names := make([]string, 0, len(section.deps))
+		for name := range section.deps {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			source := dependencySource(section.deps[name])
+			if source == "path" {
+				continue // Local path deps are a deliberate dev setup
+			}
+			prev, seen := first[name]
+			if !seen {
+				first[name] = declaration{section: section.name, source: source}
+				continue
+			}
+			if prev.source != source {
+				reco := Reco{
+					Message: fmt.Sprintf("Package '%s' is %s in %s but %s in %s",
+						name, prev.source, prev.section, source, section.name),
+					Severity:  "warn",
+					Rationale: "Two sources for the same package mean the one actually used is easy to misread, and the git entry silently shadows hosted releases",
+					Fix:       fmt.Sprintf("keep a single source for %s - remove the %s entry once it is no longer needed", name, section.name),
+				}
+				// Dropping an override entry is mechanical, so offer it as
+				// a one-key fix; pruning other sections stays manual
+				if section.name == "dependency_overrides" {
+					reco.FixAction = &RecoFixAction{
+						Kind:    RecoFixRemoveOverride,
+						Package: name,
+						Label:   fmt.Sprintf("remove the dependency_overrides entry for %s", name),
+					}
+				}
+				recos = append(recos, reco)
+			}
+		}
+	}
+	return recos
+}
+
+// checkSDKConstraint flags an environment.sdk constraint with no effective
+// upper bound ("any", or a bare >= with no < and no caret)
+func checkSDKConstraint(sdk string) *Reco {
+	sdk = strings.TrimSpace(sdk)
+	if sdk == "" {
+		return nil
+	}
+	broad := sdk == "any" || (!strings.Contains(sdk, "<") && !strings.HasPrefix(sdk, "^"))
+	if !broad {
+		return nil
+	}
+	return &Reco{
+		Message:   fmt.Sprintf("SDK constraint %q has no upper bound", sdk),
+		Severity:  "warn",
+		Rationale: "Without an upper bound the project claims to work on future SDK majors with breaking changes it has never been tested against",
+		Fix:       "use a caret constraint in the environment section, e.g. sdk: ^3.0.0",
+	}
+}
+
+// checkRefPinning recommends pinning a git dependency that follows a moving
+// branch (or the default branch) instead of a tag or commit SHA
+func checkRefPinning(spec PkgSpec) *Reco {
+	if refIsPinned(spec.Ref) {
+		return nil
+	}
+
+	ref := spec.Ref
+	if ref == "" {
+		ref = "the default branch"
+	}
+	return &Reco{
+		Message: fmt.Sprintf("Package '%s' follows %s - consider pinning to a release tag or commit SHA",
+			spec.Name, ref),
+		Severity:  "info",
+		Rationale: "Branch refs move, so two checkouts of the same pubspec.yaml can resolve different code; a tag or SHA makes builds reproducible",
+	}
+}
+
+// urlsReferToSameRepo compares two repository URLs ignoring scheme, .git
+// suffix, trailing slashes and case, so that e.g.
+// "https://github.com/owner/repo.git" matches "https://github.com/owner/repo"
+func urlsReferToSameRepo(a, b string) bool {
+	na := normalizeRepoURL(a)
+	nb := normalizeRepoURL(b)
+	if na == "" || nb == "" {
+		return false
+	}
+	return na == nb
+}
+
+// normalizeRepoURL strips the scheme, .git suffix and trailing slashes from a
+// repository URL and lowercases it for comparison
+func normalizeRepoURL(url string) string {
+	url = strings.TrimSpace(strings.ToLower(url))
+	url = strings.TrimPrefix(url, "https://")
+	url = strings.TrimPrefix(url, "http://")
+	url = strings.TrimPrefix(url, "git@")
+	url = strings.Replace(url, "github.com:", "github.com/", 1) // SSH form
+	url = strings.TrimSuffix(url, "/")
+	url = strings.TrimSuffix(url, ".git")
+	return url
+}
@@ -0,0 +1,102 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestCheckRefPinning verifies the local pinning recommendation
+func TestCheckRefPinning(t *testing.T) {
+	// Pinned refs produce no recommendation
+	for _, ref := range []string{"v1.2.3", "1.2.3", "a1b2c3d4e5f6a1b2c3d4e5f6a1b2c3d4e5f6a1b2"} {
+		spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/repo.git", Ref: ref}
+		if reco := checkRefPinning(spec); reco != nil {
+			t.Errorf("expected no recommendation for pinned ref %q, got %v", ref, reco)
+		}
+	}
+
+	// Branch refs are flagged
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/repo.git", Ref: "develop"}
+	reco := checkRefPinning(spec)
+	if reco == nil {
+		t.Fatal("expected a recommendation for branch ref")
+	}
+	if reco.Severity != "info" || !strings.Contains(reco.Message, "develop") {
+		t.Errorf("unexpected recommendation: %+v", reco)
+	}
+
+	// Empty ref means the default branch
+	spec.Ref = ""
+	reco = checkRefPinning(spec)
+	if reco == nil || !strings.Contains(reco.Message, "the default branch") {
+		t.Errorf("expected default-branch recommendation, got %v", reco)
+	}
+}
+
+// TestCheckMissingRef verifies the dedicated missing-ref warning
+func TestCheckMissingRef(t *testing.T) {
+	spec := PkgSpec{Name: "my_pkg", URL: "https://github.com/owner/repo.git"}
+	reco := checkMissingRef(spec)
+	if reco == nil || reco.Severity != "warn" || reco.Fix == "" {
+		t.Errorf("expected a warn with a fix for a missing ref, got %+v", reco)
+	}
+
+	spec.Ref = "develop"
+	if reco := checkMissingRef(spec); reco != nil {
+		t.Errorf("expected no missing-ref finding when a ref is set, got %+v", reco)
+	}
+}
+
+// TestCheckSDKConstraint verifies the broad-constraint detection
+func TestCheckSDKConstraint(t *testing.T) {
+	for _, sdk := range []string{"^3.0.0", ">=2.12.0 <4.0.0", ""} {
+		if reco := checkSDKConstraint(sdk); reco != nil {
+			t.Errorf("expected no finding for constraint %q, got %+v", sdk, reco)
+		}
+	}
+
+	for _, sdk := range []string{"any", ">=2.0.0"} {
+		reco := checkSDKConstraint(sdk)
+		if reco == nil || reco.Severity != "warn" {
+			t.Errorf("expected a warn for constraint %q, got %+v", sdk, reco)
+		}
+	}
+}
+
+// TestAnalyzePubspecStructure verifies hosted/git duplicate detection
+func TestAnalyzePubspecStructure(t *testing.T) {
+	pubspec := []byte(`
+name: demo
+environment:
+  sdk: ^3.0.0
+dependencies:
+  dio: ^5.0.0
+  local_pkg:
+    path: ../local_pkg
+dependency_overrides:
+  dio:
+    git:
+      url: https://github.com/owner/dio.git
+  local_pkg:
+    path: ../local_pkg_fork
+`)
+
+	recos := analyzePubspecStructure(pubspec)
+	if len(recos) != 1 {
+		t.Fatalf("expected exactly one finding, got %d: %+v", len(recos), recos)
+	}
+	if !strings.Contains(recos[0].Message, "dio") || recos[0].Severity != "warn" {
+		t.Errorf("unexpected duplicate finding: %+v", recos[0])
+	}
+	// The override entry can be dropped mechanically, so the finding
+	// carries an applicable fix
+	if recos[0].FixAction == nil || recos[0].FixAction.Kind != RecoFixRemoveOverride || recos[0].FixAction.Package != "dio" {
+		t.Errorf("expected a remove_override fix action for dio, got %+v", recos[0].FixAction)
+	}
+
+	// A consistent pubspec produces no findings
+	clean := []byte("name: demo\nenvironment:\n  sdk: ^3.0.0\ndependencies:\n  dio: ^5.0.0\n")
+	if recos := analyzePubspecStructure(clean); len(recos) != 0 {
+		t.Errorf("expected no findings for a clean pubspec, got %+v", recos)
+	}
+}
@@ -0,0 +1,43 @@
+// Package core/recofix.go - Applying Recommendation Fixes
+//
+// This file turns recommendations from advice into one-key actions. Analyzers
+// that can resolve their finding mechanically attach a RecoFixAction to the
+// Reco (see types.go); the results screen lets the user pick those and the
+// execution screen applies them here. Every fix delegates to an existing
+// operation that already creates a tracked backup - re-pinning goes through
+// UpdateSingleDependency and override removal through
+// RemoveDependencyOverride - so `flutter-pm rollback` undoes an applied fix
+// like any other change.
+//
+// Key features:
+// - ApplyRecoFix: Dispatch one fix action to the operation implementing it
+
+package core
+
+import "fmt"
+
+// Fix action kinds understood by ApplyRecoFix
+const (
+	RecoFixPinRef         = "pin_ref"         // Re-pin a git dependency to a concrete ref
+	RecoFixRemoveOverride = "remove_override" // Drop a dependency_overrides entry
+)
+
+// ApplyRecoFix applies one machine-applicable recommendation fix to the
+// project. The underlying operations create their own tracked backups and
+// run pub resolution, so the returned ActionResult reads like any other
+// pubspec-modifying operation.
+func ApplyRecoFix(logger *Logger, cfg *Config, projectPath string, action RecoFixAction) ActionResult {
+	logger.Narrate(fmt.Sprintf("Applying fix: %s", action.Label))
+
+	switch action.Kind {
+	case RecoFixPinRef:
+		return UpdateSingleDependency(logger, cfg, projectPath, action.Package, action.Ref, false)
+	case RecoFixRemoveOverride:
+		return RemoveDependencyOverride(logger, cfg, projectPath, action.Package)
+	default:
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("unknown fix kind %q for %s", action.Kind, action.Package),
+		}
+	}
+}
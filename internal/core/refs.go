@@ -0,0 +1,77 @@
+// Package core/refs.go - Remote Ref Enumeration for Ref Switching
+//
+// This file lists the branches and tags a git dependency's repository
+// offers, so the TUI can present a picker when re-pinning a dependency to a
+// different ref. Refs are resolved via a single `git ls-remote --heads
+// --tags` call - no clone is needed - and the actual switch goes through
+// UpdateSingleDependency, which keeps the usual backup and pub pipeline.
+//
+// Key features:
+// - ListRemoteRefs: Branches and tags of a repository via git ls-remote
+// - Tags sorted newest-first by version shape, branches alphabetically
+
+package core
+
+import (
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// RemoteRefs holds the selectable refs of a repository
+type RemoteRefs struct {
+	Branches []string `json:"branches"`
+	Tags     []string `json:"tags"`
+}
+
+// ListRemoteRefs enumerates a repository's branches and tags
+func ListRemoteRefs(url string) (*RemoteRefs, error) {
+	cmd := exec.Command("git", "ls-remote", "--heads", "--tags", url)
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git ls-remote failed for %s: %w", url, err)
+	}
+	return parseLsRemoteRefs(string(output)), nil
+}
+
+// parseLsRemoteRefs parses `git ls-remote --heads --tags` output into
+// branches and tags. Peeled tag entries (ref^{}) are skipped - the
+// annotated tag name itself is what a pubspec ref uses. Branches come back
+// alphabetical; tags version-shaped-first, newest first, so the likely
+// target is at the top of a picker.
+func parseLsRemoteRefs(output string) *RemoteRefs {
+	refs := &RemoteRefs{}
+	for _, line := range strings.Split(output, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		ref := fields[1]
+		if strings.HasSuffix(ref, "^{}") {
+			continue
+		}
+		switch {
+		case strings.HasPrefix(ref, "refs/heads/"):
+			refs.Branches = append(refs.Branches, strings.TrimPrefix(ref, "refs/heads/"))
+		case strings.HasPrefix(ref, "refs/tags/"):
+			refs.Tags = append(refs.Tags, strings.TrimPrefix(ref, "refs/tags/"))
+		}
+	}
+
+	sort.Strings(refs.Branches)
+	sort.Slice(refs.Tags, func(i, j int) bool {
+		a, b := refs.Tags[i], refs.Tags[j]
+		aVersion := versionTagParts(a) != nil
+		bVersion := versionTagParts(b) != nil
+		if aVersion != bVersion {
+			return aVersion // Version-shaped tags before everything else
+		}
+		if aVersion {
+			return compareVersionTags(a, b) > 0 // Newest release first
+		}
+		return a < b
+	})
+
+	return refs
+}
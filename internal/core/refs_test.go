@@ -0,0 +1,36 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestParseLsRemoteRefs verifies branch/tag separation, peeled-entry
+// filtering and ordering
+func TestParseLsRemoteRefs(t *testing.T) {
+	output := `a1b2c3d	refs/heads/main
+d4e5f6a	refs/heads/develop
+1111111	refs/tags/v1.0.0
+2222222	refs/tags/v1.0.0^{}
+3333333	refs/tags/v2.1.0
+4444444	refs/tags/nightly
+`
+
+	refs := parseLsRemoteRefs(output)
+
+	if !reflect.DeepEqual(refs.Branches, []string{"develop", "main"}) {
+		t.Errorf("branches wrong: %v", refs.Branches)
+	}
+	// Version tags newest-first, non-version tags after
+	if !reflect.DeepEqual(refs.Tags, []string{"v2.1.0", "v1.0.0", "nightly"}) {
+		t.Errorf("tags wrong: %v", refs.Tags)
+	}
+}
+
+// TestParseLsRemoteRefsEmpty verifies empty output yields empty slices
+func TestParseLsRemoteRefsEmpty(t *testing.T) {
+	refs := parseLsRemoteRefs("")
+	if len(refs.Branches) != 0 || len(refs.Tags) != 0 {
+		t.Errorf("expected no refs, got %+v", refs)
+	}
+}
@@ -0,0 +1,114 @@
+// Package core/relnotes.go - GitHub Release Notes for Tag Upgrades
+//
+// This file fetches the release notes published between two tags of a
+// GitHub-hosted dependency, so a tag-to-tag upgrade can show what actually
+// changed instead of a bare version pair. Releases are listed through the
+// gh CLI (works for private repositories when authenticated), filtered to
+// the (current, target] version range, and returned newest first for the
+// confirmation pane.
+//
+// Key features:
+// - FetchReleaseNotesBetween: Releases published after fromTag up to toTag
+// - Version-shaped tags are range-filtered; odd tags fall back to the
+//   target release only
+// - Drafts are skipped; prereleases inside the range are kept
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"sort"
+	"strings"
+)
+
+// ReleaseNotes is one GitHub release relevant to an upgrade
+type ReleaseNotes struct {
+	Tag  string // The release's tag name
+	Name string // Release title (falls back to the tag when empty)
+	Body string // Markdown release notes
+}
+
+// ghReleaseJSON models the subset of the GitHub releases API we read
+type ghReleaseJSON struct {
+	TagName    string `json:"tag_name"`
+	Name       string `json:"name"`
+	Body       string `json:"body"`
+	Draft      bool   `json:"draft"`
+	Prerelease bool   `json:"prerelease"`
+}
+
+// FetchReleaseNotesBetween returns the releases published after fromTag up
+// to and including toTag, newest first. When either tag is not
+// version-shaped the range cannot be ordered, so only the target tag's
+// release is returned. Repositories without releases return an empty list.
+func FetchReleaseNotesBetween(logger *Logger, gitURL, fromTag, toTag string) ([]ReleaseNotes, error) {
+	ownerRepo := githubOwnerRepo(gitURL)
+	if ownerRepo == "" {
+		return nil, fmt.Errorf("release notes are only available for GitHub repositories")
+	}
+	if _, err := exec.LookPath("gh"); err != nil {
+		return nil, fmt.Errorf("gh CLI is required to fetch release notes")
+	}
+
+	endpoint := fmt.Sprintf("repos/%s/releases?per_page=100", ownerRepo)
+	logger.Debug("relnotes", fmt.Sprintf("Running gh api %s", endpoint))
+
+	cmd := exec.Command("gh", "api", endpoint)
+	output, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("gh api releases failed: %s", strings.TrimSpace(string(exitErr.Stderr)))
+		}
+		return nil, fmt.Errorf("failed to run gh api: %w", err)
+	}
+
+	var releases []ghReleaseJSON
+	if err := json.Unmarshal(output, &releases); err != nil {
+		return nil, fmt.Errorf("failed to parse releases response: %w", err)
+	}
+
+	return selectReleaseRange(releases, fromTag, toTag), nil
+}
+
+// selectReleaseRange filters releases to the (fromTag, toTag] version range
+// and orders them newest first. Non-version tags degrade to the exact
+// target release.
+func selectReleaseRange(releases []ghReleaseJSON, fromTag, toTag string) []ReleaseNotes {
+	ranged := versionTagParts(fromTag) != nil && versionTagParts(toTag) != nil
+
+	var notes []ReleaseNotes
+	for _, release := range releases {
+		if release.Draft {
+			continue
+		}
+		if ranged {
+			if versionTagParts(release.TagName) == nil {
+				continue
+			}
+			if compareVersionTags(release.TagName, fromTag) <= 0 || compareVersionTags(release.TagName, toTag) > 0 {
+				continue
+			}
+		} else if release.TagName != toTag {
+			continue
+		}
+
+		name := release.Name
+		if name == "" {
+			name = release.TagName
+		}
+		notes = append(notes, ReleaseNotes{
+			Tag:  release.TagName,
+			Name: name,
+			Body: strings.TrimSpace(release.Body),
+		})
+	}
+
+	if ranged {
+		sort.Slice(notes, func(i, j int) bool {
+			return compareVersionTags(notes[i].Tag, notes[j].Tag) > 0
+		})
+	}
+	return notes
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"testing"
+)
+
+func releaseFixtures() []ghReleaseJSON {
+	return []ghReleaseJSON{
+		{TagName: "v2.1.0", Name: "Two point one", Body: "## Added\n- feature"},
+		{TagName: "v2.0.0", Name: "", Body: "Breaking changes"},
+		{TagName: "v2.0.0-rc.1", Name: "RC", Body: "rc notes", Prerelease: true},
+		{TagName: "v1.9.0", Name: "Old", Body: "old notes"},
+		{TagName: "v3.0.0", Name: "Draft", Body: "unreleased", Draft: true},
+		{TagName: "nightly", Name: "Nightly", Body: "rolling"},
+	}
+}
+
+func TestSelectReleaseRange(t *testing.T) {
+	notes := selectReleaseRange(releaseFixtures(), "v1.9.0", "v2.1.0")
+
+	if len(notes) != 3 {
+		t.Fatalf("expected 3 releases in range, got %v", notes)
+	}
+	// Newest first
+	if notes[0].Tag != "v2.1.0" || notes[1].Tag != "v2.0.0" || notes[2].Tag != "v2.0.0-rc.1" {
+		t.Errorf("unexpected order: %v", notes)
+	}
+	// An empty release name falls back to the tag
+	if notes[1].Name != "v2.0.0" {
+		t.Errorf("empty name should fall back to tag, got %q", notes[1].Name)
+	}
+}
+
+func TestSelectReleaseRangeSkipsDraftsAndOutOfRange(t *testing.T) {
+	notes := selectReleaseRange(releaseFixtures(), "v2.0.0", "v2.1.0")
+	for _, note := range notes {
+		if note.Tag == "v3.0.0" || note.Tag == "v1.9.0" || note.Tag == "v2.0.0" || note.Tag == "nightly" {
+			t.Errorf("release %s should have been excluded", note.Tag)
+		}
+	}
+	if len(notes) != 1 || notes[0].Tag != "v2.1.0" {
+		t.Errorf("expected only v2.1.0, got %v", notes)
+	}
+}
+
+func TestSelectReleaseRangeNonVersionTags(t *testing.T) {
+	// Unorderable tags degrade to the exact target release
+	notes := selectReleaseRange(releaseFixtures(), "nightly", "v2.1.0")
+	if len(notes) != 1 || notes[0].Tag != "v2.1.0" {
+		t.Errorf("expected exactly the target release, got %v", notes)
+	}
+}
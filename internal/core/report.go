@@ -0,0 +1,219 @@
+// Package core/report.go - Run Report Export (Markdown/HTML)
+//
+// A run's story - which packages were picked, which commands ran, what
+// failed and how long it took - lives scattered across the session log and
+// the results screen, and none of it is in a shape that can be attached to
+// a PR or a ticket. This file assembles that story into a RunReport and
+// renders it as markdown or HTML: the results screen exports the current
+// run directly, and `flutter-pm report [--last]` rebuilds a report from a
+// past session log (see core/sessionlog.go).
+//
+// Key features:
+// - BuildRunReportFromLog: Reconstruct commands, errors and timings from a
+//   session log file
+// - RunReport.Markdown / RunReport.HTML: Render the shareable report
+// - RunReport.WriteFile: Save the report with a timestamped filename
+
+package core
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// RunReport holds everything worth sharing about one run. Packages and
+// Results are only available when exporting right after a run; reports
+// rebuilt from a session log carry commands, errors and timings.
+type RunReport struct {
+	GeneratedAt time.Time
+	Source      string // Session log the commands and timings came from
+	Start       time.Time
+	End         time.Time
+
+	Packages []PkgSpec      // Packages selected in this run (optional)
+	Results  []ActionResult // Per-step outcomes (optional)
+	Commands []string       // Executed command lines, with timestamps
+	Errors   []string       // Error-level log messages
+}
+
+// BuildRunReportFromLog reconstructs a report from a session log file:
+// every executed command line (see Logger.LogCommand), every error entry,
+// and the first/last entry times as the run's span
+func BuildRunReportFromLog(path string) (*RunReport, error) {
+	entries, err := ReadSessionLog(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("session log %s is empty", path)
+	}
+
+	report := &RunReport{
+		GeneratedAt: time.Now(),
+		Source:      path,
+		Start:       entries[0].Time,
+		End:         entries[len(entries)-1].Time,
+	}
+	for _, entry := range entries {
+		if cmd, ok := strings.CutPrefix(entry.Message, "executing: "); ok {
+			report.Commands = append(report.Commands, fmt.Sprintf("%s  %s", entry.Time.Format("15:04:05"), cmd))
+			continue
+		}
+		if entry.Level == "error" {
+			message := entry.Message
+			if entry.Error != "" {
+				message = entry.Error
+			}
+			report.Errors = append(report.Errors, message)
+		}
+	}
+	return report, nil
+}
+
+// Duration returns the run's wall-clock span, rounded to the second
+func (r *RunReport) Duration() time.Duration {
+	if r.Start.IsZero() || r.End.IsZero() {
+		return 0
+	}
+	return r.End.Sub(r.Start).Round(time.Second)
+}
+
+// Markdown renders the report as a markdown document suitable for pasting
+// into a PR description or ticket
+func (r *RunReport) Markdown() string {
+	var b strings.Builder
+
+	b.WriteString("# flutter-pm run report\n\n")
+	b.WriteString(fmt.Sprintf("Generated: %s\n", r.GeneratedAt.Format("2006-01-02 15:04:05")))
+	if d := r.Duration(); d > 0 {
+		b.WriteString(fmt.Sprintf("Run: %s - %s (%s)\n", r.Start.Format("15:04:05"), r.End.Format("15:04:05"), d))
+	}
+	if r.Source != "" {
+		b.WriteString(fmt.Sprintf("Session log: `%s`\n", r.Source))
+	}
+
+	if len(r.Packages) > 0 {
+		b.WriteString("\n## Packages\n\n")
+		for _, spec := range r.Packages {
+			line := fmt.Sprintf("- **%s** — %s @ %s", spec.Name, spec.URL, spec.Ref)
+			if spec.Subdir != "" {
+				line += fmt.Sprintf(" (subdir %s)", spec.Subdir)
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+
+	if len(r.Results) > 0 {
+		b.WriteString("\n## Results\n\n")
+		for _, result := range r.Results {
+			if result.OK {
+				b.WriteString(fmt.Sprintf("- ✅ %s\n", result.Message))
+			} else {
+				b.WriteString(fmt.Sprintf("- ❌ %s\n", result.Err))
+			}
+		}
+	}
+
+	if len(r.Commands) > 0 {
+		b.WriteString("\n## Commands executed\n\n```\n")
+		b.WriteString(strings.Join(r.Commands, "\n"))
+		b.WriteString("\n```\n")
+	}
+
+	if len(r.Errors) > 0 {
+		b.WriteString("\n## Errors\n\n")
+		for _, message := range r.Errors {
+			b.WriteString(fmt.Sprintf("- %s\n", message))
+		}
+	}
+
+	return b.String()
+}
+
+// HTML renders the report as a standalone HTML page with the same sections
+// as the markdown form
+func (r *RunReport) HTML() string {
+	var b strings.Builder
+
+	b.WriteString("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n")
+	b.WriteString("<title>flutter-pm run report</title>\n")
+	b.WriteString("<style>body{font-family:sans-serif;max-width:48em;margin:2em auto}pre{background:#f4f4f4;padding:1em;overflow-x:auto}</style>\n")
+	b.WriteString("</head>\n<body>\n<h1>flutter-pm run report</h1>\n")
+
+	b.WriteString(fmt.Sprintf("<p>Generated: %s", r.GeneratedAt.Format("2006-01-02 15:04:05")))
+	if d := r.Duration(); d > 0 {
+		b.WriteString(fmt.Sprintf("<br>Run: %s &ndash; %s (%s)", r.Start.Format("15:04:05"), r.End.Format("15:04:05"), d))
+	}
+	if r.Source != "" {
+		b.WriteString(fmt.Sprintf("<br>Session log: <code>%s</code>", html.EscapeString(r.Source)))
+	}
+	b.WriteString("</p>\n")
+
+	if len(r.Packages) > 0 {
+		b.WriteString("<h2>Packages</h2>\n<ul>\n")
+		for _, spec := range r.Packages {
+			line := fmt.Sprintf("<strong>%s</strong> — %s @ %s",
+				html.EscapeString(spec.Name), html.EscapeString(spec.URL), html.EscapeString(spec.Ref))
+			if spec.Subdir != "" {
+				line += fmt.Sprintf(" (subdir %s)", html.EscapeString(spec.Subdir))
+			}
+			b.WriteString("<li>" + line + "</li>\n")
+		}
+		b.WriteString("</ul>\n")
+	}
+
+	if len(r.Results) > 0 {
+		b.WriteString("<h2>Results</h2>\n<ul>\n")
+		for _, result := range r.Results {
+			if result.OK {
+				b.WriteString("<li>✅ " + html.EscapeString(result.Message) + "</li>\n")
+			} else {
+				b.WriteString("<li>❌ " + html.EscapeString(result.Err) + "</li>\n")
+			}
+		}
+		b.WriteString("</ul>\n")
+	}
+
+	if len(r.Commands) > 0 {
+		b.WriteString("<h2>Commands executed</h2>\n<pre>")
+		b.WriteString(html.EscapeString(strings.Join(r.Commands, "\n")))
+		b.WriteString("</pre>\n")
+	}
+
+	if len(r.Errors) > 0 {
+		b.WriteString("<h2>Errors</h2>\n<ul>\n")
+		for _, message := range r.Errors {
+			b.WriteString("<li>" + html.EscapeString(message) + "</li>\n")
+		}
+		b.WriteString("</ul>\n")
+	}
+
+	b.WriteString("</body>\n</html>\n")
+	return b.String()
+}
+
+// WriteFile saves the report with a timestamped name in dir (the current
+// directory when empty) and returns the written path. format is "html" or
+// anything else for markdown.
+func (r *RunReport) WriteFile(dir, format string) (string, error) {
+	if dir == "" {
+		dir = "."
+	}
+
+	ext := ".md"
+	content := r.Markdown()
+	if format == "html" {
+		ext = ".html"
+		content = r.HTML()
+	}
+
+	path := filepath.Join(dir, "flutter-pm-report-"+r.GeneratedAt.Format("20060102-150405")+ext)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write report: %w", err)
+	}
+	return path, nil
+}
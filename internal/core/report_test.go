@@ -0,0 +1,92 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeReportTestLog(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "session.jsonl")
+	lines := []string{
+		`{"time":"2026-08-31T10:00:00Z","level":"info","component":"git","message":"executing: git clone https://github.com/owner/repo.git"}`,
+		`{"time":"2026-08-31T10:00:05Z","level":"info","component":"pub","message":"executing: dart pub get"}`,
+		`{"time":"2026-08-31T10:00:09Z","level":"error","component":"pub","message":"pub get failed","error":"exit status 65"}`,
+	}
+	if err := os.WriteFile(path, []byte(strings.Join(lines, "\n")+"\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestBuildRunReportFromLog(t *testing.T) {
+	report, err := BuildRunReportFromLog(writeReportTestLog(t))
+	if err != nil {
+		t.Fatalf("BuildRunReportFromLog failed: %v", err)
+	}
+
+	if len(report.Commands) != 2 {
+		t.Errorf("expected 2 commands, got %v", report.Commands)
+	}
+	if !strings.Contains(report.Commands[0], "git clone") {
+		t.Errorf("first command should be the clone, got %q", report.Commands[0])
+	}
+	if len(report.Errors) != 1 || report.Errors[0] != "exit status 65" {
+		t.Errorf("expected the error entry's error field, got %v", report.Errors)
+	}
+	if report.Duration() != 9*time.Second {
+		t.Errorf("expected 9s duration, got %s", report.Duration())
+	}
+}
+
+func TestRunReportMarkdownAndHTML(t *testing.T) {
+	report := &RunReport{
+		GeneratedAt: time.Now(),
+		Packages:    []PkgSpec{{Name: "my_pkg", URL: "https://github.com/owner/repo.git", Ref: "main"}},
+		Results:     []ActionResult{{OK: true, Message: "Added my_pkg"}, {OK: false, Err: "conflict <here>"}},
+		Commands:    []string{"10:00:00  git clone"},
+	}
+
+	md := report.Markdown()
+	for _, want := range []string{"## Packages", "**my_pkg**", "✅ Added my_pkg", "❌ conflict <here>", "## Commands executed"} {
+		if !strings.Contains(md, want) {
+			t.Errorf("markdown missing %q:\n%s", want, md)
+		}
+	}
+
+	page := report.HTML()
+	if !strings.Contains(page, "conflict &lt;here&gt;") {
+		t.Errorf("HTML should escape result text:\n%s", page)
+	}
+	if !strings.Contains(page, "<pre>") {
+		t.Error("HTML should render commands in a pre block")
+	}
+}
+
+func TestRunReportWriteFile(t *testing.T) {
+	report := &RunReport{GeneratedAt: time.Now(), Commands: []string{"10:00:00  git clone"}}
+	dir := t.TempDir()
+
+	path, err := report.WriteFile(dir, "html")
+	if err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	if !strings.HasSuffix(path, ".html") {
+		t.Errorf("expected an .html file, got %s", path)
+	}
+
+	path, err = report.WriteFile(dir, "markdown")
+	if err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(string(content), "# flutter-pm run report") {
+		t.Errorf("markdown report has unexpected content: %s", content)
+	}
+}
@@ -0,0 +1,123 @@
+// Package core/retry.go - Retry with Backoff for Transient Failures
+//
+// Clones and ls-remote calls fail on flaky networks, and a single dropped
+// connection used to abort the whole run. This file adds a small retry
+// policy for idempotent git/network operations: failures whose output
+// matches known transient wording (DNS, resets, timeouts, 5xx) are retried
+// with a doubling delay, while permanent failures (auth, missing refs)
+// still fail immediately. Attempts and the initial backoff are
+// configurable via FLUTTER_PM_RETRY_ATTEMPTS / FLUTTER_PM_RETRY_BACKOFF or
+// the matching flags; each retry is logged and reported through the
+// progress bus so the execution screen shows the retry status live.
+//
+// Key features:
+// - RetryPolicy / RetryPolicyFromConfig: configurable attempts and backoff
+// - isTransientNetworkError: classify failures worth retrying
+// - retryTransient: run an operation under the policy with per-attempt
+//   logging and progress reporting
+
+package core
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	defaultRetryAttempts = 3
+	defaultRetryBackoff  = 2 * time.Second
+)
+
+// transientErrorMarkers are lowercase fragments of git/network failure
+// output that indicate a temporary condition worth retrying. Permanent
+// failures (bad credentials, unknown refs, existing directories) match
+// none of these and fail on the first attempt.
+var transientErrorMarkers = []string{
+	"could not resolve host",
+	"connection timed out",
+	"connection reset",
+	"connection refused",
+	"network is unreachable",
+	"operation timed out",
+	"temporary failure",
+	"early eof",
+	"the remote end hung up",
+	"tls handshake timeout",
+	"502 bad gateway",
+	"503 service unavailable",
+	"504 gateway timeout",
+}
+
+// isTransientNetworkError reports whether failure output looks like a
+// temporary network condition rather than a permanent error
+func isTransientNetworkError(output string) bool {
+	lower := strings.ToLower(output)
+	for _, marker := range transientErrorMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryPolicy controls how often an idempotent operation is attempted and
+// how long to wait between attempts (the delay doubles after each retry)
+type RetryPolicy struct {
+	Attempts int           // Total attempts, including the first
+	Backoff  time.Duration // Delay before the first retry
+}
+
+// DefaultRetryPolicy returns the built-in policy used when no config is
+// available (3 attempts, 2s initial backoff)
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{Attempts: defaultRetryAttempts, Backoff: defaultRetryBackoff}
+}
+
+// RetryPolicyFromConfig builds the policy from the config, falling back to
+// the defaults for unset values. Attempts of 1 disables retrying.
+func RetryPolicyFromConfig(cfg *Config) RetryPolicy {
+	policy := DefaultRetryPolicy()
+	if cfg != nil {
+		if cfg.RetryAttempts > 0 {
+			policy.Attempts = cfg.RetryAttempts
+		}
+		if cfg.RetryBackoff > 0 {
+			policy.Backoff = cfg.RetryBackoff
+		}
+	}
+	return policy
+}
+
+// retryTransient runs op under the policy. op creates and runs the command
+// fresh each attempt and returns its output alongside the error; the
+// output of a failed attempt decides whether the failure is transient.
+// Retries are logged (logger may be nil) and reported on the progress bus
+// under stage, so the execution screen shows "Retrying ..." live.
+func retryTransient(logger *Logger, policy RetryPolicy, stage, label string, op func() (string, error)) (string, error) {
+	if policy.Attempts < 1 {
+		policy.Attempts = 1
+	}
+
+	delay := policy.Backoff
+	var output string
+	var err error
+	for attempt := 1; attempt <= policy.Attempts; attempt++ {
+		output, err = op()
+		if err == nil {
+			return output, nil
+		}
+		if attempt == policy.Attempts || !isTransientNetworkError(output+" "+err.Error()) {
+			return output, err
+		}
+
+		if logger != nil {
+			logger.Debug("retry", fmt.Sprintf("%s failed (attempt %d/%d), retrying in %s: %v",
+				label, attempt, policy.Attempts, delay, err))
+		}
+		reportProgress(stage, -1, fmt.Sprintf("Retrying %s (attempt %d/%d)", label, attempt+1, policy.Attempts))
+		time.Sleep(delay)
+		delay *= 2
+	}
+	return output, err
+}
@@ -0,0 +1,88 @@
+package core
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestIsTransientNetworkError(t *testing.T) {
+	tests := []struct {
+		output    string
+		transient bool
+	}{
+		{"fatal: unable to access 'https://...': Could not resolve host: github.com", true},
+		{"fatal: the remote end hung up unexpectedly", true},
+		{"error: RPC failed; HTTP 503 Service Unavailable", true},
+		{"ssh: connect to host github.com port 22: Connection timed out", true},
+		{"fatal: Authentication failed for 'https://github.com/owner/repo.git'", false},
+		{"fatal: destination path 'dir' already exists", false},
+		{"fatal: couldn't find remote ref nosuchbranch", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		if got := isTransientNetworkError(tt.output); got != tt.transient {
+			t.Errorf("isTransientNetworkError(%q) = %v, want %v", tt.output, got, tt.transient)
+		}
+	}
+}
+
+func TestRetryTransientRetriesUntilSuccess(t *testing.T) {
+	policy := RetryPolicy{Attempts: 3, Backoff: time.Millisecond}
+	attempts := 0
+	output, err := retryTransient(nil, policy, "test", "flaky op", func() (string, error) {
+		attempts++
+		if attempts < 3 {
+			return "could not resolve host", errors.New("exit status 128")
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("expected eventual success, got %v", err)
+	}
+	if attempts != 3 || output != "ok" {
+		t.Errorf("expected 3 attempts with output ok, got %d attempts, output %q", attempts, output)
+	}
+}
+
+func TestRetryTransientStopsOnPermanentFailure(t *testing.T) {
+	policy := RetryPolicy{Attempts: 3, Backoff: time.Millisecond}
+	attempts := 0
+	_, err := retryTransient(nil, policy, "test", "broken op", func() (string, error) {
+		attempts++
+		return "fatal: Authentication failed", errors.New("exit status 128")
+	})
+	if err == nil {
+		t.Fatal("expected the permanent failure to propagate")
+	}
+	if attempts != 1 {
+		t.Errorf("permanent failures should not be retried, got %d attempts", attempts)
+	}
+}
+
+func TestRetryTransientExhaustsAttempts(t *testing.T) {
+	policy := RetryPolicy{Attempts: 2, Backoff: time.Millisecond}
+	attempts := 0
+	_, err := retryTransient(nil, policy, "test", "dead network", func() (string, error) {
+		attempts++
+		return "connection reset by peer", errors.New("exit status 128")
+	})
+	if err == nil {
+		t.Fatal("expected failure after exhausting attempts")
+	}
+	if attempts != 2 {
+		t.Errorf("expected exactly 2 attempts, got %d", attempts)
+	}
+}
+
+func TestRetryPolicyFromConfig(t *testing.T) {
+	policy := RetryPolicyFromConfig(&Config{})
+	if policy.Attempts != defaultRetryAttempts || policy.Backoff != defaultRetryBackoff {
+		t.Errorf("unset config should use defaults, got %+v", policy)
+	}
+
+	policy = RetryPolicyFromConfig(&Config{RetryAttempts: 5, RetryBackoff: time.Second})
+	if policy.Attempts != 5 || policy.Backoff != time.Second {
+		t.Errorf("config values should be honored, got %+v", policy)
+	}
+}
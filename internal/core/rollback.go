@@ -0,0 +1,215 @@
+// Package core/rollback.go - Undo / Rollback Manager for pubspec Modifications
+//
+// CreateBackup produces timestamped pubspec.yaml copies but nothing restored
+// them. This file adds the journal that makes those backups undoable: every
+// tracked backup is recorded in .flutter-pm-backups.json next to
+// pubspec.yaml together with the operation, a process-wide session ID and a
+// timestamp. The journal powers `flutter-pm rollback [--last|--list]` and
+// the results screen's "Undo last change" action.
+//
+// Key features:
+// - CreateTrackedBackup: CreateBackup plus a journal entry
+// - ListRollbackEntries: journal entries with line diff summaries
+// - RollbackLast: restore the most recent backup and re-run pub get
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// RollbackJournalName is the per-project journal file, stored next to
+// pubspec.yaml like the backups themselves
+const RollbackJournalName = ".flutter-pm-backups.json"
+
+// rollbackSessionID groups all backups created by this process run
+var rollbackSessionID = fmt.Sprintf("%s-%d", time.Now().Format("20060102150405"), os.Getpid())
+
+// RollbackEntry is one journaled backup
+type RollbackEntry struct {
+	SessionID   string    `json:"session_id"`
+	Operation   string    `json:"operation"` // "add", "remove" or "update"
+	Timestamp   time.Time `json:"timestamp"`
+	BackupPath  string    `json:"backup_path"`
+	DiffSummary string    `json:"diff_summary,omitempty"` // Backup vs current pubspec.yaml
+}
+
+// CreateTrackedBackup creates a pubspec.yaml backup and records it in the
+// rollback journal. Journal failures are logged but never block the
+// operation the backup protects.
+func CreateTrackedBackup(logger *Logger, projectPath, operation string) (BackupInfo, error) {
+	backup, err := CreateBackup(projectPath)
+	if err != nil {
+		return backup, err
+	}
+
+	entry := RollbackEntry{
+		SessionID:  rollbackSessionID,
+		Operation:  operation,
+		Timestamp:  backup.Timestamp,
+		BackupPath: backup.BackupPath,
+	}
+
+	if err := appendRollbackEntry(projectPath, entry); err != nil && logger != nil {
+		logger.Debug("rollback", fmt.Sprintf("Failed to journal backup: %s", err))
+	}
+
+	return backup, nil
+}
+
+// ListRollbackEntries returns the journal newest-last, with each entry's
+// diff summary computed against the current pubspec.yaml
+func ListRollbackEntries(projectPath string) ([]RollbackEntry, error) {
+	entries, err := loadRollbackJournal(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	current, _ := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml"))
+	for i := range entries {
+		backupContent, err := os.ReadFile(entries[i].BackupPath)
+		if err != nil {
+			entries[i].DiffSummary = "backup file missing"
+			continue
+		}
+		entries[i].DiffSummary = diffSummary(backupContent, current)
+	}
+
+	return entries, nil
+}
+
+// RollbackLast restores pubspec.yaml from the most recent journaled backup
+// and re-runs pub get. The restore is the primary action: a pub get failure
+// afterwards is reported in the logs but does not undo the restore.
+func RollbackLast(logger *Logger, cfg *Config, projectPath string) ActionResult {
+	entries, err := loadRollbackJournal(projectPath)
+	if err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+	if len(entries) == 0 {
+		return ActionResult{OK: false, Err: "nothing to roll back: no journaled backups found"}
+	}
+
+	last := entries[len(entries)-1]
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would restore pubspec.yaml from %s (%s, %s)", last.BackupPath, last.Operation, last.Timestamp.Format("2006-01-02 15:04:05")),
+		}
+	}
+
+	if err := RestoreBackup(projectPath, last.BackupPath); err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+
+	// The consumed entry leaves the journal even if pub get fails below
+	if err := saveRollbackJournal(projectPath, entries[:len(entries)-1]); err != nil && logger != nil {
+		logger.Debug("rollback", fmt.Sprintf("Failed to update journal: %s", err))
+	}
+
+	logs := []string{fmt.Sprintf("Restored pubspec.yaml from %s", last.BackupPath)}
+	message := fmt.Sprintf("Undid %s from %s", last.Operation, last.Timestamp.Format("2006-01-02 15:04:05"))
+
+	syncResult := Sync(logger, cfg, projectPath)
+	logs = append(logs, syncResult.Logs...)
+	if !syncResult.OK {
+		logs = append(logs, fmt.Sprintf("Warning: pub get after restore failed: %s", syncResult.Err))
+		message += " (pub get failed, run it manually)"
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: message,
+		Logs:    logs,
+		Data: map[string]interface{}{
+			"operation":   last.Operation,
+			"backup_path": last.BackupPath,
+			"session_id":  last.SessionID,
+		},
+	}
+}
+
+// appendRollbackEntry adds one entry to the project's journal
+func appendRollbackEntry(projectPath string, entry RollbackEntry) error {
+	entries, err := loadRollbackJournal(projectPath)
+	if err != nil {
+		return err
+	}
+	return saveRollbackJournal(projectPath, append(entries, entry))
+}
+
+// loadRollbackJournal reads the journal; a missing file is an empty journal
+func loadRollbackJournal(projectPath string) ([]RollbackEntry, error) {
+	journalPath := filepath.Join(projectPath, RollbackJournalName)
+	content, err := os.ReadFile(journalPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rollback journal: %w", err)
+	}
+
+	var entries []RollbackEntry
+	if err := json.Unmarshal(content, &entries); err != nil {
+		return nil, fmt.Errorf("failed to parse rollback journal: %w", err)
+	}
+	return entries, nil
+}
+
+// saveRollbackJournal writes the journal back to disk
+func saveRollbackJournal(projectPath string, entries []RollbackEntry) error {
+	content, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode rollback journal: %w", err)
+	}
+
+	journalPath := filepath.Join(projectPath, RollbackJournalName)
+	if err := os.WriteFile(journalPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write rollback journal: %w", err)
+	}
+	return nil
+}
+
+// diffSummary produces a compact "+N/-M lines" summary between a backup and
+// the current file, good enough to tell at a glance how big an undo would be
+func diffSummary(backup, current []byte) string {
+	backupLines := lineSet(string(backup))
+	currentLines := lineSet(string(current))
+
+	added := 0
+	for line, count := range currentLines {
+		if count > backupLines[line] {
+			added += count - backupLines[line]
+		}
+	}
+
+	removed := 0
+	for line, count := range backupLines {
+		if count > currentLines[line] {
+			removed += count - currentLines[line]
+		}
+	}
+
+	if added == 0 && removed == 0 {
+		return "no changes since backup"
+	}
+	return fmt.Sprintf("undo removes %d line(s), restores %d line(s)", added, removed)
+}
+
+// lineSet counts occurrences of each non-blank line
+func lineSet(content string) map[string]int {
+	counts := make(map[string]int)
+	for _, line := range strings.Split(content, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		counts[line]++
+	}
+	return counts
+}
@@ -0,0 +1,107 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestCreateTrackedBackupJournals verifies that tracked backups land in the
+// rollback journal with operation and session metadata
+func TestCreateTrackedBackupJournals(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, "name: test_app\ndependencies:\n  http: ^1.0.0\n")
+
+	backup, err := CreateTrackedBackup(nil, projectPath, "add")
+	if err != nil {
+		t.Fatalf("CreateTrackedBackup failed: %v", err)
+	}
+
+	entries, err := ListRollbackEntries(projectPath)
+	if err != nil {
+		t.Fatalf("ListRollbackEntries failed: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("journal has %d entries, want 1", len(entries))
+	}
+
+	entry := entries[0]
+	if entry.Operation != "add" {
+		t.Errorf("operation = %q, want add", entry.Operation)
+	}
+	if entry.BackupPath != backup.BackupPath {
+		t.Errorf("backup path = %q, want %q", entry.BackupPath, backup.BackupPath)
+	}
+	if entry.SessionID == "" {
+		t.Error("session ID is empty")
+	}
+	if entry.DiffSummary != "no changes since backup" {
+		t.Errorf("diff summary = %q, want no changes", entry.DiffSummary)
+	}
+}
+
+// TestRollbackLastRestoresPubspec verifies the undo path: modify
+// pubspec.yaml after a tracked backup, roll back, and check the original
+// content is back and the journal entry is consumed
+func TestRollbackLastRestoresPubspec(t *testing.T) {
+	projectPath := t.TempDir()
+	original := "name: test_app\ndependencies:\n  http: ^1.0.0\n"
+	writePubspecFixture(t, projectPath, original)
+
+	if _, err := CreateTrackedBackup(nil, projectPath, "add"); err != nil {
+		t.Fatalf("CreateTrackedBackup failed: %v", err)
+	}
+
+	// Simulate the operation the backup protects
+	modified := original + "  extra_pkg: ^2.0.0\n"
+	writePubspecFixture(t, projectPath, modified)
+
+	// Diff summary must now reflect the pending undo
+	entries, err := ListRollbackEntries(projectPath)
+	if err != nil || len(entries) != 1 {
+		t.Fatalf("ListRollbackEntries = %v entries, err %v", len(entries), err)
+	}
+	if !strings.Contains(entries[0].DiffSummary, "removes 1 line") {
+		t.Errorf("diff summary = %q, want a 1-line removal", entries[0].DiffSummary)
+	}
+
+	cfg := &Config{Quiet: true}
+	logger := NewLogger(cfg)
+	result := RollbackLast(logger, cfg, projectPath)
+	if !result.OK {
+		t.Fatalf("RollbackLast failed: %s", result.Err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		t.Fatalf("failed to read restored pubspec: %v", err)
+	}
+	if string(content) != original {
+		t.Errorf("restored pubspec = %q, want original content", string(content))
+	}
+
+	// The consumed entry must be gone
+	entries, err = ListRollbackEntries(projectPath)
+	if err != nil {
+		t.Fatalf("ListRollbackEntries after rollback failed: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("journal has %d entries after rollback, want 0", len(entries))
+	}
+}
+
+// TestRollbackLastEmptyJournal verifies the error when nothing is undoable
+func TestRollbackLastEmptyJournal(t *testing.T) {
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, "name: test_app\n")
+
+	cfg := &Config{Quiet: true}
+	result := RollbackLast(NewLogger(cfg), cfg, projectPath)
+	if result.OK {
+		t.Error("RollbackLast succeeded with an empty journal")
+	}
+	if !strings.Contains(result.Err, "nothing to roll back") {
+		t.Errorf("error = %q, want nothing-to-roll-back", result.Err)
+	}
+}
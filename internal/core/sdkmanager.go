@@ -0,0 +1,273 @@
+// Package core/sdkmanager.go - Flutter SDK Channel/Version Manager
+//
+// This file implements the Flutter SDK manager behind `flutter-pm sdk`: it
+// lists the SDKs installed on the machine (the global flutter, FVM's cache
+// and flutter-pm's own managed directory), downloads a specific version or
+// channel, and switches which SDK the current project uses. Switching writes
+// the project's FVM pin (.fvm/version + .fvm/fvm_config.json), so the pub
+// routing from core/fvm.go picks the SDK up immediately. Downloads prefer
+// `fvm install` when FVM is available and fall back to a shallow git clone
+// of the Flutter repository at the requested tag or channel - git CLI
+// fidelity, same as every other clone in this tool.
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// flutterRepoURL is the upstream Flutter repository used for managed installs
+const flutterRepoURL = "https://github.com/flutter/flutter.git"
+
+// InstalledSDK is one Flutter SDK found on the machine
+type InstalledSDK struct {
+	Version string `json:"version"`          // Version or channel name (e.g. "3.19.0", "stable")
+	Path    string `json:"path"`             // SDK root directory; "" for the global PATH install
+	Source  string `json:"source"`           // "global", "fvm" or "managed"
+	Active  bool   `json:"active,omitempty"` // True when the current project pins this SDK
+}
+
+// managedSDKDir is where flutter-pm keeps SDKs it downloaded itself
+func managedSDKDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".flutter-pm", "sdks"), nil
+}
+
+// fvmVersionsDir is FVM's SDK cache directory
+func fvmVersionsDir() (string, error) {
+	if cachePath := os.Getenv("FVM_CACHE_PATH"); cachePath != "" {
+		return filepath.Join(cachePath, "versions"), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+	return filepath.Join(homeDir, "fvm", "versions"), nil
+}
+
+// ListInstalledSDKs returns every Flutter SDK found on the machine: the
+// global one on PATH, FVM's cache and the managed directory. The project's
+// pinned version (when any) is marked active.
+func ListInstalledSDKs(logger *Logger, projectPath string) []InstalledSDK {
+	var sdks []InstalledSDK
+
+	pinned := ""
+	if info := DetectFVM(projectPath); info != nil {
+		pinned = info.Version
+	}
+
+	// Global install on PATH
+	if flutterPath, err := exec.LookPath("flutter"); err == nil {
+		sdk := InstalledSDK{Version: "system", Path: filepath.Dir(filepath.Dir(flutterPath)), Source: "global"}
+		if output, err := exec.Command("flutter", "--version", "--machine").Output(); err == nil {
+			if version := jsonField(string(output), "frameworkVersion"); version != "" {
+				sdk.Version = version
+			}
+		}
+		sdk.Active = pinned == ""
+		sdks = append(sdks, sdk)
+	}
+
+	// FVM cache and flutter-pm's managed directory hold one SDK per directory
+	for _, source := range []struct {
+		name string
+		dir  func() (string, error)
+	}{
+		{"fvm", fvmVersionsDir},
+		{"managed", managedSDKDir},
+	} {
+		dir, err := source.dir()
+		if err != nil {
+			logger.Debug("sdk", fmt.Sprintf("Skipping %s SDKs: %v", source.name, err))
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() {
+				continue
+			}
+			sdks = append(sdks, InstalledSDK{
+				Version: entry.Name(),
+				Path:    filepath.Join(dir, entry.Name()),
+				Source:  source.name,
+				Active:  entry.Name() == pinned,
+			})
+		}
+	}
+
+	sort.Slice(sdks, func(i, j int) bool {
+		if sdks[i].Source != sdks[j].Source {
+			return sdks[i].Source < sdks[j].Source
+		}
+		return sdks[i].Version < sdks[j].Version
+	})
+	return sdks
+}
+
+// jsonField extracts a top-level string field from flat JSON output without
+// committing to the full schema of `flutter --version --machine`
+func jsonField(content, field string) string {
+	marker := fmt.Sprintf("%q:", field)
+	idx := strings.Index(content, marker)
+	if idx < 0 {
+		return ""
+	}
+	rest := content[idx+len(marker):]
+	start := strings.IndexByte(rest, '"')
+	if start < 0 {
+		return ""
+	}
+	end := strings.IndexByte(rest[start+1:], '"')
+	if end < 0 {
+		return ""
+	}
+	return rest[start+1 : start+1+end]
+}
+
+// InstallSDK downloads a Flutter SDK version or channel. With FVM installed
+// the download goes through `fvm install` into FVM's cache; otherwise the
+// Flutter repository is shallow-cloned at the requested tag or channel into
+// the managed directory.
+func InstallSDK(logger *Logger, cfg *Config, version string) ActionResult {
+	if version == "" {
+		return ActionResult{OK: false, Err: "no SDK version specified"}
+	}
+
+	if _, err := exec.LookPath("fvm"); err == nil {
+		return installSDKViaFVM(logger, cfg, version)
+	}
+	return installSDKViaClone(logger, cfg, version)
+}
+
+// installSDKViaFVM delegates the download to `fvm install`
+func installSDKViaFVM(logger *Logger, cfg *Config, version string) ActionResult {
+	args := []string{"install", version}
+	logger.LogCommand("sdk", "fvm", args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: fvm install %s", version),
+			Logs:    []string{fmt.Sprintf("DRY RUN: fvm install %s", version)},
+		}
+	}
+
+	output, err := exec.Command("fvm", args...).CombinedOutput()
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("fvm install %s failed: %v", version, err),
+			Logs: strings.Split(strings.TrimSpace(string(output)), "\n"),
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Installed Flutter %s via FVM", version),
+		Data:    map[string]interface{}{"version": version, "source": "fvm"},
+	}
+}
+
+// installSDKViaClone shallow-clones the Flutter repository at a tag or
+// channel into the managed directory and verifies bin/flutter exists
+func installSDKViaClone(logger *Logger, cfg *Config, version string) ActionResult {
+	baseDir, err := managedSDKDir()
+	if err != nil {
+		return ActionResult{OK: false, Err: err.Error()}
+	}
+	targetDir := filepath.Join(baseDir, version)
+
+	if _, err := os.Stat(filepath.Join(targetDir, "bin", "flutter")); err == nil {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Flutter %s is already installed at %s", version, targetDir),
+			Data:    map[string]interface{}{"version": version, "path": targetDir, "source": "managed"},
+		}
+	}
+
+	args := []string{"clone", "--depth", "1", "--branch", version, flutterRepoURL, targetDir}
+	logger.LogCommand("sdk", "git", args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would clone Flutter %s into %s", version, targetDir),
+			Logs:    []string{fmt.Sprintf("DRY RUN: git %s", strings.Join(args, " "))},
+		}
+	}
+
+	if err := os.MkdirAll(baseDir, 0755); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to create SDK directory: %v", err)}
+	}
+
+	output, err := exec.Command("git", args...).CombinedOutput()
+	if err != nil {
+		os.RemoveAll(targetDir) // A half-finished clone would shadow future installs
+		return ActionResult{
+			OK:   false,
+			Err:  fmt.Sprintf("failed to clone Flutter %s: %v", version, err),
+			Logs: strings.Split(strings.TrimSpace(string(output)), "\n"),
+		}
+	}
+
+	if _, err := os.Stat(filepath.Join(targetDir, "bin", "flutter")); err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("clone succeeded but %s has no bin/flutter - not a Flutter SDK checkout", targetDir),
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Installed Flutter %s at %s", version, targetDir),
+		Data:    map[string]interface{}{"version": version, "path": targetDir, "source": "managed"},
+	}
+}
+
+// UseSDKForProject pins the project to an SDK version by writing the FVM
+// marker files (.fvm/version and .fvm/fvm_config.json). From the next pub
+// command on, FindPubToolFor routes through the pinned SDK.
+func UseSDKForProject(logger *Logger, cfg *Config, projectPath, version string) ActionResult {
+	if version == "" {
+		return ActionResult{OK: false, Err: "no SDK version specified"}
+	}
+
+	fvmDir := filepath.Join(projectPath, ".fvm")
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would pin project to Flutter %s (%s)", version, fvmDir),
+			Logs:    []string{fmt.Sprintf("DRY RUN: write %s", filepath.Join(fvmDir, "version"))},
+		}
+	}
+
+	if err := os.MkdirAll(fvmDir, 0755); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to create .fvm directory: %v", err)}
+	}
+	if err := os.WriteFile(filepath.Join(fvmDir, "version"), []byte(version+"\n"), 0644); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to write SDK pin: %v", err)}
+	}
+	config := fmt.Sprintf("{\n  \"flutterSdkVersion\": \"%s\"\n}\n", version)
+	if err := os.WriteFile(filepath.Join(fvmDir, "fvm_config.json"), []byte(config), 0644); err != nil {
+		return ActionResult{OK: false, Err: fmt.Sprintf("failed to write fvm_config.json: %v", err)}
+	}
+
+	logger.Info("sdk", fmt.Sprintf("Pinned %s to Flutter %s", projectPath, version))
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Project pinned to Flutter %s - pub commands now run through it", version),
+		Data:    map[string]interface{}{"version": version, "projectPath": projectPath},
+	}
+}
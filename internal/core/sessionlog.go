@@ -0,0 +1,174 @@
+// Package core/sessionlog.go - Per-Session Structured Log Files
+//
+// Console output disappears when the TUI exits, which makes failed installs
+// hard to reconstruct after the fact. This file writes every log entry of a
+// run - including debug entries suppressed on the console and every executed
+// command line - to a JSON Lines file under ~/.cache/flutter-pm/logs/
+// (overridable with --log-file or FLUTTER_PM_LOG_FILE). The `logs` CLI
+// command and the log viewer screen read these files back for post-mortem
+// debugging.
+//
+// Key features:
+// - OpenSessionLog: Create the per-session .jsonl file (timestamped default)
+// - SessionLog.Write: Append one structured entry; a nil log discards safely
+// - ListSessionLogs / LatestSessionLog: Locate past sessions, newest first
+// - ReadSessionLog: Parse a session file back into entries
+
+package core
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+// SessionLog appends structured log entries to a per-session file. Like
+// *Transcript, a nil *SessionLog is valid and discards everything, so the
+// logger never needs to guard on whether session logging is active.
+type SessionLog struct {
+	mu   sync.Mutex
+	w    io.WriteCloser
+	path string
+}
+
+// SessionLogEntry is one line of a session log file: a regular log entry
+// plus the time it was written
+type SessionLogEntry struct {
+	Time time.Time `json:"time"`
+	LogEntry
+}
+
+// SessionLogDir returns the directory holding session log files
+// (~/.cache/flutter-pm/logs on Linux, the platform cache dir elsewhere)
+func SessionLogDir() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "flutter-pm", "logs")
+}
+
+// OpenSessionLog opens (or creates) a session log file in append mode. An
+// empty path uses a timestamped file in SessionLogDir.
+func OpenSessionLog(path string) (*SessionLog, error) {
+	if path == "" {
+		dir := SessionLogDir()
+		if dir == "" {
+			return nil, fmt.Errorf("cannot determine cache directory for session logs")
+		}
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("failed to create session log directory: %w", err)
+		}
+		path = filepath.Join(dir, time.Now().Format("20060102_150405")+".jsonl")
+	} else if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create session log directory: %w", err)
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session log file: %w", err)
+	}
+	return &SessionLog{w: file, path: path}, nil
+}
+
+// Path returns the session log file path, or "" for a nil log
+func (s *SessionLog) Path() string {
+	if s == nil {
+		return ""
+	}
+	return s.path
+}
+
+// Write appends one timestamped entry as a JSON line
+func (s *SessionLog) Write(entry LogEntry) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// Best-effort: a full disk must not take down the operation being logged
+	_ = json.NewEncoder(s.w).Encode(SessionLogEntry{Time: time.Now(), LogEntry: entry})
+}
+
+// Close closes the underlying file
+func (s *SessionLog) Close() {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.w.Close()
+}
+
+// ListSessionLogs returns the paths of all session log files, newest first
+func ListSessionLogs() ([]string, error) {
+	dir := SessionLogDir()
+	if dir == "" {
+		return nil, fmt.Errorf("cannot determine cache directory for session logs")
+	}
+
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session log directory: %w", err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if !entry.IsDir() && filepath.Ext(entry.Name()) == ".jsonl" {
+			paths = append(paths, filepath.Join(dir, entry.Name()))
+		}
+	}
+
+	// The timestamped names sort chronologically, so reverse-sorting by
+	// name puts the newest session first
+	sort.Sort(sort.Reverse(sort.StringSlice(paths)))
+	return paths, nil
+}
+
+// LatestSessionLog returns the path of the most recent session log file
+func LatestSessionLog() (string, error) {
+	paths, err := ListSessionLogs()
+	if err != nil {
+		return "", err
+	}
+	if len(paths) == 0 {
+		return "", fmt.Errorf("no session logs found in %s", SessionLogDir())
+	}
+	return paths[0], nil
+}
+
+// ReadSessionLog parses a session log file back into entries. Unparseable
+// lines are skipped so a truncated final line doesn't hide the rest of a
+// crashed session.
+func ReadSessionLog(path string) ([]SessionLogEntry, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open session log: %w", err)
+	}
+	defer file.Close()
+
+	var entries []SessionLogEntry
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry SessionLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read session log: %w", err)
+	}
+	return entries, nil
+}
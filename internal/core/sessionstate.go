@@ -0,0 +1,123 @@
+// Package core/sessionstate.go - Mid-Flow Session State Persistence
+//
+// A crash or an accidental quit in the middle of the add-dependency flow
+// used to throw away every selection the user had made. This file persists
+// a snapshot of the shared TUI state to ~/.cache/flutter-pm/session.json
+// at each screen transition, so the next launch can offer "Resume previous
+// session" and drop back into the screen the user left. The screen field is
+// an opaque id owned by the TUI; this file only stores and validates the
+// snapshot around it.
+//
+// Key features:
+// - SaveSessionState: Atomically write the current snapshot
+// - LoadSessionState: Read a resumable snapshot back (nil when none exists)
+// - ClearSessionState: Drop the snapshot once the flow finished or resumed
+// - Stale snapshots (older than SessionStateMaxAge) are ignored and removed
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// SessionStateMaxAge is how long a saved session stays resumable. Selections
+// older than this likely no longer match the repositories and projects on
+// disk, so they are silently discarded.
+const SessionStateMaxAge = 7 * 24 * time.Hour
+
+// SessionState is the serializable snapshot of the shared TUI state taken
+// at a screen transition
+type SessionState struct {
+	SavedAt time.Time `json:"saved_at"`
+	Screen  int       `json:"screen"` // Opaque screen id owned by the TUI
+
+	// Source project selections
+	SourceProject     *Project       `json:"source_project,omitempty"`
+	SourceRepo        *RepoCandidate `json:"source_repo,omitempty"`
+	SourceProjectPath string         `json:"source_project_path,omitempty"`
+	TemplateMode      bool           `json:"template_mode,omitempty"`
+
+	// Repository and dependency selections
+	AvailableSourceRepos  []RepoCandidate `json:"available_source_repos,omitempty"`
+	AvailableDependencies []RepoCandidate `json:"available_dependencies,omitempty"`
+	SelectedDependencies  []RepoCandidate `json:"selected_dependencies,omitempty"`
+
+	// Configured package specifications awaiting execution
+	PackageSpecs []PkgSpec `json:"package_specs,omitempty"`
+}
+
+// sessionStatePath returns the snapshot file path, or "" when the cache
+// directory cannot be determined
+func sessionStatePath() string {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(cacheDir, "flutter-pm", "session.json")
+}
+
+// SaveSessionState writes the snapshot atomically (write-then-rename), so a
+// crash mid-save never leaves a truncated file behind
+func SaveSessionState(state SessionState) error {
+	path := sessionStatePath()
+	if path == "" {
+		return fmt.Errorf("cannot determine cache directory for session state")
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to create session state directory: %w", err)
+	}
+
+	state.SavedAt = time.Now()
+	content, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize session state: %w", err)
+	}
+
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, content, 0644); err != nil {
+		return fmt.Errorf("failed to write session state: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace session state: %w", err)
+	}
+	return nil
+}
+
+// LoadSessionState reads the saved snapshot. It returns nil (without error)
+// when no snapshot exists or the saved one has gone stale.
+func LoadSessionState() (*SessionState, error) {
+	path := sessionStatePath()
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read session state: %w", err)
+	}
+
+	var state SessionState
+	if err := json.Unmarshal(content, &state); err != nil {
+		return nil, fmt.Errorf("session state file is corrupt: %w", err)
+	}
+
+	if time.Since(state.SavedAt) > SessionStateMaxAge {
+		ClearSessionState()
+		return nil, nil
+	}
+	return &state, nil
+}
+
+// ClearSessionState removes the snapshot; a missing file is not an error
+func ClearSessionState() {
+	if path := sessionStatePath(); path != "" {
+		_ = os.Remove(path)
+	}
+}
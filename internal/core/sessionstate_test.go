@@ -0,0 +1,74 @@
+package core
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+// TestSessionStateRoundTrip verifies save, load and clear of a snapshot
+func TestSessionStateRoundTrip(t *testing.T) {
+	// Redirect os.UserCacheDir so the test never touches the real cache
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	state := SessionState{
+		Screen: 7,
+		SelectedDependencies: []RepoCandidate{
+			{Owner: "octocat", Name: "hello_world", URL: "https://github.com/octocat/hello_world.git"},
+		},
+	}
+	if err := SaveSessionState(state); err != nil {
+		t.Fatalf("SaveSessionState failed: %v", err)
+	}
+
+	loaded, err := LoadSessionState()
+	if err != nil {
+		t.Fatalf("LoadSessionState failed: %v", err)
+	}
+	if loaded == nil {
+		t.Fatal("expected a snapshot, got nil")
+	}
+	if loaded.Screen != 7 {
+		t.Errorf("expected screen 7, got %d", loaded.Screen)
+	}
+	if len(loaded.SelectedDependencies) != 1 || loaded.SelectedDependencies[0].Name != "hello_world" {
+		t.Errorf("expected selections to round-trip, got %+v", loaded.SelectedDependencies)
+	}
+	if loaded.SavedAt.IsZero() {
+		t.Error("expected SavedAt to be stamped on save")
+	}
+
+	ClearSessionState()
+	loaded, err = LoadSessionState()
+	if err != nil {
+		t.Fatalf("LoadSessionState after clear failed: %v", err)
+	}
+	if loaded != nil {
+		t.Errorf("expected nil after clear, got %+v", loaded)
+	}
+}
+
+// TestSessionStateStaleDiscard verifies old snapshots are silently dropped
+func TestSessionStateStaleDiscard(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+
+	if err := SaveSessionState(SessionState{Screen: 3}); err != nil {
+		t.Fatalf("SaveSessionState failed: %v", err)
+	}
+
+	// Backdate the snapshot past the resumable window
+	path := sessionStatePath()
+	old := time.Now().Add(-SessionStateMaxAge - time.Hour)
+	content := []byte(`{"saved_at":"` + old.Format(time.RFC3339) + `","screen":3}`)
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatalf("failed to backdate snapshot: %v", err)
+	}
+
+	loaded, err := LoadSessionState()
+	if err != nil {
+		t.Fatalf("LoadSessionState failed: %v", err)
+	}
+	if loaded != nil {
+		t.Errorf("expected stale snapshot to be discarded, got %+v", loaded)
+	}
+}
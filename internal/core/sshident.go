@@ -0,0 +1,231 @@
+// Package core/sshident.go - SSH Identity Selection per Host or Project
+//
+// This file implements SSH identity configuration for users juggling several
+// git identities (e.g. consultants with one key per client). Identities are
+// declared per host in the global config (~/.config/flutter-pm/config.yaml)
+// and per project in .flutter-pm.yaml; git commands that know their remote
+// URL get a matching GIT_SSH_COMMAND / SSH_AUTH_SOCK injected.
+//
+// Example config:
+//
+//	ssh:
+//	  default:
+//	    identity_file: ~/.ssh/id_default
+//	  hosts:
+//	    github.com:
+//	      identity_file: ~/.ssh/id_client_a
+//	    bitbucket.org:
+//	      agent_socket: /run/user/1000/client-b-agent.sock
+//
+// Key features:
+// - ResolveSSHIdentity: Project entries override global, host beats default
+// - ApplySSHIdentity: Inject GIT_SSH_COMMAND/SSH_AUTH_SOCK into an exec.Cmd
+// - CheckSSHAuth: Doctor check that the configured key can authenticate
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// SSHIdentity selects a key file and/or agent socket for a host or project
+type SSHIdentity struct {
+	IdentityFile string `yaml:"identity_file" json:"identity_file,omitempty"`
+	AgentSocket  string `yaml:"agent_socket" json:"agent_socket,omitempty"`
+}
+
+// sshSettingsYAML models the `ssh:` section of a config file
+type sshSettingsYAML struct {
+	Default SSHIdentity            `yaml:"default"`
+	Hosts   map[string]SSHIdentity `yaml:"hosts"`
+}
+
+// sshFileYAML models a config file containing an `ssh:` section (both the
+// global config and the per-project .flutter-pm.yaml use the same schema)
+type sshFileYAML struct {
+	SSH sshSettingsYAML `yaml:"ssh"`
+}
+
+// GlobalConfigPath returns the global configuration file, next to the
+// plugin directory (see PluginDir)
+func GlobalConfigPath() string {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(homeDir, ".config", "flutter-pm", "config.yaml")
+}
+
+// isZero reports whether the identity selects nothing
+func (id SSHIdentity) isZero() bool {
+	return id.IdentityFile == "" && id.AgentSocket == ""
+}
+
+// loadSSHSettings parses the ssh section of a config file; a missing file
+// returns empty settings without error
+func loadSSHSettings(path string) sshSettingsYAML {
+	if path == "" {
+		return sshSettingsYAML{}
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return sshSettingsYAML{}
+	}
+	var parsed sshFileYAML
+	if err := yaml.Unmarshal(content, &parsed); err != nil {
+		return sshSettingsYAML{}
+	}
+	return parsed.SSH
+}
+
+// ResolveSSHIdentity picks the identity for a host, preferring project
+// config over global and host entries over defaults. Either projectPath or
+// host may be empty; a zero identity means "use the ambient SSH setup".
+func ResolveSSHIdentity(projectPath, host string) SSHIdentity {
+	// Project config wins over global
+	if projectPath != "" {
+		settings := loadSSHSettings(filepath.Join(projectPath, ProjectEnvFileName))
+		if id, ok := settings.Hosts[host]; ok && !id.isZero() {
+			return id
+		}
+		if !settings.Default.isZero() {
+			return settings.Default
+		}
+	}
+
+	settings := loadSSHSettings(GlobalConfigPath())
+	if id, ok := settings.Hosts[host]; ok && !id.isZero() {
+		return id
+	}
+	return settings.Default
+}
+
+// sshCommandFor builds the GIT_SSH_COMMAND value for an identity file,
+// pinning the key with IdentitiesOnly so the agent can't offer others
+func sshCommandFor(identityFile string) string {
+	return fmt.Sprintf("ssh -i %s -o IdentitiesOnly=yes", expandHome(identityFile))
+}
+
+// expandHome expands a leading ~/ to the user's home directory
+func expandHome(path string) string {
+	if strings.HasPrefix(path, "~/") {
+		if homeDir, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(homeDir, path[2:])
+		}
+	}
+	return path
+}
+
+// ApplySSHIdentity injects the SSH identity matching a git URL into a
+// command's environment. It composes with ApplyProjectEnv: an existing
+// cmd.Env is extended, otherwise the process environment is inherited.
+// Commands without a matching identity are left untouched.
+func ApplySSHIdentity(logger *Logger, cmd *exec.Cmd, gitURL string) {
+	host := gitURLHost(gitURL)
+	if host == "" {
+		return
+	}
+
+	identity := ResolveSSHIdentity(cmd.Dir, host)
+	if identity.isZero() {
+		return
+	}
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	if identity.IdentityFile != "" {
+		cmd.Env = append(cmd.Env, "GIT_SSH_COMMAND="+sshCommandFor(identity.IdentityFile))
+		if logger != nil {
+			logger.Debug("ssh", fmt.Sprintf("Using identity %s for %s", identity.IdentityFile, host))
+		}
+	}
+	if identity.AgentSocket != "" {
+		cmd.Env = append(cmd.Env, "SSH_AUTH_SOCK="+identity.AgentSocket)
+		if logger != nil {
+			logger.Debug("ssh", fmt.Sprintf("Using agent socket %s for %s", identity.AgentSocket, host))
+		}
+	}
+}
+
+// ConfiguredSSHHosts returns the union of hosts with an identity configured
+// globally or in the project, for the doctor check to iterate
+func ConfiguredSSHHosts(projectPath string) []string {
+	seen := make(map[string]bool)
+	var hosts []string
+
+	collect := func(settings sshSettingsYAML) {
+		for host, id := range settings.Hosts {
+			if !id.isZero() && !seen[host] {
+				seen[host] = true
+				hosts = append(hosts, host)
+			}
+		}
+	}
+
+	collect(loadSSHSettings(GlobalConfigPath()))
+	if projectPath != "" {
+		collect(loadSSHSettings(filepath.Join(projectPath, ProjectEnvFileName)))
+	}
+
+	return hosts
+}
+
+// CheckSSHAuth verifies that the configured identity can authenticate
+// against a host by running `ssh -T git@host` in batch mode. GitHub and
+// Bitbucket both refuse a shell on success, so the greeting text (not the
+// exit code) decides the outcome.
+func CheckSSHAuth(logger *Logger, projectPath, host string) ActionResult {
+	identity := ResolveSSHIdentity(projectPath, host)
+
+	args := []string{"-o", "BatchMode=yes", "-o", "ConnectTimeout=10"}
+	if identity.IdentityFile != "" {
+		args = append(args, "-i", expandHome(identity.IdentityFile), "-o", "IdentitiesOnly=yes")
+	}
+	args = append(args, "-T", "git@"+host)
+
+	logger.LogCommand("ssh", "ssh", args)
+
+	cmd := exec.Command("ssh", args...)
+	if identity.AgentSocket != "" {
+		cmd.Env = append(os.Environ(), "SSH_AUTH_SOCK="+identity.AgentSocket)
+	}
+
+	output, err := cmd.CombinedOutput()
+	outputStr := strings.TrimSpace(string(output))
+	logs := []string{outputStr}
+
+	// Success greetings: GitHub "successfully authenticated", Bitbucket
+	// "logged in as", GitLab "Welcome to GitLab"
+	lower := strings.ToLower(outputStr)
+	authenticated := strings.Contains(lower, "successfully authenticated") ||
+		strings.Contains(lower, "logged in as") ||
+		strings.Contains(lower, "welcome to gitlab")
+
+	if authenticated {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("SSH authentication to %s succeeded", host),
+			Logs:    logs,
+		}
+	}
+
+	reason := "host did not confirm authentication"
+	if err != nil && strings.Contains(lower, "permission denied") {
+		reason = "permission denied (wrong or missing key)"
+	} else if err != nil {
+		reason = err.Error()
+	}
+
+	return ActionResult{
+		OK:   false,
+		Err:  fmt.Sprintf("SSH authentication to %s failed: %s", host, reason),
+		Logs: logs,
+	}
+}
@@ -0,0 +1,104 @@
+package core
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeSSHProjectConfig writes a .flutter-pm.yaml ssh section fixture
+func writeSSHProjectConfig(t *testing.T, projectPath, content string) {
+	t.Helper()
+	path := filepath.Join(projectPath, ProjectEnvFileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write ssh config fixture: %v", err)
+	}
+}
+
+// TestResolveSSHIdentityHostOverDefault verifies that host entries beat the
+// project default
+func TestResolveSSHIdentityHostOverDefault(t *testing.T) {
+	projectPath := t.TempDir()
+	writeSSHProjectConfig(t, projectPath, `ssh:
+  default:
+    identity_file: ~/.ssh/id_default
+  hosts:
+    github.com:
+      identity_file: ~/.ssh/id_client_a
+`)
+
+	id := ResolveSSHIdentity(projectPath, "github.com")
+	if id.IdentityFile != "~/.ssh/id_client_a" {
+		t.Errorf("github.com identity = %q, want %q", id.IdentityFile, "~/.ssh/id_client_a")
+	}
+
+	// Unlisted host falls back to the default
+	id = ResolveSSHIdentity(projectPath, "bitbucket.org")
+	if id.IdentityFile != "~/.ssh/id_default" {
+		t.Errorf("fallback identity = %q, want %q", id.IdentityFile, "~/.ssh/id_default")
+	}
+}
+
+// TestApplySSHIdentityInjectsGitSSHCommand verifies the env injection for a
+// command whose remote URL matches a configured host
+func TestApplySSHIdentityInjectsGitSSHCommand(t *testing.T) {
+	projectPath := t.TempDir()
+	writeSSHProjectConfig(t, projectPath, `ssh:
+  hosts:
+    github.com:
+      identity_file: /keys/id_client_a
+      agent_socket: /run/client-a-agent.sock
+`)
+
+	cmd := exec.Command("git", "ls-remote", "https://github.com/owner/repo.git")
+	cmd.Dir = projectPath
+	ApplySSHIdentity(nil, cmd, "https://github.com/owner/repo.git")
+
+	var gotSSHCommand, gotAgentSock string
+	for _, entry := range cmd.Env {
+		switch {
+		case strings.HasPrefix(entry, "GIT_SSH_COMMAND="):
+			gotSSHCommand = strings.TrimPrefix(entry, "GIT_SSH_COMMAND=")
+		case strings.HasPrefix(entry, "SSH_AUTH_SOCK="):
+			gotAgentSock = strings.TrimPrefix(entry, "SSH_AUTH_SOCK=")
+		}
+	}
+
+	if gotSSHCommand != "ssh -i /keys/id_client_a -o IdentitiesOnly=yes" {
+		t.Errorf("GIT_SSH_COMMAND = %q", gotSSHCommand)
+	}
+	if gotAgentSock != "/run/client-a-agent.sock" {
+		t.Errorf("SSH_AUTH_SOCK = %q", gotAgentSock)
+	}
+
+	// A URL for an unconfigured host must leave the command untouched
+	untouched := exec.Command("git", "ls-remote", "https://example.com/repo.git")
+	untouched.Dir = projectPath
+	ApplySSHIdentity(nil, untouched, "https://example.com/repo.git")
+	if untouched.Env != nil {
+		t.Errorf("expected untouched env for unconfigured host, got %d entries", len(untouched.Env))
+	}
+}
+
+// TestConfiguredSSHHosts verifies the host list used by the doctor check
+func TestConfiguredSSHHosts(t *testing.T) {
+	projectPath := t.TempDir()
+	writeSSHProjectConfig(t, projectPath, `ssh:
+  hosts:
+    github.com:
+      identity_file: /keys/a
+    bitbucket.org:
+      agent_socket: /run/b.sock
+`)
+
+	hosts := ConfiguredSSHHosts(projectPath)
+	seen := make(map[string]bool)
+	for _, host := range hosts {
+		seen[host] = true
+	}
+	if !seen["github.com"] || !seen["bitbucket.org"] {
+		t.Errorf("expected both configured hosts, got %v", hosts)
+	}
+}
@@ -237,6 +237,10 @@ func parsePubspecLock(lockPath string) (*PubspecLock, error) {
 		if strings.HasPrefix(line, "    ") {
 			if strings.HasPrefix(trimmed, "source:") {
 				currentDep.Source = extractValue(trimmed, "source:")
+			} else if strings.HasPrefix(trimmed, "dependency:") {
+				currentDep.Dependency = extractValue(trimmed, "dependency:")
+			} else if strings.HasPrefix(trimmed, "version:") {
+				currentDep.Version = extractValue(trimmed, "version:")
 			} else if strings.HasPrefix(trimmed, "resolved-ref:") {
 				currentDep.ResolvedRef = extractValue(trimmed, "resolved-ref:")
 			} else if strings.HasPrefix(trimmed, "url:") {
@@ -337,7 +341,7 @@ func UpdateStaleDependencies(logger *Logger, cfg *Config, projectPath string, st
 		}
 	}
 
-	tool, err := FindPubTool()
+	tool, toolArgs, err := FindPubToolFor(projectPath)
 	if err != nil {
 		return ActionResult{
 			OK:  false,
@@ -346,14 +350,14 @@ func UpdateStaleDependencies(logger *Logger, cfg *Config, projectPath string, st
 	}
 
 	// Create backup before updating
-	backupInfo, err := CreateBackup(projectPath)
+	backupInfo, err := CreateTrackedBackup(logger, projectPath, "update")
 	if err != nil {
 		logger.Error("backup", err)
 	} else {
 		logger.Info("backup", fmt.Sprintf("Created backup: %s", backupInfo.BackupPath))
 	}
 
-	args := []string{"pub", "upgrade"}
+	args := append(toolArgs, "pub", "upgrade")
 	logger.LogCommand("update", tool, args)
 
 	if cfg.DryRun {
@@ -364,8 +368,10 @@ func UpdateStaleDependencies(logger *Logger, cfg *Config, projectPath string, st
 		}
 	}
 
+	reportProgress("update", -1, fmt.Sprintf("Upgrading %d stale packages", len(stalePackages)))
 	cmd := exec.Command(tool, args...)
 	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
 
 	output, err := cmd.CombinedOutput()
 	logs := []string{strings.TrimSpace(string(output))}
@@ -0,0 +1,149 @@
+// Package core/stream.go - Live Command Output Streaming
+//
+// Long-running git/pub commands used to buffer their output and only hand
+// it back after the process exited, so the execution screen showed nothing
+// while pub's resolver worked. This file lets the TUI register a channel as
+// an output sink; the streaming command runner then forwards every stdout/
+// stderr line to it as the line is produced, while still collecting the
+// full combined output for the usual ActionResult logs. When no sink is
+// registered (CLI mode, tests) the runner behaves exactly like
+// cmd.CombinedOutput.
+//
+// Key features:
+// - SetOutputSink / ClearOutputSink: Register a channel for live output lines
+// - runCommandStreaming: CombinedOutput drop-in that also emits line-by-line
+// - Non-blocking emits so a slow or full UI never stalls the child process
+
+package core
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"sync"
+)
+
+// outputSink holds the registered live-output channel, if any. A package
+// level registry (like githubCache) keeps the core command signatures
+// unchanged for callers that don't care about streaming.
+var outputSink struct {
+	mu sync.RWMutex
+	ch chan<- string
+}
+
+// SetOutputSink registers a channel that receives every output line of
+// streamed commands. Pass a buffered channel; lines are dropped rather than
+// blocking the child process when it fills up.
+func SetOutputSink(ch chan<- string) {
+	outputSink.mu.Lock()
+	defer outputSink.mu.Unlock()
+	outputSink.ch = ch
+}
+
+// ClearOutputSink deregisters the live-output channel. After it returns no
+// further lines are sent, so the caller may safely close the channel.
+func ClearOutputSink() {
+	outputSink.mu.Lock()
+	defer outputSink.mu.Unlock()
+	outputSink.ch = nil
+}
+
+// emitOutputLine sends a line to the registered sink, if any, without
+// blocking
+func emitOutputLine(line string) {
+	outputSink.mu.RLock()
+	defer outputSink.mu.RUnlock()
+	if outputSink.ch == nil {
+		return
+	}
+	select {
+	case outputSink.ch <- line:
+	default:
+	}
+}
+
+// runCommandStreaming runs cmd, forwarding each stdout/stderr line to the
+// output sink as it is produced, and returns the combined output exactly
+// like cmd.CombinedOutput
+func runCommandStreaming(cmd *exec.Cmd) ([]byte, error) {
+	w := &streamWriter{}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	err := cmd.Run()
+	w.flush()
+	return w.buf.Bytes(), err
+}
+
+// runCommandStreamingProgress is runCommandStreaming with live progress
+// reporting: carriage-return separated "Stage: NN%" updates (as emitted by
+// git --progress) are parsed from the stream and forwarded to the
+// registered ProgressReporter under the given stage name.
+func runCommandStreamingProgress(cmd *exec.Cmd, stage string) ([]byte, error) {
+	w := &streamWriter{progressStage: stage}
+	cmd.Stdout = w
+	cmd.Stderr = w
+	err := cmd.Run()
+	w.flush()
+	return w.buf.Bytes(), err
+}
+
+// streamWriter collects everything written to it while emitting complete
+// lines to the output sink. Stdout and stderr share one instance, so writes
+// are serialized with a mutex.
+type streamWriter struct {
+	mu      sync.Mutex
+	buf     bytes.Buffer
+	partial []byte
+
+	// Non-empty enables progress parsing: percentage updates found in the
+	// stream are reported under this stage name (see core/progress.go)
+	progressStage string
+	lastPercent   int // Highest combined percentage reported so far
+}
+
+func (w *streamWriter) Write(p []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.buf.Write(p)
+
+	// Progress updates arrive as carriage-return separated segments that
+	// never reach the line-based sink below, so parse them from the raw
+	// chunk (best effort: a segment split across writes is simply skipped).
+	// Git's phases are folded into one monotonic percentage; interleaved
+	// phase output is kept from moving the bar backwards.
+	if w.progressStage != "" {
+		for _, segment := range strings.Split(string(p), "\r") {
+			phase, percent, ok := parseProgressLine(segment)
+			if !ok {
+				continue
+			}
+			combined := combinedGitPercent(phase, percent)
+			if combined < w.lastPercent {
+				continue
+			}
+			w.lastPercent = combined
+			reportProgress(w.progressStage, combined, phase)
+		}
+	}
+
+	w.partial = append(w.partial, p...)
+	for {
+		idx := bytes.IndexByte(w.partial, '\n')
+		if idx < 0 {
+			break
+		}
+		emitOutputLine(strings.TrimRight(string(w.partial[:idx]), "\r"))
+		w.partial = w.partial[idx+1:]
+	}
+	return len(p), nil
+}
+
+// flush emits any trailing output that didn't end in a newline
+func (w *streamWriter) flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if len(w.partial) > 0 {
+		emitOutputLine(strings.TrimRight(string(w.partial), "\r"))
+		w.partial = nil
+	}
+}
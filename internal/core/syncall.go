@@ -0,0 +1,107 @@
+// Package core/syncall.go - Workspace-Wide Project Synchronization
+//
+// This file implements the "sync all projects" mode for users who maintain
+// several Flutter projects sharing the same internal git packages. It takes
+// the projects found by the discovery scan (see core/discovery.go),
+// optionally narrows them to the ones that declare a given dependency, and
+// runs the express git update followed by pub get in each one, collecting
+// per-project results so the caller can render an aggregated report.
+//
+// Key features:
+// - ProjectHasDependency: Check whether a project declares a dependency
+// - FilterProjectsByDependency: Narrow a project list to dependency users
+// - SyncAllProjects: Express update + pub get across projects with progress
+// - ProjectSyncResult: Per-project express and sync outcomes
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ProjectSyncResult holds the outcome of syncing one project
+type ProjectSyncResult struct {
+	Project Project      `json:"project"`
+	Express ActionResult `json:"express"` // Express git update outcome
+	Sync    ActionResult `json:"sync"`    // pub get outcome
+}
+
+// OK reports whether both steps succeeded for this project
+func (r ProjectSyncResult) OK() bool {
+	return r.Express.OK && r.Sync.OK
+}
+
+// syncAllPubspec models the dependency sections needed for the filter
+type syncAllPubspec struct {
+	Dependencies        map[string]interface{} `yaml:"dependencies"`
+	DevDependencies     map[string]interface{} `yaml:"dev_dependencies"`
+	DependencyOverrides map[string]interface{} `yaml:"dependency_overrides"`
+}
+
+// ProjectHasDependency reports whether the project's pubspec.yaml declares
+// the named package in dependencies, dev_dependencies or
+// dependency_overrides. Unreadable pubspecs count as not declaring it.
+func ProjectHasDependency(projectPath, pkgName string) bool {
+	content, err := os.ReadFile(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return false
+	}
+
+	var pubspec syncAllPubspec
+	if err := yaml.Unmarshal(content, &pubspec); err != nil {
+		return false
+	}
+
+	for _, section := range []map[string]interface{}{
+		pubspec.Dependencies, pubspec.DevDependencies, pubspec.DependencyOverrides,
+	} {
+		if _, ok := section[pkgName]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// FilterProjectsByDependency returns the projects that declare the named
+// dependency. An empty package name keeps every project.
+func FilterProjectsByDependency(projects []Project, pkgName string) []Project {
+	if pkgName == "" {
+		return projects
+	}
+
+	var matched []Project
+	for _, project := range projects {
+		if ProjectHasDependency(project.Path, pkgName) {
+			matched = append(matched, project)
+		}
+	}
+	return matched
+}
+
+// SyncAllProjects runs the express git update followed by pub get in each
+// project, in order. The progress callback (may be nil) fires before each
+// project starts so callers can show aggregated progress. A failing project
+// does not stop the run - its errors land in the returned results.
+func SyncAllProjects(logger *Logger, cfg *Config, projects []Project, progress func(index, total int, project Project)) []ProjectSyncResult {
+	results := make([]ProjectSyncResult, 0, len(projects))
+
+	for i, project := range projects {
+		if progress != nil {
+			progress(i, len(projects), project)
+		}
+
+		logger.Narrate(fmt.Sprintf("Syncing project %d of %d: %s", i+1, len(projects), project.Name))
+		logger.Info("syncall", fmt.Sprintf("[%d/%d] %s", i+1, len(projects), project.Path))
+
+		result := ProjectSyncResult{Project: project}
+		result.Express = ExpressGitUpdate(logger, cfg, project.Path)
+		result.Sync = Sync(logger, cfg, project.Path)
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// TestProjectHasDependency verifies the dependency filter across sections
+func TestProjectHasDependency(t *testing.T) {
+	dir := t.TempDir()
+	pubspec := `name: test_app
+dependencies:
+  dio: ^5.0.0
+dev_dependencies:
+  lints: ^3.0.0
+dependency_overrides:
+  http:
+    git:
+      url: https://github.com/example/http.git
+`
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to write pubspec: %v", err)
+	}
+
+	for _, pkg := range []string{"dio", "lints", "http"} {
+		if !ProjectHasDependency(dir, pkg) {
+			t.Errorf("expected %s to be detected", pkg)
+		}
+	}
+	if ProjectHasDependency(dir, "provider") {
+		t.Error("expected provider to be absent")
+	}
+	if ProjectHasDependency(t.TempDir(), "dio") {
+		t.Error("expected a project without pubspec.yaml to match nothing")
+	}
+}
+
+// TestFilterProjectsByDependency verifies the empty-name passthrough
+func TestFilterProjectsByDependency(t *testing.T) {
+	projects := []Project{{Path: t.TempDir(), Name: "empty"}}
+	if got := FilterProjectsByDependency(projects, ""); len(got) != 1 {
+		t.Errorf("expected empty package name to keep all projects, got %d", len(got))
+	}
+	if got := FilterProjectsByDependency(projects, "dio"); len(got) != 0 {
+		t.Errorf("expected no matches, got %d", len(got))
+	}
+}
@@ -0,0 +1,211 @@
+// Package core/template.go - Template-Based Project Identity Rewriting
+//
+// This file supports the "New project from template" flow: after a GitHub
+// template repository has been cloned, the copy still carries the template's
+// package name and bundle identifiers. RewriteProjectIdentity renames the
+// project in place - pubspec.yaml name, Android applicationId/namespace and
+// the iOS PRODUCT_BUNDLE_IDENTIFIER - so the new project builds under its
+// own identity before any dependencies are added.
+//
+// All rewrites are line-based string edits rather than full parses: the
+// Gradle and pbxproj formats are not YAML, and touching only the identifier
+// lines keeps the template's formatting and comments intact.
+//
+// Key features:
+// - FilterTemplateRepos: Narrow a repo list to GitHub template repositories
+// - SanitizeDartPackageName: Turn an arbitrary name into a valid package name
+// - RewriteProjectIdentity: Rename pubspec, Android and iOS identifiers
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// FilterTemplateRepos returns the candidates GitHub marks as template
+// repositories
+func FilterTemplateRepos(repos []RepoCandidate) []RepoCandidate {
+	var templates []RepoCandidate
+	for _, repo := range repos {
+		if repo.IsTemplate {
+			templates = append(templates, repo)
+		}
+	}
+	return templates
+}
+
+// SanitizeDartPackageName converts an arbitrary project name into a valid
+// Dart package name: lowercase, [a-z0-9_] only, never starting with a digit
+func SanitizeDartPackageName(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+
+	sanitized := strings.Trim(b.String(), "_")
+	if sanitized == "" {
+		return "my_project"
+	}
+	if sanitized[0] >= '0' && sanitized[0] <= '9' {
+		sanitized = "_" + sanitized
+	}
+	return sanitized
+}
+
+// RewriteProjectIdentity renames a freshly cloned template project: the
+// pubspec.yaml name becomes the sanitized newName, and the Android and iOS
+// bundle identifiers become org.<name>. Platform files that the template
+// doesn't ship (e.g. a pure Dart package without android/) are skipped.
+func RewriteProjectIdentity(logger *Logger, cfg *Config, projectPath, newName, org string) ActionResult {
+	pkgName := SanitizeDartPackageName(newName)
+	bundleID := org + "." + pkgName
+
+	oldName, err := extractProjectName(filepath.Join(projectPath, "pubspec.yaml"))
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("cannot read template pubspec.yaml: %v", err),
+		}
+	}
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would rename project %s to %s (bundle %s)", oldName, pkgName, bundleID),
+		}
+	}
+
+	logger.Narrate(fmt.Sprintf("Renaming template project %s to %s", oldName, pkgName))
+
+	// Each target file gets its own rewriter; missing files are expected
+	// (not every template ships every platform) and simply skipped
+	targets := []struct {
+		relPath string
+		rewrite func(content string) string
+	}{
+		{"pubspec.yaml", func(c string) string { return rewritePubspecName(c, pkgName) }},
+		{filepath.Join("android", "app", "build.gradle"), func(c string) string { return rewriteGradleIdentifiers(c, bundleID) }},
+		{filepath.Join("android", "app", "build.gradle.kts"), func(c string) string { return rewriteGradleIdentifiers(c, bundleID) }},
+		{filepath.Join("ios", "Runner.xcodeproj", "project.pbxproj"), func(c string) string { return rewritePbxprojBundleID(c, bundleID) }},
+	}
+
+	var logs []string
+	for _, target := range targets {
+		fullPath := filepath.Join(projectPath, target.relPath)
+		content, err := os.ReadFile(fullPath)
+		if err != nil {
+			logger.Debug("template", fmt.Sprintf("skipping %s: %v", target.relPath, err))
+			continue
+		}
+
+		rewritten := target.rewrite(string(content))
+		if rewritten == string(content) {
+			continue
+		}
+
+		if err := os.WriteFile(fullPath, []byte(rewritten), 0644); err != nil {
+			return ActionResult{
+				OK:   false,
+				Err:  fmt.Sprintf("failed to rewrite %s: %v", target.relPath, err),
+				Logs: logs,
+			}
+		}
+		logs = append(logs, fmt.Sprintf("Rewrote %s", target.relPath))
+	}
+
+	logger.Info("template", fmt.Sprintf("Renamed project %s -> %s (bundle %s)", oldName, pkgName, bundleID))
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Renamed project to %s (bundle %s)", pkgName, bundleID),
+		Logs:    logs,
+		Data: map[string]interface{}{
+			"old_name":  oldName,
+			"new_name":  pkgName,
+			"bundle_id": bundleID,
+		},
+	}
+}
+
+// rewritePubspecName replaces the top-level name field in pubspec.yaml
+func rewritePubspecName(content, newName string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		// Only the unindented name field is the package name; indented
+		// name keys belong to nested sections
+		if strings.HasPrefix(line, "name:") {
+			lines[i] = "name: " + newName
+			break
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// rewriteGradleIdentifiers replaces the quoted values of applicationId and
+// namespace lines in a Gradle build file (Groovy or Kotlin DSL)
+func rewriteGradleIdentifiers(content, bundleID string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if !strings.HasPrefix(trimmed, "applicationId") && !strings.HasPrefix(trimmed, "namespace") {
+			continue
+		}
+		lines[i] = replaceQuotedValue(line, bundleID)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// replaceQuotedValue swaps the content of the first quoted string in line,
+// handling both single and double quotes. Lines without quotes are returned
+// unchanged.
+func replaceQuotedValue(line, newValue string) string {
+	for _, quote := range []string{`"`, `'`} {
+		start := strings.Index(line, quote)
+		if start == -1 {
+			continue
+		}
+		end := strings.Index(line[start+1:], quote)
+		if end == -1 {
+			continue
+		}
+		return line[:start+1] + newValue + line[start+1+end:]
+	}
+	return line
+}
+
+// rewritePbxprojBundleID replaces every PRODUCT_BUNDLE_IDENTIFIER value in
+// an Xcode project file, keeping test-target suffixes like .RunnerTests
+func rewritePbxprojBundleID(content, bundleID string) string {
+	lines := strings.Split(content, "\n")
+	for i, line := range lines {
+		key := "PRODUCT_BUNDLE_IDENTIFIER = "
+		start := strings.Index(line, key)
+		if start == -1 {
+			continue
+		}
+		end := strings.Index(line[start:], ";")
+		if end == -1 {
+			continue
+		}
+
+		oldValue := line[start+len(key) : start+end]
+		newValue := bundleID
+		// Secondary targets append a suffix to the app identifier
+		// (e.g. com.example.app.RunnerTests) - keep it
+		if dot := strings.LastIndex(oldValue, "."); dot != -1 {
+			if suffix := oldValue[dot+1:]; strings.Contains(suffix, "Tests") {
+				newValue += "." + suffix
+			}
+		}
+		lines[i] = line[:start+len(key)] + newValue + line[start+end:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,70 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestSanitizeDartPackageName verifies package name normalization
+func TestSanitizeDartPackageName(t *testing.T) {
+	cases := map[string]string{
+		"My App":        "my_app",
+		"flutter-demo":  "flutter_demo",
+		"already_fine":  "already_fine",
+		"3d_viewer":     "_3d_viewer",
+		"--weird--":     "weird",
+		"!!!":           "my_project",
+		"CamelCaseName": "camelcasename",
+	}
+	for input, want := range cases {
+		if got := SanitizeDartPackageName(input); got != want {
+			t.Errorf("SanitizeDartPackageName(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+// TestRewritePubspecName verifies only the top-level name field changes
+func TestRewritePubspecName(t *testing.T) {
+	content := "name: template_app\ndescription: A template.\ndependencies:\n  flutter:\n    sdk: flutter\n"
+	got := rewritePubspecName(content, "my_app")
+	if !strings.HasPrefix(got, "name: my_app\n") {
+		t.Errorf("expected renamed pubspec, got %q", got)
+	}
+	if !strings.Contains(got, "description: A template.") {
+		t.Error("expected the rest of the pubspec to be untouched")
+	}
+}
+
+// TestRewriteGradleIdentifiers verifies applicationId and namespace rewrites
+func TestRewriteGradleIdentifiers(t *testing.T) {
+	content := `android {
+    namespace "com.example.template_app"
+    defaultConfig {
+        applicationId "com.example.template_app"
+        minSdkVersion 21
+    }
+}`
+	got := rewriteGradleIdentifiers(content, "com.acme.my_app")
+	if strings.Contains(got, "com.example.template_app") {
+		t.Errorf("expected old bundle id to be gone, got %q", got)
+	}
+	if strings.Count(got, "com.acme.my_app") != 2 {
+		t.Errorf("expected namespace and applicationId rewritten, got %q", got)
+	}
+	if !strings.Contains(got, "minSdkVersion 21") {
+		t.Error("expected unrelated lines to be untouched")
+	}
+}
+
+// TestRewritePbxprojBundleID verifies test-target suffixes are preserved
+func TestRewritePbxprojBundleID(t *testing.T) {
+	content := `PRODUCT_BUNDLE_IDENTIFIER = com.example.templateApp;
+PRODUCT_BUNDLE_IDENTIFIER = com.example.templateApp.RunnerTests;`
+	got := rewritePbxprojBundleID(content, "com.acme.my_app")
+	if !strings.Contains(got, "PRODUCT_BUNDLE_IDENTIFIER = com.acme.my_app;") {
+		t.Errorf("expected app bundle id rewritten, got %q", got)
+	}
+	if !strings.Contains(got, "PRODUCT_BUNDLE_IDENTIFIER = com.acme.my_app.RunnerTests;") {
+		t.Errorf("expected RunnerTests suffix preserved, got %q", got)
+	}
+}
@@ -0,0 +1,53 @@
+// Package core/topics.go - Topic/Keyword Grouping for Bulk Selection
+//
+// Repository sources can tag packages with topics (GitHub repository
+// topics; other providers may populate RepoCandidate.Topics from their own
+// keyword metadata). This file groups a candidate list by those topics so
+// the multi-select screen can offer group-level toggles - "select all
+// analytics packages" - feeding the expansion back into the normal
+// pre-checked list when assembling a stack for a new app.
+//
+// Key features:
+// - GroupReposByTopic: stable topic -> member-index groups for a candidate list
+// - Groups are sorted by topic name; topic-less candidates are simply absent
+
+package core
+
+import (
+	"sort"
+	"strings"
+)
+
+// TopicGroup is one topic with the indices of its member candidates in the
+// original list, so toggles map directly onto selection state
+type TopicGroup struct {
+	Topic   string `json:"topic"`
+	Indices []int  `json:"indices"`
+}
+
+// GroupReposByTopic groups candidates by their topics. Topics are
+// normalized to lower case, each candidate can appear in several groups,
+// and groups are sorted by topic name for a stable display. Candidates
+// without topics contribute no groups.
+func GroupReposByTopic(repos []RepoCandidate) []TopicGroup {
+	byTopic := make(map[string][]int)
+	for i, repo := range repos {
+		for _, topic := range repo.Topics {
+			topic = strings.ToLower(strings.TrimSpace(topic))
+			if topic == "" {
+				continue
+			}
+			byTopic[topic] = append(byTopic[topic], i)
+		}
+	}
+
+	groups := make([]TopicGroup, 0, len(byTopic))
+	for topic, indices := range byTopic {
+		groups = append(groups, TopicGroup{Topic: topic, Indices: indices})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].Topic < groups[j].Topic
+	})
+
+	return groups
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	"reflect"
+	"testing"
+)
+
+// TestGroupReposByTopic verifies normalization, multi-membership and the
+// sorted group order
+func TestGroupReposByTopic(t *testing.T) {
+	repos := []RepoCandidate{
+		{Name: "events_sdk", Topics: []string{"Analytics", "networking"}},
+		{Name: "metrics_ui", Topics: []string{"analytics"}},
+		{Name: "http_helper", Topics: []string{"networking"}},
+		{Name: "plain_repo"}, // No topics
+	}
+
+	groups := GroupReposByTopic(repos)
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 topic groups, got %d: %v", len(groups), groups)
+	}
+
+	// Sorted by topic: analytics before networking
+	if groups[0].Topic != "analytics" || groups[1].Topic != "networking" {
+		t.Errorf("groups not sorted by topic: %v", groups)
+	}
+
+	if !reflect.DeepEqual(groups[0].Indices, []int{0, 1}) {
+		t.Errorf("analytics members wrong: %v", groups[0].Indices)
+	}
+	if !reflect.DeepEqual(groups[1].Indices, []int{0, 2}) {
+		t.Errorf("networking members wrong: %v", groups[1].Indices)
+	}
+}
+
+// TestGroupReposByTopicEmpty verifies topic-less lists yield no groups
+func TestGroupReposByTopicEmpty(t *testing.T) {
+	groups := GroupReposByTopic([]RepoCandidate{{Name: "a"}, {Name: "b"}})
+	if len(groups) != 0 {
+		t.Errorf("expected no groups, got %v", groups)
+	}
+}
@@ -0,0 +1,71 @@
+// Package core/transcript.go - Linear Transcript of State Changes
+//
+// This file implements an optional transcript mode that runs alongside the
+// TUI. When enabled (FLUTTER_PM_TRANSCRIPT=path or --transcript path), plain
+// language descriptions of state changes ("Selected 3 repositories",
+// "Installing package 2 of 5: dio_fork") are appended to a readable file.
+// The linear stream makes the flow accessible to screen reader users and
+// gives automated tests a stable record to assert against, independent of
+// terminal frame rendering.
+
+package core
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+// Transcript appends plain-language state change descriptions to a stream.
+// A nil *Transcript is valid and discards everything, so call sites never
+// need to guard on whether transcript mode is enabled.
+type Transcript struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+// OpenTranscript opens (or creates) the transcript file in append mode.
+// A path of "-" writes to stdout, which is useful for piping into other
+// tools during automated verification.
+func OpenTranscript(path string) (*Transcript, error) {
+	if path == "-" {
+		return &Transcript{w: nopCloser{os.Stdout}}, nil
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open transcript file: %w", err)
+	}
+	return &Transcript{w: file}, nil
+}
+
+// Say appends one timestamped line to the transcript
+func (t *Transcript) Say(message string) {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	fmt.Fprintf(t.w, "%s %s\n", time.Now().Format("15:04:05"), message)
+}
+
+// Close closes the underlying stream
+func (t *Transcript) Close() {
+	if t == nil {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.w.Close()
+}
+
+// nopCloser wraps a writer that must not be closed (e.g. stdout)
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error { return nil }
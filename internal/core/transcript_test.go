@@ -0,0 +1,59 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestTranscriptAppendsPlainLines verifies that Say appends timestamped
+// plain-language lines and that reopening the file keeps earlier entries
+func TestTranscriptAppendsPlainLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "transcript.log")
+
+	transcript, err := OpenTranscript(path)
+	if err != nil {
+		t.Fatalf("OpenTranscript failed: %v", err)
+	}
+	transcript.Say("Selected 3 repositories")
+	transcript.Say("Installing package 2 of 5: dio_fork")
+	transcript.Close()
+
+	// Append mode: a second session must not truncate the first
+	transcript, err = OpenTranscript(path)
+	if err != nil {
+		t.Fatalf("OpenTranscript reopen failed: %v", err)
+	}
+	transcript.Say("Package installation completed successfully")
+	transcript.Close()
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read transcript: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected 3 transcript lines, got %d:\n%s", len(lines), content)
+	}
+	if !strings.HasSuffix(lines[0], "Selected 3 repositories") {
+		t.Errorf("unexpected first line: %q", lines[0])
+	}
+	if !strings.HasSuffix(lines[2], "Package installation completed successfully") {
+		t.Errorf("unexpected last line: %q", lines[2])
+	}
+}
+
+// TestTranscriptNilSafe verifies that a nil transcript discards everything,
+// so call sites never need to guard on whether transcript mode is enabled
+func TestTranscriptNilSafe(t *testing.T) {
+	var transcript *Transcript
+	transcript.Say("this must not panic")
+	transcript.Close()
+
+	// Narrate on a logger without transcript mode must also be a no-op
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+	logger.Narrate("this must not panic either")
+}
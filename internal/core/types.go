@@ -32,12 +32,23 @@ type Project struct {
 
 // RepoCandidate represents a GitHub repository that can be added as a dependency
 type RepoCandidate struct {
-	Owner       string `json:"owner"`
-	Name        string `json:"name"`
-	URL         string `json:"url"`
-	Privacy     string `json:"privacy"` // "public" or "private"
-	Desc        string `json:"description,omitempty"`
-	PackageName string `json:"package_name,omitempty"` // Actual package name from pubspec.yaml (may differ from repo name)
+	Owner       string   `json:"owner"`
+	Name        string   `json:"name"`
+	URL         string   `json:"url"`
+	Privacy     string   `json:"privacy"` // "public" or "private"
+	Desc        string   `json:"description,omitempty"`
+	PackageName string   `json:"package_name,omitempty"` // Actual package name from pubspec.yaml (may differ from repo name)
+	Topics      []string `json:"topics,omitempty"`       // Repository topics/keywords (e.g. GitHub topics), used for bulk selection
+
+	// Metadata for sorting and filtering in the selection screen
+	Stars      int       `json:"stars,omitempty"`       // Stargazer count
+	PushedAt   time.Time `json:"pushed_at,omitempty"`   // Time of the last push (zero when unknown)
+	Language   string    `json:"language,omitempty"`    // Primary language reported by GitHub
+	IsTemplate bool      `json:"is_template,omitempty"` // GitHub template repository flag
+
+	// Pubspec probe results (see core.DetectDartPackages)
+	HasPubspec     bool `json:"has_pubspec,omitempty"`     // Repo contains a pubspec.yaml (valid only when PubspecChecked)
+	PubspecChecked bool `json:"pubspec_checked,omitempty"` // A pubspec probe gave a definitive answer
 }
 
 // PkgSpec represents a package specification for adding as a dependency
@@ -59,9 +70,20 @@ type ActionResult struct {
 
 // Reco represents a recommendation
 type Reco struct {
-	Message   string `json:"message"`
-	Severity  string `json:"severity"` // "info", "warn", "error"
-	Rationale string `json:"rationale"`
+	Message   string         `json:"message"`
+	Severity  string         `json:"severity"` // "info", "warn", "error"
+	Rationale string         `json:"rationale"`
+	Fix       string         `json:"fix,omitempty"`        // Concrete action or command resolving the finding
+	FixAction *RecoFixAction `json:"fix_action,omitempty"` // Machine-applicable fix, when one exists (see ApplyRecoFix)
+}
+
+// RecoFixAction describes a fix the tool can apply on the user's behalf
+// (kinds and dispatch in core/recofix.go)
+type RecoFixAction struct {
+	Kind    string `json:"kind"`          // RecoFixPinRef or RecoFixRemoveOverride
+	Package string `json:"package"`       // Package the fix targets
+	Ref     string `json:"ref,omitempty"` // Tag or SHA to pin to (RecoFixPinRef)
+	Label   string `json:"label"`         // Short description shown in the fix picker, e.g. "pin dio to v5.4.0"
 }
 
 // Step represents the current step in the TUI workflow
@@ -88,10 +110,25 @@ type PubspecLock struct {
 // PubspecLockDep represents a dependency in pubspec.lock
 type PubspecLockDep struct {
 	Source      string `json:"source"`
+	Dependency  string `json:"dependency,omitempty"` // "direct main", "direct dev" or "transitive"
+	Version     string `json:"version,omitempty"`
 	ResolvedRef string `json:"resolved_ref,omitempty"`
 	URL         string `json:"url,omitempty"`
 }
 
+// DepOverview summarizes a single pubspec.yaml dependency for display,
+// classifying its source and whether it is overridden (see the badge
+// rendering in the manage/remove screen)
+type DepOverview struct {
+	Name       string `json:"name"`
+	Source     string `json:"source"` // "git", "hosted", "path" or "sdk"
+	URL        string `json:"url,omitempty"`
+	Ref        string `json:"ref,omitempty"`
+	Path       string `json:"path,omitempty"`
+	Constraint string `json:"constraint,omitempty"` // Hosted version constraint
+	Overridden bool   `json:"overridden,omitempty"` // Listed under dependency_overrides
+}
+
 // BackupInfo represents information about a backup
 type BackupInfo struct {
 	OriginalPath string    `json:"original_path"`
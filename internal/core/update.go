@@ -0,0 +1,255 @@
+// Package core/update.go - Targeted Single-Dependency Update
+//
+// This file implements `flutter-pm update <package>`: the smallest unit of
+// the express update, usable from scripts and git hooks. The target ref
+// comes from --ref, from the repository's highest version tag with
+// --latest-tag, or defaults to refreshing the currently pinned ref. The
+// pubspec entry is updated through pub add (never YAML surgery), the lock
+// follows via pub resolution, and the result reports the old -> new
+// SHA/ref diff.
+//
+// Key features:
+// - UpdateSingleDependency: Update one git dependency with old -> new diff
+// - GitLatestTag: Resolve the highest version tag via git ls-remote
+
+package core
+
+import (
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// UpdateSingleDependency updates one git dependency in a project. With
+// newRef the dependency is re-pinned to that ref; with latestTag the
+// highest version tag is resolved first; with neither, the current ref is
+// refreshed to its newest commit.
+func UpdateSingleDependency(logger *Logger, cfg *Config, projectPath, pkgName, newRef string, latestTag bool) ActionResult {
+	deps, err := ListGitDependencies(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+
+	var spec *PkgSpec
+	for i := range deps {
+		if deps[i].Name == pkgName {
+			spec = &deps[i]
+			break
+		}
+	}
+	if spec == nil {
+		return ActionResult{
+			OK:  false,
+			Err: fmt.Sprintf("%s is not a git dependency in %s", pkgName, projectPath),
+		}
+	}
+
+	oldRef := spec.Ref
+	oldSHA := lockedSHA(projectPath, pkgName)
+
+	// Resolve the target ref
+	targetRef := newRef
+	if latestTag {
+		tag, err := GitLatestTag(spec.URL)
+		if err != nil {
+			return ActionResult{
+				OK:  false,
+				Err: fmt.Sprintf("failed to resolve latest tag for %s: %v", pkgName, err),
+			}
+		}
+		targetRef = tag
+		logger.Info("update", fmt.Sprintf("Latest tag for %s is %s", pkgName, tag))
+	}
+	if targetRef == "" {
+		targetRef = oldRef
+	}
+
+	logger.Narrate(fmt.Sprintf("Updating %s to %s", pkgName, describeRef(targetRef)))
+
+	// Create backup before updating
+	if !cfg.DryRun {
+		if backup, err := CreateTrackedBackup(logger, projectPath, "update"); err != nil {
+			logger.Error("backup", err)
+		} else {
+			logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+		}
+	}
+
+	var result ActionResult
+	if targetRef != oldRef {
+		// Re-pin through pub add, same pipeline as adding (policy checks,
+		// conflict analysis and resolution included)
+		updated := *spec
+		updated.Ref = targetRef
+		result = AddGitDependency(logger, cfg, projectPath, updated, true)
+	} else {
+		// Same ref: refresh the locked commit via pub upgrade
+		result = pubUpgradePackage(logger, cfg, projectPath, pkgName)
+	}
+	if !result.OK {
+		return result
+	}
+
+	newSHA := lockedSHA(projectPath, pkgName)
+	message := fmt.Sprintf("Updated %s: %s -> %s",
+		pkgName, describeRefSHA(oldRef, oldSHA), describeRefSHA(targetRef, newSHA))
+	if cfg.DryRun {
+		message = fmt.Sprintf("Would update %s: %s -> %s",
+			pkgName, describeRefSHA(oldRef, oldSHA), describeRef(targetRef))
+	} else if oldSHA != "" && oldSHA == newSHA && targetRef == oldRef {
+		message = fmt.Sprintf("%s is already up to date (%s)", pkgName, describeRefSHA(oldRef, oldSHA))
+	}
+
+	staleCache.InvalidateProject(projectPath)
+
+	return ActionResult{
+		OK:      true,
+		Message: message,
+		Logs:    result.Logs,
+		Data: map[string]interface{}{
+			"package": pkgName,
+			"old_ref": oldRef,
+			"new_ref": targetRef,
+			"old_sha": oldSHA,
+			"new_sha": newSHA,
+		},
+	}
+}
+
+// pubUpgradePackage runs `pub upgrade <package>` to refresh one dependency
+func pubUpgradePackage(logger *Logger, cfg *Config, projectPath, pkgName string) ActionResult {
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return ActionResult{
+			OK:  false,
+			Err: err.Error(),
+		}
+	}
+
+	args := append(toolArgs, "pub", "upgrade", pkgName)
+	logger.LogCommand("update", tool, args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would execute: %s %s", tool, strings.Join(args, " ")),
+		}
+	}
+
+	cmd := exec.Command(tool, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	output, err := cmd.CombinedOutput()
+	logs := []string{strings.TrimSpace(string(output))}
+
+	if err != nil {
+		return ActionResult{
+			OK:   false,
+			Err:  err.Error(),
+			Logs: logs,
+		}
+	}
+
+	return ActionResult{
+		OK:      true,
+		Message: fmt.Sprintf("Upgraded %s", pkgName),
+		Logs:    logs,
+	}
+}
+
+// lockedSHA returns the resolved commit for a package from pubspec.lock,
+// truncated to 7 characters; empty when unknown
+func lockedSHA(projectPath, pkgName string) string {
+	lock, err := parsePubspecLock(filepath.Join(projectPath, "pubspec.lock"))
+	if err != nil {
+		return ""
+	}
+	dep, ok := lock.Dependencies[pkgName]
+	if !ok || dep.Source != "git" {
+		return ""
+	}
+	sha := dep.ResolvedRef
+	if len(sha) > 7 {
+		sha = sha[:7]
+	}
+	return sha
+}
+
+// describeRef renders a ref for messages, treating empty as default branch
+func describeRef(ref string) string {
+	if ref == "" {
+		return "default branch"
+	}
+	return ref
+}
+
+// describeRefSHA renders "ref (sha)" with graceful fallbacks
+func describeRefSHA(ref, sha string) string {
+	if sha == "" {
+		return describeRef(ref)
+	}
+	return fmt.Sprintf("%s (%s)", describeRef(ref), sha)
+}
+
+// GitLatestTag returns the highest version-shaped tag of a repository,
+// resolved via git ls-remote so no clone is needed
+func GitLatestTag(url string) (string, error) {
+	tags, err := gitVersionTags(url)
+	if err != nil {
+		return "", err
+	}
+	if len(tags) == 0 {
+		return "", fmt.Errorf("no version tags found")
+	}
+	return tags[len(tags)-1], nil
+}
+
+// versionTagParts parses a version-shaped tag (v1.2.3 or 1.2.3) into its
+// numeric components; nil means the tag is not version-shaped
+func versionTagParts(tag string) []int {
+	candidate := strings.TrimPrefix(tag, "v")
+	// Ignore pre-release/build suffixes for ordering purposes
+	if idx := strings.IndexAny(candidate, "-+"); idx > 0 {
+		candidate = candidate[:idx]
+	}
+
+	segments := strings.Split(candidate, ".")
+	if len(segments) < 2 {
+		return nil
+	}
+	parts := make([]int, 0, len(segments))
+	for _, segment := range segments {
+		n, err := strconv.Atoi(segment)
+		if err != nil {
+			return nil
+		}
+		parts = append(parts, n)
+	}
+	return parts
+}
+
+// compareVersionTags orders two version-shaped tags numerically
+func compareVersionTags(a, b string) int {
+	aParts := versionTagParts(a)
+	bParts := versionTagParts(b)
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		aVal, bVal := 0, 0
+		if i < len(aParts) {
+			aVal = aParts[i]
+		}
+		if i < len(bParts) {
+			bVal = bParts[i]
+		}
+		if aVal != bVal {
+			return aVal - bVal
+		}
+	}
+	return 0
+}
@@ -0,0 +1,76 @@
+package core
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestVersionTagParts verifies version-shaped tag recognition
+func TestVersionTagParts(t *testing.T) {
+	tests := []struct {
+		tag     string
+		version bool
+	}{
+		{"v1.2.3", true},
+		{"1.2.3", true},
+		{"v2.0.0-beta.1", true},
+		{"v10.0", true},
+		{"main", false},
+		{"release", false},
+		{"v1", false},
+	}
+
+	for _, tt := range tests {
+		parts := versionTagParts(tt.tag)
+		if tt.version && parts == nil {
+			t.Errorf("tag %q: expected version shape", tt.tag)
+		}
+		if !tt.version && parts != nil {
+			t.Errorf("tag %q: unexpected version shape %v", tt.tag, parts)
+		}
+	}
+}
+
+// TestCompareVersionTags verifies numeric ordering (not lexicographic)
+func TestCompareVersionTags(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int // sign of the comparison
+	}{
+		{"v1.2.3", "v1.2.4", -1},
+		{"v1.10.0", "v1.9.0", 1},
+		{"v2.0", "v2.0.0", 0},
+		{"1.0.0", "v1.0.0", 0},
+	}
+
+	for _, tt := range tests {
+		got := compareVersionTags(tt.a, tt.b)
+		switch {
+		case tt.want < 0 && got >= 0,
+			tt.want > 0 && got <= 0,
+			tt.want == 0 && got != 0:
+			t.Errorf("compareVersionTags(%q, %q) = %d, want sign %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+// TestUpdateSingleDependencyUnknownPackage verifies a clear error when the
+// package is not a git dependency
+func TestUpdateSingleDependencyUnknownPackage(t *testing.T) {
+	cfg := Config{Quiet: true}
+	logger := NewLogger(&cfg)
+
+	projectPath := t.TempDir()
+	writePubspecFixture(t, projectPath, `name: test_app
+dependencies:
+  http: ^1.2.0
+`)
+
+	result := UpdateSingleDependency(logger, &cfg, projectPath, "missing_pkg", "", false)
+	if result.OK {
+		t.Fatal("expected failure for unknown package")
+	}
+	if !strings.Contains(result.Err, "missing_pkg") || !strings.Contains(result.Err, "not a git dependency") {
+		t.Errorf("error = %q, want package name and explanation", result.Err)
+	}
+}
@@ -0,0 +1,144 @@
+// Package core/verify.go - Post-Install Verification Hooks
+//
+// This file implements optional verification steps that run after packages
+// have been added and resolved: `dart analyze` and `flutter test`. A bad
+// dependency bump often resolves cleanly but breaks the build or the test
+// suite - running the hooks immediately after installation surfaces that on
+// the results screen instead of at the next manual build.
+//
+// The hooks are configured per project in .flutter-pm.yaml (the same file
+// core/projectenv.go reads), under the `verify:` key:
+//
+//	verify:
+//	  analyze: true
+//	  test: true
+//
+// Both default to off, so projects without a configuration are unaffected.
+//
+// Key features:
+// - LoadVerifyConfig: Parse the verify block from .flutter-pm.yaml
+// - RunPostInstallVerification: Run the enabled hooks, one ActionResult each
+// - FVM-aware tool selection, matching every other pub invocation
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// VerifyConfig selects which post-install verification hooks run for a
+// project. The zero value disables both.
+type VerifyConfig struct {
+	Analyze bool `yaml:"analyze"` // Run `dart analyze` after installation
+	Test    bool `yaml:"test"`    // Run `flutter test` / `dart test` after installation
+}
+
+// Enabled reports whether any verification hook is configured to run
+func (v VerifyConfig) Enabled() bool {
+	return v.Analyze || v.Test
+}
+
+// verifyConfigYAML models the verify block of .flutter-pm.yaml
+type verifyConfigYAML struct {
+	Verify VerifyConfig `yaml:"verify"`
+}
+
+// LoadVerifyConfig reads the verify block from .flutter-pm.yaml in
+// projectPath. A missing or unreadable file simply disables the hooks -
+// verification is opt-in and must never block an installation.
+func LoadVerifyConfig(projectPath string) VerifyConfig {
+	content, err := os.ReadFile(filepath.Join(projectPath, ProjectEnvFileName))
+	if err != nil {
+		return VerifyConfig{}
+	}
+
+	var config verifyConfigYAML
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return VerifyConfig{}
+	}
+	return config.Verify
+}
+
+// RunPostInstallVerification runs the verification hooks enabled in the
+// project's .flutter-pm.yaml and returns one result per hook, in run order.
+// Projects without a verify block return nil. A failing hook produces a
+// failed ActionResult carrying the tool output, but never aborts the caller -
+// the packages are already installed and the user decides what to do next.
+func RunPostInstallVerification(ctx context.Context, logger *Logger, cfg *Config, projectPath string) []ActionResult {
+	verify := LoadVerifyConfig(projectPath)
+	if !verify.Enabled() {
+		return nil
+	}
+
+	tool, toolArgs, err := FindPubToolFor(projectPath)
+	if err != nil {
+		return []ActionResult{{
+			OK:  false,
+			Err: fmt.Sprintf("verification skipped: %v", err),
+		}}
+	}
+
+	var results []ActionResult
+	if verify.Analyze {
+		results = append(results, runVerifyHook(ctx, logger, cfg, projectPath,
+			"dart analyze", tool, append(toolArgs, "analyze")))
+	}
+	if verify.Test {
+		// `flutter test` for flutter projects, `dart test` for pure dart -
+		// both tools expose the same subcommand
+		results = append(results, runVerifyHook(ctx, logger, cfg, projectPath,
+			tool+" test", tool, append(toolArgs, "test")))
+	}
+	return results
+}
+
+// runVerifyHook executes one verification command in the project directory
+// and wraps the outcome in an ActionResult tagged as a verification step
+func runVerifyHook(ctx context.Context, logger *Logger, cfg *Config, projectPath, label, tool string, args []string) ActionResult {
+	ctx, cancel := withDefaultTimeout(ctx, DefaultPubTimeout)
+	defer cancel()
+
+	logger.LogCommand("verify", tool, args)
+
+	if cfg.DryRun {
+		return ActionResult{
+			OK:      true,
+			Message: fmt.Sprintf("Would run %s", label),
+			Logs:    []string{fmt.Sprintf("DRY RUN: %s %s", tool, strings.Join(args, " "))},
+		}
+	}
+
+	reportProgress("verify", -1, fmt.Sprintf("Running %s", label))
+	cmd := exec.CommandContext(ctx, tool, args...)
+	cmd.Dir = projectPath
+	ApplyProjectEnv(logger, cmd)
+
+	output, err := runCommandStreaming(cmd)
+	logs := []string{strings.TrimSpace(string(output))}
+
+	result := ActionResult{
+		Data: map[string]interface{}{
+			"verification": label,
+		},
+		Logs: logs,
+	}
+	if err != nil {
+		result.OK = false
+		result.Err = fmt.Sprintf("%s failed - the new dependencies likely broke the project: %s",
+			label, contextFailure(ctx, label, err))
+		logger.Info("verify", fmt.Sprintf("❌ %s failed", label))
+		return result
+	}
+
+	result.OK = true
+	result.Message = fmt.Sprintf("%s passed", label)
+	logger.Info("verify", fmt.Sprintf("✅ %s passed", label))
+	return result
+}
@@ -0,0 +1,61 @@
+package core
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadVerifyConfig(t *testing.T) {
+	projectPath := t.TempDir()
+
+	// No config file means both hooks are off
+	if config := LoadVerifyConfig(projectPath); config.Enabled() {
+		t.Errorf("missing config should disable hooks, got %+v", config)
+	}
+
+	configPath := filepath.Join(projectPath, ProjectEnvFileName)
+	content := `env:
+  PUB_HOSTED_URL: https://pub.example.com
+verify:
+  analyze: true
+  test: true
+`
+	if err := os.WriteFile(configPath, []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	config := LoadVerifyConfig(projectPath)
+	if !config.Analyze || !config.Test {
+		t.Errorf("both hooks should be enabled, got %+v", config)
+	}
+
+	// Analyze only
+	if err := os.WriteFile(configPath, []byte("verify:\n  analyze: true\n"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	config = LoadVerifyConfig(projectPath)
+	if !config.Analyze || config.Test {
+		t.Errorf("only analyze should be enabled, got %+v", config)
+	}
+
+	// A broken file disables the hooks rather than failing
+	if err := os.WriteFile(configPath, []byte("verify: [not: a map"), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+	if config := LoadVerifyConfig(projectPath); config.Enabled() {
+		t.Errorf("broken config should disable hooks, got %+v", config)
+	}
+}
+
+func TestRunPostInstallVerificationDisabled(t *testing.T) {
+	logger := NewLogger(&Config{Quiet: true})
+	projectPath := t.TempDir()
+
+	// No verify block: nothing runs, nothing is reported
+	results := RunPostInstallVerification(context.Background(), logger, &Config{Quiet: true}, projectPath)
+	if results != nil {
+		t.Errorf("expected no results without a verify block, got %v", results)
+	}
+}
@@ -0,0 +1,161 @@
+// Package core/workspace.go - Monorepo / Melos Workspace Support
+//
+// This file implements workspace detection for monorepos that keep several
+// packages in one repository. A directory is treated as a workspace when it
+// contains a melos.yaml (package globs are honoured) or when multiple
+// pubspec.yaml files live in its subdirectories (the packages/* convention).
+// Member packages are surfaced as individual projects in discovery so the
+// TUI can target any of them, and AddGitDependencyToPackages applies the
+// same git dependency to several workspace packages in one run.
+//
+// Key features:
+// - DetectWorkspace: Identify melos/multi-package workspaces and list members
+// - Melos package glob parsing from melos.yaml
+// - AddGitDependencyToPackages: Batch-apply one dependency across packages
+
+package core
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MelosFileName is the melos workspace configuration file
+const MelosFileName = "melos.yaml"
+
+// Workspace represents a monorepo root with its member packages
+type Workspace struct {
+	Root     string    `json:"root"`
+	HasMelos bool      `json:"has_melos"`
+	Packages []Project `json:"packages"`
+}
+
+// melosYAML models the parts of melos.yaml we read here
+type melosYAML struct {
+	Name     string   `json:"name" yaml:"name"`
+	Packages []string `json:"packages" yaml:"packages"`
+}
+
+// DetectWorkspace checks whether root is a monorepo workspace and returns
+// its member packages. It returns (nil, nil) for single-package projects so
+// callers can branch without error handling.
+func DetectWorkspace(root string) (*Workspace, error) {
+	melosPath := filepath.Join(root, MelosFileName)
+	if _, err := os.Stat(melosPath); err == nil {
+		packages, err := melosPackages(root, melosPath)
+		if err != nil {
+			return nil, err
+		}
+		return &Workspace{Root: root, HasMelos: true, Packages: packages}, nil
+	}
+
+	// No melos.yaml: fall back to the packages/* convention. Two or more
+	// member pubspecs make this a workspace; one nested package is just a
+	// subdirectory.
+	packages := scanWorkspaceMembers(root)
+	if len(packages) < 2 {
+		return nil, nil
+	}
+	return &Workspace{Root: root, Packages: packages}, nil
+}
+
+// melosPackages resolves the package globs from melos.yaml to member
+// projects. Missing or empty globs fall back to the packages/* scan.
+func melosPackages(root, melosPath string) ([]Project, error) {
+	content, err := os.ReadFile(melosPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", MelosFileName, err)
+	}
+
+	var config melosYAML
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", MelosFileName, err)
+	}
+
+	if len(config.Packages) == 0 {
+		return scanWorkspaceMembers(root), nil
+	}
+
+	var packages []Project
+	seen := make(map[string]bool)
+	for _, pattern := range config.Packages {
+		// Globs are relative to the workspace root ("packages/*", "apps/**")
+		matches, err := filepath.Glob(filepath.Join(root, filepath.FromSlash(pattern)))
+		if err != nil {
+			continue // Bad glob: skip it rather than failing the whole parse
+		}
+		for _, match := range matches {
+			if project, ok := projectAt(match); ok && !seen[project.Path] {
+				seen[project.Path] = true
+				packages = append(packages, project)
+			}
+		}
+	}
+
+	return packages, nil
+}
+
+// scanWorkspaceMembers finds member packages in the conventional monorepo
+// directories (packages/, apps/, modules/) one level below the root
+func scanWorkspaceMembers(root string) []Project {
+	var packages []Project
+	for _, container := range []string{"packages", "apps", "modules"} {
+		containerPath := filepath.Join(root, container)
+		entries, err := os.ReadDir(containerPath)
+		if err != nil {
+			continue
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+				continue
+			}
+			if project, ok := projectAt(filepath.Join(containerPath, entry.Name())); ok {
+				packages = append(packages, project)
+			}
+		}
+	}
+	return packages
+}
+
+// projectAt returns the project rooted at dir when it holds a pubspec.yaml
+func projectAt(dir string) (Project, bool) {
+	pubspecPath := filepath.Join(dir, "pubspec.yaml")
+	if _, err := os.Stat(pubspecPath); err != nil {
+		return Project{}, false
+	}
+
+	project := Project{
+		Path:        dir,
+		PubspecPath: pubspecPath,
+	}
+	if name, err := extractProjectName(pubspecPath); err == nil {
+		project.Name = name
+	}
+	return project, true
+}
+
+// AddGitDependencyToPackages applies the same git dependency to several
+// workspace packages in one run, creating a backup per package first. One
+// ActionResult is returned per package, in order.
+func AddGitDependencyToPackages(logger *Logger, cfg *Config, packages []Project, spec PkgSpec) []ActionResult {
+	results := make([]ActionResult, 0, len(packages))
+	for i, pkg := range packages {
+		reportProgress("add", i*100/len(packages), fmt.Sprintf("Adding %s to %s", spec.Name, pkg.Name))
+		logger.Info("workspace", fmt.Sprintf("Adding %s to %s", spec.Name, pkg.Name))
+
+		if !cfg.DryRun {
+			if backup, err := CreateTrackedBackup(logger, pkg.Path, "add"); err != nil {
+				logger.Error("backup", err)
+			} else {
+				logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+			}
+		}
+
+		results = append(results, AddGitDependency(logger, cfg, pkg.Path, spec, true))
+	}
+	return results
+}
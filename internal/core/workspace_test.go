@@ -0,0 +1,94 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeWorkspacePackage creates a member package with a named pubspec.yaml
+func writeWorkspacePackage(t *testing.T, root, relPath, name string) {
+	t.Helper()
+	dir := filepath.Join(root, filepath.FromSlash(relPath))
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatalf("failed to create package dir: %v", err)
+	}
+	pubspec := "name: " + name + "\nversion: 1.0.0\n"
+	if err := os.WriteFile(filepath.Join(dir, "pubspec.yaml"), []byte(pubspec), 0644); err != nil {
+		t.Fatalf("failed to write pubspec fixture: %v", err)
+	}
+}
+
+// TestDetectWorkspaceMelos verifies that melos.yaml package globs resolve
+// to member projects
+func TestDetectWorkspaceMelos(t *testing.T) {
+	root := t.TempDir()
+	melos := "name: my_workspace\npackages:\n  - packages/*\n  - apps/*\n"
+	if err := os.WriteFile(filepath.Join(root, MelosFileName), []byte(melos), 0644); err != nil {
+		t.Fatalf("failed to write melos fixture: %v", err)
+	}
+	writeWorkspacePackage(t, root, "packages/core_lib", "core_lib")
+	writeWorkspacePackage(t, root, "packages/ui_kit", "ui_kit")
+	writeWorkspacePackage(t, root, "apps/main_app", "main_app")
+
+	ws, err := DetectWorkspace(root)
+	if err != nil {
+		t.Fatalf("DetectWorkspace failed: %v", err)
+	}
+	if ws == nil {
+		t.Fatal("expected a workspace, got nil")
+	}
+	if !ws.HasMelos {
+		t.Error("expected HasMelos to be true")
+	}
+	if len(ws.Packages) != 3 {
+		t.Fatalf("expected 3 member packages, got %d", len(ws.Packages))
+	}
+
+	names := make(map[string]bool)
+	for _, pkg := range ws.Packages {
+		names[pkg.Name] = true
+	}
+	for _, want := range []string{"core_lib", "ui_kit", "main_app"} {
+		if !names[want] {
+			t.Errorf("expected member package %q, got %v", want, names)
+		}
+	}
+}
+
+// TestDetectWorkspacePackagesConvention verifies the packages/* fallback
+// when no melos.yaml is present
+func TestDetectWorkspacePackagesConvention(t *testing.T) {
+	root := t.TempDir()
+	writeWorkspacePackage(t, root, "packages/feature_a", "feature_a")
+	writeWorkspacePackage(t, root, "packages/feature_b", "feature_b")
+
+	ws, err := DetectWorkspace(root)
+	if err != nil {
+		t.Fatalf("DetectWorkspace failed: %v", err)
+	}
+	if ws == nil {
+		t.Fatal("expected a workspace, got nil")
+	}
+	if ws.HasMelos {
+		t.Error("expected HasMelos to be false")
+	}
+	if len(ws.Packages) != 2 {
+		t.Fatalf("expected 2 member packages, got %d", len(ws.Packages))
+	}
+}
+
+// TestDetectWorkspaceSinglePackage verifies that ordinary projects (at most
+// one nested package) are not treated as workspaces
+func TestDetectWorkspaceSinglePackage(t *testing.T) {
+	root := t.TempDir()
+	writeWorkspacePackage(t, root, "packages/only_one", "only_one")
+
+	ws, err := DetectWorkspace(root)
+	if err != nil {
+		t.Fatalf("DetectWorkspace failed: %v", err)
+	}
+	if ws != nil {
+		t.Errorf("expected nil workspace for single nested package, got %+v", ws)
+	}
+}
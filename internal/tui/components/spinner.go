@@ -0,0 +1,32 @@
+// Package components provides shared, pre-configured bubbles widgets used
+// across the TUI screens. Before this package every screen built its own
+// spinner with copy-pasted frames and styling, which drifted apart (one
+// loader used a different color) and made tick rates inconsistent.
+// Screens should take widgets from here instead of configuring bubbles
+// primitives inline.
+package components
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// spinnerInterval is the shared frame interval for every spinner in the
+// TUI. One consistent rate keeps concurrent spinners in step and avoids
+// extra render wakeups from mixed tick cadences.
+const spinnerInterval = time.Second / 10
+
+// NewSpinner returns the TUI's standard spinner: dot frames at the shared
+// tick rate, styled in the theme accent color
+func NewSpinner() spinner.Model {
+	s := spinner.New()
+	s.Spinner = spinner.Spinner{
+		Frames: spinner.Dot.Frames,
+		FPS:    spinnerInterval,
+	}
+	s.Style = lipgloss.NewStyle().Foreground(theme.Accent())
+	return s
+}
@@ -7,8 +7,15 @@
 package models
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // AppScreen represents the current screen/model being displayed
@@ -32,6 +39,22 @@ const (
 	ScreenSearchConfig // NEW: Configure repository search filters
 	ScreenForceUpdate  // NEW: Force update stale packages
 	ScreenSelfUpdate   // NEW: Update Flutter-PM itself
+	ScreenPluginRun    // NEW: Run an external plugin and show its result
+	ScreenPubDevSearch // NEW: Search pub.dev and add hosted dependencies
+	ScreenRemoveDeps   // NEW: Multi-select removal of git dependencies
+	ScreenUpdatePlan   // NEW: Lockfile-aware update plan (read-only)
+	ScreenOverrides    // NEW: List and clean up dependency_overrides
+	ScreenRefSwitch    // NEW: Switch a git dependency's pinned ref (branch/tag picker)
+	ScreenGitHubAuth   // NEW: Device-flow GitHub sign-in when gh and tokens are unavailable
+	ScreenCommandPane  // NEW: Run ad-hoc allowlisted commands in the project directory
+	ScreenLogViewer    // NEW: Browse per-session structured log files
+	ScreenLicenses     // NEW: Dependency license report with copyleft warnings
+	ScreenOutdated     // NEW: Outdated hosted packages report with multi-select upgrade
+	ScreenTemplateRepo // NEW: Template repository picker for new project creation
+	ScreenEnvironment  // NEW: Environment diagnostics (tools, gh auth, network, permissions)
+	ScreenStats        // NEW: Local operation timings and cache hit rates (see core/metrics.go)
+	ScreenBundles      // NEW: Install a named dependency bundle from the config (see core/bundles.go)
+	ScreenResumeSession // NEW: Pseudo-screen that restores a saved session snapshot
 	ScreenError
 )
 
@@ -43,9 +66,21 @@ type AppModel struct {
 
 	// Screen management
 	currentScreen AppScreen
+	screenStack   []AppScreen // Navigation history for esc/back (see navigateBack)
+	navigatingBack bool       // Suppresses history pushes while popping
 	width         int
 	height        int
 
+	// Keyboard shortcuts: active bindings (with config overrides) and the
+	// "?" help overlay state (see keymap.go)
+	keyMap   AppKeyMap
+	helpView help.Model
+	showHelp bool
+
+	// Command palette overlay (ctrl+p, see palette.go)
+	palette     *PaletteModel
+	showPalette bool
+
 	// Screen models
 	splash              tea.Model // NEW: Splash screen with prerequisites check
 	mainMenu            tea.Model
@@ -64,6 +99,21 @@ type AppModel struct {
 	searchConfig        tea.Model // NEW: Configure search filters
 	forceUpdate         tea.Model // NEW: Force update packages
 	selfUpdate          tea.Model // NEW: Self-update Flutter-PM
+	pluginRun           tea.Model // NEW: Run external plugin
+	pubDevSearch        tea.Model // NEW: Search pub.dev packages
+	removeDeps          tea.Model // NEW: Remove git dependencies
+	updatePlan          tea.Model // NEW: Lockfile-aware update plan
+	overrides           tea.Model // NEW: Manage dependency_overrides
+	refSwitch           tea.Model // NEW: Branch/tag picker for re-pinning a git dependency
+	gitHubAuth          tea.Model // NEW: Device-flow GitHub sign-in
+	commandPane         tea.Model // NEW: Ad-hoc command pane
+	logViewer           tea.Model // NEW: Session log viewer
+	licenseReport       tea.Model // NEW: Dependency license report
+	outdatedReport      tea.Model // NEW: Outdated hosted packages report
+	templateRepo        tea.Model // NEW: Template repository loading screen
+	environment         tea.Model // NEW: Environment diagnostics screen
+	stats               tea.Model // NEW: Local metrics screen
+	bundleInstall       tea.Model // NEW: Dependency bundle install screen
 	errorScreen         tea.Model
 
 	// Shared application state
@@ -84,9 +134,19 @@ type AppState struct {
 	LocalPubspecAvailable bool                 // Whether local pubspec was found
 	HasGitDeps            bool                 // Whether project has git dependencies
 
+	// Additional target projects for multi-project adds: the chosen packages
+	// are applied to each of these pubspecs as well as the primary project
+	// (selected with space on the scan screen)
+	TargetProjects []core.Project
+
 	// Available source projects (for selection)
 	AvailableSourceRepos []core.RepoCandidate // Available Flutter projects to work on
 
+	// Whether the source flow started from the template picker: the
+	// execution screen then rewrites the clone's identity (see
+	// core/template.go)
+	TemplateMode bool
+
 	// Dependencies (packages to ADD to the source project)
 	AvailableDependencies []core.RepoCandidate // Available packages to add as dependencies
 	SelectedDependencies  []core.RepoCandidate // Selected packages to add to pubspec
@@ -97,9 +157,44 @@ type AppState struct {
 	// Operation results
 	Results []core.ActionResult
 
+	// Smart recommendations generated after execution
+	Recommendations []core.Reco
+
+	// Fixes picked on the results screen, applied by the execution screen
+	// (see core/recofix.go)
+	PendingFixes []core.RecoFixAction
+
+	// Security advisories found by the post-install OSV.dev audit
+	// (see core/audit.go)
+	AuditFindings []core.AuditFinding
+
+	// Repository source provider (GitHub by default; Bitbucket or plain git
+	// URLs flow through the same interface)
+	RepoSource core.RepoProvider
+
+	// External plugins (discovered in ~/.config/flutter-pm/plugins/)
+	AvailablePlugins  []core.Plugin // Plugins registered as menu actions
+	SelectedPlugin    *core.Plugin  // Plugin chosen from the main menu
+	PluginsDiscovered bool          // Whether plugin discovery already ran
+
+	// Saved mid-flow snapshot offered as "Resume previous session" on the
+	// main menu (see core/sessionstate.go)
+	ResumeState   *core.SessionState
+	ResumeChecked bool // Whether the snapshot lookup already ran
+
+	// Recently used projects shown at the top of the main menu
+	// (see core/recent.go)
+	RecentProjects []core.RecentProject
+	RecentChecked  bool // Whether the MRU list was already loaded
+
 	// User choices
 	ProjectSourceChoice int // 1-6 from shell script menu
 
+	// Dry-run preview toggled on the confirmation screen ('d'): the
+	// execution screen then simulates every step and offers an
+	// "apply for real" shortcut afterwards. Seeded from --dry-run.
+	DryRunPreview bool
+
 	// Search configuration (used by scan_directories)
 	SearchPaths    []string // Custom search paths configured by user
 	SearchDepth    int      // Directory search depth (levels)
@@ -112,9 +207,28 @@ type ScreenTransitionMsg struct {
 	Data   interface{} // Optional data to pass to the new screen
 }
 
+// NavigateBackMsg is sent when a screen wants to return to whatever screen
+// the user came from (esc/q), instead of hard-coding a destination
+type NavigateBackMsg struct{}
+
+// NavigateBack returns a command that pops the navigation stack. Screens use
+// this for their esc/back handling so nested flows unwind one step at a time.
+func NavigateBack() tea.Cmd {
+	return func() tea.Msg {
+		return NavigateBackMsg{}
+	}
+}
+
 // NewAppModel creates a new main application coordinator
 func NewAppModel(cfg core.Config, logger *core.Logger) *AppModel {
 	sharedState := &AppState{}
+	// Seed search settings from the global config, including values
+	// migrated from the shell script (see core/migration.go)
+	if settings, err := core.LoadSearchSettings(); err == nil && settings != nil {
+		sharedState.SearchPaths = settings.Dirs
+		sharedState.SearchDepth = settings.Depth
+		sharedState.FullDiskSearch = settings.FullDisk
+	}
 	cacheWarmer := core.NewCacheWarmer(logger, &cfg)
 
 	return &AppModel{
@@ -123,6 +237,8 @@ func NewAppModel(cfg core.Config, logger *core.Logger) *AppModel {
 		currentScreen: ScreenSplash, // Start with splash screen
 		SharedState:   sharedState,
 		cacheWarmer:   cacheWarmer,
+		keyMap:        LoadAppKeyMap(logger),
+		helpView:      help.New(),
 	}
 }
 
@@ -143,18 +259,61 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		setLayoutWidth(msg.Width)
 		// Pass size to current screen
 		return m.updateCurrentScreen(msg)
 
 	case ScreenTransitionMsg:
 		return m.transitionToScreen(msg.Screen, msg.Data)
 
+	case NavigateBackMsg:
+		return m.navigateBack()
+
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case tea.KeyMsg:
 		if msg.String() == "ctrl+c" {
 			// Stop cache warmer before quitting
 			m.cacheWarmer.Stop()
 			return m, tea.Quit
 		}
+
+		// Command palette overlay: route keys to it while open; ctrl+p is
+		// a control chord so it's safe to open even on text-input screens
+		if m.showPalette {
+			cmd, done := m.palette.Handle(msg)
+			if done {
+				m.showPalette = false
+				m.palette = nil
+			}
+			return m, cmd
+		}
+		if key.Matches(msg, m.keyMap.Palette) && m.currentScreen != ScreenSplash {
+			m.palette = NewPaletteModel(m.SharedState)
+			m.showPalette = true
+			return m, nil
+		}
+
+		// Help overlay: swallow keys while open, any close key dismisses
+		if m.showHelp {
+			switch msg.String() {
+			case "?", "esc", "q", "enter":
+				m.showHelp = false
+			}
+			return m, nil
+		}
+		if msg.String() == "?" && m.plainKeyContext() {
+			m.showHelp = true
+			return m, nil
+		}
+
+		// Apply keymap aliases before the screen matches on the key, but
+		// never while the user is typing into an input
+		if m.plainKeyContext() {
+			msg = m.keyMap.translateKey(msg)
+		}
+
 		// Pass other keys to current screen
 		return m.updateCurrentScreen(msg)
 
@@ -164,8 +323,118 @@ func (m *AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	}
 }
 
-// View renders the current screen
+// View renders the current screen, with the navigation breadcrumb trail
+// above it when the user is inside a nested flow
 func (m *AppModel) View() string {
+	if m.showPalette {
+		return m.palette.View()
+	}
+	if m.showHelp {
+		return m.helpOverlayView()
+	}
+
+	view := m.screenView()
+	if crumbs := m.breadcrumbs(); crumbs != "" {
+		return crumbs + "\n" + view
+	}
+	return view
+}
+
+// handleMouse routes mouse events. Screens with their own mouse handling
+// (viewports, clickable lists) get the raw event; everywhere else the wheel
+// is translated to cursor movement so any list screen scrolls.
+func (m *AppModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if m.showHelp || m.showPalette {
+		return m, nil
+	}
+
+	// The breadcrumb line shifts the screen down one row, so adjust click
+	// coordinates to screen-relative ones
+	if m.breadcrumbs() != "" {
+		msg.Y--
+	}
+
+	switch m.currentScreen {
+	case ScreenCommandPane, ScreenExecution, ScreenLicenses, ScreenLogViewer, ScreenResults, ScreenStats:
+		// Viewport screens scroll natively
+		return m.updateCurrentScreen(msg)
+	}
+
+	if msg.Action == tea.MouseActionPress {
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			return m.updateCurrentScreen(tea.KeyMsg{Type: tea.KeyUp})
+		case tea.MouseButtonWheelDown:
+			return m.updateCurrentScreen(tea.KeyMsg{Type: tea.KeyDown})
+		}
+	}
+
+	// Clicks go to the screen; click-to-select screens handle tea.MouseMsg
+	return m.updateCurrentScreen(msg)
+}
+
+// plainKeyContext reports whether global shortcuts (help overlay, keymap
+// aliases) may intercept keys: false on screens with text inputs and while
+// a list's fuzzy filter is capturing typing
+func (m *AppModel) plainKeyContext() bool {
+	switch m.currentScreen {
+	case ScreenSplash, ScreenCommandPane, ScreenPubDevSearch, ScreenSearchConfig,
+		ScreenSourceConfig, ScreenConfiguration, ScreenConflictResolver, ScreenExecution:
+		return false
+	}
+	return !m.filterActive()
+}
+
+// filterActive reports whether the current screen's fuzzy filter input is
+// open and capturing raw typing
+func (m *AppModel) filterActive() bool {
+	var active tea.Model
+	switch m.currentScreen {
+	case ScreenSourceSelection:
+		active = m.sourceSelection
+	case ScreenDependencySelection:
+		active = m.dependencySelection
+	default:
+		return false
+	}
+	if probe, ok := active.(interface{ FilterActive() bool }); ok {
+		return probe.FilterActive()
+	}
+	return false
+}
+
+// helpOverlayView renders the "?" overlay: the shared bindings plus any
+// screen-specific ones (see keymap.go)
+func (m *AppModel) helpOverlayView() string {
+	headerBox := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("⌨️ Keyboard Shortcuts")
+
+	sectionStyle := lipgloss.NewStyle().Foreground(theme.Primary()).Bold(true)
+
+	view := headerBox + "\n\n"
+	view += m.helpView.FullHelpView(m.keyMap.FullHelp())
+
+	if extras := screenExtraBindings(m.currentScreen); len(extras) > 0 {
+		view += "\n\n" + sectionStyle.Render("─── "+screenName(m.currentScreen)+" ───") + "\n"
+		view += m.helpView.FullHelpView([][]key.Binding{extras})
+	}
+
+	view += "\n\n" + lipgloss.NewStyle().
+		Foreground(theme.Subtle()).
+		Italic(true).
+		Render("? or esc: close")
+	return view
+}
+
+// screenView renders the current screen's own content
+func (m *AppModel) screenView() string {
 	switch m.currentScreen {
 	case ScreenSplash:
 		if m.splash != nil {
@@ -235,6 +504,66 @@ func (m *AppModel) View() string {
 		if m.selfUpdate != nil {
 			return m.selfUpdate.View()
 		}
+	case ScreenPluginRun:
+		if m.pluginRun != nil {
+			return m.pluginRun.View()
+		}
+	case ScreenPubDevSearch:
+		if m.pubDevSearch != nil {
+			return m.pubDevSearch.View()
+		}
+	case ScreenRemoveDeps:
+		if m.removeDeps != nil {
+			return m.removeDeps.View()
+		}
+	case ScreenUpdatePlan:
+		if m.updatePlan != nil {
+			return m.updatePlan.View()
+		}
+	case ScreenOverrides:
+		if m.overrides != nil {
+			return m.overrides.View()
+		}
+	case ScreenRefSwitch:
+		if m.refSwitch != nil {
+			return m.refSwitch.View()
+		}
+	case ScreenGitHubAuth:
+		if m.gitHubAuth != nil {
+			return m.gitHubAuth.View()
+		}
+	case ScreenCommandPane:
+		if m.commandPane != nil {
+			return m.commandPane.View()
+		}
+	case ScreenLogViewer:
+		if m.logViewer != nil {
+			return m.logViewer.View()
+		}
+	case ScreenLicenses:
+		if m.licenseReport != nil {
+			return m.licenseReport.View()
+		}
+	case ScreenOutdated:
+		if m.outdatedReport != nil {
+			return m.outdatedReport.View()
+		}
+	case ScreenEnvironment:
+		if m.environment != nil {
+			return m.environment.View()
+		}
+	case ScreenStats:
+		if m.stats != nil {
+			return m.stats.View()
+		}
+	case ScreenBundles:
+		if m.bundleInstall != nil {
+			return m.bundleInstall.View()
+		}
+	case ScreenTemplateRepo:
+		if m.templateRepo != nil {
+			return m.templateRepo.View()
+		}
 	case ScreenError:
 		if m.errorScreen != nil {
 			return m.errorScreen.View()
@@ -317,6 +646,66 @@ func (m *AppModel) updateCurrentScreen(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.selfUpdate != nil {
 			m.selfUpdate, cmd = m.selfUpdate.Update(msg)
 		}
+	case ScreenPluginRun:
+		if m.pluginRun != nil {
+			m.pluginRun, cmd = m.pluginRun.Update(msg)
+		}
+	case ScreenPubDevSearch:
+		if m.pubDevSearch != nil {
+			m.pubDevSearch, cmd = m.pubDevSearch.Update(msg)
+		}
+	case ScreenRemoveDeps:
+		if m.removeDeps != nil {
+			m.removeDeps, cmd = m.removeDeps.Update(msg)
+		}
+	case ScreenUpdatePlan:
+		if m.updatePlan != nil {
+			m.updatePlan, cmd = m.updatePlan.Update(msg)
+		}
+	case ScreenOverrides:
+		if m.overrides != nil {
+			m.overrides, cmd = m.overrides.Update(msg)
+		}
+	case ScreenRefSwitch:
+		if m.refSwitch != nil {
+			m.refSwitch, cmd = m.refSwitch.Update(msg)
+		}
+	case ScreenGitHubAuth:
+		if m.gitHubAuth != nil {
+			m.gitHubAuth, cmd = m.gitHubAuth.Update(msg)
+		}
+	case ScreenCommandPane:
+		if m.commandPane != nil {
+			m.commandPane, cmd = m.commandPane.Update(msg)
+		}
+	case ScreenLogViewer:
+		if m.logViewer != nil {
+			m.logViewer, cmd = m.logViewer.Update(msg)
+		}
+	case ScreenLicenses:
+		if m.licenseReport != nil {
+			m.licenseReport, cmd = m.licenseReport.Update(msg)
+		}
+	case ScreenOutdated:
+		if m.outdatedReport != nil {
+			m.outdatedReport, cmd = m.outdatedReport.Update(msg)
+		}
+	case ScreenEnvironment:
+		if m.environment != nil {
+			m.environment, cmd = m.environment.Update(msg)
+		}
+	case ScreenStats:
+		if m.stats != nil {
+			m.stats, cmd = m.stats.Update(msg)
+		}
+	case ScreenBundles:
+		if m.bundleInstall != nil {
+			m.bundleInstall, cmd = m.bundleInstall.Update(msg)
+		}
+	case ScreenTemplateRepo:
+		if m.templateRepo != nil {
+			m.templateRepo, cmd = m.templateRepo.Update(msg)
+		}
 	case ScreenError:
 		if m.errorScreen != nil {
 			m.errorScreen, cmd = m.errorScreen.Update(msg)
@@ -328,7 +717,13 @@ func (m *AppModel) updateCurrentScreen(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // transitionToScreen handles switching between screens
 func (m *AppModel) transitionToScreen(screen AppScreen, data interface{}) (tea.Model, tea.Cmd) {
+	m.pushScreenStack(screen)
 	m.currentScreen = screen
+	m.logger.Narrate("Opened screen: " + screenName(screen))
+
+	// Mid-flow transitions snapshot the shared state so a crash or quit can
+	// be resumed on the next launch (see core/sessionstate.go)
+	m.persistSessionState(screen)
 
 	switch screen {
 	case ScreenSplash:
@@ -402,9 +797,9 @@ func (m *AppModel) transitionToScreen(screen AppScreen, data interface{}) (tea.M
 		return m, m.confirmation.Init()
 
 	case ScreenExecution:
-		if m.execution == nil {
-			m.execution = NewExecutionModel(m.cfg, m.logger, m.SharedState)
-		}
+		// Always create fresh so each run (install, fixes, a dry run applied
+		// for real) starts with clean channels and step state
+		m.execution = NewExecutionModel(m.cfg, m.logger, m.SharedState)
 		return m, m.execution.Init()
 
 	case ScreenConflictResolver:
@@ -420,10 +815,8 @@ func (m *AppModel) transitionToScreen(screen AppScreen, data interface{}) (tea.M
 		return m, m.results.Init()
 
 	case ScreenForceUpdate:
-		if m.forceUpdate == nil {
-			// Route to execution model for now (building on existing foundation)
-			m.forceUpdate = NewExecutionModel(m.cfg, m.logger, m.SharedState)
-		}
+		// Always create fresh so the stale comparison is re-run each visit
+		m.forceUpdate = NewForceUpdateModel(m.cfg, m.logger, m.SharedState)
 		return m, m.forceUpdate.Init()
 
 	case ScreenSelfUpdate:
@@ -432,6 +825,85 @@ func (m *AppModel) transitionToScreen(screen AppScreen, data interface{}) (tea.M
 		}
 		return m, m.selfUpdate.Init()
 
+	case ScreenPluginRun:
+		// Always create fresh so each run starts with a clean result view
+		m.pluginRun = NewPluginRunModel(m.cfg, m.logger, m.SharedState)
+		return m, m.pluginRun.Init()
+
+	case ScreenPubDevSearch:
+		// Always create fresh so each visit starts with an empty query
+		m.pubDevSearch = NewPubDevSearchModel(m.cfg, m.logger, m.SharedState)
+		return m, m.pubDevSearch.Init()
+
+	case ScreenRemoveDeps:
+		// Always create fresh so the dependency list is re-read each visit
+		m.removeDeps = NewRemoveDependenciesModel(m.cfg, m.logger, m.SharedState)
+		return m, m.removeDeps.Init()
+
+	case ScreenUpdatePlan:
+		// Always create fresh so the plan is rebuilt each visit
+		m.updatePlan = NewUpdatePlanModel(m.cfg, m.logger, m.SharedState)
+		return m, m.updatePlan.Init()
+
+	case ScreenOverrides:
+		// Always create fresh so the override list is re-read each visit
+		m.overrides = NewOverridesModel(m.cfg, m.logger, m.SharedState)
+		return m, m.overrides.Init()
+
+	case ScreenRefSwitch:
+		// Always create fresh so the dependency list is re-read each visit
+		m.refSwitch = NewRefSwitchModel(m.cfg, m.logger, m.SharedState)
+		return m, m.refSwitch.Init()
+
+	case ScreenGitHubAuth:
+		// Always create fresh so each visit requests a new device code
+		m.gitHubAuth = NewGitHubAuthModel(m.cfg, m.logger, m.SharedState)
+		return m, m.gitHubAuth.Init()
+
+	case ScreenCommandPane:
+		// Always create fresh so each visit starts with a clean prompt
+		m.commandPane = NewCommandPaneModel(m.cfg, m.logger, m.SharedState)
+		return m, m.commandPane.Init()
+
+	case ScreenLogViewer:
+		// Always create fresh so the log list includes the latest sessions
+		m.logViewer = NewLogViewerModel(m.cfg, m.logger, m.SharedState)
+		return m, m.logViewer.Init()
+
+	case ScreenLicenses:
+		// Always create fresh so the scan reflects the current pubspec
+		m.licenseReport = NewLicenseReportModel(m.cfg, m.logger, m.SharedState)
+		return m, m.licenseReport.Init()
+
+	case ScreenOutdated:
+		// Always create fresh so the report reflects the current lock
+		m.outdatedReport = NewOutdatedModel(m.cfg, m.logger, m.SharedState)
+		return m, m.outdatedReport.Init()
+
+	case ScreenEnvironment:
+		// Always create fresh so the checks re-run on each visit
+		m.environment = NewEnvironmentModel(m.cfg, m.logger, m.SharedState)
+		return m, m.environment.Init()
+
+	case ScreenStats:
+		// Always create fresh so the aggregates are re-read on each visit
+		m.stats = NewStatsModel(m.cfg, m.logger, m.SharedState)
+		return m, m.stats.Init()
+
+	case ScreenBundles:
+		// Always create fresh so config edits are picked up each visit
+		m.bundleInstall = NewBundlesModel(m.cfg, m.logger, m.SharedState)
+		return m, m.bundleInstall.Init()
+
+	case ScreenTemplateRepo:
+		// Always create fresh so the template list is refetched
+		m.templateRepo = NewTemplateRepoModel(m.cfg, m.logger, m.SharedState)
+		return m, m.templateRepo.Init()
+
+	case ScreenResumeSession:
+		// Pseudo-screen: restore the snapshot and jump to the saved screen
+		return m.resumeSavedSession()
+
 	case ScreenError:
 		if m.errorScreen == nil {
 			m.errorScreen = NewErrorModel(m.cfg, m.logger, m.SharedState)
@@ -446,9 +918,238 @@ func (m *AppModel) transitionToScreen(screen AppScreen, data interface{}) (tea.M
 	return m, nil
 }
 
+// stackableScreen reports whether a screen belongs on the navigation stack.
+// Transient screens (loaders, execution, resolvers) and terminal screens
+// (results, errors) are skipped so esc never walks back into them.
+func stackableScreen(screen AppScreen) bool {
+	switch screen {
+	case ScreenSplash, ScreenGitHubRepo, ScreenTemplateRepo, ScreenSourceDownload,
+		ScreenGitHubAuth, ScreenExecution, ScreenConflictResolver, ScreenResults,
+		ScreenResumeSession, ScreenError:
+		return false
+	}
+	return true
+}
+
+// pushScreenStack records the screen being left so navigateBack can return
+// to it. The main menu is the navigation root, so reaching it clears the
+// history.
+func (m *AppModel) pushScreenStack(target AppScreen) {
+	if m.navigatingBack {
+		return
+	}
+	if target == ScreenMainMenu || target == ScreenSplash {
+		m.screenStack = m.screenStack[:0]
+		return
+	}
+	if !stackableScreen(m.currentScreen) || m.currentScreen == target {
+		return
+	}
+	m.screenStack = append(m.screenStack, m.currentScreen)
+	// Bound the history so a long session can't grow it without limit
+	if len(m.screenStack) > 10 {
+		m.screenStack = m.screenStack[1:]
+	}
+}
+
+// navigateBack pops the navigation stack and transitions to the previous
+// screen; an empty stack falls back to the main menu
+func (m *AppModel) navigateBack() (tea.Model, tea.Cmd) {
+	target := ScreenMainMenu
+	if n := len(m.screenStack); n > 0 {
+		target = m.screenStack[n-1]
+		m.screenStack = m.screenStack[:n-1]
+	}
+
+	// Suppress the history push so back doesn't re-record the screen it
+	// is leaving
+	m.navigatingBack = true
+	model, cmd := m.transitionToScreen(target, nil)
+	m.navigatingBack = false
+	return model, cmd
+}
+
+// breadcrumbs renders the navigation trail shown above nested screens, e.g.
+// "Main menu › Dependency selection › Package configuration"
+func (m *AppModel) breadcrumbs() string {
+	if len(m.screenStack) == 0 || !stackableScreen(m.currentScreen) {
+		return ""
+	}
+	parts := make([]string, 0, len(m.screenStack)+2)
+	parts = append(parts, "Main menu")
+	for _, screen := range m.screenStack {
+		if screen == ScreenMainMenu {
+			continue
+		}
+		parts = append(parts, screenName(screen))
+	}
+	parts = append(parts, screenName(m.currentScreen))
+	return lipgloss.NewStyle().
+		Foreground(theme.Subtle()).
+		Italic(true).
+		Render(strings.Join(parts, " › "))
+}
+
+// resumableScreen reports whether a screen is worth snapshotting: the
+// mid-flow screens between picking repositories and confirming the run.
+// Loading screens and terminal screens (results, errors) are not resumable.
+func resumableScreen(screen AppScreen) bool {
+	switch screen {
+	case ScreenSourceSelection, ScreenSourceConfig, ScreenDependencySelection,
+		ScreenConfiguration, ScreenConfirmation:
+		return true
+	}
+	return false
+}
+
+// persistSessionState snapshots the shared state on mid-flow screens so a
+// crash or quit can be resumed later, and drops the snapshot once the flow
+// reaches the results screen. Save failures are logged but never block the
+// transition.
+func (m *AppModel) persistSessionState(screen AppScreen) {
+	if screen == ScreenResults {
+		core.ClearSessionState()
+		return
+	}
+	if !resumableScreen(screen) {
+		return
+	}
+
+	// Nothing worth resuming yet (also keeps headless test runs from
+	// writing snapshot files)
+	s := m.SharedState
+	if len(s.AvailableSourceRepos) == 0 && len(s.AvailableDependencies) == 0 &&
+		len(s.SelectedDependencies) == 0 && len(s.PackageSpecs) == 0 {
+		return
+	}
+
+	state := core.SessionState{
+		Screen:                int(screen),
+		SourceProject:         s.SourceProject,
+		SourceRepo:            s.SourceRepo,
+		SourceProjectPath:     s.SourceProjectPath,
+		TemplateMode:          s.TemplateMode,
+		AvailableSourceRepos:  s.AvailableSourceRepos,
+		AvailableDependencies: s.AvailableDependencies,
+		SelectedDependencies:  s.SelectedDependencies,
+		PackageSpecs:          s.PackageSpecs,
+	}
+	if err := core.SaveSessionState(state); err != nil {
+		m.logger.Debug("session", fmt.Sprintf("Failed to save session state: %v", err))
+	}
+}
+
+// resumeSavedSession restores the snapshot loaded at startup and jumps back
+// to the screen the user left. An unusable snapshot falls through to the
+// main menu.
+func (m *AppModel) resumeSavedSession() (tea.Model, tea.Cmd) {
+	state := m.SharedState.ResumeState
+	m.SharedState.ResumeState = nil
+	core.ClearSessionState()
+
+	if state == nil || !resumableScreen(AppScreen(state.Screen)) {
+		return m.transitionToScreen(ScreenMainMenu, nil)
+	}
+	if len(state.AvailableSourceRepos) == 0 && len(state.AvailableDependencies) == 0 &&
+		len(state.PackageSpecs) == 0 {
+		// Empty selections would strand the restored screen
+		return m.transitionToScreen(ScreenMainMenu, nil)
+	}
+
+	s := m.SharedState
+	s.SourceProject = state.SourceProject
+	s.SourceRepo = state.SourceRepo
+	s.SourceProjectPath = state.SourceProjectPath
+	s.TemplateMode = state.TemplateMode
+	s.AvailableSourceRepos = state.AvailableSourceRepos
+	s.AvailableDependencies = state.AvailableDependencies
+	s.SelectedDependencies = state.SelectedDependencies
+	s.PackageSpecs = state.PackageSpecs
+
+	m.logger.Narrate("Resumed previous session from " + state.SavedAt.Format("2006-01-02 15:04"))
+	return m.transitionToScreen(AppScreen(state.Screen), nil)
+}
+
 // Helper function to send screen transition commands
 func TransitionToScreen(screen AppScreen) tea.Cmd {
 	return func() tea.Msg {
 		return ScreenTransitionMsg{Screen: screen}
 	}
 }
+
+// screenName returns a plain-language name for a screen, used by the
+// transcript so state changes read naturally ("Opened screen: Main menu")
+func screenName(screen AppScreen) string {
+	switch screen {
+	case ScreenSplash:
+		return "Splash"
+	case ScreenMainMenu:
+		return "Main menu"
+	case ScreenPrerequisites:
+		return "Prerequisites check"
+	case ScreenScanDirectories:
+		return "Scan local directories"
+	case ScreenGitHubRepo:
+		return "GitHub repository loading"
+	case ScreenSourceSelection:
+		return "Source project selection"
+	case ScreenSourceConfig:
+		return "Source project configuration"
+	case ScreenSourceDownload:
+		return "Source project download"
+	case ScreenDependencySelection:
+		return "Dependency selection"
+	case ScreenConfiguration:
+		return "Package configuration"
+	case ScreenConfirmation:
+		return "Confirmation"
+	case ScreenExecution:
+		return "Execution"
+	case ScreenConflictResolver:
+		return "Conflict resolution"
+	case ScreenResults:
+		return "Results"
+	case ScreenSearchConfig:
+		return "Search configuration"
+	case ScreenForceUpdate:
+		return "Update stale packages"
+	case ScreenSelfUpdate:
+		return "Self update"
+	case ScreenPluginRun:
+		return "Plugin run"
+	case ScreenPubDevSearch:
+		return "pub.dev search"
+	case ScreenRemoveDeps:
+		return "Remove dependencies"
+	case ScreenUpdatePlan:
+		return "Update plan"
+	case ScreenOverrides:
+		return "Manage overrides"
+	case ScreenRefSwitch:
+		return "Switch dependency ref"
+	case ScreenGitHubAuth:
+		return "GitHub sign-in"
+	case ScreenCommandPane:
+		return "Command pane"
+	case ScreenLogViewer:
+		return "Session logs"
+	case ScreenLicenses:
+		return "License report"
+	case ScreenOutdated:
+		return "Outdated packages"
+	case ScreenTemplateRepo:
+		return "Template repository selection"
+	case ScreenEnvironment:
+		return "Environment diagnostics"
+	case ScreenStats:
+		return "Local stats"
+	case ScreenBundles:
+		return "Dependency bundles"
+	case ScreenResumeSession:
+		return "Resume previous session"
+	case ScreenError:
+		return "Error"
+	default:
+		return "Unknown"
+	}
+}
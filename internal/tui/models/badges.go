@@ -0,0 +1,107 @@
+// Package models/badges.go - Shared Dependency Badge Component
+//
+// This file renders the compact badges shown next to each dependency in the
+// manage/remove screen: source type (git/hosted/path/sdk), override status,
+// pin status (branch vs tag vs SHA, where a SHA pin shows as "frozen") and
+// freshness. All badges share one theme-aware style table so every screen
+// that lists dependencies renders them the same way.
+
+package models
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// badgeColor maps badge kinds to theme roles. Source badges use the app
+// accent palette; status badges use the shared warning/error colors. Colors
+// are resolved per render so the active theme always applies.
+func badgeColor(kind string) lipgloss.TerminalColor {
+	switch kind {
+	case "git":
+		return theme.Primary()
+	case "hosted":
+		return theme.Success()
+	case "path":
+		return lipgloss.Color("220")
+	case "override":
+		return theme.Selected()
+	case "frozen", "tag":
+		return lipgloss.Color("75")
+	case "stale":
+		return lipgloss.Color("208")
+	default: // "sdk", "branch" and unknown kinds render muted
+		return theme.Muted()
+	}
+}
+
+// renderBadge renders a single [kind] badge in its theme color
+func renderBadge(kind string) string {
+	return lipgloss.NewStyle().Foreground(badgeColor(kind)).Render("[" + kind + "]")
+}
+
+// depBadges renders the badge row for one dependency: source, override,
+// pin status for git deps, and a stale marker when the project's lock file
+// is out of date
+func depBadges(dep core.DepOverview, stale bool) string {
+	badges := []string{renderBadge(dep.Source)}
+
+	if dep.Overridden {
+		badges = append(badges, renderBadge("override"))
+	}
+
+	if dep.Source == "git" {
+		switch classifyGitRef(dep.Ref) {
+		case "sha":
+			badges = append(badges, renderBadge("frozen"))
+		case "tag":
+			badges = append(badges, renderBadge("tag"))
+		default:
+			badges = append(badges, renderBadge("branch"))
+		}
+
+		// Freshness only applies to git deps; hosted deps follow the
+		// resolver and path deps are always current
+		if stale {
+			badges = append(badges, renderBadge("stale"))
+		}
+	}
+
+	return strings.Join(badges, " ")
+}
+
+// classifyGitRef reports whether a git ref pins a branch, a tag or a commit
+// SHA. An empty ref tracks the default branch.
+func classifyGitRef(ref string) string {
+	if ref == "" {
+		return "branch"
+	}
+
+	// A ref that is entirely hex and at least short-SHA length is a commit pin
+	if len(ref) >= 7 && isHexString(ref) {
+		return "sha"
+	}
+
+	// Version-shaped refs (v1.2.3, 1.2.3) are tags
+	trimmed := strings.TrimPrefix(ref, "v")
+	if trimmed != ref || strings.ContainsAny(trimmed, "0123456789") {
+		if strings.Count(trimmed, ".") >= 1 && !strings.ContainsAny(trimmed, "/_ ") {
+			return "tag"
+		}
+	}
+
+	return "branch"
+}
+
+// isHexString reports whether s consists only of hexadecimal characters
+func isHexString(s string) bool {
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,28 @@
+package models
+
+import "testing"
+
+// TestClassifyGitRef verifies the pin status classification driving the
+// branch/tag/frozen badges
+func TestClassifyGitRef(t *testing.T) {
+	cases := []struct {
+		ref  string
+		want string
+	}{
+		{"", "branch"},
+		{"main", "branch"},
+		{"develop", "branch"},
+		{"feature/new-thing", "branch"},
+		{"v1.2.3", "tag"},
+		{"1.2.3", "tag"},
+		{"a1b2c3d", "sha"},
+		{"0123456789abcdef0123456789abcdef01234567", "sha"},
+		{"abc", "branch"}, // Too short to be a short SHA
+	}
+
+	for _, tc := range cases {
+		if got := classifyGitRef(tc.ref); got != tc.want {
+			t.Errorf("classifyGitRef(%q) = %q, want %q", tc.ref, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+// Package models/bundles_model.go - Dependency Bundle Install Screen
+//
+// This file implements the named bundle flow (see core/bundles.go): it
+// lists the bundles defined in the global config, shows the contents of
+// the highlighted one, and installs the whole group with one confirmation.
+// Entries install one at a time with live progress, git entries through
+// the usual git add pipeline and hosted entries through `pub add`.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// BundlesModel handles the dependency bundle install screen
+type BundlesModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// Bundle picker state
+	names   []string // Sorted bundle names from the config
+	bundles map[string][]core.BundleEntry
+	cursor  int
+
+	// Install state
+	installing   bool
+	confirmed    bool // Enter pressed once; next enter starts the install
+	currentIndex int  // Entry currently being installed
+	results      []core.ActionResult
+	done         bool
+
+	// UI components
+	spinner spinner.Model
+
+	// Styles
+	titleStyle   lipgloss.Style
+	cursorStyle  lipgloss.Style
+	normalStyle  lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// bundleEntryDoneMsg is sent after each bundle entry finishes installing
+type bundleEntryDoneMsg struct {
+	result core.ActionResult
+}
+
+// NewBundlesModel creates a new dependency bundle model
+func NewBundlesModel(cfg core.Config, logger *core.Logger, shared *AppState) *BundlesModel {
+	s := components.NewSpinner()
+
+	return &BundlesModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		names:   core.BundleNames(),
+		bundles: core.LoadBundles(),
+		spinner: s,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		cursorStyle: lipgloss.NewStyle().
+			Foreground(theme.Secondary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init initializes the bundle screen
+func (m *BundlesModel) Init() tea.Cmd {
+	return nil
+}
+
+// Update handles messages for the bundle screen
+func (m *BundlesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// No input while installing
+		if m.installing {
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			// Esc steps back out of the confirmation before it navigates
+			if m.confirmed && !m.done {
+				m.confirmed = false
+				return m, nil
+			}
+			return m, NavigateBack()
+
+		case "up", "k":
+			if !m.confirmed && m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+
+		case "down", "j":
+			if !m.confirmed && m.cursor < len(m.names)-1 {
+				m.cursor++
+			}
+			return m, nil
+
+		case "enter":
+			if m.done || len(m.names) == 0 {
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			if !m.confirmed {
+				// First enter shows the contents for confirmation
+				m.confirmed = true
+				return m, nil
+			}
+			// Confirmed: install the entries one at a time
+			m.installing = true
+			m.currentIndex = 0
+			m.results = nil
+			m.logger.Narrate(fmt.Sprintf("Installing bundle %q (%d packages)", m.selectedName(), len(m.selectedEntries())))
+			return m, tea.Batch(m.spinner.Tick, m.installCurrentEntry())
+		}
+		return m, nil
+
+	case bundleEntryDoneMsg:
+		m.results = append(m.results, msg.result)
+		m.currentIndex++
+		if m.currentIndex < len(m.selectedEntries()) {
+			return m, m.installCurrentEntry()
+		}
+		// All entries processed
+		m.installing = false
+		m.done = true
+		succeeded := 0
+		for _, result := range m.results {
+			if result.OK {
+				succeeded++
+			}
+		}
+		m.logger.Narrate(fmt.Sprintf("Bundle %q finished: %d of %d packages installed", m.selectedName(), succeeded, len(m.results)))
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.installing {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the bundle screen
+func (m *BundlesModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🧰 Dependency Bundles")
+
+	b.WriteString(header + "\n\n")
+
+	if len(m.names) == 0 {
+		b.WriteString(m.normalStyle.Render("No bundles defined.") + "\n\n")
+		b.WriteString(m.normalStyle.Render("Define named bundles in "+core.GlobalConfigPath()+":") + "\n")
+		b.WriteString(m.normalStyle.Render("  bundles:") + "\n")
+		b.WriteString(m.normalStyle.Render("    networking:") + "\n")
+		b.WriteString(m.normalStyle.Render("      - name: dio") + "\n")
+		b.WriteString(m.normalStyle.Render("      - url: https://github.com/acme/api_client.git") + "\n")
+		b.WriteString(m.normalStyle.Render("        ref: main") + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	entries := m.selectedEntries()
+
+	if m.installing {
+		entry := entries[m.currentIndex]
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Installing %s (%d/%d)...",
+			m.spinner.View(), entry.Label(), m.currentIndex+1, len(entries))) + "\n\n")
+		b.WriteString(m.resultLines(entries))
+		return b.String()
+	}
+
+	if m.done {
+		succeeded := 0
+		for _, result := range m.results {
+			if result.OK {
+				succeeded++
+			}
+		}
+		if succeeded == len(m.results) {
+			b.WriteString(m.successStyle.Render(fmt.Sprintf("✅ Bundle %q installed: %d packages", m.selectedName(), succeeded)) + "\n\n")
+		} else {
+			b.WriteString(m.errorStyle.Render(fmt.Sprintf("⚠️ Bundle %q: %d of %d packages installed", m.selectedName(), succeeded, len(m.results))) + "\n\n")
+		}
+		b.WriteString(m.resultLines(entries))
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.confirmed {
+		// Confirmation: the full contents of the chosen bundle
+		b.WriteString(m.titleStyle.Render(fmt.Sprintf("Bundle %q adds %d package(s):", m.selectedName(), len(entries))) + "\n\n")
+		for _, entry := range entries {
+			b.WriteString(m.normalStyle.Render("  "+entry.Label()) + "\n")
+		}
+		b.WriteString("\n" + m.helpStyle.Render("enter: install all • esc: choose another bundle") + "\n")
+		return b.String()
+	}
+
+	// Bundle picker
+	b.WriteString(m.normalStyle.Render("Select a bundle to install:") + "\n\n")
+	for i, name := range m.names {
+		line := fmt.Sprintf("%s (%d packages)", name, len(m.bundles[name]))
+		if i == m.cursor {
+			b.WriteString(m.cursorStyle.Render("▶ "+line) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+		}
+	}
+	b.WriteString("\n" + m.helpStyle.Render("↑/↓ navigate • enter: review bundle • esc: back to menu") + "\n")
+
+	return b.String()
+}
+
+// resultLines renders one status line per processed entry
+func (m *BundlesModel) resultLines(entries []core.BundleEntry) string {
+	var b strings.Builder
+	for i, result := range m.results {
+		if result.OK {
+			b.WriteString(m.successStyle.Render("  ✅ "+entries[i].Label()) + "\n")
+		} else {
+			b.WriteString(m.errorStyle.Render(fmt.Sprintf("  ❌ %s: %s", entries[i].Label(), result.Err)) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// selectedName returns the highlighted bundle's name
+func (m *BundlesModel) selectedName() string {
+	if m.cursor >= 0 && m.cursor < len(m.names) {
+		return m.names[m.cursor]
+	}
+	return ""
+}
+
+// selectedEntries returns the highlighted bundle's entries
+func (m *BundlesModel) selectedEntries() []core.BundleEntry {
+	return m.bundles[m.selectedName()]
+}
+
+// installCurrentEntry installs the current bundle entry in the background
+func (m *BundlesModel) installCurrentEntry() tea.Cmd {
+	return func() tea.Msg {
+		entry := m.selectedEntries()[m.currentIndex]
+		result := core.ApplyBundleEntry(m.logger, &m.cfg, m.bundleProjectPath(), entry)
+		return bundleEntryDoneMsg{result: result}
+	}
+}
+
+// bundleProjectPath resolves the project directory the bundle targets
+func (m *BundlesModel) bundleProjectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
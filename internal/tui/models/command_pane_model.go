@@ -0,0 +1,259 @@
+// Package models/command_pane_model.go - Mini Terminal Pane Screen
+//
+// This file implements a small command pane for ad-hoc checks in the active
+// project directory. The user types a command from a scoped allowlist
+// (dart, flutter, git, gh - see core/adhoc.go), output streams into a
+// scrollable viewport, and the prompt returns for the next command - so
+// quick checks like `git status` or `dart pub deps` don't require leaving
+// the TUI and losing context.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// CommandPaneModel handles the ad-hoc command pane
+type CommandPaneModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// Command state
+	commandInput textinput.Model
+	runner       *core.AdhocRunner
+	running      bool
+	lastCommand  string
+	lastErr      string
+
+	// Output viewport
+	output   viewport.Model
+	hasRun   bool
+	outWidth int
+
+	// Styles
+	titleStyle   lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	normalStyle  lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// commandPaneTickMsg polls the runner for new output while a command runs
+type commandPaneTickMsg struct{}
+
+// NewCommandPaneModel creates a new command pane model
+func NewCommandPaneModel(cfg core.Config, logger *core.Logger, shared *AppState) *CommandPaneModel {
+	ti := textinput.New()
+	ti.Placeholder = "git status"
+	ti.CharLimit = 200
+	ti.Width = 56
+
+	vp := viewport.New(76, 14)
+
+	return &CommandPaneModel{
+		cfg:          cfg,
+		logger:       logger,
+		shared:       shared,
+		commandInput: ti,
+		output:       vp,
+		outWidth:     76,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init focuses the command input
+func (m *CommandPaneModel) Init() tea.Cmd {
+	return m.commandInput.Focus()
+}
+
+// Update handles messages for the command pane
+func (m *CommandPaneModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// While a command runs, only the viewport scrolls
+		if m.running {
+			var cmd tea.Cmd
+			m.output, cmd = m.output.Update(msg)
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "esc":
+			return m, NavigateBack()
+		case "enter":
+			input := strings.TrimSpace(m.commandInput.Value())
+			if input == "" {
+				return m, nil
+			}
+			bin, args, err := core.ParseAdhocCommand(input)
+			if err != nil {
+				m.lastErr = err.Error()
+				return m, nil
+			}
+			m.lastErr = ""
+			m.lastCommand = input
+			m.hasRun = true
+			m.running = true
+			m.commandInput.Blur()
+			m.logger.Narrate(fmt.Sprintf("Running command: %s", input))
+			m.runner = core.StartAdhocCommand(m.logger, &m.cfg, m.projectPath(), bin, args)
+			m.refreshOutput()
+			return m, m.pollTick()
+		case "up", "down", "pgup", "pgdown", "home", "end":
+			// Scroll previous output while typing the next command
+			var cmd tea.Cmd
+			m.output, cmd = m.output.Update(msg)
+			return m, cmd
+		default:
+			var cmd tea.Cmd
+			m.commandInput, cmd = m.commandInput.Update(msg)
+			return m, cmd
+		}
+
+	case tea.MouseMsg:
+		// Wheel scrolling through the captured output
+		var cmd tea.Cmd
+		m.output, cmd = m.output.Update(msg)
+		return m, cmd
+
+	case commandPaneTickMsg:
+		if !m.running || m.runner == nil {
+			return m, nil
+		}
+		_, done, _ := m.runner.Snapshot()
+		m.refreshOutput()
+		if done {
+			m.running = false
+			m.commandInput.SetValue("")
+			return m, m.commandInput.Focus()
+		}
+		return m, m.pollTick()
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		m.outWidth = msg.Width - 4
+		if m.outWidth < 40 {
+			m.outWidth = 40
+		}
+		m.output.Width = m.outWidth
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the command pane
+func (m *CommandPaneModel) View() string {
+	var b strings.Builder
+
+	// Header with warm amber theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("⌨️ Command Pane")
+
+	b.WriteString(header + "\n\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("Project: %s", m.projectPath())) + "\n\n")
+
+	if m.hasRun {
+		b.WriteString(m.titleStyle.Render("$ "+m.lastCommand) + "\n")
+		b.WriteString(m.output.View() + "\n")
+
+		if m.running {
+			b.WriteString(m.normalStyle.Render("⏳ Running...") + "\n\n")
+		} else if m.runner != nil {
+			if _, _, err := m.runner.Snapshot(); err != nil {
+				b.WriteString(m.errorStyle.Render("❌ "+err.Error()) + "\n\n")
+			} else {
+				b.WriteString(m.successStyle.Render("✅ Command completed") + "\n\n")
+			}
+		}
+	}
+
+	if !m.running {
+		b.WriteString(m.titleStyle.Render("Command:") + "\n")
+		b.WriteString(m.commandInput.View() + "\n")
+		if m.lastErr != "" {
+			b.WriteString(m.errorStyle.Render("❌ "+m.lastErr) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	allowed := strings.Join(core.AdhocAllowedTools(), "/")
+	if m.running {
+		b.WriteString(m.helpStyle.Render("↑/↓ scroll output") + "\n")
+	} else {
+		b.WriteString(m.helpStyle.Render(fmt.Sprintf("enter run (%s only) • ↑/↓ scroll output • esc back to menu", allowed)) + "\n")
+	}
+
+	return b.String()
+}
+
+// projectPath resolves the directory commands run in: the active project
+// if one is selected or detected, otherwise the current directory
+func (m *CommandPaneModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// refreshOutput copies the runner's current output into the viewport,
+// following the tail like a terminal
+func (m *CommandPaneModel) refreshOutput() {
+	if m.runner == nil {
+		return
+	}
+	lines, _, _ := m.runner.Snapshot()
+	atBottom := m.output.AtBottom()
+	m.output.SetContent(strings.Join(lines, "\n"))
+	if atBottom {
+		m.output.GotoBottom()
+	}
+}
+
+// pollTick polls the runner for streamed output (same cadence as the
+// directory scan progress tick)
+func (m *CommandPaneModel) pollTick() tea.Cmd {
+	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		return commandPaneTickMsg{}
+	})
+}
@@ -3,8 +3,10 @@
 // This file implements the interactive configuration screen where users fine-tune
 // each selected package before installation. For each package, users can specify:
 //   - Package name (defaults to repository name)
-//   - Git ref (branch, tag, or commit hash - defaults to "main")
-//   - Subdirectory (optional, for monorepo packages)
+//   - Git ref (branch, tag, or commit hash - defaults to the latest release
+//     tag when the repository has one, otherwise "main")
+//   - Subdirectory (optional, for monorepo packages; ctrl+d opens a picker
+//     over pubspec.yaml paths discovered in the repository tree)
 //
 // The screen uses a wizard-style flow, presenting one package at a time with
 // three text input fields. Navigation uses Tab/Shift+Tab between fields and
@@ -19,11 +21,13 @@ package models
 import (
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ConfigurationModel handles the interactive package configuration wizard.
@@ -41,9 +45,26 @@ type ConfigurationModel struct {
 	inputs       []textinput.Model // Flat array: [pkg0_name, pkg0_ref, pkg0_subdir, pkg1_name, ...]
 	complete     bool              // Whether all packages have been configured
 
-	// Package name fetching state
-	fetchingNames bool   // Whether we're currently fetching package names from git
-	fetchError    string // Error message if fetching failed
+	// Package name prefetch state. Bounded workers resolve each repository
+	// concurrently and stream results over nameCh (see startNameFetch), so
+	// the wizard stays interactive while names arrive one by one.
+	fetchingNames bool                        // Whether prefetch workers are still running
+	resolvedCount int                         // How many repositories have resolved so far
+	nameCh        chan packageNameResolvedMsg // Streams worker results into Update
+
+	// Fork detection state: repo index -> fork info (only entries for actual forks)
+	forkInfo map[int]*core.ForkInfo
+
+	// Latest semver tag per repo index (only entries for repos with releases),
+	// used as the ref default so new dependencies start pinned
+	latestTags map[int]string
+
+	// Discovered pubspec.yaml directories per repo index ("" for the repo
+	// root; only entries for repos whose tree scan succeeded), plus the
+	// inline picker state for the subdir field
+	subdirOptions  map[int][]string
+	subdirPickMode bool
+	subdirCursor   int
 
 	// Lipgloss styles for visual hierarchy
 	headerStyle   lipgloss.Style // Purple bold for headers
@@ -52,14 +73,25 @@ type ConfigurationModel struct {
 	helpStyle     lipgloss.Style // Gray italic for help text
 }
 
-// packageNamesFetchedMsg is sent when package names have been fetched from git repositories
-type packageNamesFetchedMsg struct {
-	err error
+// packageNameResolvedMsg carries one repository's prefetched metadata as the
+// bounded workers finish: the resolved package name plus the best-effort
+// latest release tag, discovered subdirectories, and fork status
+type packageNameResolvedMsg struct {
+	index       int
+	packageName string
+	latestTag   string
+	subdirs     []string
+	fork        *core.ForkInfo
 }
 
+// packageNamesFetchedMsg is sent once every repository has resolved (the
+// prefetch channel closed)
+type packageNamesFetchedMsg struct{}
+
 // NewConfigurationModel creates a new package configuration wizard.
 // The model creates three text inputs per selected package and initializes
-// them with sensible defaults (package name from repo, "main" for ref).
+// them with sensible defaults (package name from repo, latest release tag or
+// "main" for ref).
 //
 // Color scheme matches the app theme:
 //   - Headers: Purple (color 211)
@@ -68,11 +100,14 @@ type packageNamesFetchedMsg struct {
 //   - Help text: Gray italic
 func NewConfigurationModel(cfg core.Config, logger *core.Logger, shared *AppState) *ConfigurationModel {
 	return &ConfigurationModel{
-		cfg:          cfg,
-		logger:       logger,
-		shared:       shared,
-		currentRepo:  0,
-		currentField: 1, // Start at field 1 (ref) since field 0 (name) is read-only
+		cfg:           cfg,
+		logger:        logger,
+		shared:        shared,
+		currentRepo:   0,
+		currentField:  1, // Start at field 1 (ref) since field 0 (name) is read-only
+		forkInfo:      make(map[int]*core.ForkInfo),
+		latestTags:    make(map[int]string),
+		subdirOptions: make(map[int][]string),
 
 		// Styles
 		headerStyle: lipgloss.NewStyle().
@@ -80,26 +115,33 @@ func NewConfigurationModel(cfg core.Config, logger *core.Logger, shared *AppStat
 			Bold(true),
 
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#8B5CF6")).
+			Foreground(theme.Text()).
+			Background(theme.Highlight()).
 			Padding(0, 1),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		helpStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
+			Foreground(theme.Muted()).
 			Italic(true),
 	}
 }
 
 // Init initializes the configuration screen by creating and populating all text inputs.
-// Returns a batch of commands including cursor blink and package name fetching.
+// Inputs are created immediately with repo-name defaults so the wizard is
+// usable right away; the concurrent prefetch then patches each row in place
+// as the real package names from pubspec.yaml arrive.
+// Returns a batch of commands including cursor blink and the prefetch start.
 func (m *ConfigurationModel) Init() tea.Cmd {
+	m.setupInputs()
+	if len(m.shared.SelectedDependencies) == 0 {
+		return textinput.Blink
+	}
 	m.fetchingNames = true
 	return tea.Batch(
 		textinput.Blink,
-		m.fetchPackageNames(),
+		m.startNameFetch(),
 	)
 }
 
@@ -115,26 +157,22 @@ func (m *ConfigurationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	switch msg := msg.(type) {
+	case packageNameResolvedMsg:
+		// One repository resolved - patch its row and wait for the next
+		m.applyResolvedPackage(msg)
+		return m, m.waitForNameResult()
+
 	case packageNamesFetchedMsg:
-		// Package names have been fetched, now setup inputs with correct names
+		// The prefetch channel closed - every repository has resolved
 		m.fetchingNames = false
-		if msg.err != nil {
-			m.fetchError = msg.err.Error()
-			m.logger.Info("configuration", fmt.Sprintf("Error fetching package names: %s", msg.err))
-		}
-		m.setupInputs()
 		return m, nil
 
 	case tea.KeyMsg:
-		// Don't allow navigation while fetching package names
-		if m.fetchingNames {
-			return m, nil
-		}
 		return m.handleKeys(msg)
 
 	default:
 		// Update current input
-		if !m.fetchingNames && m.currentRepo < len(m.shared.SelectedDependencies) && len(m.inputs) > 0 {
+		if m.currentRepo < len(m.shared.SelectedDependencies) && len(m.inputs) > 0 {
 			inputIndex := m.currentRepo*3 + m.currentField
 			if inputIndex >= 0 && inputIndex < len(m.inputs) {
 				var cmd tea.Cmd
@@ -162,23 +200,38 @@ func (m *ConfigurationModel) View() string {
 		return m.headerStyle.Render("❌ No Repositories Selected") + "\n\nPlease go back and select repositories first.\n\nPress Q to return to main menu"
 	}
 
-	// Show loading message while fetching package names
-	if m.fetchingNames {
-		return m.headerStyle.Render("🔧 Package Configuration") + "\n\n" +
-			"⏳ Fetching actual package names from repositories...\n\n" +
-			m.helpStyle.Render("This ensures the correct package names are used for dart pub add")
-	}
-
 	var b strings.Builder
 
 	// Header
 	b.WriteString(m.headerStyle.Render("🔧 Package Configuration") + "\n")
-	b.WriteString(fmt.Sprintf("Configure %d selected packages:\n\n", len(m.shared.SelectedDependencies)))
+	b.WriteString(fmt.Sprintf("Configure %d selected packages:\n", len(m.shared.SelectedDependencies)))
+
+	// Name fields refresh in place as the background prefetch resolves each
+	// repository's pubspec.yaml, so the wizard never blocks on slow repos
+	if m.fetchingNames {
+		b.WriteString(m.helpStyle.Render(fmt.Sprintf("⏳ Resolving package names in the background (%d/%d done)...",
+			m.resolvedCount, len(m.shared.SelectedDependencies))) + "\n")
+	}
+	b.WriteString("\n")
 
 	// Show current repository being configured
 	if m.currentRepo < len(m.shared.SelectedDependencies) {
 		repo := m.shared.SelectedDependencies[m.currentRepo]
-		b.WriteString(fmt.Sprintf("📦 Configuring: %s/%s\n\n", repo.Owner, repo.Name))
+		b.WriteString(fmt.Sprintf("📦 Configuring: %s/%s\n", repo.Owner, repo.Name))
+
+		// Fork warning: show upstream repo and divergence, offer to switch
+		if info, isFork := m.forkInfo[m.currentRepo]; isFork && info != nil {
+			b.WriteString(m.headerStyle.Render(fmt.Sprintf("⚠️  Fork of %s/%s (%d ahead / %d behind upstream)",
+				info.UpstreamOwner, info.UpstreamName, info.AheadBy, info.BehindBy)) + "\n")
+			b.WriteString(m.helpStyle.Render("   press ctrl+u to add the upstream repository instead") + "\n")
+		}
+		b.WriteString("\n")
+
+		// Subdir picker replaces the input fields while open
+		if m.subdirPickMode {
+			b.WriteString(m.subdirPickerView())
+			return b.String()
+		}
 
 		// Show input fields
 		fields := []string{"Package Name (read-only):", "Git Ref (branch/tag):", "Subdirectory:"}
@@ -191,7 +244,18 @@ func (m *ConfigurationModel) View() string {
 
 			inputIndex := m.currentRepo*3 + i
 			if inputIndex < len(m.inputs) {
-				b.WriteString(m.inputs[inputIndex].View() + "\n\n")
+				b.WriteString(m.inputs[inputIndex].View() + "\n")
+				if i == 1 {
+					if tag, ok := m.latestTags[m.currentRepo]; ok {
+						b.WriteString(m.helpStyle.Render(fmt.Sprintf("   latest release: %s (pre-filled - edit to track a branch instead)", tag)) + "\n")
+					}
+				}
+				if i == 2 {
+					if options := m.subdirOptions[m.currentRepo]; len(options) > 0 {
+						b.WriteString(m.helpStyle.Render(fmt.Sprintf("   press ctrl+d to pick from %d discovered package path(s)", len(options))) + "\n")
+					}
+				}
+				b.WriteString("\n")
 			}
 		}
 
@@ -222,9 +286,31 @@ func (m *ConfigurationModel) View() string {
 //
 // All other keys are forwarded to the active text input for typing.
 func (m *ConfigurationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	// The subdir picker captures keys while open
+	if m.subdirPickMode {
+		return m.handleSubdirPickKeys(msg)
+	}
+
 	switch msg.String() {
-	case "q", "ctrl+c":
-		return m, TransitionToScreen(ScreenMainMenu)
+	case "esc", "q", "ctrl+c":
+		// Back to the dependency selection screen
+		return m, NavigateBack()
+
+	case "ctrl+d":
+		// Open the discovered-subdirectory picker for the current package
+		if options := m.subdirOptions[m.currentRepo]; len(options) > 0 {
+			m.subdirPickMode = true
+			m.subdirCursor = 0
+			// Pre-select the current value when it matches a discovered path
+			current := m.inputs[m.currentRepo*3+2].Value()
+			for i, option := range options {
+				if option == current {
+					m.subdirCursor = i
+					break
+				}
+			}
+		}
+		return m, nil
 
 	case "tab":
 		if m.currentRepo < len(m.shared.SelectedDependencies) {
@@ -246,6 +332,19 @@ func (m *ConfigurationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case "ctrl+u":
+		// Switch the current fork to its upstream repository
+		if info, isFork := m.forkInfo[m.currentRepo]; isFork && info != nil && m.currentRepo < len(m.shared.SelectedDependencies) {
+			repo := &m.shared.SelectedDependencies[m.currentRepo]
+			m.logger.Info("configuration", fmt.Sprintf("Switching %s/%s to upstream %s/%s",
+				repo.Owner, repo.Name, info.UpstreamOwner, info.UpstreamName))
+			repo.Owner = info.UpstreamOwner
+			repo.Name = info.UpstreamName
+			repo.URL = info.UpstreamURL
+			delete(m.forkInfo, m.currentRepo)
+		}
+		return m, nil
+
 	case "enter":
 		if m.currentRepo >= len(m.shared.SelectedDependencies) {
 			// All configured, move to confirmation
@@ -274,12 +373,71 @@ func (m *ConfigurationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// handleSubdirPickKeys handles keyboard input while the discovered
+// subdirectory picker is open
+func (m *ConfigurationModel) handleSubdirPickKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	options := m.subdirOptions[m.currentRepo]
+
+	switch msg.String() {
+	case "esc", "q", "ctrl+d":
+		m.subdirPickMode = false
+		return m, nil
+
+	case "up", "k":
+		if m.subdirCursor > 0 {
+			m.subdirCursor--
+		}
+		return m, nil
+
+	case "down", "j":
+		if m.subdirCursor < len(options)-1 {
+			m.subdirCursor++
+		}
+		return m, nil
+
+	case "enter":
+		if m.subdirCursor >= 0 && m.subdirCursor < len(options) {
+			m.inputs[m.currentRepo*3+2].SetValue(options[m.subdirCursor])
+			m.currentField = 2
+			m.focusCurrentInput()
+		}
+		m.subdirPickMode = false
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// subdirPickerView renders the discovered subdirectory picker shown in
+// place of the input fields while subdirPickMode is active
+func (m *ConfigurationModel) subdirPickerView() string {
+	var b strings.Builder
+
+	options := m.subdirOptions[m.currentRepo]
+	b.WriteString(m.headerStyle.Render("📂 Discovered package paths:") + "\n\n")
+
+	for i, option := range options {
+		label := option
+		if label == "" {
+			label = "(repository root)"
+		}
+		if i == m.subdirCursor {
+			b.WriteString(m.selectedStyle.Render("▶ "+label) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render("  "+label) + "\n")
+		}
+	}
+
+	b.WriteString("\n" + m.helpStyle.Render("enter: use path • esc: cancel"))
+	return b.String()
+}
+
 // setupInputs creates a text input for each configuration field of each package.
 // Creates exactly 3 * len(SelectedDependencies) inputs in a flat array.
 //
 // For each package, creates:
 //  1. Name input: Pre-filled with repository name, width 40
-//  2. Ref input: Pre-filled with "main", width 40
+//  2. Ref input: Pre-filled with the latest release tag or "main", width 40
 //  3. Subdir input: Empty with "(optional)" placeholder, width 40
 //
 // The first input (name of first package) is automatically focused.
@@ -314,10 +472,15 @@ func (m *ConfigurationModel) setupInputs() {
 		nameInput.TextStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("245")) // Dimmed gray
 		m.inputs[i*3] = nameInput
 
-		// Ref input
+		// Ref input - default to the latest release tag when the repository
+		// has one, so new dependencies start pinned instead of tracking main
+		defaultRef := "main"
+		if tag, ok := m.latestTags[i]; ok {
+			defaultRef = tag
+		}
 		refInput := textinput.New()
-		refInput.Placeholder = "main"
-		refInput.SetValue("main")
+		refInput.Placeholder = defaultRef
+		refInput.SetValue(defaultRef)
 		refInput.Width = 40
 		m.inputs[i*3+1] = refInput
 
@@ -422,35 +585,151 @@ func (m *ConfigurationModel) generatePackageSpecs() {
 	m.logger.Info("configuration", fmt.Sprintf("Generated %d package specifications", len(m.packageSpecs)))
 }
 
-// fetchPackageNames fetches the actual package names from git repositories asynchronously
-// This prevents the UI from showing incorrect package names (repo name vs actual package name)
-func (m *ConfigurationModel) fetchPackageNames() tea.Cmd {
+// startNameFetch launches bounded workers (cfg.Jobs, default 4) that resolve
+// each selected repository's package name and related metadata concurrently.
+// Workers only compute - results stream back over nameCh and are applied to
+// shared state on the Update goroutine, so the name fields populate one by
+// one instead of blocking the whole wizard on the slowest repository.
+// The pool itself starts lazily when bubbletea runs the returned command.
+func (m *ConfigurationModel) startNameFetch() tea.Cmd {
+	repos := make([]core.RepoCandidate, len(m.shared.SelectedDependencies))
+	copy(repos, m.shared.SelectedDependencies)
+	m.nameCh = make(chan packageNameResolvedMsg, len(repos))
+
+	workers := m.cfg.Jobs
+	if workers <= 0 {
+		workers = 4
+	}
+	if workers > len(repos) {
+		workers = len(repos)
+	}
+
+	logger := m.logger
+	ch := m.nameCh
 	return func() tea.Msg {
-		m.logger.Info("configuration", "Fetching actual package names from repositories...")
+		logger.Info("configuration", fmt.Sprintf("Prefetching package names for %d repositories (%d workers)", len(repos), workers))
+
+		go func() {
+			sem := make(chan struct{}, workers)
+			var wg sync.WaitGroup
+			for i, repo := range repos {
+				wg.Add(1)
+				go func(i int, repo core.RepoCandidate) {
+					defer wg.Done()
+					sem <- struct{}{}
+					defer func() { <-sem }()
+					ch <- prefetchRepoMetadata(logger, i, repo)
+				}(i, repo)
+			}
+			wg.Wait()
+			close(ch)
+		}()
 
-		// Fetch package name for each selected dependency
-		for i := range m.shared.SelectedDependencies {
-			repo := &m.shared.SelectedDependencies[i]
+		if msg, ok := <-ch; ok {
+			return msg
+		}
+		return packageNamesFetchedMsg{}
+	}
+}
 
-			// Skip if package name is already set
-			if repo.PackageName != "" {
-				m.logger.Info("configuration", fmt.Sprintf("Package name already set for %s: %s", repo.Name, repo.PackageName))
-				continue
-			}
+// waitForNameResult forwards the next prefetch result from nameCh into the
+// bubbletea loop, or signals completion once the channel closes
+func (m *ConfigurationModel) waitForNameResult() tea.Cmd {
+	ch := m.nameCh
+	return func() tea.Msg {
+		if msg, ok := <-ch; ok {
+			return msg
+		}
+		return packageNamesFetchedMsg{}
+	}
+}
 
-			// Fetch the actual package name from pubspec.yaml
-			packageName, err := core.FetchPackageNameFromGit(m.logger, repo.URL, "main", "")
-			if err != nil {
-				m.logger.Info("configuration", fmt.Sprintf("Failed to fetch package name for %s: %s (will use repo name)", repo.Name, err))
-				// Fallback to repo name - don't fail the entire operation
-				repo.PackageName = repo.Name
-				continue
-			}
+// prefetchRepoMetadata resolves one repository's package name plus the
+// best-effort extras (latest release tag, nested pubspec paths, fork status).
+// Every lookup degrades gracefully so a slow or failing repository only
+// affects its own row. Results are memoized in core (see packageNameCache
+// and gitLsRemoteCache in core/git.go), so revisiting the screen is cheap.
+func prefetchRepoMetadata(logger *core.Logger, index int, repo core.RepoCandidate) packageNameResolvedMsg {
+	msg := packageNameResolvedMsg{index: index, packageName: repo.PackageName}
+
+	if msg.packageName == "" {
+		name, err := core.FetchPackageNameFromGit(logger, repo.URL, "main", "")
+		if err != nil {
+			logger.Info("configuration", fmt.Sprintf("Failed to fetch package name for %s: %s (will use repo name)", repo.Name, err))
+			// Fallback to repo name - don't fail the entire operation
+			name = repo.Name
+		}
+		msg.packageName = name
+	}
+
+	// Latest release tag so the ref field can default to a pinned version
+	// instead of main (best-effort)
+	if tag, err := core.GitLatestTag(repo.URL); err == nil {
+		msg.latestTag = tag
+	} else {
+		logger.Debug("configuration", fmt.Sprintf("No version tags for %s/%s: %s", repo.Owner, repo.Name, err))
+	}
+
+	// Nested pubspec.yaml paths so the subdir field can be picked from real
+	// paths (best-effort)
+	if subdirs, err := core.ListRepoPubspecSubdirs(logger, repo.Owner, repo.Name); err == nil {
+		msg.subdirs = subdirs
+	} else {
+		logger.Debug("configuration", fmt.Sprintf("Subdir scan skipped for %s/%s: %s", repo.Owner, repo.Name, err))
+	}
+
+	// Fork status so we can warn about stale personal forks and offer the
+	// upstream repository instead (best-effort, requires gh CLI)
+	if info, err := core.CheckForkStatus(logger, repo.Owner, repo.Name, ""); err != nil {
+		logger.Debug("configuration", fmt.Sprintf("Fork check skipped for %s/%s: %s", repo.Owner, repo.Name, err))
+	} else if info.IsFork {
+		msg.fork = info
+	}
 
-			m.logger.Info("configuration", fmt.Sprintf("Fetched package name for %s: %s", repo.Name, packageName))
-			repo.PackageName = packageName
+	return msg
+}
+
+// applyResolvedPackage patches shared state and the already-visible inputs
+// with one worker's result. Inputs are updated in place (setupInputs would
+// wipe edits), and the ref default is only replaced while it still holds the
+// initial "main" value so a user's edit is never overwritten.
+func (m *ConfigurationModel) applyResolvedPackage(msg packageNameResolvedMsg) {
+	if msg.index < 0 || msg.index >= len(m.shared.SelectedDependencies) {
+		return
+	}
+	m.resolvedCount++
+
+	repo := &m.shared.SelectedDependencies[msg.index]
+	if msg.packageName != "" {
+		if repo.PackageName == "" {
+			m.logger.Info("configuration", fmt.Sprintf("Fetched package name for %s: %s", repo.Name, msg.packageName))
 		}
+		repo.PackageName = msg.packageName
+	}
 
-		return packageNamesFetchedMsg{err: nil}
+	if msg.latestTag != "" {
+		m.logger.Info("configuration", fmt.Sprintf("Latest release tag for %s/%s: %s", repo.Owner, repo.Name, msg.latestTag))
+		m.latestTags[msg.index] = msg.latestTag
+	}
+	if len(msg.subdirs) > 0 {
+		m.subdirOptions[msg.index] = msg.subdirs
+	}
+	if msg.fork != nil {
+		m.forkInfo[msg.index] = msg.fork
+	}
+
+	if msg.index*3+2 >= len(m.inputs) {
+		return
+	}
+	if msg.packageName != "" {
+		m.inputs[msg.index*3].Placeholder = msg.packageName
+		m.inputs[msg.index*3].SetValue(msg.packageName)
+	}
+	if msg.latestTag != "" {
+		refInput := &m.inputs[msg.index*3+1]
+		if refInput.Value() == "" || refInput.Value() == "main" {
+			refInput.SetValue(msg.latestTag)
+		}
+		refInput.Placeholder = msg.latestTag
 	}
 }
@@ -4,11 +4,14 @@ package models
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ConfirmationModel handles change confirmation
@@ -18,7 +21,16 @@ type ConfirmationModel struct {
 	shared *AppState
 
 	// State
-	choice int // 0=confirm, 1=cancel
+	choice     int      // 0=confirm, 1=cancel
+	violations []string // Policy violations blocking confirmation (see core/policy.go)
+	dirtyFiles []string // Pubspec files with uncommitted changes (see core/dirtycheck.go)
+	stashNote  string   // Outcome of a stash requested with 's'
+
+	// Projected pubspec.yaml diff (see core/diffpreview.go), scrollable
+	// when it doesn't fit
+	diff    string
+	diffVP  viewport.Model
+	hasDiff bool
 
 	// Styles
 	headerStyle   lipgloss.Style
@@ -26,6 +38,10 @@ type ConfirmationModel struct {
 	selectedStyle lipgloss.Style
 	normalStyle   lipgloss.Style
 	warningStyle  lipgloss.Style
+	dryRunStyle   lipgloss.Style
+	diffAddStyle  lipgloss.Style
+	diffDelStyle  lipgloss.Style
+	diffHunkStyle lipgloss.Style
 }
 
 // NewConfirmationModel creates a new confirmation model
@@ -48,25 +64,93 @@ func NewConfirmationModel(cfg core.Config, logger *core.Logger, shared *AppState
 			BorderForeground(lipgloss.Color("10")),
 
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#8B5CF6")).
+			Foreground(theme.Text()).
+			Background(theme.Highlight()).
 			Padding(0, 1).
 			Bold(true),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		warningStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("202")).
 			Bold(true),
+
+		dryRunStyle: lipgloss.NewStyle().
+			Foreground(theme.Accent()).
+			Bold(true),
+
+		diffAddStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		diffDelStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		diffHunkStyle: lipgloss.NewStyle().
+			Foreground(theme.Accent()),
 	}
 }
 
-// Init initializes the confirmation screen
+// Init initializes the confirmation screen and checks the pinning policy
+// against every configured spec, so violations block before execution
 func (m *ConfirmationModel) Init() tea.Cmd {
+	policy := core.LoadPolicyConfig()
+	m.violations = nil
+	for _, spec := range m.shared.PackageSpecs {
+		m.violations = append(m.violations, policy.Check(spec)...)
+	}
+	if len(m.violations) > 0 && !m.cfg.Force {
+		m.choice = 1 // Default to cancel when confirmation is blocked
+	}
+
+	// Uncommitted pubspec edits would be mixed into the tool's write - warn
+	// and let the user stash them first (see core/dirtycheck.go)
+	m.dirtyFiles = core.PubspecDirtyFiles(m.projectPath())
+
+	// --dry-run seeds the preview toggle on; 'd' flips it per run
+	if m.cfg.DryRun {
+		m.shared.DryRunPreview = true
+	}
+
+	// Project the exact pubspec.yaml change (see core/diffpreview.go); a
+	// preview failure is not fatal - the screen just omits the diff
+	m.hasDiff = false
+	if diff, err := core.PreviewPubspecDiff(m.projectPath(), m.shared.PackageSpecs); err == nil && diff != "" {
+		m.diff = diff
+		m.diffVP = viewport.New(76, 10)
+		m.diffVP.SetContent(m.colorizeDiff(diff))
+		m.hasDiff = true
+	}
 	return nil
 }
 
+// colorizeDiff styles added/removed/hunk lines of a unified diff
+func (m *ConfirmationModel) colorizeDiff(diff string) string {
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "+"):
+			lines[i] = m.diffAddStyle.Render(line)
+		case strings.HasPrefix(line, "-"):
+			lines[i] = m.diffDelStyle.Render(line)
+		case strings.HasPrefix(line, "@@"):
+			lines[i] = m.diffHunkStyle.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// projectPath resolves the target project directory from shared state
+func (m *ConfirmationModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
 // Update handles messages for confirmation
 func (m *ConfirmationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -74,7 +158,17 @@ func (m *ConfirmationModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m.handleKeys(msg)
 
 	case tea.WindowSizeMsg:
-		// Handle window resize gracefully
+		// Reflow the diff viewport on narrow terminals
+		if m.hasDiff {
+			width := msg.Width - 4
+			if width > 76 {
+				width = 76
+			}
+			if width < 40 {
+				width = 40
+			}
+			m.diffVP.Width = width
+		}
 		return m, nil
 	}
 	return m, nil
@@ -113,11 +207,48 @@ func (m *ConfirmationModel) View() string {
 
 	b.WriteString("\n\n")
 
+	// The exact file change about to be written (see core/diffpreview.go)
+	if m.hasDiff {
+		b.WriteString(m.headerStyle.Render("📄 pubspec.yaml changes:") + "\n")
+		b.WriteString(m.diffVP.View() + "\n\n")
+	}
+
+	// Policy violations block confirmation entirely; --force downgrades
+	// them to warnings so the user can proceed deliberately
+	if len(m.violations) > 0 {
+		if m.cfg.Force {
+			b.WriteString(m.warningStyle.Render("⚠️  Policy violations (proceeding anyway - --force):") + "\n")
+		} else {
+			b.WriteString(m.warningStyle.Render("🚫 Policy violations - installation is blocked:") + "\n")
+		}
+		for _, violation := range m.violations {
+			b.WriteString(m.warningStyle.Render("   • "+violation) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	// Uncommitted manual edits warning with a stash offer
+	if len(m.dirtyFiles) > 0 {
+		b.WriteString(m.warningStyle.Render(fmt.Sprintf("⚠️  Uncommitted changes in %s", strings.Join(m.dirtyFiles, ", "))) + "\n")
+		b.WriteString(m.normalStyle.Render("   Press 's' to stash them first, or confirm to continue anyway") + "\n\n")
+	}
+	if m.stashNote != "" {
+		b.WriteString(m.normalStyle.Render(m.stashNote) + "\n\n")
+	}
+
 	// Backup warning
 	b.WriteString(m.warningStyle.Render("⚠️  A backup of pubspec.yaml will be created") + "\n\n")
 
+	// Dry-run preview status: execution simulates every step while it's on
+	if m.shared.DryRunPreview {
+		b.WriteString(m.dryRunStyle.Render("🧪 Dry-run is ON - steps will be simulated, nothing is written") + "\n\n")
+	}
+
 	// Choice buttons
 	choices := []string{"✅ Confirm Installation", "❌ Cancel"}
+	if m.shared.DryRunPreview {
+		choices[0] = "🧪 Simulate Installation"
+	}
 	for i, choice := range choices {
 		if i == m.choice {
 			b.WriteString(m.selectedStyle.Render(choice))
@@ -132,7 +263,11 @@ func (m *ConfirmationModel) View() string {
 	b.WriteString("\n\n")
 
 	// Help
-	b.WriteString(m.normalStyle.Render("left/right: select • enter: confirm choice • q: back to menu"))
+	help := "left/right: select • d: toggle dry-run • enter: confirm choice • q: back to menu"
+	if m.hasDiff {
+		help = "left/right: select • d: toggle dry-run • up/down: scroll diff • enter: confirm choice • q: back to menu"
+	}
+	b.WriteString(m.normalStyle.Render(help))
 
 	return b.String()
 }
@@ -140,8 +275,9 @@ func (m *ConfirmationModel) View() string {
 // handleKeys handles keyboard input
 func (m *ConfirmationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
-	case "q", "ctrl+c":
-		return m, TransitionToScreen(ScreenMainMenu)
+	case "esc", "q", "ctrl+c":
+		// Back to the package configuration screen
+		return m, NavigateBack()
 
 	case "left", "h":
 		m.choice = 0 // Confirm
@@ -151,6 +287,24 @@ func (m *ConfirmationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.choice = 1 // Cancel
 		return m, nil
 
+	case "d":
+		// Toggle the dry-run preview for the upcoming execution
+		m.shared.DryRunPreview = !m.shared.DryRunPreview
+		return m, nil
+
+	case "s":
+		// Stash the manual pubspec edits before the write
+		if len(m.dirtyFiles) > 0 {
+			result := core.StashPubspecChanges(m.logger, &m.cfg, m.projectPath())
+			if result.OK {
+				m.dirtyFiles = nil
+				m.stashNote = "📦 " + result.Message
+			} else {
+				m.stashNote = "❌ " + result.Err
+			}
+		}
+		return m, nil
+
 	case "y":
 		m.choice = 0
 		return m.confirm()
@@ -163,12 +317,29 @@ func (m *ConfirmationModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m.confirm()
 	}
 
+	// Remaining keys (up/down/pgup/pgdn) scroll the diff preview
+	if m.hasDiff {
+		var cmd tea.Cmd
+		m.diffVP, cmd = m.diffVP.Update(msg)
+		return m, cmd
+	}
+
 	return m, nil
 }
 
 // confirm executes the user's choice
 func (m *ConfirmationModel) confirm() (tea.Model, tea.Cmd) {
 	if m.choice == 0 {
+		// Confirming is a no-op while policy violations are unresolved,
+		// unless --force downgrades them to warnings
+		if len(m.violations) > 0 {
+			if !m.cfg.Force {
+				m.logger.Info("confirmation", "Confirmation blocked by policy violations")
+				return m, nil
+			}
+			m.logger.Info("confirmation", "Policy violations overridden with --force")
+		}
+
 		// Confirm installation
 		m.logger.Info("confirmation", "User confirmed package installation")
 		return m, TransitionToScreen(ScreenExecution)
@@ -21,9 +21,12 @@ import (
 
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ConflictResolverModel handles interactive resolution of dependency conflicts
@@ -40,6 +43,16 @@ type ConflictResolverModel struct {
 	resolveError    string // Error from last resolution attempt
 	resolveSuccess  bool   // Whether last resolution succeeded
 
+	// Override editing state
+	editingOverride bool            // Whether the override textinput is active
+	overrideInput   textinput.Model // Editable override argument (e.g. override:pkg:^2.0.0)
+
+	// Strategy suggestion state (see core.SuggestConflictStrategies)
+	strategyMode      bool                    // Whether the strategy list is active
+	loadingStrategies bool                    // Whether suggestions are being computed
+	strategies        []core.OverrideStrategy // Suggested strategies for the current conflict
+	strategyCursor    int                     // Currently selected strategy
+
 	// Batch resolution state
 	batchResolving     bool   // Whether we're in batch resolution mode
 	batchCurrentIndex  int    // Current package being resolved in batch mode
@@ -67,6 +80,7 @@ type ConflictResolverModel struct {
 const (
 	optionOverrideAll = iota // Override all conflicts (recommended)
 	optionUseOverride        // Try using dependency_overrides for current package
+	optionStrategies         // Suggest concrete strategies (versions, tags) for current package
 	optionSkipPackage        // Skip this package and continue
 	optionRetry              // Retry without changes
 	optionContinue           // Continue to results
@@ -81,6 +95,11 @@ type resolveCompleteMsg struct {
 // batchResolveNextMsg is sent to trigger resolution of the next package in batch mode
 type batchResolveNextMsg struct{}
 
+// strategiesLoadedMsg is sent when strategy suggestions have been computed
+type strategiesLoadedMsg struct {
+	strategies []core.OverrideStrategy
+}
+
 // NewConflictResolverModel creates a new conflict resolver screen
 func NewConflictResolverModel(cfg core.Config, logger *core.Logger, shared *AppState) *ConflictResolverModel {
 	// Find all packages that need conflict resolution
@@ -94,14 +113,18 @@ func NewConflictResolverModel(cfg core.Config, logger *core.Logger, shared *AppS
 	}
 
 	// Create spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#13B9FD"))
+	s := components.NewSpinner()
 
 	// Create progress bar
 	p := progress.New(progress.WithScaledGradient("#FF7CCB", "#FDAB3D"))
 	p.Width = 40
 
+	// Create override editor input (pre-filled per conflict when activated)
+	ti := textinput.New()
+	ti.Placeholder = "override:package:any"
+	ti.CharLimit = 200
+	ti.Width = 60
+
 	return &ConflictResolverModel{
 		cfg:             cfg,
 		logger:          logger,
@@ -111,28 +134,29 @@ func NewConflictResolverModel(cfg core.Config, logger *core.Logger, shared *AppS
 		selectedOption:  optionOverrideAll, // Default to recommended option
 		spinner:         s,
 		progress:        p,
+		overrideInput:   ti,
 
 		headerStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("211")).
 			Bold(true),
 
 		titleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
+			Foreground(theme.Warning()).
 			Bold(true),
 
 		conflictStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		optionStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		selectedStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("13")).
 			Bold(true),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		successStyle: lipgloss.NewStyle().
@@ -140,7 +164,7 @@ func NewConflictResolverModel(cfg core.Config, logger *core.Logger, shared *AppS
 			Bold(true),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		progressStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("13")).
@@ -158,7 +182,51 @@ func (m *ConflictResolverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		// Don't allow input while resolving
-		if m.resolving || m.batchResolving {
+		if m.resolving || m.batchResolving || m.loadingStrategies {
+			return m, nil
+		}
+
+		// While editing the override, keys go to the textinput
+		if m.editingOverride {
+			switch msg.String() {
+			case "esc":
+				m.editingOverride = false
+				m.overrideInput.Blur()
+				return m, nil
+			case "enter":
+				m.editingOverride = false
+				m.overrideInput.Blur()
+				m.resolving = true
+				return m, tea.Batch(m.spinner.Tick, m.resolveWithEditedOverride(m.overrideInput.Value()))
+			default:
+				var cmd tea.Cmd
+				m.overrideInput, cmd = m.overrideInput.Update(msg)
+				return m, cmd
+			}
+		}
+
+		// While the strategy list is active, keys drive its cursor
+		if m.strategyMode {
+			switch msg.String() {
+			case "esc", "q":
+				m.strategyMode = false
+				return m, nil
+			case "up", "k":
+				if m.strategyCursor > 0 {
+					m.strategyCursor--
+				}
+			case "down", "j":
+				if m.strategyCursor < len(m.strategies)-1 {
+					m.strategyCursor++
+				}
+			case "enter":
+				if len(m.strategies) > 0 {
+					strategy := m.strategies[m.strategyCursor]
+					m.strategyMode = false
+					m.resolving = true
+					return m, tea.Batch(m.spinner.Tick, m.resolveWithStrategy(strategy))
+				}
+			}
 			return m, nil
 		}
 
@@ -180,6 +248,7 @@ func (m *ConflictResolverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedOption = optionOverrideAll
 				m.resolveError = ""
 				m.resolveSuccess = false
+				m.strategies = nil
 			}
 		case "right", "l":
 			if m.currentIndex < len(m.conflictIndices)-1 {
@@ -187,6 +256,7 @@ func (m *ConflictResolverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.selectedOption = optionOverrideAll
 				m.resolveError = ""
 				m.resolveSuccess = false
+				m.strategies = nil
 			}
 		case "enter":
 			return m, m.handleOptionSelection()
@@ -207,6 +277,18 @@ func (m *ConflictResolverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case strategiesLoadedMsg:
+		// Strategy suggestions are ready - open the strategy list
+		m.loadingStrategies = false
+		m.strategies = msg.strategies
+		m.strategyCursor = 0
+		if len(m.strategies) == 0 {
+			m.resolveError = "no resolution strategies could be computed for this conflict"
+			return m, nil
+		}
+		m.strategyMode = true
+		return m, nil
+
 	case batchResolveNextMsg:
 		// Process next package in batch resolution
 		if m.batchCurrentIndex < len(m.conflictIndices) {
@@ -223,7 +305,7 @@ func (m *ConflictResolverModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case spinner.TickMsg:
-		if m.resolving || m.batchResolving {
+		if m.resolving || m.batchResolving || m.loadingStrategies {
 			var cmd tea.Cmd
 			m.spinner, cmd = m.spinner.Update(msg)
 			return m, cmd
@@ -311,8 +393,40 @@ func (m *ConflictResolverModel) View() string {
 	}
 	b.WriteString("\n")
 
+	// Override editor: show the exact command that will run and let the user
+	// tweak the override (version constraint or git ref instead of `any`)
+	if m.editingOverride {
+		b.WriteString(m.headerStyle.Render("Edit Dependency Override:") + "\n\n")
+		b.WriteString(m.normalStyle.Render("Command to execute:") + "\n")
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("  dart pub add %s %s", core.InlinePackageArg(spec), m.overrideInput.Value())) + "\n\n")
+		b.WriteString(m.overrideInput.View() + "\n\n")
+		b.WriteString(m.normalStyle.Render("Examples: override:pkg:^2.0.0  •  override:pkg:{git:{url: https://..., ref: main}, version: any}") + "\n")
+		b.WriteString(m.normalStyle.Render("Enter: Apply  Esc: Cancel") + "\n")
+		return b.String()
+	}
+
+	// Strategy list: concrete ways out of this conflict, computed from
+	// pub deps, pub.dev and the repository's release tags
+	if m.strategyMode {
+		b.WriteString(m.headerStyle.Render("Suggested Resolution Strategies:") + "\n\n")
+		for i, strategy := range m.strategies {
+			prefix := "  "
+			style := m.optionStyle
+			if i == m.strategyCursor {
+				prefix = "> "
+				style = m.selectedStyle
+			}
+			b.WriteString(style.Render(prefix+strategy.Title) + "\n")
+			b.WriteString(m.normalStyle.Render("     "+strategy.Detail) + "\n")
+		}
+		b.WriteString("\n" + m.normalStyle.Render("↑/↓: Select strategy  Enter: Apply  Esc: Back") + "\n")
+		return b.String()
+	}
+
 	// Show resolution status if present
-	if m.resolving {
+	if m.loadingStrategies {
+		b.WriteString(m.progressStyle.Render(fmt.Sprintf("%s Computing resolution strategies...", m.spinner.View())) + "\n\n")
+	} else if m.resolving {
 		b.WriteString(m.progressStyle.Render(fmt.Sprintf("%s Resolving conflict...", m.spinner.View())) + "\n\n")
 	} else if m.resolveSuccess {
 		b.WriteString(m.successStyle.Render("✅ Conflict resolved successfully!") + "\n\n")
@@ -325,7 +439,8 @@ func (m *ConflictResolverModel) View() string {
 
 	options := []string{
 		"🚀 Override All Conflicts (Recommended)",
-		"📝 Use dependency override for this package",
+		"📝 Edit & apply dependency override for this package",
+		"🧭 Suggest resolution strategies (versions & tags)",
 		"⏭️  Skip this package",
 		"🔄 Retry installation",
 		"✅ Continue to results",
@@ -359,8 +474,11 @@ func (m *ConflictResolverModel) handleOptionSelection() tea.Cmd {
 		// Start batch resolution of all conflicts
 		return m.startBatchResolution()
 	case optionUseOverride:
-		// Try resolution with dependency override for current package
-		return m.resolveSinglePackage()
+		// Show the override that would be applied and let the user edit it
+		return m.startOverrideEdit()
+	case optionStrategies:
+		// Compute concrete strategies (network lookups, so async with spinner)
+		return m.startStrategySuggestions()
 	case optionSkipPackage:
 		// Mark as skipped and move to next
 		return m.skipPackage()
@@ -432,16 +550,41 @@ func (m *ConflictResolverModel) resolveBatchPackage() tea.Cmd {
 	}
 }
 
-// resolveSinglePackage attempts to resolve the current package only
-func (m *ConflictResolverModel) resolveSinglePackage() tea.Cmd {
+// startOverrideEdit activates the override editor, pre-filled with the exact
+// override argument that would otherwise be applied automatically
+func (m *ConflictResolverModel) startOverrideEdit() tea.Cmd {
+	resultIndex := m.conflictIndices[m.currentIndex]
+	result := m.shared.Results[resultIndex]
+
+	// Default to override:pkg:any for the detected conflicting package
+	conflictingPkg := ""
+	if result.Data != nil {
+		if pkg, ok := result.Data["conflicting_pkg"].(string); ok {
+			conflictingPkg = pkg
+		}
+	}
+	defaultOverride := ""
+	if conflictingPkg != "" {
+		defaultOverride = core.DefaultOverrideArg(conflictingPkg)
+	}
+
+	m.editingOverride = true
+	m.resolveError = ""
+	m.resolveSuccess = false
+	m.overrideInput.SetValue(defaultOverride)
+	m.overrideInput.CursorEnd()
+	return m.overrideInput.Focus()
+}
+
+// resolveWithEditedOverride attempts resolution using the user-edited override argument
+func (m *ConflictResolverModel) resolveWithEditedOverride(overrideArg string) tea.Cmd {
 	return func() tea.Msg {
-		m.resolving = true
 		defer func() { m.resolving = false }()
 
 		resultIndex := m.conflictIndices[m.currentIndex]
 		spec := m.shared.PackageSpecs[resultIndex]
 
-		m.logger.Info("conflict_resolver", fmt.Sprintf("Attempting override resolution for %s", spec.Name))
+		m.logger.Info("conflict_resolver", fmt.Sprintf("Attempting override resolution for %s with %q", spec.Name, overrideArg))
 
 		// Determine project path
 		projectPath := m.shared.SourceProjectPath
@@ -449,8 +592,8 @@ func (m *ConflictResolverModel) resolveSinglePackage() tea.Cmd {
 			projectPath = "."
 		}
 
-		// Attempt resolution with override
-		newResult := core.AddGitDependency(m.logger, &m.cfg, projectPath, spec, true)
+		// Apply the edited override (empty means plain retry with inline syntax)
+		newResult := core.AddGitDependencyWithOverride(m.logger, &m.cfg, projectPath, spec, strings.TrimSpace(overrideArg))
 
 		if newResult.OK {
 			// Success! Update the result
@@ -479,6 +622,88 @@ func (m *ConflictResolverModel) resolveSinglePackage() tea.Cmd {
 	}
 }
 
+// startStrategySuggestions asynchronously computes resolution strategies for
+// the current conflict (pub deps, pub.dev and git tag lookups)
+func (m *ConflictResolverModel) startStrategySuggestions() tea.Cmd {
+	resultIndex := m.conflictIndices[m.currentIndex]
+	result := m.shared.Results[resultIndex]
+	spec := m.shared.PackageSpecs[resultIndex]
+
+	conflictingPkg := ""
+	if result.Data != nil {
+		if pkg, ok := result.Data["conflicting_pkg"].(string); ok {
+			conflictingPkg = pkg
+		}
+	}
+
+	projectPath := m.shared.SourceProjectPath
+	if projectPath == "" {
+		projectPath = "."
+	}
+
+	m.loadingStrategies = true
+	m.resolveError = ""
+	m.resolveSuccess = false
+	m.logger.Info("conflict_resolver", fmt.Sprintf("Computing resolution strategies for %s", spec.Name))
+
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		return strategiesLoadedMsg{
+			strategies: core.SuggestConflictStrategies(m.logger, projectPath, spec, conflictingPkg),
+		}
+	})
+}
+
+// resolveWithStrategy applies a selected strategy: override strategies go
+// through the inline override pipeline, ref strategies retry the package at
+// the suggested git ref
+func (m *ConflictResolverModel) resolveWithStrategy(strategy core.OverrideStrategy) tea.Cmd {
+	if strategy.OverrideArg != "" {
+		m.logger.Info("conflict_resolver", fmt.Sprintf("Applying strategy: %s", strategy.Title))
+		return m.resolveWithEditedOverride(strategy.OverrideArg)
+	}
+
+	return func() tea.Msg {
+		defer func() { m.resolving = false }()
+
+		resultIndex := m.conflictIndices[m.currentIndex]
+		spec := m.shared.PackageSpecs[resultIndex]
+		spec.Ref = strategy.Ref
+
+		m.logger.Info("conflict_resolver", fmt.Sprintf("Applying strategy: %s", strategy.Title))
+
+		projectPath := m.shared.SourceProjectPath
+		if projectPath == "" {
+			projectPath = "."
+		}
+
+		// Retry at the suggested ref without auto-resolution so a remaining
+		// conflict surfaces instead of being papered over
+		newResult := core.AddGitDependency(m.logger, &m.cfg, projectPath, spec, false)
+
+		if newResult.OK {
+			// Success! Keep the new ref for any later retries and update the result
+			m.shared.PackageSpecs[resultIndex] = spec
+			m.shared.Results[resultIndex] = newResult
+			m.logger.Info("conflict_resolver", fmt.Sprintf("✅ Resolved conflict for %s at ref %s", spec.Name, strategy.Ref))
+
+			// Remove this index from conflict list
+			m.conflictIndices = append(m.conflictIndices[:m.currentIndex], m.conflictIndices[m.currentIndex+1:]...)
+
+			if len(m.conflictIndices) == 0 {
+				return resolveCompleteMsg{success: true, err: nil}
+			}
+			if m.currentIndex >= len(m.conflictIndices) {
+				m.currentIndex = len(m.conflictIndices) - 1
+			}
+			return resolveCompleteMsg{success: true, err: nil}
+		}
+
+		// Failed - show error
+		m.logger.Info("conflict_resolver", fmt.Sprintf("❌ Strategy failed for %s: %s", spec.Name, newResult.Err))
+		return resolveCompleteMsg{success: false, err: fmt.Errorf("%s", newResult.Err)}
+	}
+}
+
 // skipPackage marks the current package as skipped and moves to the next
 func (m *ConflictResolverModel) skipPackage() tea.Cmd {
 	return func() tea.Msg {
@@ -0,0 +1,181 @@
+// Package models/environment_model.go - Environment Diagnostics Screen
+//
+// This file implements the "Environment" screen that runs the diagnostics
+// from core/diagnostics.go: git version, dart/flutter presence, gh auth
+// state, network reachability to github.com and pub.dev, and write
+// permission in the project directory. Each failed check is shown with a
+// concrete remediation step. The same checks back the `flutter-pm doctor`
+// CLI command.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// EnvironmentModel handles the environment diagnostics screen
+type EnvironmentModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	loading bool
+	checks  []core.DiagnosticCheck
+
+	spinner spinner.Model
+
+	titleStyle   lipgloss.Style
+	normalStyle  lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// environmentChecksMsg is sent when all diagnostics have finished
+type environmentChecksMsg struct {
+	checks []core.DiagnosticCheck
+}
+
+// NewEnvironmentModel creates a new environment diagnostics model
+func NewEnvironmentModel(cfg core.Config, logger *core.Logger, shared *AppState) *EnvironmentModel {
+	s := components.NewSpinner()
+
+	return &EnvironmentModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		loading: true,
+		spinner: s,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// projectPath resolves the directory the write-permission check probes
+func (m *EnvironmentModel) projectPath() string {
+	projectPath := m.shared.SourceProjectPath
+	if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+		projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	if projectPath == "" {
+		projectPath = "."
+	}
+	return projectPath
+}
+
+// Init starts the diagnostics in the background; the network probes can take
+// a few seconds on a broken connection, so they never block the UI
+func (m *EnvironmentModel) Init() tea.Cmd {
+	projectPath := m.projectPath()
+	return tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			m.logger.Narrate("Running environment diagnostics")
+			return environmentChecksMsg{checks: core.RunEnvironmentDiagnostics(m.logger, projectPath)}
+		},
+	)
+}
+
+// Update handles messages for the environment diagnostics screen
+func (m *EnvironmentModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, NavigateBack()
+		case "r":
+			// Re-run after fixing something, without leaving the screen
+			if !m.loading {
+				m.loading = true
+				m.checks = nil
+				return m, m.Init()
+			}
+		}
+
+	case environmentChecksMsg:
+		m.loading = false
+		m.checks = msg.checks
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.loading {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// View renders the environment diagnostics screen
+func (m *EnvironmentModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🩺 Environment")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(fmt.Sprintf("%s Checking tools, authentication and network...\n", m.spinner.View()))
+		return b.String()
+	}
+
+	failed := 0
+	for _, check := range m.checks {
+		if check.OK {
+			b.WriteString(m.successStyle.Render("✅ "+check.Name) + "\n")
+			b.WriteString(m.normalStyle.Render("   "+check.Detail) + "\n")
+		} else {
+			failed++
+			b.WriteString(m.errorStyle.Render("❌ "+check.Name) + "\n")
+			b.WriteString(m.normalStyle.Render("   "+check.Detail) + "\n")
+			if check.Remedy != "" {
+				b.WriteString(m.titleStyle.Render("   💡 "+check.Remedy) + "\n")
+			}
+		}
+	}
+
+	b.WriteString("\n")
+	if failed == 0 {
+		b.WriteString(m.successStyle.Render("All checks passed - the environment is ready") + "\n")
+	} else {
+		b.WriteString(m.errorStyle.Render(fmt.Sprintf("%d of %d checks failed", failed, len(m.checks))) + "\n")
+	}
+
+	b.WriteString("\n" + m.helpStyle.Render("r: re-run checks • esc: back") + "\n")
+
+	return b.String()
+}
@@ -12,6 +12,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ErrorModel handles error display and recovery
@@ -50,18 +51,18 @@ func NewErrorModel(cfg core.Config, logger *core.Logger, shared *AppState) *Erro
 
 		// Styles
 		headerStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Background(lipgloss.Color("52")).
 			Padding(1, 2).
 			Border(lipgloss.NormalBorder()).
-			BorderForeground(lipgloss.Color("196")),
+			BorderForeground(theme.Error()),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 	}
 }
 
@@ -6,6 +6,11 @@
 //   - Progress bar showing overall completion
 //   - Live status updates for each package
 //   - Error handling and recovery
+//   - Abort key (x) that cancels the in-flight git/pub child process
+//
+// The same screen also applies recommendation fixes selected on the results
+// screen (shared.PendingFixes, see core/recofix.go), reusing the step
+// machine, progress bar and live output pane.
 //
 // The execution flow follows these steps:
 //   1. Create pubspec.yaml backup (safety measure)
@@ -14,12 +19,18 @@
 //   4. Run pub get to resolve dependencies
 //   5. Transition to results screen
 //
+// The GitHub source flow runs the full bootstrap pipeline instead: clone
+// the repository, verify it is a Flutter project (running `flutter create .`
+// when pubspec.yaml is missing), add the selected dependencies, resolve
+// them with pub get and open the project directory (see core/bootstrap.go).
+//
 // This model maintains full parity with the shell script's installation behavior
 // while providing a modern, visual progress interface.
 
 package models
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -28,9 +39,12 @@ import (
 
 	"github.com/charmbracelet/bubbles/progress"
 	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ExecutionModel handles package installation execution with real-time progress feedback.
@@ -42,14 +56,31 @@ type ExecutionModel struct {
 	shared *AppState    // Shared state containing package specs to install
 
 	// Execution state tracking
-	executing   bool           // Whether installation is currently in progress
-	currentStep int            // Current step number (1-based)
-	totalSteps  int            // Total number of steps to complete
-	stepName    string         // Human-readable name of current operation
-	progress    progress.Model // Animated progress bar (gradient pink to orange)
-	spinner     spinner.Model  // Dot spinner for active operations
-	complete    bool           // Whether installation has finished
-	err         error          // Any error that occurred during execution
+	executing   bool               // Whether installation is currently in progress
+	execCtx     context.Context    // Cancelled by the abort key; kills in-flight git/pub children
+	execCancel  context.CancelFunc // Invoked when the user aborts the installation
+	aborted     bool               // Whether the user requested an abort
+	fixMode     bool               // Whether this run applies recommendation fixes instead of packages
+	sourceFlow  bool               // Whether this run bootstraps a cloned source project first
+	batchTried  bool               // Whether single-pass batch addition was attempted
+	batchDone   bool               // Whether the batch edit handled all packages
+	currentStep int                // Current step number (1-based)
+	totalSteps  int                // Total number of steps to complete
+	stepName    string             // Human-readable name of current operation
+	progress    progress.Model     // Animated progress bar (gradient pink to orange)
+	spinner     spinner.Model      // Dot spinner for active operations
+	complete    bool               // Whether installation has finished
+	err         error              // Any error that occurred during execution
+
+	// Live command output (see core/stream.go); git/pub output lines arrive
+	// on outputCh and accumulate in a scrollable viewport pane
+	output      viewport.Model // Scrollable pane showing streamed command output
+	outputLines []string       // Line history backing the viewport content
+	outputCh    chan string    // Sink registered with core.SetOutputSink
+
+	// Live core progress events (clone percentages, resolver steps)
+	// registered with core.SetProgressReporter (see core/progress.go)
+	progressCh chan executionLiveProgressMsg
 
 	// Lipgloss styles for consistent theming
 	headerStyle  lipgloss.Style // Purple bold header
@@ -57,6 +88,7 @@ type ExecutionModel struct {
 	errorStyle   lipgloss.Style // Red bold for errors
 	warningStyle lipgloss.Style // Yellow/Orange for warnings
 	normalStyle  lipgloss.Style // Gray for normal text
+	dryRunStyle  lipgloss.Style // Accent for simulated "would do" steps
 }
 
 // executionStepMsg is sent internally when advancing to the next installation step.
@@ -72,6 +104,24 @@ type executionProgressMsg struct {
 	stepName string // Human-readable description of current operation
 }
 
+// executionOutputMsg carries one streamed stdout/stderr line from an
+// in-flight git/pub command
+type executionOutputMsg struct {
+	line string // A single output line, newline stripped
+}
+
+// executionLiveProgressMsg carries one progress event from a core
+// operation (stage, 0-100 percent or -1 when indeterminate, message)
+type executionLiveProgressMsg struct {
+	stage   string
+	percent int
+	message string
+}
+
+// maxOutputLines caps the live log history so a chatty resolver can't grow
+// the model without bound
+const maxOutputLines = 500
+
 // executionCompleteMsg is sent when the entire installation process completes.
 // It contains the results for all packages and any overall error.
 type executionCompleteMsg struct {
@@ -93,24 +143,57 @@ func NewExecutionModel(cfg core.Config, logger *core.Logger, shared *AppState) *
 	p.Width = 40
 
 	// Create spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#13B9FD"))
+	s := components.NewSpinner()
 
-	// Calculate total steps (source clone + packages + backup + pub get)
+	// Calculate total steps (source bootstrap + packages + backup + pub get)
 	totalSteps := len(shared.PackageSpecs) + 2
-	if shared.SourceProject != nil && shared.SourceProject.Path != "" {
-		totalSteps++ // Add step for cloning source project
+	sourceFlow := shared.SourceRepo != nil && shared.SourceProject != nil && shared.SourceProject.Path != ""
+	if sourceFlow {
+		// Bootstrap pipeline: clone, flutter project check and final pub get
+		// each get their own step (see core/bootstrap.go)
+		totalSteps += 2
 	}
 
+	// Recommendation fix flow (entered from the results screen): one step
+	// per selected fix plus preparation and finalization
+	fixMode := len(shared.PendingFixes) > 0
+	if fixMode {
+		totalSteps = len(shared.PendingFixes) + 2
+	}
+
+	stepName := "Starting installation..."
+	if fixMode {
+		stepName = "Starting fix application..."
+	}
+
+	// The confirmation screen's dry-run toggle decides whether this run is
+	// simulated; core functions honor cfg.DryRun end to end. The toggle is
+	// seeded from --dry-run, so switching it off "applies for real".
+	cfg.DryRun = shared.DryRunPreview
+
+	// Cancelling this context kills whichever git/pub child process is
+	// running when the user presses the abort key
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// Live log viewport; buffered so a burst of resolver output never
+	// blocks the child process (overflow lines are dropped by the sink)
+	vp := viewport.New(72, 8)
+
 	return &ExecutionModel{
 		cfg:         cfg,
 		logger:      logger,
 		shared:      shared,
 		executing:   true,
+		execCtx:     ctx,
+		execCancel:  cancel,
+		fixMode:     fixMode,
+		sourceFlow:  sourceFlow && !fixMode,
+		output:      vp,
+		outputCh:    make(chan string, 512),
+		progressCh:  make(chan executionLiveProgressMsg, 64),
 		currentStep: 0,
 		totalSteps:  totalSteps,
-		stepName:    "Starting installation...",
+		stepName:    stepName,
 		progress:    p,
 		spinner:     s,
 
@@ -124,15 +207,19 @@ func NewExecutionModel(cfg core.Config, logger *core.Logger, shared *AppState) *
 			Bold(true),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		warningStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")).
+			Foreground(theme.Warning()).
 			Bold(true),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
+
+		dryRunStyle: lipgloss.NewStyle().
+			Foreground(theme.Accent()).
+			Bold(true),
 	}
 }
 
@@ -144,10 +231,14 @@ func NewExecutionModel(cfg core.Config, logger *core.Logger, shared *AppState) *
 //   - A batch command containing the spinner tick and installation starter
 func (m *ExecutionModel) Init() tea.Cmd {
 	// Check if this is a source clone flow (option 2)
-	if m.shared.SourceRepo != nil && m.shared.SourceProject != nil {
-		// This is the GitHub source clone flow
-		// Log the information about what needs to be done
-		m.logger.Info("execution", "=== SOURCE CLONE FLOW DETECTED ===")
+	if m.fixMode {
+		m.logger.Info("execution", "=== RECOMMENDATION FIX FLOW ===")
+		m.logger.Info("execution", fmt.Sprintf("  Fixes: %d", len(m.shared.PendingFixes)))
+		m.logger.Info("execution", fmt.Sprintf("  Total Steps: %d", m.totalSteps))
+	} else if m.sourceFlow {
+		// This is the GitHub source bootstrap flow: clone, Flutter project
+		// check, dependency additions and a final pub get
+		m.logger.Info("execution", "=== SOURCE BOOTSTRAP FLOW DETECTED ===")
 		m.logger.Info("execution", fmt.Sprintf("  Repository: %s", m.shared.SourceRepo.Name))
 		m.logger.Info("execution", fmt.Sprintf("  URL: %s", m.shared.SourceRepo.URL))
 		m.logger.Info("execution", fmt.Sprintf("  Target Path: %s", m.shared.SourceProject.Path))
@@ -159,16 +250,57 @@ func (m *ExecutionModel) Init() tea.Cmd {
 		m.logger.Info("execution", fmt.Sprintf("  Total Steps: %d", m.totalSteps))
 	}
 
+	// Route streamed git/pub output lines into the live log viewport
+	core.SetOutputSink(m.outputCh)
+
+	// Route core progress events into the progress bar, dropping rather
+	// than blocking when the UI falls behind
+	core.SetProgressReporter(core.ProgressFunc(func(stage string, percent int, message string) {
+		select {
+		case m.progressCh <- executionLiveProgressMsg{stage: stage, percent: percent, message: message}:
+		default:
+		}
+	}))
+
 	return tea.Batch(
 		m.spinner.Tick,
+		m.waitForOutputLine(),
+		m.waitForProgress(),
 		m.executeInstallation(),
 	)
 }
 
+// waitForOutputLine blocks until the next streamed command output line
+// arrives and converts it into a message for the update loop. The command
+// re-arms itself from the executionOutputMsg handler.
+func (m *ExecutionModel) waitForOutputLine() tea.Cmd {
+	return func() tea.Msg {
+		line, ok := <-m.outputCh
+		if !ok {
+			return nil
+		}
+		return executionOutputMsg{line: line}
+	}
+}
+
+// waitForProgress blocks until the next core progress event arrives and
+// converts it into a message for the update loop. The command re-arms
+// itself from the executionLiveProgressMsg handler.
+func (m *ExecutionModel) waitForProgress() tea.Cmd {
+	return func() tea.Msg {
+		event, ok := <-m.progressCh
+		if !ok {
+			return nil
+		}
+		return event
+	}
+}
+
 // Update handles all incoming messages during package installation.
 //
 // Message handling:
-//   - tea.KeyMsg: Allows proceeding to results when complete (enter/q)
+//   - tea.KeyMsg: Aborts the installation while executing (x), proceeds
+//     to results when complete (enter/q)
 //   - executionStepMsg: Advances to next step, updates progress bar
 //   - executionCompleteMsg: Marks installation done, stores results
 //   - spinner.TickMsg: Animates the spinner during active work
@@ -184,15 +316,70 @@ func (m *ExecutionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			switch msg.String() {
 			case "q", "ctrl+c", "enter":
 				return m, TransitionToScreen(ScreenResults)
+			case "a":
+				// Apply for real: drop the dry-run preview and rerun the
+				// same installation (the screen is recreated fresh)
+				if m.cfg.DryRun && !m.fixMode {
+					m.shared.DryRunPreview = false
+					m.shared.Results = nil
+					m.logger.Info("execution", "Dry run approved - re-running for real")
+					m.logger.Narrate("Dry run approved - applying for real")
+					return m, TransitionToScreen(ScreenExecution)
+				}
 			}
+		} else if m.executing && !m.aborted && msg.String() == "x" {
+			// Abort: cancel the context so the in-flight child process is
+			// killed; the step machine reports the abort on its next tick
+			m.aborted = true
+			m.stepName = "Aborting..."
+			m.execCancel()
+			m.logger.Info("execution", "User requested abort - cancelling in-flight operation")
+			m.logger.Narrate("Installation aborted by user")
+			return m, nil
 		}
-		return m, nil
+		// Remaining keys scroll the live output viewport
+		var cmd tea.Cmd
+		m.output, cmd = m.output.Update(msg)
+		return m, cmd
+
+	case tea.MouseMsg:
+		// Wheel scrolling through the streamed output
+		var cmd tea.Cmd
+		m.output, cmd = m.output.Update(msg)
+		return m, cmd
+
+	case executionOutputMsg:
+		// Append the line and follow the tail unless the user scrolled up
+		m.outputLines = append(m.outputLines, msg.line)
+		if len(m.outputLines) > maxOutputLines {
+			m.outputLines = m.outputLines[len(m.outputLines)-maxOutputLines:]
+		}
+		wasAtBottom := m.output.AtBottom()
+		m.output.SetContent(strings.Join(m.outputLines, "\n"))
+		if wasAtBottom {
+			m.output.GotoBottom()
+		}
+		return m, m.waitForOutputLine()
 
 	case executionProgressMsg:
 		// Update step name without changing step number
 		m.stepName = msg.stepName
 		return m, nil
 
+	case executionLiveProgressMsg:
+		// Refine the bar within the in-flight step using real core
+		// progress (e.g. clone byte percentage), so a long clone advances
+		// smoothly instead of jumping when the step completes
+		if msg.percent >= 0 && m.executing && m.totalSteps > 0 {
+			progressValue := (float64(m.currentStep) + float64(msg.percent)/100) / float64(m.totalSteps)
+			if progressValue > 1 {
+				progressValue = 1
+			}
+			cmds = append(cmds, m.progress.SetPercent(progressValue))
+		}
+		cmds = append(cmds, m.waitForProgress())
+		return m, tea.Batch(cmds...)
+
 	case executionStepMsg:
 		m.currentStep = msg.step
 		m.stepName = msg.stepName
@@ -212,14 +399,31 @@ func (m *ExecutionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case executionCompleteMsg:
 		m.executing = false
+		if !m.complete {
+			// Stop streaming; closing after the sink is cleared is safe and
+			// unblocks the pending waitForOutputLine command
+			core.ClearOutputSink()
+			close(m.outputCh)
+			core.ClearProgressReporter()
+			close(m.progressCh)
+		}
 		m.complete = true
+		m.execCancel() // Release the context now that no children remain
 		if msg.err != nil {
 			m.err = msg.err
 			return m, nil
 		}
 
 		m.shared.Results = msg.results
+		if m.fixMode {
+			m.logger.Info("execution", "Recommendation fixes applied")
+			m.logger.Narrate("Recommendation fixes applied")
+			// Fix results never enter the conflict resolver; any conflict a
+			// re-pin hits is reported as a plain failure on the results screen
+			return m, nil
+		}
 		m.logger.Info("execution", "Package installation completed successfully")
+		m.logger.Narrate("Package installation completed successfully")
 
 		// Check if any packages need conflict resolution
 		hasConflicts := false
@@ -275,12 +479,23 @@ func (m *ExecutionModel) View() string {
 	var b strings.Builder
 
 	// Header
-	b.WriteString(m.headerStyle.Render("⚡ Installing Packages") + "\n\n")
+	if m.fixMode {
+		b.WriteString(m.headerStyle.Render("🔧 Applying Fixes") + "\n\n")
+	} else if m.cfg.DryRun {
+		b.WriteString(m.dryRunStyle.Render("🧪 Simulating Installation (dry-run)") + "\n\n")
+	} else {
+		b.WriteString(m.headerStyle.Render("⚡ Installing Packages") + "\n\n")
+	}
 
 	if m.err != nil {
 		// Error state
-		b.WriteString(m.errorStyle.Render("❌ Installation Failed") + "\n\n")
+		if m.fixMode {
+			b.WriteString(m.errorStyle.Render("❌ Fix Application Failed") + "\n\n")
+		} else {
+			b.WriteString(m.errorStyle.Render("❌ Installation Failed") + "\n\n")
+		}
 		b.WriteString(fmt.Sprintf("Error: %s\n\n", m.err.Error()))
+		b.WriteString(m.outputPaneView())
 		b.WriteString("Press Enter or Q to view results\n")
 		return b.String()
 	}
@@ -298,30 +513,60 @@ func (m *ExecutionModel) View() string {
 		}
 
 		// Success state with accurate counts
-		if failedCount == 0 {
-			b.WriteString(m.successStyle.Render("✅ All packages installed successfully!") + "\n\n")
+		if m.fixMode {
+			if failedCount == 0 {
+				b.WriteString(m.successStyle.Render("✅ All fixes applied successfully!") + "\n\n")
+			} else {
+				b.WriteString(m.warningStyle.Render("⚠️  Fix application completed with some failures") + "\n\n")
+			}
+			b.WriteString(m.normalStyle.Render(fmt.Sprintf("Successfully applied: %d fixes", successCount)) + "\n")
+			if failedCount > 0 {
+				b.WriteString(m.errorStyle.Render(fmt.Sprintf("Failed to apply: %d fixes", failedCount)) + "\n")
+			}
+		} else if m.cfg.DryRun {
+			// Simulated run: every step is a "would do", rendered in the
+			// distinct dry-run style with the apply-for-real shortcut
+			b.WriteString(m.dryRunStyle.Render("🧪 Dry run complete - nothing was written") + "\n\n")
+			for _, result := range m.shared.Results {
+				if result.OK && result.Message != "" {
+					b.WriteString(m.dryRunStyle.Render("   ≈ "+result.Message) + "\n")
+				}
+			}
+			if failedCount > 0 {
+				b.WriteString(m.errorStyle.Render(fmt.Sprintf("Failed to simulate: %d steps", failedCount)) + "\n")
+			}
+			b.WriteString("\n")
+			b.WriteString(m.outputPaneView())
+			b.WriteString("Press 'a' to apply for real • Enter to view results\n")
+			return b.String()
 		} else {
-			b.WriteString(m.warningStyle.Render("⚠️  Installation completed with some failures") + "\n\n")
-		}
-
-		b.WriteString(m.normalStyle.Render(fmt.Sprintf("Successfully installed: %d packages", successCount)) + "\n")
-		if failedCount > 0 {
-			b.WriteString(m.errorStyle.Render(fmt.Sprintf("Failed to install: %d packages", failedCount)) + "\n")
+			if failedCount == 0 {
+				b.WriteString(m.successStyle.Render("✅ All packages installed successfully!") + "\n\n")
+			} else {
+				b.WriteString(m.warningStyle.Render("⚠️  Installation completed with some failures") + "\n\n")
+			}
+			b.WriteString(m.normalStyle.Render(fmt.Sprintf("Successfully installed: %d packages", successCount)) + "\n")
+			if failedCount > 0 {
+				b.WriteString(m.errorStyle.Render(fmt.Sprintf("Failed to install: %d packages", failedCount)) + "\n")
+			}
 		}
-		b.WriteString("\nPress Enter to view detailed results\n")
+		b.WriteString("\n")
+		b.WriteString(m.outputPaneView())
+		b.WriteString("Press Enter to view detailed results\n")
 		return b.String()
 	}
 
 	// Executing state - show spinner with current action
 	if m.executing {
-		// Determine current package being processed
-		packageIndex := m.currentStep - 2
-		currentPackage := ""
-		if packageIndex >= 0 && packageIndex < len(m.shared.PackageSpecs) {
-			currentPackage = m.shared.PackageSpecs[packageIndex].Name
-		}
-
-		if currentPackage != "" {
+		// Determine current package (or fix) being processed
+		packageIndex := m.currentStep - m.packageStepBase()
+		currentPackage := m.stepItemName(packageIndex)
+
+		if m.aborted {
+			b.WriteString(m.warningStyle.Render(fmt.Sprintf("%s  ⏹  Aborting - waiting for the current operation to stop...", m.spinner.View())) + "\n")
+		} else if currentPackage != "" && m.fixMode {
+			b.WriteString(m.headerStyle.Render(fmt.Sprintf("%s  🔧 Applying: %s", m.spinner.View(), currentPackage)) + "\n")
+		} else if currentPackage != "" {
 			b.WriteString(m.headerStyle.Render(fmt.Sprintf("%s  📦 Installing: %s", m.spinner.View(), currentPackage)) + "\n")
 		} else {
 			b.WriteString(m.headerStyle.Render(fmt.Sprintf("%s  Preparing installation...", m.spinner.View())) + "\n")
@@ -338,27 +583,35 @@ func (m *ExecutionModel) View() string {
 			failedCount++
 		}
 	}
-	totalPackages := len(m.shared.PackageSpecs)
+	totalPackages := m.stepItemCount()
 	remainingCount := totalPackages - completedCount - failedCount
+	noun := "packages"
+	if m.fixMode {
+		noun = "fixes"
+	}
 
 	// Progress bar
 	b.WriteString("\n")
 	b.WriteString(m.progress.View() + "\n\n")
 
 	// Statistics line
-	b.WriteString(m.normalStyle.Render(fmt.Sprintf("Progress: %d / %d packages", completedCount+failedCount, totalPackages)) + "\n")
-	b.WriteString(m.normalStyle.Render(fmt.Sprintf("✅ Success: %d  ❌ Failed: %d  ⏳ Remaining: %d", completedCount, failedCount, remainingCount)) + "\n\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("Progress: %d / %d %s", completedCount+failedCount, totalPackages, noun)) + "\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("✅ Success: %d  ❌ Failed: %d  ⏳ Remaining: %d", completedCount, failedCount, remainingCount)) + "\n")
+	if m.executing && !m.aborted {
+		b.WriteString(m.normalStyle.Render("Press X to abort the remaining steps") + "\n")
+	}
+	b.WriteString("\n")
 
 	// Show only completed packages (successful or failed)
 	if completedCount+failedCount > 0 {
-		for i := 0; i < len(m.shared.Results) && i < len(m.shared.PackageSpecs); i++ {
+		for i := 0; i < len(m.shared.Results) && i < totalPackages; i++ {
 			result := m.shared.Results[i]
-			spec := m.shared.PackageSpecs[i]
+			name := m.stepItemName(i)
 
 			if result.OK {
-				b.WriteString(m.successStyle.Render(fmt.Sprintf("✅ %s", spec.Name)) + "\n")
+				b.WriteString(m.successStyle.Render(fmt.Sprintf("✅ %s", name)) + "\n")
 			} else {
-				b.WriteString(m.errorStyle.Render(fmt.Sprintf("❌ %s", spec.Name)) + "\n")
+				b.WriteString(m.errorStyle.Render(fmt.Sprintf("❌ %s", name)) + "\n")
 			}
 		}
 	}
@@ -368,8 +621,8 @@ func (m *ExecutionModel) View() string {
 		b.WriteString("\n")
 		startIndex := completedCount + failedCount
 		for i := startIndex; i < totalPackages && i < startIndex+3; i++ {
-			if i < len(m.shared.PackageSpecs) {
-				b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ %s", m.shared.PackageSpecs[i].Name)) + "\n")
+			if name := m.stepItemName(i); name != "" {
+				b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ %s", name)) + "\n")
 			}
 		}
 		if remainingCount > 3 {
@@ -377,6 +630,23 @@ func (m *ExecutionModel) View() string {
 		}
 	}
 
+	// Live command output pane (git clone progress, pub resolver output)
+	if pane := m.outputPaneView(); pane != "" {
+		b.WriteString("\n" + pane)
+	}
+
+	return b.String()
+}
+
+// outputPaneView renders the scrollable live command output, or nothing
+// when no output has been captured yet
+func (m *ExecutionModel) outputPaneView() string {
+	if len(m.outputLines) == 0 {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteString(m.normalStyle.Render("── Command output (↑/↓ to scroll) ──") + "\n")
+	b.WriteString(m.output.View() + "\n")
 	return b.String()
 }
 
@@ -384,10 +654,14 @@ func (m *ExecutionModel) View() string {
 // This is the entry point that kicks off the first step (backup creation).
 // Returns a command that sends the first executionStepMsg after a brief delay.
 func (m *ExecutionModel) executeInstallation() tea.Cmd {
+	stepName := "Creating pubspec.yaml backup"
+	if m.fixMode {
+		stepName = "Preparing fix application"
+	}
 	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
 		return executionStepMsg{
 			step:     1,
-			stepName: "Creating pubspec.yaml backup",
+			stepName: stepName,
 			err:      nil,
 		}
 	})
@@ -406,9 +680,26 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 	return func() tea.Msg {
 		m.logger.Info("execution", fmt.Sprintf("=== executeNextStep called: currentStep=%d, totalSteps=%d ===", m.currentStep, m.totalSteps))
 
+		// Stop between steps once the user has aborted - completed work is
+		// kept, remaining packages are skipped
+		if m.execCtx.Err() != nil {
+			m.logger.Info("execution", "Abort requested - skipping remaining steps")
+			return executionCompleteMsg{
+				results: m.shared.Results,
+				err:     fmt.Errorf("installation aborted - completed steps were kept, remaining packages skipped"),
+			}
+		}
+
+		// Recommendation fix flow runs its own step machine; the underlying
+		// operations create their own tracked backups (see core/recofix.go)
+		if m.fixMode {
+			return m.executeFixStep()
+		}
+
 		// Check if we need to clone source project first (step 1)
-		if m.shared.SourceRepo != nil && m.shared.SourceProject != nil && m.currentStep == 1 {
+		if m.sourceFlow && m.currentStep == 1 {
 			m.logger.Info("execution", ">>> EXECUTING SOURCE CLONE <<<")
+			m.logger.Narrate(fmt.Sprintf("Cloning source project %s", m.shared.SourceRepo.Name))
 			// Step 1: Clone source project
 			m.logger.Info("execution", fmt.Sprintf("Cloning source: %s to %s/%s",
 				m.shared.SourceRepo.URL,
@@ -443,8 +734,10 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 			// Make targetPath absolute for display
 			absPath, _ := filepath.Abs(targetPath)
 
-			// Use GitClone from core
-			result := core.GitClone(m.logger, &m.cfg, m.shared.SourceRepo.URL, targetPath, "")
+			// Clone through the provider matching the repo's host (GitHub,
+			// Bitbucket or plain git all share the git CLI clone path)
+			provider := core.ProviderForURL(m.shared.SourceRepo.URL)
+			result := provider.Clone(m.execCtx, m.logger, &m.cfg, *m.shared.SourceRepo, targetPath, "")
 
 			if !result.OK {
 				errMsg := fmt.Sprintf("Failed to clone source project: %s", result.Err)
@@ -494,14 +787,81 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 			}
 		}
 
-		// Add dependencies to pubspec.yaml (step 2+)
-		if m.currentStep > 1 && m.currentStep <= len(m.shared.PackageSpecs)+1 {
-			packageIndex := m.currentStep - 2
+		// Step 2 (source flow): verify the clone is a Flutter project,
+		// scaffolding one with `flutter create .` when the repository ships
+		// without a pubspec.yaml (see core/bootstrap.go)
+		if m.sourceFlow && m.currentStep == 2 {
+			m.logger.Info("execution", ">>> VERIFYING FLUTTER PROJECT <<<")
+			m.logger.Narrate("Verifying the cloned repository is a Flutter project")
+
+			result := core.EnsureFlutterProjectContext(m.execCtx, m.logger, &m.cfg, m.activeProjectPath())
+			m.shared.Results = append(m.shared.Results, result)
+
+			if !result.OK {
+				errMsg := fmt.Sprintf("Failed to initialize Flutter project: %s", result.Err)
+				m.logger.Info("execution", errMsg)
+				return executionStepMsg{
+					step:     m.currentStep + 1,
+					stepName: "Failed to initialize project",
+					err:      fmt.Errorf("%s", errMsg),
+				}
+			}
+
+			// Template flow: the clone still carries the template's package
+			// name and bundle identifiers - rename it to the project name
+			// the user chose (see core/template.go)
+			if m.shared.TemplateMode && m.shared.SourceProject != nil {
+				org := "com." + core.SanitizeDartPackageName(m.shared.SourceRepo.Owner)
+				renameResult := core.RewriteProjectIdentity(m.logger, &m.cfg, m.activeProjectPath(), m.shared.SourceProject.Name, org)
+				m.shared.Results = append(m.shared.Results, renameResult)
+				if !renameResult.OK {
+					// A failed rename leaves a working project under the
+					// template's identity - report it but keep going
+					m.logger.Info("execution", fmt.Sprintf("⚠️ Identity rewrite failed: %s", renameResult.Err))
+				} else {
+					m.logger.Info("execution", renameResult.Message)
+				}
+			}
+
+			m.logger.Info("execution", result.Message)
+			return executionStepMsg{
+				step:     m.currentStep + 1,
+				stepName: result.Message,
+				err:      nil,
+			}
+		}
+
+		// Step N+3 (source flow): resolve the freshly added dependencies
+		// with a final pub get before wrapping up
+		if m.sourceFlow && m.currentStep == m.totalSteps-1 {
+			m.logger.Info("execution", ">>> RUNNING FINAL PUB GET <<<")
+			m.logger.Narrate("Resolving dependencies with pub get")
+
+			// A failed resolve is reported but doesn't fail the bootstrap -
+			// the dependencies are already in pubspec.yaml and the user can
+			// rerun pub get after fixing the cause
+			if result := core.SyncContext(m.execCtx, m.logger, &m.cfg, m.activeProjectPath()); !result.OK {
+				m.logger.Info("execution", fmt.Sprintf("⚠️ pub get failed: %s", result.Err))
+			} else {
+				m.logger.Info("execution", result.Message)
+			}
+
+			return executionStepMsg{
+				step:     m.currentStep + 1,
+				stepName: "Opening project directory...",
+				err:      nil,
+			}
+		}
+
+		// Add dependencies to pubspec.yaml
+		if base := m.packageStepBase(); m.currentStep >= base && m.currentStep < base+len(m.shared.PackageSpecs) {
+			packageIndex := m.currentStep - base
 			if packageIndex >= 0 && packageIndex < len(m.shared.PackageSpecs) {
 				spec := m.shared.PackageSpecs[packageIndex]
 
 				m.logger.Info("execution", fmt.Sprintf(">>> ADDING DEPENDENCY: %s <<<", spec.Name))
 				m.logger.Info("execution", fmt.Sprintf("Package index: %d of %d", packageIndex+1, len(m.shared.PackageSpecs)))
+				m.logger.Narrate(fmt.Sprintf("Installing package %d of %d: %s", packageIndex+1, len(m.shared.PackageSpecs), spec.Name))
 				m.logger.Info("execution", fmt.Sprintf("Current step: %d of %d", m.currentStep, m.totalSteps))
 
 				// Determine project path
@@ -519,6 +879,59 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 				m.logger.Debug("execution", fmt.Sprintf("  URL: %s", spec.URL))
 				m.logger.Debug("execution", fmt.Sprintf("  Ref: %s", spec.Ref))
 
+				// Single-pass batch mode: write every git dependency in one
+				// pubspec.yaml edit followed by a single pub get (see
+				// core/batch.go). Failure falls through to the per-package
+				// pub add flow below with its conflict analysis.
+				if !m.batchTried && packageIndex == 0 && len(m.shared.PackageSpecs) > 1 {
+					m.batchTried = true
+					batchStart := time.Now()
+					if results, ok := core.AddGitDependenciesBatch(m.logger, &m.cfg, projectPath, m.shared.PackageSpecs); ok {
+						m.batchDone = true
+						m.logger.Info("execution", fmt.Sprintf("✅ Batch mode added %d packages in %s",
+							len(results), time.Since(batchStart)))
+						for i, result := range results {
+							if result.Data == nil {
+								result.Data = make(map[string]interface{})
+							}
+							batchSpec := m.shared.PackageSpecs[i]
+							result.Data["package"] = batchSpec.Name
+							result.Data["url"] = batchSpec.URL
+							result.Data["ref"] = batchSpec.Ref
+							result.Data["projectPath"] = absProjectPath
+							m.shared.Results = append(m.shared.Results, result)
+
+							// Count the add for the quick-add favorites list
+							// (simulated adds don't count)
+							if result.OK && !m.cfg.DryRun {
+								if err := core.RecordFavoriteAdd(batchSpec); err != nil {
+									m.logger.Debug("execution", fmt.Sprintf("Could not update favorites: %v", err))
+								}
+							}
+						}
+						// Extra targets get the same packages, one by one
+						for _, batchSpec := range m.shared.PackageSpecs {
+							m.applyToExtraTargets(batchSpec, projectPath)
+						}
+						return executionStepMsg{
+							step:     m.currentStep + 1,
+							stepName: "Finalizing...",
+							err:      nil,
+						}
+					}
+					m.logger.Info("execution", "Batch mode not used, adding packages one by one")
+				}
+
+				// Remaining package steps are no-ops once the batch edit
+				// already recorded every result
+				if m.batchDone {
+					return executionStepMsg{
+						step:     m.currentStep + 1,
+						stepName: "Finalizing...",
+						err:      nil,
+					}
+				}
+
 				// INSTRUMENTATION: Track time between package additions
 				if packageIndex > 0 {
 					m.logger.Debug("execution", "=== TIME SINCE LAST PACKAGE ADDITION ===")
@@ -532,7 +945,7 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 
 				// Phase 1: Try installation without auto-resolving conflicts
 				// Conflicts will be collected and resolved in separate phase
-				result := core.AddGitDependency(m.logger, &m.cfg, projectPath, spec, false)
+				result := core.AddGitDependencyContext(m.execCtx, m.logger, &m.cfg, projectPath, spec, false)
 
 				addEndTime := time.Now()
 				addDuration := addEndTime.Sub(addStartTime)
@@ -566,6 +979,10 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 					// Store failed result
 					m.shared.Results = append(m.shared.Results, result)
 
+					// A failure on the primary project doesn't block the
+					// extra targets - each pubspec resolves independently
+					m.applyToExtraTargets(spec, projectPath)
+
 					// Continue to next package instead of stopping
 					// This allows other packages to be installed even if one fails
 					stepMsg := fmt.Sprintf("Failed: %s", spec.Name)
@@ -601,6 +1018,17 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 				// Store successful result (only once!)
 				m.shared.Results = append(m.shared.Results, result)
 
+				// Count the add for the quick-add favorites list (simulated
+				// adds don't count)
+				if !m.cfg.DryRun {
+					if err := core.RecordFavoriteAdd(spec); err != nil {
+						m.logger.Debug("execution", fmt.Sprintf("Could not update favorites: %v", err))
+					}
+				}
+
+				// Apply the same package to the extra target projects
+				m.applyToExtraTargets(spec, projectPath)
+
 				// Determine next step message
 				nextStepMsg := ""
 				nextPackageIndex := packageIndex + 1
@@ -630,6 +1058,29 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 				}}
 			}
 
+			// Generate smart recommendations for successfully added packages
+			// (e.g. warn when a git dependency is also published on pub.dev)
+			var addedSpecs []core.PkgSpec
+			for i, result := range m.shared.Results {
+				packageIndex := i - (len(m.shared.Results) - len(m.shared.PackageSpecs))
+				if result.OK && packageIndex >= 0 && packageIndex < len(m.shared.PackageSpecs) {
+					addedSpecs = append(addedSpecs, m.shared.PackageSpecs[packageIndex])
+				}
+			}
+			recos := core.GeneratePubDevRecommendations(m.logger, addedSpecs)
+			// Pinning findings name a concrete tag and carry a one-key fix
+			// the results screen can apply (see core/recofix.go)
+			recos = append(recos, core.GeneratePinningRecommendations(m.logger, addedSpecs)...)
+			m.shared.Recommendations = recos
+
+			// Security: check the freshly resolved lock against OSV.dev; a
+			// failed audit (e.g. offline) just leaves the section empty
+			if findings, auditErr := core.AuditDependencies(m.logger, m.activeProjectPath()); auditErr == nil {
+				m.shared.AuditFindings = findings
+			} else {
+				m.logger.Debug("audit", fmt.Sprintf("post-install audit skipped: %v", auditErr))
+			}
+
 			// Check if any packages need conflict resolution
 			var conflictPackages []int // indices of packages that need resolution
 			for i, result := range m.shared.Results {
@@ -646,6 +1097,36 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 				m.logger.Info("execution", fmt.Sprintf("📋 Installation complete. %d packages need conflict resolution", len(conflictPackages)))
 			}
 
+			// Post-install verification hooks (see core/verify.go): projects
+			// opting in via .flutter-pm.yaml get `dart analyze` / `flutter
+			// test` run against the fresh resolution, so a bad dependency
+			// bump is caught here instead of at the next manual build
+			if !m.fixMode && len(addedSpecs) > 0 && len(conflictPackages) == 0 {
+				if verifyResults := core.RunPostInstallVerification(m.execCtx, m.logger, &m.cfg, m.activeProjectPath()); len(verifyResults) > 0 {
+					m.logger.Narrate("Running post-install verification hooks")
+					m.shared.Results = append(m.shared.Results, verifyResults...)
+				}
+			}
+
+			// Config-driven post-step: commit the pubspec changes with a
+			// generated message (see core/autocommit.go); only when the run
+			// actually added something and nothing is left to resolve
+			if m.cfg.AutoCommit && !m.fixMode && len(addedSpecs) > 0 && len(conflictPackages) == 0 {
+				commitResult := core.AutoCommitChanges(m.logger, &m.cfg, m.activeProjectPath(), addedSpecs)
+				m.shared.Results = append(m.shared.Results, commitResult)
+				if !commitResult.OK {
+					m.logger.Info("execution", fmt.Sprintf("⚠️ Auto-commit failed: %s", commitResult.Err))
+				}
+			}
+
+			// Final bootstrap stage: show the freshly set up project in the
+			// platform file manager; a missing opener is merely logged
+			if m.sourceFlow && len(conflictPackages) == 0 {
+				if result := core.OpenProjectDir(m.logger, &m.cfg, m.activeProjectPath()); !result.OK {
+					m.logger.Debug("execution", fmt.Sprintf("could not open project directory: %s", result.Err))
+				}
+			}
+
 			return executionCompleteMsg{
 				results: m.shared.Results,
 				err:     nil,
@@ -670,3 +1151,161 @@ func (m *ExecutionModel) executeNextStep() tea.Cmd {
 		}
 	}
 }
+
+// executeFixStep advances the recommendation fix flow: step 1 validates the
+// target project, steps 2..N+1 apply one selected fix each, and the final
+// step prunes the applied recommendations before completing.
+func (m *ExecutionModel) executeFixStep() tea.Msg {
+	fixes := m.shared.PendingFixes
+
+	// Step 1: make sure the project the fixes target still has a pubspec
+	if m.currentStep == 1 {
+		projectPath := m.activeProjectPath()
+		if _, err := os.Stat(filepath.Join(projectPath, "pubspec.yaml")); err != nil {
+			return executionCompleteMsg{
+				results: m.shared.Results,
+				err:     fmt.Errorf("no pubspec.yaml found in %s", projectPath),
+			}
+		}
+		return executionStepMsg{
+			step:     m.currentStep + 1,
+			stepName: fmt.Sprintf("Applying: %s", fixes[0].Label),
+			err:      nil,
+		}
+	}
+
+	// Steps 2..N+1: apply one fix per step; failures don't stop the rest
+	fixIndex := m.currentStep - 2
+	if fixIndex >= 0 && fixIndex < len(fixes) {
+		action := fixes[fixIndex]
+		m.logger.Info("execution", fmt.Sprintf(">>> APPLYING FIX: %s <<<", action.Label))
+
+		result := core.ApplyRecoFix(m.logger, &m.cfg, m.activeProjectPath(), action)
+		if result.Data == nil {
+			result.Data = make(map[string]interface{})
+		}
+		result.Data["package"] = action.Package
+		result.Data["fix"] = action.Label
+		m.shared.Results = append(m.shared.Results, result)
+
+		if !result.OK {
+			m.logger.Info("execution", fmt.Sprintf("❌ Fix failed for %s: %s", action.Package, result.Err))
+		}
+
+		stepName := "Finalizing..."
+		if fixIndex+1 < len(fixes) {
+			stepName = fmt.Sprintf("Applying: %s", fixes[fixIndex+1].Label)
+		}
+		return executionStepMsg{
+			step:     m.currentStep + 1,
+			stepName: stepName,
+			err:      nil,
+		}
+	}
+
+	// Final step: drop the recommendations whose fix succeeded so the
+	// results screen only re-offers what is still outstanding
+	applied := make(map[string]bool)
+	for i, result := range m.shared.Results {
+		if result.OK && i < len(fixes) {
+			applied[fixes[i].Kind+"/"+fixes[i].Package] = true
+		}
+	}
+	var remaining []core.Reco
+	for _, reco := range m.shared.Recommendations {
+		if reco.FixAction != nil && applied[reco.FixAction.Kind+"/"+reco.FixAction.Package] {
+			continue
+		}
+		remaining = append(remaining, reco)
+	}
+	m.shared.Recommendations = remaining
+	m.shared.PendingFixes = nil
+
+	return executionCompleteMsg{
+		results: m.shared.Results,
+		err:     nil,
+	}
+}
+
+// activeProjectPath resolves the project this screen operates on, matching
+// the path resolution the results screen uses for undo
+func (m *ExecutionModel) activeProjectPath() string {
+	projectPath := m.shared.SourceProjectPath
+	if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+		projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	if projectPath == "" {
+		projectPath = "."
+	}
+	return projectPath
+}
+
+// packageStepBase returns the step number of the first package step: the
+// source clone flow spends steps 1-2 on the clone and the Flutter project
+// check, every other flow only step 1 on preparation
+func (m *ExecutionModel) packageStepBase() int {
+	if m.sourceFlow {
+		return 3
+	}
+	return 2
+}
+
+// applyToExtraTargets adds spec to every extra target project selected on
+// the scan screen (space-toggled), skipping the primary project. One tagged
+// result per target lands in shared.Results so the results screen can show
+// the per-project matrix.
+func (m *ExecutionModel) applyToExtraTargets(spec core.PkgSpec, primaryPath string) {
+	if len(m.shared.TargetProjects) == 0 {
+		return
+	}
+
+	absPrimary, _ := filepath.Abs(primaryPath)
+	extra := make([]core.Project, 0, len(m.shared.TargetProjects))
+	for _, target := range m.shared.TargetProjects {
+		if absTarget, _ := filepath.Abs(target.Path); absTarget == absPrimary {
+			continue
+		}
+		extra = append(extra, target)
+	}
+	if len(extra) == 0 {
+		return
+	}
+
+	m.logger.Info("execution", fmt.Sprintf("Applying %s to %d extra target project(s)", spec.Name, len(extra)))
+	results := core.AddGitDependencyToPackages(m.logger, &m.cfg, extra, spec)
+	for i, result := range results {
+		if result.Data == nil {
+			result.Data = make(map[string]interface{})
+		}
+		result.Data["package"] = spec.Name
+		result.Data["url"] = spec.URL
+		result.Data["ref"] = spec.Ref
+		if i < len(extra) {
+			absTarget, _ := filepath.Abs(extra[i].Path)
+			result.Data["projectPath"] = absTarget
+		}
+		m.shared.Results = append(m.shared.Results, result)
+	}
+}
+
+// stepItemCount and stepItemName abstract the per-step item list over the
+// two flows this screen runs: package installation and fix application
+func (m *ExecutionModel) stepItemCount() int {
+	if m.fixMode {
+		return len(m.shared.PendingFixes)
+	}
+	return len(m.shared.PackageSpecs)
+}
+
+func (m *ExecutionModel) stepItemName(i int) string {
+	if m.fixMode {
+		if i >= 0 && i < len(m.shared.PendingFixes) {
+			return m.shared.PendingFixes[i].Label
+		}
+		return ""
+	}
+	if i >= 0 && i < len(m.shared.PackageSpecs) {
+		return m.shared.PackageSpecs[i].Name
+	}
+	return ""
+}
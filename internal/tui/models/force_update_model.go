@@ -0,0 +1,415 @@
+// Package models/force_update_model.go - Express Git Update Screen
+//
+// This file implements the express git update flow (menu option "Update
+// stale packages"). It enumerates the project's git dependencies, compares
+// the SHAs locked in pubspec.lock against upstream via git ls-remote, shows
+// a pre-flight table of what will change, and only then runs a targeted
+// `pub upgrade <pkg>` per stale package with per-package progress and a
+// final old -> new SHA summary. Nothing is touched before the user confirms
+// the pre-flight table.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// ForceUpdateModel handles the express git update screen
+type ForceUpdateModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// Pre-flight state
+	loading bool
+	loadErr string
+	stale   []core.StaleInfo  // Only entries with IsStale=true
+	fresh   int               // Git dependencies already up to date
+	urls    map[string]string // Git URL per package, for changelog fetching
+
+	// Changelog pane (see core/changelog.go): commits between the locked
+	// and upstream SHA per stale package, fetched on demand with 'c'
+	changelogLoading bool
+	changelogReady   bool
+	changelogErr     string
+	changelog        viewport.Model
+
+	// Update state
+	updating     bool
+	currentIndex int                 // Package currently being updated
+	results      []core.ActionResult // One per stale package, in order
+	done         bool
+
+	// UI components
+	spinner spinner.Model
+
+	// Styles
+	titleStyle   lipgloss.Style
+	normalStyle  lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// forceUpdateLoadedMsg is sent when the stale comparison has finished
+type forceUpdateLoadedMsg struct {
+	stale []core.StaleInfo
+	fresh int
+	urls  map[string]string
+	err   error
+}
+
+// forceUpdateChangelogMsg is sent when the commit logs have been fetched
+type forceUpdateChangelogMsg struct {
+	lines []string
+	err   error
+}
+
+// forceUpdatePkgDoneMsg is sent after each targeted pub upgrade completes
+type forceUpdatePkgDoneMsg struct {
+	result core.ActionResult
+}
+
+// NewForceUpdateModel creates a new express git update model
+func NewForceUpdateModel(cfg core.Config, logger *core.Logger, shared *AppState) *ForceUpdateModel {
+	s := components.NewSpinner()
+
+	return &ForceUpdateModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		loading: true,
+		spinner: s,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts the stale comparison
+func (m *ForceUpdateModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.loadStaleInfo())
+}
+
+// Update handles messages for the express update screen
+func (m *ForceUpdateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// No input while scanning or updating
+		if m.loading || m.updating {
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			return m, NavigateBack()
+		case "c":
+			// Fetch the commit logs behind the pre-flight table on demand
+			if !m.done && m.loadErr == "" && len(m.stale) > 0 && !m.changelogLoading && !m.changelogReady {
+				m.changelogLoading = true
+				return m, tea.Batch(m.spinner.Tick, m.loadChangelog())
+			}
+			return m, nil
+		case "enter":
+			if m.done || m.loadErr != "" || len(m.stale) == 0 {
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			// Confirmed pre-flight: start the targeted updates
+			m.updating = true
+			m.currentIndex = 0
+			m.logger.Narrate(fmt.Sprintf("Express update: refreshing %d stale git dependencies", len(m.stale)))
+			return m, tea.Batch(m.spinner.Tick, m.updateCurrentPackage())
+		}
+		// Remaining keys (up/down/pgup/pgdn) scroll the changelog pane
+		if m.changelogReady {
+			var cmd tea.Cmd
+			m.changelog, cmd = m.changelog.Update(msg)
+			return m, cmd
+		}
+		return m, nil
+
+	case forceUpdateLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.stale = msg.stale
+		m.fresh = msg.fresh
+		m.urls = msg.urls
+		return m, nil
+
+	case forceUpdateChangelogMsg:
+		m.changelogLoading = false
+		if msg.err != nil {
+			m.changelogErr = msg.err.Error()
+			return m, nil
+		}
+		m.changelog = viewport.New(76, 10)
+		m.changelog.SetContent(strings.Join(msg.lines, "\n"))
+		m.changelogReady = true
+		return m, nil
+
+	case forceUpdatePkgDoneMsg:
+		m.results = append(m.results, msg.result)
+		m.currentIndex++
+		if m.currentIndex < len(m.stale) {
+			return m, m.updateCurrentPackage()
+		}
+		// All packages processed
+		m.updating = false
+		m.done = true
+		succeeded := 0
+		for _, result := range m.results {
+			if result.OK {
+				succeeded++
+			}
+		}
+		m.logger.Narrate(fmt.Sprintf("Express update finished: %d of %d packages updated", succeeded, len(m.stale)))
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.loading || m.updating || m.changelogLoading {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the express update screen
+func (m *ForceUpdateModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🔄 Express Git Update")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Comparing pubspec.lock against upstream...", m.spinner.View())) + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Project: %s", m.shared.DetectedProject)) + "\n\n")
+
+	if len(m.stale) == 0 {
+		b.WriteString(m.successStyle.Render(fmt.Sprintf("✅ All %d git dependencies are up to date", m.fresh)) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.updating {
+		current := m.stale[m.currentIndex]
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Updating %s (%d/%d)...",
+			m.spinner.View(), current.PackageName, m.currentIndex+1, len(m.stale))) + "\n\n")
+		b.WriteString(m.resultLines())
+		return b.String()
+	}
+
+	if m.done {
+		succeeded := 0
+		for _, result := range m.results {
+			if result.OK {
+				succeeded++
+			}
+		}
+		if succeeded == len(m.results) {
+			b.WriteString(m.successStyle.Render(fmt.Sprintf("✅ Updated %d packages", succeeded)) + "\n\n")
+		} else {
+			b.WriteString(m.errorStyle.Render(fmt.Sprintf("⚠️ Updated %d of %d packages", succeeded, len(m.results))) + "\n\n")
+		}
+		b.WriteString(m.resultLines())
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	// Pre-flight table: what will change, old -> new SHA per package
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("%d stale package(s) will be updated:", len(m.stale))) + "\n\n")
+	for _, info := range m.stale {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("  %s (%s): %s → %s",
+			info.PackageName, info.CurrentRef, shortSHA(info.CurrentSHA), shortSHA(info.UpstreamSHA))) + "\n")
+	}
+	if m.fresh > 0 {
+		b.WriteString("\n" + m.normalStyle.Render(fmt.Sprintf("%d other git dependencies are already up to date", m.fresh)) + "\n")
+	}
+
+	// Incoming commits pane (see core/changelog.go), fetched with 'c'
+	switch {
+	case m.changelogLoading:
+		b.WriteString("\n" + m.normalStyle.Render(fmt.Sprintf("%s Fetching incoming commits...", m.spinner.View())) + "\n")
+	case m.changelogErr != "":
+		b.WriteString("\n" + m.errorStyle.Render("⚠️ Could not fetch commits: "+m.changelogErr) + "\n")
+	case m.changelogReady:
+		b.WriteString("\n" + m.titleStyle.Render("📜 Incoming commits:") + "\n")
+		b.WriteString(m.changelog.View() + "\n")
+	}
+
+	help := "enter run updates • c show incoming commits • esc back to menu"
+	if m.changelogReady {
+		help = "enter run updates • up/down scroll commits • esc back to menu"
+	}
+	b.WriteString("\n" + m.helpStyle.Render(help) + "\n")
+
+	return b.String()
+}
+
+// resultLines renders one status line per processed package
+func (m *ForceUpdateModel) resultLines() string {
+	var b strings.Builder
+	for i, result := range m.results {
+		if result.OK {
+			b.WriteString(m.successStyle.Render("  ✅ "+result.Message) + "\n")
+		} else {
+			b.WriteString(m.errorStyle.Render(fmt.Sprintf("  ❌ %s: %s", m.stale[i].PackageName, result.Err)) + "\n")
+		}
+	}
+	return b.String()
+}
+
+// loadStaleInfo compares locked SHAs against upstream in the background
+func (m *ForceUpdateModel) loadStaleInfo() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.projectPath()
+
+		deps, err := core.ListGitDependencies(projectPath)
+		if err != nil {
+			return forceUpdateLoadedMsg{err: err}
+		}
+		if len(deps) == 0 {
+			return forceUpdateLoadedMsg{err: fmt.Errorf("no git dependencies found in %s", projectPath)}
+		}
+
+		staleInfo, err := core.CheckStalePrecise(m.logger, projectPath)
+		if err != nil {
+			return forceUpdateLoadedMsg{err: err}
+		}
+
+		var stale []core.StaleInfo
+		fresh := 0
+		for _, info := range staleInfo {
+			if info.IsStale {
+				stale = append(stale, info)
+			} else {
+				fresh++
+			}
+		}
+
+		// Remember each dependency's URL so the changelog pane can compare
+		// the locked SHA against upstream
+		urls := make(map[string]string, len(deps))
+		for _, dep := range deps {
+			urls[dep.Name] = dep.URL
+		}
+		return forceUpdateLoadedMsg{stale: stale, fresh: fresh, urls: urls}
+	}
+}
+
+// loadChangelog fetches the commit log between the locked and upstream SHA
+// for every stale package, concatenated into one pane with per-package
+// headings; packages whose log cannot be fetched report inline
+func (m *ForceUpdateModel) loadChangelog() tea.Cmd {
+	return func() tea.Msg {
+		var lines []string
+		for _, info := range m.stale {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, fmt.Sprintf("── %s (%s → %s) ──",
+				info.PackageName, shortSHA(info.CurrentSHA), shortSHA(info.UpstreamSHA)))
+
+			log, err := core.FetchCommitLog(m.logger, m.urls[info.PackageName], info.CurrentSHA, info.UpstreamSHA)
+			if err != nil {
+				lines = append(lines, fmt.Sprintf("  (unavailable: %v)", err))
+				continue
+			}
+			if len(log) == 0 {
+				lines = append(lines, "  (no commits in range)")
+				continue
+			}
+			for _, line := range log {
+				lines = append(lines, "  "+line)
+			}
+		}
+		return forceUpdateChangelogMsg{lines: lines}
+	}
+}
+
+// updateCurrentPackage runs a targeted pub upgrade for the current stale
+// package, reusing the single-dependency update pipeline (backup, lock
+// refresh and old -> new SHA diff)
+func (m *ForceUpdateModel) updateCurrentPackage() tea.Cmd {
+	return func() tea.Msg {
+		info := m.stale[m.currentIndex]
+		result := core.UpdateSingleDependency(m.logger, &m.cfg, m.projectPath(), info.PackageName, "", false)
+		return forceUpdatePkgDoneMsg{result: result}
+	}
+}
+
+// projectPath resolves the project directory the update targets
+func (m *ForceUpdateModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// shortSHA truncates a commit SHA for display
+func shortSHA(sha string) string {
+	if sha == "" {
+		return "unknown"
+	}
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
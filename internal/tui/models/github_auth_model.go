@@ -0,0 +1,202 @@
+// Package models/github_auth_model.go - GitHub Device-Flow Sign-In Screen
+//
+// This file implements the OAuth device-flow sign-in shown when neither the
+// gh CLI nor a configured token is available (see core/github_api.go). It
+// requests a device code, displays the short user code with the
+// verification URL, polls GitHub until the user approves in the browser,
+// stores the granted token in the global config, and then returns to the
+// repository loading screen to retry the fetch.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// GitHubAuthModel handles the device-flow sign-in screen
+type GitHubAuthModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	auth    *core.DeviceAuth // Pending authorization (nil while requesting)
+	authErr string
+	saved   bool // Token granted and written to the global config
+
+	spinner spinner.Model
+
+	titleStyle   lipgloss.Style
+	codeStyle    lipgloss.Style
+	normalStyle  lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// deviceCodeMsg is sent when the device/user code pair has been issued
+type deviceCodeMsg struct {
+	auth *core.DeviceAuth
+	err  error
+}
+
+// deviceTokenMsg is sent when polling finishes (grant, denial or expiry)
+type deviceTokenMsg struct {
+	token string
+	err   error
+}
+
+// NewGitHubAuthModel creates a new device-flow sign-in model
+func NewGitHubAuthModel(cfg core.Config, logger *core.Logger, shared *AppState) *GitHubAuthModel {
+	s := components.NewSpinner()
+
+	return &GitHubAuthModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		spinner: s,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		codeStyle: lipgloss.NewStyle().
+			Foreground(theme.Text()).
+			Background(theme.Highlight()).
+			Padding(0, 1).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init requests the device code
+func (m *GitHubAuthModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, func() tea.Msg {
+		auth, err := core.StartGitHubDeviceFlow()
+		return deviceCodeMsg{auth: auth, err: err}
+	})
+}
+
+// Update handles messages for the sign-in screen
+func (m *GitHubAuthModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, NavigateBack()
+		case "enter":
+			if m.saved {
+				// Retry the repository fetch with the stored token
+				return m, TransitionToScreen(ScreenGitHubRepo)
+			}
+			if m.authErr != "" {
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+		}
+		return m, nil
+
+	case deviceCodeMsg:
+		if msg.err != nil {
+			m.authErr = msg.err.Error()
+			return m, nil
+		}
+		m.auth = msg.auth
+		m.logger.Info("github_auth", fmt.Sprintf("Device flow started, user code %s", msg.auth.UserCode))
+		// Poll in the background until the user approves in the browser
+		return m, func() tea.Msg {
+			token, err := core.PollGitHubDeviceFlow(msg.auth)
+			return deviceTokenMsg{token: token, err: err}
+		}
+
+	case deviceTokenMsg:
+		if msg.err != nil {
+			m.authErr = msg.err.Error()
+			return m, nil
+		}
+		if err := core.SaveGitHubToken(msg.token); err != nil {
+			m.authErr = err.Error()
+			return m, nil
+		}
+		m.saved = true
+		m.logger.Info("github_auth", "GitHub token stored in global config")
+		m.logger.Narrate("Signed in to GitHub via device flow")
+		return m, nil
+
+	case spinner.TickMsg:
+		if !m.saved && m.authErr == "" {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the sign-in screen
+func (m *GitHubAuthModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🔐 Sign in to GitHub")
+
+	b.WriteString(header + "\n\n")
+
+	if m.authErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.authErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.saved {
+		b.WriteString(m.successStyle.Render("✅ Signed in - token stored in the global config") + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to browse your repositories") + "\n")
+		return b.String()
+	}
+
+	if m.auth == nil {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Requesting a sign-in code from GitHub...", m.spinner.View())) + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.normalStyle.Render("The gh CLI is not available, so we'll sign in directly:") + "\n\n")
+	b.WriteString(m.normalStyle.Render("  1. Open ") + m.titleStyle.Render(m.auth.VerificationURI) + "\n")
+	b.WriteString(m.normalStyle.Render("  2. Enter the code ") + m.codeStyle.Render(m.auth.UserCode) + "\n\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Waiting for approval in the browser...", m.spinner.View())) + "\n\n")
+	b.WriteString(m.helpStyle.Render("esc cancel and return to main menu") + "\n")
+
+	return b.String()
+}
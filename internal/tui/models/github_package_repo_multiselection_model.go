@@ -7,16 +7,31 @@ package models
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 	"io"
 )
 
+// resizeDebounce is how long a resize must settle before the list is
+// re-laid out. Terminals emit bursts of WindowSizeMsg while the user
+// drags, and re-laying out a 300+ repo list on every one stutters.
+const resizeDebounce = 100 * time.Millisecond
+
+// resizeSettledMsg fires after resizeDebounce; only the message matching
+// the latest resize sequence applies the pending size
+type resizeSettledMsg struct {
+	seq int
+}
+
 // RepoSelectionModel handles repository browsing and selection using list-simple style
 type RepoSelectionModel struct {
 	cfg    core.Config
@@ -31,43 +46,113 @@ type RepoSelectionModel struct {
 	// State
 	loading     bool
 	loadingText string
+	loadingMore bool // Further repo pages still streaming in
 	ready       bool
 	quitting    bool
 
+	// Channel delivering streamed repository pages (GitHub only; see
+	// core.StreamGitHubRepos)
+	repoPages <-chan core.RepoPage
+
+	// Topic group state for bulk selection (package mode only;
+	// see core/topics.go)
+	topicMode   bool
+	topicGroups []core.TopicGroup
+	topicCursor int
+
+	// View state for large listings (see applyListView): sort mode plus
+	// privacy and Dart/Flutter filter toggles
+	sortMode    repoSortMode
+	privacyOnly string // "" (all), "public" or "private"
+	dartOnly    bool
+	detecting   bool // Background pubspec probe in flight
+
+	// Resize debounce state: the last size seen during a resize burst and
+	// a sequence number so only the final settled message applies it
+	pendingSize *tea.WindowSizeMsg
+	resizeSeq   int
+
+	// Quick add state: git URLs of the most frequently added packages
+	// (pinned on top with a ⭐ marker) and the config-defined team-standard
+	// bundle selectable in one keystroke (see core/favorites.go)
+	favoriteURLs map[string]bool
+	teamBundle   []core.PkgSpec
+
 	// Styles
 	headerStyle   lipgloss.Style
 	overflowStyle lipgloss.Style
 }
 
-// RepoItem represents a repository in the list
+// repoSortMode selects the ordering of the repository list
+type repoSortMode int
+
+const (
+	repoSortPushed repoSortMode = iota // Recently pushed first (default)
+	repoSortName                       // Alphabetical by owner/name
+	repoSortStars                      // Most starred first
+)
+
+func (s repoSortMode) String() string {
+	switch s {
+	case repoSortName:
+		return "name"
+	case repoSortStars:
+		return "stars"
+	default:
+		return "pushed"
+	}
+}
+
+// RepoItem represents a repository in the list. index always points into
+// the underlying shared slice, not the (possibly filtered/sorted) list
+// position, so selections survive view changes.
 type RepoItem struct {
-	repo  core.RepoCandidate
-	index int
+	repo     core.RepoCandidate
+	index    int
+	favorite bool // Frequently added package, pinned in the quick-add section
 }
 
 func (i RepoItem) Title() string {
-	// Simple clean format like list-simple
-	return fmt.Sprintf("%s/%s", i.repo.Owner, i.repo.Name)
+	// Simple clean format like list-simple, with a marker for repos the
+	// pubspec probe confirmed as Dart packages
+	title := fmt.Sprintf("%s/%s", i.repo.Owner, i.repo.Name)
+	if i.favorite {
+		title = "⭐ " + title
+	}
+	if i.repo.PubspecChecked && i.repo.HasPubspec {
+		title += " 📦"
+	}
+	return title
 }
 
 func (i RepoItem) Description() string {
-	// Minimal description for list-simple style
-	if i.repo.Desc == "" {
-		return ""
-	}
-	if len(i.repo.Desc) > 50 {
-		return i.repo.Desc[:47] + "..."
-	}
-	return i.repo.Desc
+	// Minimal description for list-simple style, cut by display cells so
+	// double-width emoji never splits mid-rune
+	return fitLine(i.repo.Desc, 50)
 }
 
 func (i RepoItem) FilterValue() string {
 	return fmt.Sprintf("%s/%s %s", i.repo.Owner, i.repo.Name, i.repo.Desc)
 }
 
-// simpleMultiSelectDelegate is a custom delegate for list-simple style with > markers
+// rowRenderKey identifies one memoized styled row. Width is part of the
+// key so a resize never serves rows styled for the old terminal size, and
+// cursor/selection state is part of the key so toggling needs no explicit
+// invalidation.
+type rowRenderKey struct {
+	index    int // Stable index into the underlying shared slice
+	cursor   bool
+	selected bool
+	width    int
+}
+
+// simpleMultiSelectDelegate is a custom delegate for list-simple style with > markers.
+// The list's paginator already virtualizes rendering - Render only runs for
+// rows on the current page - and rowCache memoizes the styled output of
+// those rows so scrolling a large list doesn't re-run lipgloss per frame.
 type simpleMultiSelectDelegate struct {
 	selectedItems map[int]bool
+	rowCache      map[rowRenderKey]string
 	cursorStyle   lipgloss.Style
 	selectedStyle lipgloss.Style
 	normalStyle   lipgloss.Style
@@ -76,12 +161,13 @@ type simpleMultiSelectDelegate struct {
 func newSimpleMultiSelectDelegate() *simpleMultiSelectDelegate {
 	return &simpleMultiSelectDelegate{
 		selectedItems: make(map[int]bool),
+		rowCache:      make(map[rowRenderKey]string),
 		cursorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#F59E0B")). // Vibrant amber/orange
+			Foreground(theme.Secondary()). // Vibrant amber/orange
 			Bold(true),
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#8B5CF6")). // Beautiful purple
+			Foreground(theme.Text()).
+			Background(theme.Highlight()). // Beautiful purple
 			Bold(true).
 			Padding(0, 1),
 		normalStyle: lipgloss.NewStyle().
@@ -94,6 +180,17 @@ func (d *simpleMultiSelectDelegate) Spacing() int                              {
 func (d *simpleMultiSelectDelegate) Update(msg tea.Msg, m *list.Model) tea.Cmd { return nil }
 func (d *simpleMultiSelectDelegate) Render(w io.Writer, m list.Model, index int, listItem list.Item) {
 	if item, ok := listItem.(RepoItem); ok {
+		key := rowRenderKey{
+			index:    item.index,
+			cursor:   index == m.Index(),
+			selected: d.selectedItems[item.index],
+			width:    contentWidth(),
+		}
+		if line, ok := d.rowCache[key]; ok {
+			fmt.Fprint(w, line)
+			return
+		}
+
 		var cursor string
 		if index == m.Index() {
 			cursor = d.cursorStyle.Render("▶ ") // Beautiful arrow instead of >
@@ -102,7 +199,7 @@ func (d *simpleMultiSelectDelegate) Render(w io.Writer, m list.Model, index int,
 		}
 
 		itemText := item.Title()
-		if d.selectedItems[index] {
+		if d.selectedItems[item.index] {
 			// Highlighted selected item with glowing effect
 			itemText = d.selectedStyle.Render(" ✓ " + itemText + " ")
 		} else {
@@ -117,11 +214,28 @@ func (d *simpleMultiSelectDelegate) Render(w io.Writer, m list.Model, index int,
 			}
 		}
 
-		line := cursor + itemText
+		// Clamp the row to the terminal so long repo names don't wrap and
+		// break the list's fixed-height row math
+		line := fitLine(cursor+itemText, contentWidth())
+		d.rowCache[key] = line
 		fmt.Fprint(w, line)
 	}
 }
 
+// invalidateRows drops the memoized styled rows. Called whenever row
+// content may have changed (new titles after the pubspec probe, a settled
+// resize) so the cache never serves stale text.
+func (d *simpleMultiSelectDelegate) invalidateRows() {
+	d.rowCache = make(map[rowRenderKey]string)
+}
+
+// FilterActive reports whether the fuzzy filter input is capturing typing,
+// so the coordinator keeps global shortcuts (help overlay, keymap aliases)
+// out of the way
+func (m *RepoSelectionModel) FilterActive() bool {
+	return m.list.FilterState() == list.Filtering
+}
+
 // toggleSelection toggles the selection state of an item
 func (d *simpleMultiSelectDelegate) toggleSelection(index int) {
 	if d.selectedItems[index] {
@@ -146,6 +260,18 @@ type reposLoadedMsg struct {
 	err   error
 }
 
+// repoPageMsg carries one streamed page of the GitHub repository listing
+type repoPageMsg struct {
+	page core.RepoPage
+}
+
+// pubspecDetectedMsg carries the repo list re-tagged with pubspec probe
+// results (see core.DetectDartPackages)
+type pubspecDetectedMsg struct {
+	repos      []core.RepoCandidate
+	sourceMode bool
+}
+
 // NewRepoSelectionModel creates a new repository selection model using list-simple style
 func NewRepoSelectionModel(cfg core.Config, logger *core.Logger, shared *AppState) *RepoSelectionModel {
 	// Create custom delegate for list-simple style with > markers and highlights
@@ -154,27 +280,35 @@ func NewRepoSelectionModel(cfg core.Config, logger *core.Logger, shared *AppStat
 	// Create list with custom delegate
 	l := list.New([]list.Item{}, delegate, 80, 20)
 	l.SetShowStatusBar(false)
-	l.SetFilteringEnabled(false)
+	l.SetFilteringEnabled(true) // Fuzzy filter over owner/name/description via "/"
 	l.SetShowHelp(false)
 	l.SetShowTitle(false)
 
 	// Create spinner
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#13B9FD"))
+	s := components.NewSpinner()
+
+	// Quick add data: the most frequently added packages get pinned on top
+	// of the listing, and the team-standard bundle (if configured) is
+	// selectable with one keystroke (see core/favorites.go)
+	favoriteURLs := make(map[string]bool)
+	for _, fav := range core.TopFavoritePackages(5) {
+		favoriteURLs[normalizeRepoURL(fav.URL)] = true
+	}
 
 	return &RepoSelectionModel{
-		cfg:         cfg,
-		logger:      logger,
-		shared:      shared,
-		list:        l,
-		delegate:    delegate,
-		spinner:     s,
-		loading:     true,
-		loadingText: "Fetching GitHub repositories...",
+		cfg:          cfg,
+		logger:       logger,
+		shared:       shared,
+		list:         l,
+		delegate:     delegate,
+		spinner:      s,
+		loading:      true,
+		loadingText:  "Fetching GitHub repositories...",
+		favoriteURLs: favoriteURLs,
+		teamBundle:   core.LoadTeamPackages(),
 
 		headerStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#8B5CF6")).
+			Foreground(theme.Highlight()).
 			Bold(true),
 
 		overflowStyle: lipgloss.NewStyle().
@@ -191,7 +325,7 @@ func (m *RepoSelectionModel) Init() tea.Cmd {
 		m.loading = false
 		m.ready = true
 		m.setupListFromSource()
-		return nil
+		return m.detectPubspecs()
 	}
 
 	// PACKAGE SELECTION MODE - multiselect
@@ -202,7 +336,7 @@ func (m *RepoSelectionModel) Init() tea.Cmd {
 		m.loading = false
 		m.ready = true
 		m.setupList()
-		return nil
+		return m.detectPubspecs()
 	}
 
 	m.loading = true
@@ -225,6 +359,12 @@ func (m *RepoSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m.handleKeys(msg)
 
+	case tea.MouseMsg:
+		if m.loading || !m.ready {
+			return m, nil
+		}
+		return m.handleMouse(msg)
+
 	case reposLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
@@ -237,6 +377,46 @@ func (m *RepoSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.shared.AvailableDependencies = msg.repos
 		m.setupList()
 		m.ready = true
+		return m, m.detectPubspecs()
+
+	case repoPageMsg:
+		page := msg.page
+		if page.Err != nil && len(m.shared.AvailableDependencies) == 0 {
+			m.loading = false
+			m.logger.Error("repo_selection", fmt.Errorf("failed to load repositories: %w", page.Err))
+			return m, TransitionToScreen(ScreenMainMenu)
+		}
+		if len(page.Repos) > 0 {
+			m.shared.AvailableDependencies = append(m.shared.AvailableDependencies, page.Repos...)
+		}
+		if m.loading {
+			// First page: show the list immediately while the rest streams
+			m.loading = false
+			m.ready = true
+			m.setupList()
+		} else if len(page.Repos) > 0 {
+			// Later pages grow the list in place. Appending keeps stable
+			// indices, so selections already made are unaffected.
+			m.topicGroups = core.GroupReposByTopic(m.shared.AvailableDependencies)
+			m.applyListView()
+		}
+		if page.Done {
+			m.loadingMore = false
+			return m, m.detectPubspecs()
+		}
+		m.loadingMore = true
+		return m, m.waitForRepoPage()
+
+	case pubspecDetectedMsg:
+		m.detecting = false
+		if msg.sourceMode {
+			m.shared.AvailableSourceRepos = msg.repos
+		} else {
+			m.shared.AvailableDependencies = msg.repos
+		}
+		// Same order as before, so stable indices (selections, topic
+		// groups) are unaffected
+		m.applyListView()
 		return m, nil
 
 	case spinner.TickMsg:
@@ -247,8 +427,24 @@ func (m *RepoSelectionModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case tea.WindowSizeMsg:
-		m.list.SetWidth(msg.Width)
-		m.list.SetHeight(msg.Height - 8) // Leave space for header and footer
+		// Debounce resize bursts: remember the latest size and only re-lay
+		// out the list once the terminal stops resizing for resizeDebounce
+		m.pendingSize = &msg
+		m.resizeSeq++
+		seq := m.resizeSeq
+		return m, tea.Tick(resizeDebounce, func(time.Time) tea.Msg {
+			return resizeSettledMsg{seq: seq}
+		})
+
+	case resizeSettledMsg:
+		if msg.seq != m.resizeSeq || m.pendingSize == nil {
+			// A newer resize superseded this one; its own tick will apply it
+			return m, nil
+		}
+		m.list.SetWidth(m.pendingSize.Width)
+		m.list.SetHeight(m.pendingSize.Height - 8) // Leave space for header and footer
+		m.pendingSize = nil
+		m.delegate.invalidateRows()
 		return m, nil
 
 	default:
@@ -295,21 +491,67 @@ func (m *RepoSelectionModel) View() string {
 		headerColor = "#8B5CF6" // Cool purple for packages
 	}
 
+	// The count updates live while further pages stream in
+	countLabel := fmt.Sprintf("%d available", itemCount)
+	if m.loadingMore {
+		countLabel += ", loading more..."
+	}
+
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color(headerColor)).
 		Foreground(lipgloss.Color(headerColor)).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Width(62).
+		Width(headerBoxWidth()).
 		Bold(true).
-		Render(fmt.Sprintf("%s (%d available)", headerText, itemCount))
+		Render(fmt.Sprintf("%s (%s)", headerText, countLabel))
+
+	b.WriteString(headerBox + "\n")
+
+	// Quick add section: frequently added packages sit pinned on top of
+	// the listing with a ⭐ marker
+	if !isSourceMode && len(m.favoriteURLs) > 0 {
+		quickAddStyle := lipgloss.NewStyle().
+			Foreground(theme.Secondary()).
+			Bold(true)
+		b.WriteString(quickAddStyle.Render("⭐ Quick add: your most used packages are pinned on top") + "\n")
+	}
+
+	// Background pubspec probe status; confirmed packages get a 📦 marker
+	if m.detecting {
+		detectStyle := lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true)
+		b.WriteString(detectStyle.Render("🔍 Detecting which repositories are Dart packages...") + "\n")
+	}
+	b.WriteString("\n")
+
+	// Topic panel replaces the package list while bulk-selecting by group
+	if m.topicMode {
+		b.WriteString(m.topicPanelView())
 
-	b.WriteString(headerBox + "\n\n")
+		helpStyle := lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true)
+		keyStyle := lipgloss.NewStyle().
+			Foreground(theme.Secondary()).
+			Bold(true)
+
+		b.WriteString("\n")
+		if count := len(m.delegate.getSelectedIndices()); count > 0 {
+			selectionCountStyle := lipgloss.NewStyle().
+				Foreground(lipgloss.Color("#10B981")).
+				Bold(true)
+			b.WriteString(selectionCountStyle.Render(fmt.Sprintf("✓ %d packages selected", count)) + "\n")
+		}
+		b.WriteString(helpStyle.Render(keyStyle.Render("space") + ": toggle group • " + keyStyle.Render("t") + ": back to packages • " + keyStyle.Render("q") + ": back to menu"))
+		return b.String()
+	}
 
 	// Calculate overflow indicators using list's internal pagination
-	// Use correct list depending on mode
-	totalItems := itemCount
+	// (visible items, so active filters are reflected)
+	totalItems := len(m.list.VisibleItems())
 	if totalItems > 0 {
 		visibleItems := m.list.Paginator.ItemsOnPage(totalItems)
 		currentPage := m.list.Paginator.Page
@@ -371,20 +613,41 @@ func (m *RepoSelectionModel) View() string {
 
 	// Footer instructions with colors
 	helpStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#94A3B8")).
+		Foreground(theme.Subtle()).
 		Italic(true)
 
 	keyStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#F59E0B")).
+		Foreground(theme.Secondary()).
 		Bold(true)
 
+	// Active view modifiers (sort always shown; filters only when active)
+	viewHints := []string{"sort: " + m.sortMode.String()}
+	if m.privacyOnly != "" {
+		viewHints = append(viewHints, m.privacyOnly+" only")
+	}
+	if m.dartOnly {
+		viewHints = append(viewHints, "dart/flutter only")
+	}
+	viewHint := " • " + strings.Join(viewHints, " • ")
+
+	viewKeys := " • " + keyStyle.Render("/") + ": search • " + keyStyle.Render("s") + ": sort • " + keyStyle.Render("p") + ": privacy • " + keyStyle.Render("d") + ": dart"
+
 	if isSourceMode {
-		b.WriteString(helpStyle.Render(keyStyle.Render("enter") + ": select project • " + keyStyle.Render("q") + ": back to menu"))
+		b.WriteString(helpStyle.Render(keyStyle.Render("enter") + ": select project" + viewKeys + " • " + keyStyle.Render("q") + ": back to menu" + viewHint))
 	} else {
+		// Offer topic bulk selection only when the provider supplied topics
+		topicHint := ""
+		if len(m.topicGroups) > 0 {
+			topicHint = " • " + keyStyle.Render("t") + ": topic groups"
+		}
+		// Offer the team bundle only when the config defines one
+		if len(m.teamBundle) > 0 {
+			topicHint += " • " + keyStyle.Render("b") + ": team bundle"
+		}
 		if len(selectedIndices) > 0 {
-			b.WriteString(helpStyle.Render(keyStyle.Render("space") + ": toggle • " + keyStyle.Render("enter") + ": confirm selection • " + keyStyle.Render("q") + ": back"))
+			b.WriteString(helpStyle.Render(keyStyle.Render("space") + ": toggle • " + keyStyle.Render("enter") + ": confirm selection" + topicHint + viewKeys + " • " + keyStyle.Render("q") + ": back" + viewHint))
 		} else {
-			b.WriteString(helpStyle.Render(keyStyle.Render("space") + ": toggle packages • select at least 1 to continue • " + keyStyle.Render("q") + ": back"))
+			b.WriteString(helpStyle.Render(keyStyle.Render("space") + ": toggle packages • select at least 1 to continue" + topicHint + viewKeys + " • " + keyStyle.Render("q") + ": back" + viewHint))
 		}
 	}
 
@@ -396,34 +659,104 @@ func (m *RepoSelectionModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// Check if we're in SOURCE selection mode
 	isSourceMode := len(m.shared.AvailableSourceRepos) > 0
 
+	// Topic group panel captures keys while open
+	if m.topicMode {
+		return m.handleTopicKeys(msg)
+	}
+
+	// While the fuzzy filter input is open, every key goes to the list
+	// so typing filters live
+	if m.list.FilterState() == list.Filtering {
+		var cmd tea.Cmd
+		m.list, cmd = m.list.Update(msg)
+		return m, cmd
+	}
+
 	switch msg.String() {
 	case "q", "ctrl+c":
-		return m, TransitionToScreen(ScreenMainMenu)
+		return m, NavigateBack()
+
+	case "esc":
+		// esc clears an applied fuzzy filter before it navigates back
+		if m.list.FilterState() != list.Unfiltered {
+			var cmd tea.Cmd
+			m.list, cmd = m.list.Update(msg)
+			return m, cmd
+		}
+		return m, NavigateBack()
+
+	case "s":
+		// Cycle sort mode: pushed -> name -> stars
+		m.sortMode = (m.sortMode + 1) % 3
+		m.applyListView()
+		return m, nil
+
+	case "p":
+		// Cycle privacy filter: all -> public -> private
+		switch m.privacyOnly {
+		case "":
+			m.privacyOnly = "public"
+		case "public":
+			m.privacyOnly = "private"
+		default:
+			m.privacyOnly = ""
+		}
+		m.applyListView()
+		return m, nil
+
+	case "d":
+		// Toggle "looks like a Dart/Flutter package" filter
+		m.dartOnly = !m.dartOnly
+		m.applyListView()
+		return m, nil
+
+	case "b":
+		// PACKAGE MODE: select the config-defined team-standard bundle in
+		// one keystroke (see core.LoadTeamPackages)
+		if !isSourceMode && len(m.teamBundle) > 0 {
+			m.selectTeamBundle()
+			return m, nil
+		}
+		// Fall through to the list (prev-page binding) without a bundle
+		var bundleCmd tea.Cmd
+		m.list, bundleCmd = m.list.Update(msg)
+		return m, bundleCmd
+
+	case "t":
+		// PACKAGE MODE: open the topic group panel for bulk selection
+		if !isSourceMode && len(m.topicGroups) > 0 {
+			m.topicMode = true
+			return m, nil
+		}
+		// Fall through to the list (e.g. for sources without topics)
+		var cmd tea.Cmd
+		m.list, cmd = m.list.Update(msg)
+		return m, cmd
 
 	case " ":
 		if isSourceMode {
 			// SOURCE MODE: space does nothing (single-select only)
 			return m, nil
 		}
-		// PACKAGE MODE: Multi-select - toggle selection using delegate
-		currentIndex := m.list.Index()
-		if currentIndex >= 0 && currentIndex < len(m.shared.AvailableDependencies) {
-			m.delegate.toggleSelection(currentIndex)
+		// PACKAGE MODE: Multi-select - toggle selection using the item's
+		// stable index so filtered/sorted views select the right repo
+		if item, ok := m.list.SelectedItem().(RepoItem); ok {
+			m.delegate.toggleSelection(item.index)
 		}
 		return m, nil
 
 	case "enter":
 		if isSourceMode {
 			// SOURCE MODE: Select single source and move to source configuration
-			currentIndex := m.list.Index()
-			if currentIndex >= 0 && currentIndex < len(m.shared.AvailableSourceRepos) {
-				selectedRepo := m.shared.AvailableSourceRepos[currentIndex]
+			if item, ok := m.list.SelectedItem().(RepoItem); ok {
+				selectedRepo := item.repo
 				m.shared.SourceProject = &core.Project{
 					Name: selectedRepo.Name,
 					Path: "",
 				}
 				m.shared.SourceRepo = &selectedRepo // Store the full repo info (includes URL)
 				m.logger.Info("source_selection", fmt.Sprintf("Selected source: %s/%s", selectedRepo.Owner, selectedRepo.Name))
+				m.logger.Narrate(fmt.Sprintf("Selected source project %s/%s", selectedRepo.Owner, selectedRepo.Name))
 
 				// Go to source config (save location editing)
 				return m, TransitionToScreen(ScreenSourceConfig)
@@ -448,36 +781,205 @@ func (m *RepoSelectionModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 }
 
-// loadRepositories loads repositories from GitHub
+// handleMouse maps a left click onto the list row under the pointer. In
+// package mode a click toggles the clicked repository like space does; in
+// source mode a click moves the cursor and clicking the highlighted row
+// again selects it like enter. Row math mirrors the View layout.
+func (m *RepoSelectionModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	// The topic panel and the open filter input lay out differently
+	if m.topicMode || m.list.FilterState() == list.Filtering {
+		return m, nil
+	}
+
+	totalItems := len(m.list.VisibleItems())
+	if totalItems == 0 {
+		return m, nil
+	}
+
+	// The list starts below the 5-row header box, the optional pubspec
+	// detection line, a blank line and the optional top overflow indicator
+	listTop := 6
+	if m.detecting {
+		listTop++
+	}
+	startIndex := m.list.Paginator.Page * m.list.Paginator.PerPage
+	if startIndex > 0 {
+		listTop++
+	}
+
+	row := msg.Y - listTop
+	if row < 0 || row >= m.list.Paginator.ItemsOnPage(totalItems) {
+		return m, nil
+	}
+
+	alreadyAtRow := m.list.Index() == startIndex+row
+	m.list.Select(startIndex + row)
+
+	if len(m.shared.AvailableSourceRepos) > 0 {
+		// SOURCE MODE: a second click on the highlighted row acts as enter
+		if alreadyAtRow {
+			return m.handleKeys(tea.KeyMsg{Type: tea.KeyEnter})
+		}
+		return m, nil
+	}
+
+	// PACKAGE MODE: clicking a row toggles it, like moving there and
+	// pressing space
+	if item, ok := m.list.SelectedItem().(RepoItem); ok {
+		m.delegate.toggleSelection(item.index)
+	}
+	return m, nil
+}
+
+// handleTopicKeys handles keyboard input while the topic group panel is open
+func (m *RepoSelectionModel) handleTopicKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "q", "ctrl+c":
+		return m, NavigateBack()
+
+	case "t", "esc":
+		// Back to the package list with the expanded selection pre-checked
+		m.topicMode = false
+		return m, nil
+
+	case "up", "k":
+		if m.topicCursor > 0 {
+			m.topicCursor--
+		}
+		return m, nil
+
+	case "down", "j":
+		if m.topicCursor < len(m.topicGroups)-1 {
+			m.topicCursor++
+		}
+		return m, nil
+
+	case " ", "enter":
+		m.toggleTopicGroup(m.topicCursor)
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// toggleTopicGroup selects every member of a topic group, or deselects
+// them all when the group is already fully selected
+func (m *RepoSelectionModel) toggleTopicGroup(groupIndex int) {
+	if groupIndex < 0 || groupIndex >= len(m.topicGroups) {
+		return
+	}
+	group := m.topicGroups[groupIndex]
+
+	allSelected := m.topicGroupSelected(group) == len(group.Indices)
+	for _, idx := range group.Indices {
+		if allSelected {
+			delete(m.delegate.selectedItems, idx)
+		} else {
+			m.delegate.selectedItems[idx] = true
+		}
+	}
+
+	m.logger.Debug("repo_selection", fmt.Sprintf("Toggled topic group %q (%d packages)", group.Topic, len(group.Indices)))
+}
+
+// topicGroupSelected counts how many of a group's members are selected
+func (m *RepoSelectionModel) topicGroupSelected(group core.TopicGroup) int {
+	count := 0
+	for _, idx := range group.Indices {
+		if m.delegate.selectedItems[idx] {
+			count++
+		}
+	}
+	return count
+}
+
+// topicPanelView renders the topic group panel shown instead of the
+// package list while topic mode is active
+func (m *RepoSelectionModel) topicPanelView() string {
+	var b strings.Builder
+
+	titleStyle := lipgloss.NewStyle().
+		Foreground(theme.Highlight()).
+		Bold(true)
+	cursorStyle := lipgloss.NewStyle().
+		Foreground(theme.Secondary()).
+		Bold(true)
+	normalStyle := lipgloss.NewStyle().
+		Foreground(lipgloss.Color("#6B7280"))
+
+	b.WriteString(titleStyle.Render("🏷️ Select by topic group:") + "\n\n")
+
+	for i, group := range m.topicGroups {
+		selected := m.topicGroupSelected(group)
+		check := "☐"
+		switch {
+		case selected == len(group.Indices):
+			check = "✅"
+		case selected > 0:
+			check = "◐"
+		}
+
+		line := fmt.Sprintf("%s %s (%d package(s))", check, group.Topic, len(group.Indices))
+		if i == m.topicCursor {
+			b.WriteString(cursorStyle.Render("▶ "+line) + "\n")
+		} else {
+			b.WriteString(normalStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// loadRepositories starts loading repositories from the configured
+// provider. GitHub listings stream in page by page so the first results
+// render immediately (see core.StreamGitHubRepos); other providers load
+// in one blocking call.
 func (m *RepoSelectionModel) loadRepositories() tea.Cmd {
+	provider := m.shared.RepoSource
+	if provider == nil {
+		provider = &core.GitHubProvider{}
+	}
+	if _, ok := provider.(*core.GitHubProvider); ok {
+		m.repoPages = core.StreamGitHubRepos(m.logger)
+		return m.waitForRepoPage()
+	}
 	return func() tea.Msg {
-		repos, err := core.ListGitHubRepos(m.logger)
+		repos, err := provider.List(m.logger)
 		return reposLoadedMsg{repos: repos, err: err}
 	}
 }
 
-// setupListFromSource configures the list with source repositories (single-select mode)
-func (m *RepoSelectionModel) setupListFromSource() {
-	items := make([]list.Item, len(m.shared.AvailableSourceRepos))
-	for i, repo := range m.shared.AvailableSourceRepos {
-		items[i] = RepoItem{
-			repo:  repo,
-			index: i,
+// waitForRepoPage delivers the next streamed repository page as a message
+func (m *RepoSelectionModel) waitForRepoPage() tea.Cmd {
+	pages := m.repoPages
+	return func() tea.Msg {
+		page, ok := <-pages
+		if !ok {
+			// Channel closed without a Done page - treat as finished
+			return repoPageMsg{page: core.RepoPage{Done: true}}
 		}
+		return repoPageMsg{page: page}
 	}
-	m.list.SetItems(items)
+}
+
+// setupListFromSource configures the list with source repositories (single-select mode)
+func (m *RepoSelectionModel) setupListFromSource() {
+	m.applyListView()
 }
 
 // setupList configures the list with repository items
 func (m *RepoSelectionModel) setupList() {
-	items := make([]list.Item, len(m.shared.AvailableDependencies))
-	for i, repo := range m.shared.AvailableDependencies {
-		items[i] = RepoItem{
-			repo:  repo,
-			index: i,
-		}
-	}
-	m.list.SetItems(items)
+	m.applyListView()
+
+	// Build topic groups for bulk selection (empty when the provider
+	// supplies no topic metadata)
+	m.topicGroups = core.GroupReposByTopic(m.shared.AvailableDependencies)
+	m.topicMode = false
+	m.topicCursor = 0
+
 	// Restore selection markers from shared state when returning to the screen
 	if len(m.shared.SelectedDependencies) > 0 {
 		selected := make(map[string]struct{}, len(m.shared.SelectedDependencies))
@@ -496,6 +998,157 @@ func (m *RepoSelectionModel) setupList() {
 	// Use default delegate for clean list-simple style with > indicator
 }
 
+// applyListView rebuilds the visible list from the active repo set
+// (sources or packages), applying the privacy/Dart filters and the current
+// sort mode. Item indices always point into the underlying shared slice so
+// selections survive view changes.
+func (m *RepoSelectionModel) applyListView() {
+	base := m.shared.AvailableDependencies
+	if len(m.shared.AvailableSourceRepos) > 0 {
+		base = m.shared.AvailableSourceRepos
+	}
+
+	// Row titles may have changed (e.g. the pubspec probe adds 📦 markers)
+	m.delegate.invalidateRows()
+
+	items := make([]list.Item, 0, len(base))
+	for i, repo := range base {
+		if m.privacyOnly != "" && repo.Privacy != m.privacyOnly {
+			continue
+		}
+		if m.dartOnly && !repoLooksLikeDartPackage(repo) {
+			continue
+		}
+		items = append(items, RepoItem{
+			repo:     repo,
+			index:    i,
+			favorite: m.favoriteURLs[normalizeRepoURL(repo.URL)],
+		})
+	}
+
+	sort.SliceStable(items, func(a, b int) bool {
+		ia, ib := items[a].(RepoItem), items[b].(RepoItem)
+		// Quick add: frequently added packages stay pinned above the rest
+		// regardless of the active sort mode
+		if ia.favorite != ib.favorite {
+			return ia.favorite
+		}
+		ra, rb := ia.repo, ib.repo
+		switch m.sortMode {
+		case repoSortName:
+			return ra.Owner+"/"+ra.Name < rb.Owner+"/"+rb.Name
+		case repoSortStars:
+			return ra.Stars > rb.Stars
+		default:
+			// Recently pushed first; zero timestamps keep the provider order
+			return ra.PushedAt.After(rb.PushedAt)
+		}
+	})
+
+	// Keep the cursor in place where possible; clamp when the view shrank
+	idx := m.list.Index()
+	m.list.SetItems(items)
+	if idx >= len(items) {
+		idx = len(items) - 1
+	}
+	if idx >= 0 {
+		m.list.Select(idx)
+	}
+}
+
+// detectPubspecs probes the active repo set for pubspec.yaml files in the
+// background so confirmed packages get marked once results arrive. Returns
+// nil when every candidate already has a probe result.
+func (m *RepoSelectionModel) detectPubspecs() tea.Cmd {
+	sourceMode := len(m.shared.AvailableSourceRepos) > 0
+	repos := m.shared.AvailableDependencies
+	if sourceMode {
+		repos = m.shared.AvailableSourceRepos
+	}
+	if !core.ReposNeedPubspecProbe(repos) {
+		return nil
+	}
+
+	snapshot := make([]core.RepoCandidate, len(repos))
+	copy(snapshot, repos)
+	m.detecting = true
+
+	return func() tea.Msg {
+		return pubspecDetectedMsg{
+			repos:      core.DetectDartPackages(m.logger, snapshot),
+			sourceMode: sourceMode,
+		}
+	}
+}
+
+// repoLooksLikeDartPackage reports whether a repository plausibly contains
+// a pubspec.yaml. The probe result is authoritative once available; until
+// then the primary language and topics stand in for an actual pubspec check.
+func repoLooksLikeDartPackage(repo core.RepoCandidate) bool {
+	if repo.PubspecChecked {
+		return repo.HasPubspec
+	}
+	if strings.EqualFold(repo.Language, "Dart") {
+		return true
+	}
+	for _, topic := range repo.Topics {
+		t := strings.ToLower(topic)
+		if t == "flutter" || t == "dart" || strings.HasPrefix(t, "flutter-") || strings.HasPrefix(t, "dart-") {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeRepoURL canonicalizes a git URL for matching favorites and
+// bundle entries against listed repositories (".git" and trailing slashes
+// are dropped, case is ignored)
+func normalizeRepoURL(url string) string {
+	return strings.ToLower(strings.TrimSuffix(strings.TrimSuffix(url, "/"), ".git"))
+}
+
+// selectTeamBundle marks every team-standard package as selected. Bundle
+// entries already in the listing get their selection marker; entries the
+// listing doesn't contain (e.g. repos outside the user's account) are
+// appended as synthetic candidates so they flow through configuration and
+// execution like any other selection.
+func (m *RepoSelectionModel) selectTeamBundle() {
+	byURL := make(map[string]int, len(m.shared.AvailableDependencies))
+	for i, repo := range m.shared.AvailableDependencies {
+		byURL[normalizeRepoURL(repo.URL)] = i
+	}
+
+	matched := 0
+	for _, spec := range m.teamBundle {
+		if idx, ok := byURL[normalizeRepoURL(spec.URL)]; ok {
+			m.delegate.selectedItems[idx] = true
+			matched++
+			continue
+		}
+
+		// Derive owner/name from the URL so the synthetic entry renders
+		// like a listed repository
+		owner := ""
+		name := spec.Name
+		trimmed := strings.TrimSuffix(strings.TrimSuffix(spec.URL, "/"), ".git")
+		if parts := strings.Split(trimmed, "/"); len(parts) >= 2 {
+			name = parts[len(parts)-1]
+			owner = parts[len(parts)-2]
+		}
+		m.shared.AvailableDependencies = append(m.shared.AvailableDependencies, core.RepoCandidate{
+			Owner: owner,
+			Name:  name,
+			URL:   spec.URL,
+			Desc:  "Team-standard package (from config)",
+		})
+		m.delegate.selectedItems[len(m.shared.AvailableDependencies)-1] = true
+	}
+
+	m.applyListView()
+	m.logger.Info("repo_selection", fmt.Sprintf("Team bundle selected: %d packages (%d from listing, %d from config)",
+		len(m.teamBundle), matched, len(m.teamBundle)-matched))
+}
+
 // finalizeSelection saves the selected repositories to shared state
 func (m *RepoSelectionModel) finalizeSelection() {
 	selectedIndices := m.delegate.getSelectedIndices()
@@ -510,6 +1163,7 @@ func (m *RepoSelectionModel) finalizeSelection() {
 	m.shared.SelectedDependencies = selectedRepos
 
 	m.logger.Info("repo_selection", fmt.Sprintf("Selected %d repositories", len(selectedRepos)))
+	m.logger.Narrate(fmt.Sprintf("Selected %d repositories", len(selectedRepos)))
 }
 
 // Single-select mode - using native list rendering with beautiful styling
@@ -5,12 +5,13 @@
 package models
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
 )
 
 // gitHubReposLoadedMsg is emitted when repositories have been fetched
@@ -25,15 +26,14 @@ type GitHubRepoModel struct {
 	logger *core.Logger
 	shared *AppState
 
-	spinner spinner.Model
-	loading bool
+	spinner      spinner.Model
+	loading      bool
+	templateOnly bool // Restrict the list to GitHub template repositories
 }
 
 // NewGitHubRepoModel creates a new GitHub repo loading model
 func NewGitHubRepoModel(cfg core.Config, logger *core.Logger, shared *AppState) *GitHubRepoModel {
-	s := spinner.New()
-	s.Spinner = spinner.Dot
-	s.Style = lipgloss.NewStyle().Foreground(lipgloss.Color("#0EA5E9"))
+	s := components.NewSpinner()
 
 	return &GitHubRepoModel{
 		cfg:     cfg,
@@ -44,6 +44,16 @@ func NewGitHubRepoModel(cfg core.Config, logger *core.Logger, shared *AppState)
 	}
 }
 
+// NewTemplateRepoModel creates a loader restricted to template repositories.
+// The rest of the source flow is shared: the user picks a template, names
+// the project, and the execution screen rewrites its identity after the
+// clone (see core/template.go).
+func NewTemplateRepoModel(cfg core.Config, logger *core.Logger, shared *AppState) *GitHubRepoModel {
+	m := NewGitHubRepoModel(cfg, logger, shared)
+	m.templateOnly = true
+	return m
+}
+
 // Init initializes the GitHub repo loading screen
 func (m *GitHubRepoModel) Init() tea.Cmd {
 	m.loading = true
@@ -66,6 +76,12 @@ func (m *GitHubRepoModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case gitHubReposLoadedMsg:
 		m.loading = false
 		if msg.err != nil {
+			// No gh and no token - offer the device-flow sign-in instead
+			// of a dead-end error screen
+			if errors.Is(msg.err, core.ErrGitHubAuthRequired) {
+				m.logger.Info("github_repo_loader", "No GitHub authentication available - offering device-flow sign-in")
+				return m, TransitionToScreen(ScreenGitHubAuth)
+			}
 			wrappedErr := fmt.Errorf("failed to list GitHub repositories: %w", msg.err)
 			m.logger.Error("github_repo_loader", wrappedErr)
 			errorData := ErrorData{
@@ -79,8 +95,25 @@ func (m *GitHubRepoModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// Store repositories for SOURCE selection
-		m.shared.AvailableSourceRepos = msg.repos
+		repos := msg.repos
+		if m.templateOnly {
+			repos = core.FilterTemplateRepos(repos)
+			if len(repos) == 0 {
+				errorData := ErrorData{
+					Title:        "No template repositories found",
+					Message:      "None of your GitHub repositories has the template flag set. Mark a repository as a template in its GitHub settings first.",
+					ReturnScreen: ScreenMainMenu,
+				}
+				return m, func() tea.Msg {
+					return ScreenTransitionMsg{Screen: ScreenError, Data: errorData}
+				}
+			}
+		}
+
+		// Store repositories for SOURCE selection; template mode carries
+		// through to the execution screen's identity rewrite
+		m.shared.TemplateMode = m.templateOnly
+		m.shared.AvailableSourceRepos = repos
 		m.shared.SelectedDependencies = nil
 
 		return m, TransitionToScreen(ScreenSourceSelection)
@@ -99,13 +132,21 @@ func (m *GitHubRepoModel) View() string {
 		return "Preparing repository list..."
 	}
 
+	if m.templateOnly {
+		return fmt.Sprintf("\n%s Fetching GitHub template repositories...\n\nPlease wait while we gather available templates.\n", m.spinner.View())
+	}
 	return fmt.Sprintf("\n%s Fetching GitHub repositories...\n\nPlease wait while we gather available packages.\n", m.spinner.View())
 }
 
-// loadRepositories fetches repositories from GitHub
+// loadRepositories fetches repositories from the configured provider
+// (GitHub unless another repo source is set in shared state)
 func (m *GitHubRepoModel) loadRepositories() tea.Cmd {
 	return func() tea.Msg {
-		repos, err := core.ListGitHubRepos(m.logger)
+		provider := m.shared.RepoSource
+		if provider == nil {
+			provider = &core.GitHubProvider{}
+		}
+		repos, err := provider.List(m.logger)
 		return gitHubReposLoadedMsg{repos: repos, err: err}
 	}
 }
@@ -0,0 +1,246 @@
+// Package models/keymap.go - Shared Key Bindings and Help Overlay Content
+//
+// This file defines the application-wide key bindings on top of
+// bubbles/key, so the "?" help overlay can list them with descriptions and
+// users can remap actions via the global config (see core/keymap.go). The
+// coordinator (app_model.go) translates remapped keys back to the
+// canonical ones before a screen sees them, so individual screens keep
+// matching their usual key strings.
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+)
+
+// AppKeyMap holds the shared bindings shown in the help overlay. It
+// implements bubbles/help.KeyMap so the overlay renders straight from it.
+type AppKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Select  key.Binding
+	Back    key.Binding
+	Toggle  key.Binding
+	Filter  key.Binding
+	Palette key.Binding
+	Help    key.Binding
+	Quit    key.Binding
+
+	// aliases maps remapped keys to the canonical key message the screens
+	// expect (see translateKey)
+	aliases map[string]tea.KeyMsg
+}
+
+// DefaultAppKeyMap returns the built-in bindings: arrows plus vim-style
+// movement, esc/q to go back, space to toggle
+func DefaultAppKeyMap() AppKeyMap {
+	return AppKeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "move down"),
+		),
+		Select: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "select / confirm"),
+		),
+		Back: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc/q", "back"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "toggle selection"),
+		),
+		Filter: key.NewBinding(
+			key.WithKeys("/"),
+			key.WithHelp("/", "filter list"),
+		),
+		Palette: key.NewBinding(
+			key.WithKeys("ctrl+p"),
+			key.WithHelp("ctrl+p", "command palette"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("ctrl+c"),
+			key.WithHelp("ctrl+c", "quit"),
+		),
+	}
+}
+
+// LoadAppKeyMap builds the active keymap: the defaults with any `keymap:`
+// overrides from the global config applied. Remapped keys also register an
+// alias so they translate to the canonical key the screens match on.
+func LoadAppKeyMap(logger *core.Logger) AppKeyMap {
+	keyMap := DefaultAppKeyMap()
+	overrides := core.LoadKeymapOverrides()
+	if overrides == nil {
+		return keyMap
+	}
+
+	keyMap.aliases = make(map[string]tea.KeyMsg)
+	for action, keys := range overrides {
+		if len(keys) == 0 {
+			continue
+		}
+
+		binding := keyMap.binding(action)
+		if binding == nil {
+			logger.Debug("keymap", fmt.Sprintf("Ignoring unknown keymap action: %s", action))
+			continue
+		}
+
+		// The first default key is the canonical one screens match on;
+		// every custom key translates to it
+		canonical := binding.Keys()[0]
+		canonicalMsg, ok := keyMsgFor(canonical)
+		if !ok {
+			continue
+		}
+		defaults := make(map[string]bool)
+		for _, k := range binding.Keys() {
+			defaults[k] = true
+		}
+		for _, k := range keys {
+			if !defaults[k] {
+				keyMap.aliases[k] = canonicalMsg
+			}
+		}
+
+		binding.SetKeys(keys...)
+		binding.SetHelp(keyHelpLabel(keys), binding.Help().Desc)
+		logger.Debug("keymap", fmt.Sprintf("Remapped %s to %v", action, keys))
+	}
+
+	return keyMap
+}
+
+// binding resolves a config action name to its binding, or nil
+func (k *AppKeyMap) binding(action string) *key.Binding {
+	switch action {
+	case "up":
+		return &k.Up
+	case "down":
+		return &k.Down
+	case "select":
+		return &k.Select
+	case "back":
+		return &k.Back
+	case "toggle":
+		return &k.Toggle
+	case "filter":
+		return &k.Filter
+	case "palette":
+		return &k.Palette
+	case "help":
+		return &k.Help
+	case "quit":
+		return &k.Quit
+	}
+	return nil
+}
+
+// translateKey rewrites a remapped key press to the canonical key message
+// the screens expect; unmapped keys pass through unchanged
+func (k AppKeyMap) translateKey(msg tea.KeyMsg) tea.KeyMsg {
+	if replacement, ok := k.aliases[msg.String()]; ok {
+		return replacement
+	}
+	return msg
+}
+
+// ShortHelp implements help.KeyMap (the condensed single-line view)
+func (k AppKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Select, k.Back, k.Help}
+}
+
+// FullHelp implements help.KeyMap (the expanded overlay columns)
+func (k AppKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Select, k.Back},
+		{k.Toggle, k.Filter, k.Palette, k.Help, k.Quit},
+	}
+}
+
+// screenExtraBindings lists screen-specific keys shown in the overlay
+// below the shared ones. Screens without extras show only the shared set.
+func screenExtraBindings(screen AppScreen) []key.Binding {
+	extra := func(keys, desc string) key.Binding {
+		return key.NewBinding(key.WithKeys(keys), key.WithHelp(keys, desc))
+	}
+
+	switch screen {
+	case ScreenSourceSelection, ScreenDependencySelection:
+		return []key.Binding{
+			extra("s", "cycle sort (pushed/name/stars)"),
+			extra("p", "cycle privacy filter"),
+			extra("d", "toggle dart/flutter filter"),
+			extra("t", "topic group selection"),
+		}
+	case ScreenOutdated:
+		return []key.Binding{
+			extra("m", "toggle major version upgrades"),
+		}
+	case ScreenLogViewer:
+		return []key.Binding{
+			extra("h/l", "older / newer session"),
+		}
+	case ScreenRefSwitch:
+		return []key.Binding{
+			extra("enter", "pick dependency, then ref"),
+		}
+	case ScreenMainMenu:
+		return []key.Binding{
+			extra("1-9", "select menu entry directly"),
+		}
+	}
+	return nil
+}
+
+// keyMsgFor builds the key message for a canonical key name, so remapped
+// keys can be rewritten before screens match on them
+func keyMsgFor(name string) (tea.KeyMsg, bool) {
+	switch name {
+	case "up":
+		return tea.KeyMsg{Type: tea.KeyUp}, true
+	case "down":
+		return tea.KeyMsg{Type: tea.KeyDown}, true
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}, true
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}, true
+	case " ", "space":
+		return tea.KeyMsg{Type: tea.KeySpace}, true
+	case "ctrl+c":
+		return tea.KeyMsg{Type: tea.KeyCtrlC}, true
+	}
+	if runes := []rune(name); len(runes) == 1 {
+		return tea.KeyMsg{Type: tea.KeyRunes, Runes: runes}, true
+	}
+	return tea.KeyMsg{}, false
+}
+
+// keyHelpLabel joins custom keys into the overlay's key column
+func keyHelpLabel(keys []string) string {
+	label := ""
+	for i, k := range keys {
+		if k == " " {
+			k = "space"
+		}
+		if i > 0 {
+			label += "/"
+		}
+		label += k
+	}
+	return label
+}
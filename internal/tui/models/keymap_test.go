@@ -0,0 +1,53 @@
+package models
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// TestKeyMsgFor verifies canonical key name resolution
+func TestKeyMsgFor(t *testing.T) {
+	msg, ok := keyMsgFor("esc")
+	if !ok || msg.String() != "esc" {
+		t.Errorf("expected esc key message, got %q (ok=%v)", msg.String(), ok)
+	}
+	msg, ok = keyMsgFor("h")
+	if !ok || msg.String() != "h" {
+		t.Errorf("expected rune key message, got %q (ok=%v)", msg.String(), ok)
+	}
+	if _, ok := keyMsgFor("not-a-key"); ok {
+		t.Error("expected unknown key name to be rejected")
+	}
+}
+
+// TestTranslateKeyPassthrough verifies unmapped keys are unchanged
+func TestTranslateKeyPassthrough(t *testing.T) {
+	keyMap := DefaultAppKeyMap()
+	original := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")}
+	if got := keyMap.translateKey(original); got.String() != "x" {
+		t.Errorf("expected passthrough, got %q", got.String())
+	}
+}
+
+// TestTranslateKeyAlias verifies a remapped key rewrites to the canonical one
+func TestTranslateKeyAlias(t *testing.T) {
+	keyMap := DefaultAppKeyMap()
+	backMsg, _ := keyMsgFor("esc")
+	keyMap.aliases = map[string]tea.KeyMsg{"h": backMsg}
+
+	pressed := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("h")}
+	if got := keyMap.translateKey(pressed); got.String() != "esc" {
+		t.Errorf("expected h to translate to esc, got %q", got.String())
+	}
+}
+
+// TestKeyHelpLabel verifies the overlay key column formatting
+func TestKeyHelpLabel(t *testing.T) {
+	if got := keyHelpLabel([]string{"esc", "h"}); got != "esc/h" {
+		t.Errorf("expected esc/h, got %q", got)
+	}
+	if got := keyHelpLabel([]string{" "}); got != "space" {
+		t.Errorf("expected space, got %q", got)
+	}
+}
@@ -0,0 +1,58 @@
+// Package models/layout.go - Responsive Layout Helpers
+//
+// Fixed 62-column header boxes and byte-indexed truncation overflow badly
+// on narrow terminals, and slicing a string by bytes can split a
+// double-width emoji, misaligning every following cell under Windows
+// Terminal/ConPTY. These helpers measure display cells instead (runewidth
+// via the ANSI-aware x/ansi package) and shrink the shared layout
+// primitives, so screens reflow cleanly for widths down to 60 columns.
+
+package models
+
+import (
+	"github.com/charmbracelet/x/ansi"
+)
+
+// layoutWidth is the terminal width last reported by tea.WindowSizeMsg
+// (recorded by the AppModel coordinator). Zero means no size was received
+// yet, which keeps the classic 80-column layout.
+var layoutWidth int
+
+// setLayoutWidth records the current terminal width for the layout helpers
+func setLayoutWidth(width int) {
+	layoutWidth = width
+}
+
+// headerBoxWidth returns the inner width for the standard bordered header
+// box: the classic 62 columns, shrunk on narrow terminals so the rounded
+// border (two more columns on screen) still fits
+func headerBoxWidth() int {
+	if layoutWidth == 0 || layoutWidth >= 64 {
+		return 62
+	}
+	width := layoutWidth - 2
+	if width < 40 {
+		width = 40
+	}
+	return width
+}
+
+// contentWidth returns the usable width for free-form lines such as list
+// rows, capped at the classic 80 columns
+func contentWidth() int {
+	if layoutWidth == 0 || layoutWidth > 80 {
+		return 80
+	}
+	return layoutWidth
+}
+
+// fitLine truncates text to the given number of display cells, appending
+// an ellipsis when something was cut. Both the measurement and the cut are
+// ANSI- and runewidth-aware, so styled text and double-width emoji never
+// push a row past the terminal edge.
+func fitLine(text string, width int) string {
+	if width <= 0 || ansi.StringWidth(text) <= width {
+		return text
+	}
+	return ansi.Truncate(text, width, "…")
+}
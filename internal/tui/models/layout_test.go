@@ -0,0 +1,39 @@
+package models
+
+import "testing"
+
+// TestFitLine verifies display-cell truncation, including double-width emoji
+func TestFitLine(t *testing.T) {
+	if got := fitLine("short", 10); got != "short" {
+		t.Errorf("expected short text unchanged, got %q", got)
+	}
+	if got := fitLine("abcdefgh", 5); got != "abcd…" {
+		t.Errorf("expected cell-limited cut with ellipsis, got %q", got)
+	}
+	// Each 📦 is two display cells: width 5 leaves room for one emoji,
+	// one narrow rune and the ellipsis - never half an emoji
+	if got := fitLine("📦a📦b", 5); got != "📦a…" {
+		t.Errorf("expected emoji-safe cut, got %q", got)
+	}
+}
+
+// TestHeaderBoxWidth verifies the header shrinks on narrow terminals
+func TestHeaderBoxWidth(t *testing.T) {
+	defer setLayoutWidth(0)
+
+	cases := []struct {
+		term int
+		want int
+	}{
+		{0, 62},   // No size reported yet: classic layout
+		{120, 62}, // Wide terminal: classic layout
+		{60, 58},  // Narrow: header plus border fits exactly
+		{30, 40},  // Below the supported minimum: clamp
+	}
+	for _, tc := range cases {
+		setLayoutWidth(tc.term)
+		if got := headerBoxWidth(); got != tc.want {
+			t.Errorf("headerBoxWidth() at %d columns = %d, want %d", tc.term, got, tc.want)
+		}
+	}
+}
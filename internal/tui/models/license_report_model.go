@@ -0,0 +1,247 @@
+// Package models/license_report_model.go - Dependency License Report Screen
+//
+// This file implements the "License report" screen that classifies the
+// license of every git dependency in the active project (see
+// core/license.go). Copyleft licenses (the GPL family) are highlighted,
+// with an explicit warning when the project is an application - distributing
+// an app that links copyleft code carries source-disclosure obligations.
+// The same scanner backs the `flutter-pm licenses` CLI command.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// LicenseReportModel handles the dependency license report screen
+type LicenseReportModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	loading bool
+	loadErr string
+	infos   []core.LicenseInfo
+	isApp   bool
+
+	spinner  spinner.Model
+	viewport viewport.Model
+
+	titleStyle   lipgloss.Style
+	normalStyle  lipgloss.Style
+	warningStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// licenseReportMsg is sent when the license scan has finished
+type licenseReportMsg struct {
+	infos []core.LicenseInfo
+	isApp bool
+	err   error
+}
+
+// NewLicenseReportModel creates a new license report model
+func NewLicenseReportModel(cfg core.Config, logger *core.Logger, shared *AppState) *LicenseReportModel {
+	s := components.NewSpinner()
+
+	vp := viewport.New(76, 14)
+
+	return &LicenseReportModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		loading:  true,
+		spinner:  s,
+		viewport: vp,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		warningStyle: lipgloss.NewStyle().
+			Foreground(theme.Warning()).
+			Bold(true),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// projectPath resolves the project whose dependencies are scanned
+func (m *LicenseReportModel) projectPath() string {
+	projectPath := m.shared.SourceProjectPath
+	if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+		projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	if projectPath == "" {
+		projectPath = "."
+	}
+	return projectPath
+}
+
+// Init starts the license scan in the background
+func (m *LicenseReportModel) Init() tea.Cmd {
+	projectPath := m.projectPath()
+	return tea.Batch(
+		m.spinner.Tick,
+		func() tea.Msg {
+			m.logger.Narrate("Scanning git dependency licenses")
+			infos, err := core.ScanDependencyLicenses(m.logger, projectPath)
+			return licenseReportMsg{
+				infos: infos,
+				isApp: core.IsAppProject(projectPath),
+				err:   err,
+			}
+		},
+	)
+}
+
+// Update handles messages for the license report screen
+func (m *LicenseReportModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, NavigateBack()
+		}
+		// Remaining keys scroll the viewport
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
+	case tea.MouseMsg:
+		// Wheel scrolling through the report
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		// Reflow the viewport on narrow terminals
+		width := msg.Width - 4
+		if width > 76 {
+			width = 76
+		}
+		if width < 40 {
+			width = 40
+		}
+		m.viewport.Width = width
+		return m, nil
+
+	case licenseReportMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.infos = msg.infos
+		m.isApp = msg.isApp
+		m.viewport.SetContent(m.renderReport())
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.loading {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+	}
+
+	return m, nil
+}
+
+// renderReport formats the scan results as viewport content
+func (m *LicenseReportModel) renderReport() string {
+	if len(m.infos) == 0 {
+		return m.normalStyle.Render("No git dependencies found in this project")
+	}
+
+	var b strings.Builder
+
+	copyleftCount := 0
+	for _, info := range m.infos {
+		icon := "✅"
+		line := fmt.Sprintf("%s (%s)", info.Package, info.License)
+		switch {
+		case info.Copyleft:
+			icon = "⚠️ "
+			copyleftCount++
+		case info.License == "unknown":
+			icon = "❓"
+			line = fmt.Sprintf("%s (no license detected)", info.Package)
+		}
+
+		if info.Copyleft {
+			b.WriteString(m.warningStyle.Render(fmt.Sprintf("%s %s", icon, line)) + "\n")
+		} else {
+			b.WriteString(fmt.Sprintf("%s %s\n", icon, line))
+		}
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("   %s", info.URL)) + "\n")
+	}
+
+	if copyleftCount > 0 {
+		b.WriteString("\n")
+		if m.isApp {
+			b.WriteString(m.warningStyle.Render(fmt.Sprintf(
+				"⚠️  %d copyleft dependenc(ies) - distributing this application carries source-disclosure obligations", copyleftCount)) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render(fmt.Sprintf(
+				"ℹ️  %d copyleft dependenc(ies) - verify the terms are compatible with your project", copyleftCount)) + "\n")
+		}
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// View renders the license report screen
+func (m *LicenseReportModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("⚖️ Dependency Licenses")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(fmt.Sprintf("%s Scanning dependency licenses...\n", m.spinner.View()))
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'esc' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Project: %s (%d git dependencies)", m.projectPath(), len(m.infos))) + "\n\n")
+
+	b.WriteString(m.viewport.View() + "\n\n")
+
+	b.WriteString(m.helpStyle.Render("↑/↓: scroll • esc: back") + "\n")
+
+	return b.String()
+}
@@ -0,0 +1,243 @@
+// Package models/log_viewer_model.go - Session Log Viewer Screen
+//
+// This file implements the "View logs" screen that reads the per-session
+// structured log files written under ~/.cache/flutter-pm/logs (see
+// core/sessionlog.go). The newest session - usually the current run - is
+// shown first in a scrollable viewport; left/right switches between
+// sessions so a failed install can be examined right after it happened or
+// days later. The same files back the `flutter-pm logs` CLI command.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// LogViewerModel handles the session log viewer screen
+type LogViewerModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	loading  bool
+	loadErr  string
+	paths    []string // Available session log files, newest first
+	selected int      // Index into paths of the session being shown
+	entries  []core.SessionLogEntry
+
+	viewport viewport.Model
+
+	titleStyle  lipgloss.Style
+	normalStyle lipgloss.Style
+	errorStyle  lipgloss.Style
+	helpStyle   lipgloss.Style
+}
+
+// sessionLogsListedMsg is sent when the available session files are known
+type sessionLogsListedMsg struct {
+	paths []string
+	err   error
+}
+
+// sessionLogLoadedMsg is sent when one session file has been parsed
+type sessionLogLoadedMsg struct {
+	path    string
+	entries []core.SessionLogEntry
+	err     error
+}
+
+// NewLogViewerModel creates a new session log viewer model
+func NewLogViewerModel(cfg core.Config, logger *core.Logger, shared *AppState) *LogViewerModel {
+	vp := viewport.New(76, 14)
+
+	return &LogViewerModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		loading:  true,
+		viewport: vp,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init lists the available session logs
+func (m *LogViewerModel) Init() tea.Cmd {
+	return func() tea.Msg {
+		paths, err := core.ListSessionLogs()
+		return sessionLogsListedMsg{paths: paths, err: err}
+	}
+}
+
+// Update handles messages for the log viewer screen
+func (m *LogViewerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, NavigateBack()
+		case "left", "h":
+			// Older session
+			if m.selected < len(m.paths)-1 {
+				m.selected++
+				return m, m.loadSelected()
+			}
+			return m, nil
+		case "right", "l":
+			// Newer session
+			if m.selected > 0 {
+				m.selected--
+				return m, m.loadSelected()
+			}
+			return m, nil
+		}
+		// Remaining keys scroll the viewport
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
+	case tea.MouseMsg:
+		// Wheel scrolling through the log
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
+	case tea.WindowSizeMsg:
+		// Reflow the viewport on narrow terminals
+		width := msg.Width - 4
+		if width > 76 {
+			width = 76
+		}
+		if width < 40 {
+			width = 40
+		}
+		m.viewport.Width = width
+		return m, nil
+
+	case sessionLogsListedMsg:
+		if msg.err != nil {
+			m.loading = false
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.paths = msg.paths
+		if len(m.paths) == 0 {
+			m.loading = false
+			return m, nil
+		}
+		m.selected = 0
+		return m, m.loadSelected()
+
+	case sessionLogLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.loadErr = ""
+		m.entries = msg.entries
+		m.viewport.SetContent(m.renderEntries())
+		m.viewport.GotoBottom()
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// loadSelected parses the currently selected session file
+func (m *LogViewerModel) loadSelected() tea.Cmd {
+	path := m.paths[m.selected]
+	return func() tea.Msg {
+		entries, err := core.ReadSessionLog(path)
+		return sessionLogLoadedMsg{path: path, entries: entries, err: err}
+	}
+}
+
+// renderEntries formats the loaded entries as viewport content
+func (m *LogViewerModel) renderEntries() string {
+	if len(m.entries) == 0 {
+		return m.normalStyle.Render("(this session log is empty)")
+	}
+
+	var b strings.Builder
+	for _, entry := range m.entries {
+		line := fmt.Sprintf("%s %-5s %s", entry.Time.Format("15:04:05"), entry.Level, entry.Message)
+		if entry.Component != "" {
+			line = fmt.Sprintf("%s %-5s [%s] %s", entry.Time.Format("15:04:05"), entry.Level, entry.Component, entry.Message)
+		}
+		if entry.Level == "error" {
+			b.WriteString(m.errorStyle.Render(line) + "\n")
+		} else {
+			b.WriteString(line + "\n")
+		}
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+// View renders the session log viewer
+func (m *LogViewerModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("📜 Session Logs")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render("Loading session logs...") + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'esc' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if len(m.paths) == 0 {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("No session logs found in %s", core.SessionLogDir())) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'esc' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	// Session selector: newest first, so index 0 is this (or the latest) run
+	name := strings.TrimSuffix(filepath.Base(m.paths[m.selected]), ".jsonl")
+	label := fmt.Sprintf("Session %s (%d of %d, %d entries)", name, m.selected+1, len(m.paths), len(m.entries))
+	if m.paths[m.selected] == m.logger.SessionLogPath() {
+		label += " - current session"
+	}
+	b.WriteString(m.titleStyle.Render(label) + "\n\n")
+
+	b.WriteString(m.viewport.View() + "\n\n")
+
+	b.WriteString(m.helpStyle.Render("↑/↓: scroll • ←/→: older/newer session • esc: back") + "\n")
+
+	return b.String()
+}
@@ -8,6 +8,7 @@ package models
 
 import (
 	"fmt"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -15,6 +16,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // MainMenuModel handles the main menu screen
@@ -24,10 +26,23 @@ type MainMenuModel struct {
 	shared *AppState
 
 	// Menu state
-	choice      int // 0-based cursor position
-	menuTimeout int // seconds remaining
+	choice      int  // 0-based cursor position
+	menuTimeout int  // seconds remaining on the auto-select countdown
+	timerActive bool // Countdown running; any key or click cancels it
 	quitting    bool
 
+	// Whether the global config defines dependency bundles; read once per
+	// menu so the render loop never touches the disk (see core/bundles.go)
+	bundlesConfigured bool
+
+	// Active SDK description (FVM pin awareness), computed once per visit
+	sdkLine    string
+	sdkChecked bool
+
+	// GitHub API budget status line, refreshed in the background per visit
+	// (see core/ratelimit.go)
+	rateLine string
+
 	// Styles (bubbletea documentation colors)
 	subtleStyle   lipgloss.Style
 	ticksStyle    lipgloss.Style
@@ -37,72 +52,279 @@ type MainMenuModel struct {
 	// Performance optimization: pre-allocated render buffer
 	renderBuffer strings.Builder
 	menuLines    []string // Pre-allocated slice for menu lines
+	itemRows     []int    // Terminal row of each menu item in the last render, for click-to-select
 }
 
-// Menu options
-type MenuOption struct {
+// MenuAction represents a single registered menu action with availability predicates
+type MenuAction struct {
 	title       string
 	description string
-	action      AppScreen
+	screen      AppScreen
+	visible     func(s *AppState) bool // nil = always shown
+	enabled     func(s *AppState) bool // nil = always selectable
+	plugin      *core.Plugin           // Set for external plugin actions
+	recent      *core.RecentProject    // Set for recent project entries
 }
 
-// getMenuOptions returns the menu options, conditionally including local project option
-func (m *MainMenuModel) getMenuOptions() []MenuOption {
-	var options []MenuOption
+// MenuGroup is a titled category of related menu actions
+type MenuGroup struct {
+	title   string
+	actions []MenuAction
+}
 
-	// Option 1: Add packages to local project (if detected)
-	if m.shared.LocalPubspecAvailable {
-		options = append(options, MenuOption{
-			fmt.Sprintf("📦 Add package to local - (%s)", m.shared.DetectedProject),
-			fmt.Sprintf("Add Git packages to local project: %s", m.shared.DetectedProject),
-			ScreenDependencySelection, // Will add packages to detected project
-		})
+// menuItem is a flattened, numbered menu entry ready for rendering and selection
+type menuItem struct {
+	action  MenuAction
+	enabled bool
+}
+
+// getMenuGroups returns the registered menu actions organized by task category.
+// Titles and descriptions are built dynamically from shared state (e.g. the
+// detected local project name); availability predicates decide whether an
+// action is shown and whether it can currently be selected.
+func (m *MainMenuModel) getMenuGroups() []MenuGroup {
+	localAvailable := func(s *AppState) bool { return s.LocalPubspecAvailable }
+
+	var groups []MenuGroup
+
+	// Recently used projects come first so yesterday's project is one
+	// keypress away instead of a directory rescan (see core/recent.go)
+	if len(m.shared.RecentProjects) > 0 {
+		shown := m.shared.RecentProjects
+		if len(shown) > 3 {
+			shown = shown[:3]
+		}
+		recentActions := make([]MenuAction, 0, len(shown))
+		for i := range shown {
+			recent := &shown[i]
+			icon := "🕘"
+			if recent.Pinned {
+				icon = "📌"
+			}
+			name := recent.Name
+			if name == "" {
+				name = recent.Path
+			}
+			recentActions = append(recentActions, MenuAction{
+				title:       fmt.Sprintf("%s %s", icon, name),
+				description: fmt.Sprintf("Use %s (last used %s)", recent.Path, recent.LastUsed.Format("2006-01-02 15:04")),
+				screen:      ScreenMainMenu,
+				recent:      recent,
+			})
+		}
+		groups = append(groups, MenuGroup{title: "Recent projects", actions: recentActions})
 	}
 
-	// Option 2 (or 1 if no local): Search local Flutter sources
-	options = append(options, MenuOption{
-		"📁 Search local Flutter sources",
-		"Scan for Flutter projects in local directories and select one",
-		ScreenScanDirectories,
-	})
+	groups = append(groups, []MenuGroup{
+		{
+			title: "Project",
+			actions: []MenuAction{
+				{
+					title:       "📁 Search local Flutter sources",
+					description: "Scan for Flutter projects in local directories and select one",
+					screen:      ScreenScanDirectories,
+				},
+				{
+					title:       "⏪ Resume previous session",
+					description: m.resumeDescription(),
+					screen:      ScreenResumeSession,
+					visible: func(s *AppState) bool {
+						// Hidden unless a snapshot exists, keeping the default numbering
+						return s.ResumeState != nil
+					},
+				},
+			},
+		},
+		{
+			title: "Dependencies",
+			actions: []MenuAction{
+				{
+					title:       fmt.Sprintf("📦 Add package to local - (%s)", m.shared.DetectedProject),
+					description: fmt.Sprintf("Add Git packages to local project: %s", m.shared.DetectedProject),
+					screen:      ScreenDependencySelection,
+					visible:     localAvailable,
+				},
+				{
+					title:       "🐙 GitHub repo",
+					description: "Browse and select packages from GitHub repositories",
+					screen:      ScreenGitHubRepo,
+				},
+				{
+					title:       "🔍 Search pub.dev",
+					description: "Search hosted packages on pub.dev and add them",
+					screen:      ScreenPubDevSearch,
+					enabled:     localAvailable,
+				},
+				{
+					title:       "🧰 Install dependency bundle",
+					description: "Install a named package bundle defined in the global config",
+					screen:      ScreenBundles,
+					visible: func(s *AppState) bool {
+						// Hidden without configured bundles, keeping the default numbering
+						return m.bundlesConfigured
+					},
+					enabled: localAvailable,
+				},
+			},
+		},
+		{
+			title: "Maintenance",
+			actions: []MenuAction{
+				{
+					title:       m.updateStaleTitle(),
+					description: m.updateStaleDescription(),
+					screen:      ScreenForceUpdate,
+					enabled: func(s *AppState) bool {
+						// Express update only makes sense when the local project has git deps
+						return s.LocalPubspecAvailable && s.HasGitDeps
+					},
+				},
+				{
+					title:       "🔀 Switch dependency ref",
+					description: "Pick a branch or tag for a git dependency and re-pin it",
+					screen:      ScreenRefSwitch,
+					visible: func(s *AppState) bool {
+						// Hidden (not greyed) so the default menu keeps single-digit numbering
+						return s.LocalPubspecAvailable && s.HasGitDeps
+					},
+				},
+				{
+					title:       "🗑️ Remove dependencies",
+					description: "Select and remove git dependencies from the local project",
+					screen:      ScreenRemoveDeps,
+					enabled: func(s *AppState) bool {
+						// Removal lists git deps, so it needs a project that has some
+						return s.LocalPubspecAvailable && s.HasGitDeps
+					},
+				},
+				{
+					title:       "📋 Update plan",
+					description: "Compare pubspec.lock against upstream and estimate breaking risk",
+					screen:      ScreenUpdatePlan,
+					enabled:     localAvailable,
+				},
+				{
+					title:       "🧹 Manage overrides",
+					description: "List dependency_overrides, see why each exists and remove stale ones",
+					screen:      ScreenOverrides,
+					enabled:     localAvailable,
+				},
+			},
+		},
+		{
+			title: "Tool",
+			actions: []MenuAction{
+				{
+					title:       "⚙️ Configure search",
+					description: "Set up search filters and preferences",
+					screen:      ScreenSearchConfig,
+				},
+				{
+					title:       "⌨️ Command pane",
+					description: "Run dart/flutter/git/gh commands in the active project",
+					screen:      ScreenCommandPane,
+				},
+				{
+					title:       "🩺 Environment",
+					description: "Check git, SDKs, gh auth, network reachability and write permissions",
+					screen:      ScreenEnvironment,
+				},
+				{
+					title:       "🔄 Check for Flutter-PM updates",
+					description: "Update Flutter Package Manager to latest version",
+					screen:      ScreenSelfUpdate,
+				},
+				{
+					title:       "📜 View logs",
+					description: "Browse the structured session logs from this and previous runs",
+					screen:      ScreenLogViewer,
+				},
+				{
+					title:       "📈 Local stats",
+					description: "Operation timings and cache hit rates recorded locally",
+					screen:      ScreenStats,
+				},
+				{
+					title:       "⚖️ License report",
+					description: "Classify git dependency licenses and flag copyleft ones",
+					screen:      ScreenLicenses,
+					enabled:     localAvailable,
+				},
+				{
+					title:       "⬆️ Outdated packages",
+					description: "List outdated hosted dependencies and upgrade selected ones",
+					screen:      ScreenOutdated,
+					enabled:     localAvailable,
+				},
+				{
+					title:       "🧬 New project from template",
+					description: "Clone a GitHub template repository and rename it into a new project",
+					screen:      ScreenTemplateRepo,
+				},
+			},
+		},
+	}...)
+
+	// External plugins register as their own group (see core/plugins.go)
+	if len(m.shared.AvailablePlugins) > 0 {
+		pluginActions := make([]MenuAction, 0, len(m.shared.AvailablePlugins))
+		for i := range m.shared.AvailablePlugins {
+			plugin := &m.shared.AvailablePlugins[i]
+			pluginActions = append(pluginActions, MenuAction{
+				title:       "🔌 " + plugin.Manifest.Title,
+				description: plugin.Manifest.Description,
+				screen:      ScreenPluginRun,
+				plugin:      plugin,
+			})
+		}
+		groups = append(groups, MenuGroup{title: "Plugins", actions: pluginActions})
+	}
 
-	// Option 3 (or 2): GitHub repo
-	options = append(options, MenuOption{
-		"🐙 GitHub repo",
-		"Browse and select packages from GitHub repositories",
-		ScreenGitHubRepo,
-	})
+	return groups
+}
 
-	// Option 4 (or 3): Configure search
-	options = append(options, MenuOption{
-		"⚙️ Configure search",
-		"Set up search filters and preferences",
-		ScreenSearchConfig,
-	})
+// resumeDescription builds the description for the resume action, showing
+// when the saved session was left off
+func (m *MainMenuModel) resumeDescription() string {
+	if m.shared.ResumeState == nil {
+		return "Restore the selections from an interrupted session"
+	}
+	return fmt.Sprintf("Restore the selections saved %s", m.shared.ResumeState.SavedAt.Format("2006-01-02 15:04"))
+}
 
-	// Option 5 (or 4): Update stale packages - show project name or greyed out
-	var updateTitle, updateDesc string
+// updateStaleTitle builds the title for the express update action
+func (m *MainMenuModel) updateStaleTitle() string {
 	if m.shared.LocalPubspecAvailable {
-		updateTitle = fmt.Sprintf("🔄 Update stale packages - (%s)", m.shared.DetectedProject)
-		updateDesc = fmt.Sprintf("Update stale packages in %s", m.shared.DetectedProject)
-	} else {
-		updateTitle = "🔄 Update stale packages - (none found)"
-		updateDesc = "No local Flutter project detected within +-3 levels"
+		if m.shared.HasGitDeps {
+			return fmt.Sprintf("🔄 Update stale packages - (%s)", m.shared.DetectedProject)
+		}
+		return fmt.Sprintf("🔄 Update stale packages - (%s has no git deps)", m.shared.DetectedProject)
 	}
-	options = append(options, MenuOption{
-		updateTitle,
-		updateDesc,
-		ScreenForceUpdate, // Changed to force update screen
-	})
+	return "🔄 Update stale packages - (none found)"
+}
 
-	// Option 6 (or 5): Self-update
-	options = append(options, MenuOption{
-		"🔄 Check for Flutter-PM updates",
-		"Update Flutter Package Manager to latest version",
-		ScreenSelfUpdate,
-	})
+// updateStaleDescription builds the description for the express update action
+func (m *MainMenuModel) updateStaleDescription() string {
+	if m.shared.LocalPubspecAvailable {
+		return fmt.Sprintf("Update stale packages in %s", m.shared.DetectedProject)
+	}
+	return "No local Flutter project detected within +-3 levels"
+}
 
-	return options
+// getMenuItems flattens the visible actions from all groups into the numbered
+// list used for cursor navigation and number-key selection
+func (m *MainMenuModel) getMenuItems() []menuItem {
+	var items []menuItem
+	for _, group := range m.getMenuGroups() {
+		for _, action := range group.actions {
+			if action.visible != nil && !action.visible(m.shared) {
+				continue
+			}
+			enabled := action.enabled == nil || action.enabled(m.shared)
+			items = append(items, menuItem{action: action, enabled: enabled})
+		}
+	}
+	return items
 }
 
 // timerTickMsg represents a timer tick
@@ -110,22 +332,56 @@ type timerTickMsg struct{}
 
 // NewMainMenuModel creates a new main menu model
 func NewMainMenuModel(cfg core.Config, logger *core.Logger, shared *AppState) *MainMenuModel {
+	// Discover external plugins once per session (a cheap directory read)
+	if !shared.PluginsDiscovered {
+		shared.PluginsDiscovered = true
+		plugins, err := core.DiscoverPlugins(logger)
+		if err != nil {
+			logger.Error("plugins", err)
+		} else {
+			shared.AvailablePlugins = plugins
+		}
+	}
+
+	// Look for a resumable session snapshot once per session
+	if !shared.ResumeChecked {
+		shared.ResumeChecked = true
+		state, err := core.LoadSessionState()
+		if err != nil {
+			logger.Debug("session", fmt.Sprintf("Ignoring unreadable session state: %v", err))
+		} else {
+			shared.ResumeState = state
+		}
+	}
+
+	// Load the recently used project list once per session
+	if !shared.RecentChecked {
+		shared.RecentChecked = true
+		shared.RecentProjects = core.LoadRecentProjects()
+	}
+
 	model := &MainMenuModel{
-		cfg:         cfg,
-		logger:      logger,
-		shared:      shared,
-		choice:      0,
-		menuTimeout: 60, // 60-second timeout like shell script
+		cfg:    cfg,
+		logger: logger,
+		shared: shared,
+		choice: 0,
+		// Auto-select countdown like the shell script, but configurable
+		// (--menu-timeout / FLUTTER_PM_MENU_TIMEOUT) and 0 disables it,
+		// which is safer on shared terminals
+		menuTimeout: cfg.MenuTimeout,
+		timerActive: cfg.MenuTimeout > 0,
+
+		bundlesConfigured: len(core.BundleNames()) > 0,
 
 		// Styles matching bubbletea documentation
 		subtleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		ticksStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("79")),
 
 		checkboxStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("212")),
+			Foreground(theme.Selected()),
 
 		headerStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("211")).
@@ -143,7 +399,26 @@ func NewMainMenuModel(cfg core.Config, logger *core.Logger, shared *AppState) *M
 
 // Init initializes the main menu
 func (m *MainMenuModel) Init() tea.Cmd {
-	return m.tickTimer()
+	if m.timerActive {
+		return tea.Batch(m.tickTimer(), m.refreshRateLimit())
+	}
+	return m.refreshRateLimit()
+}
+
+// rateLimitLineMsg carries the refreshed GitHub API status line
+type rateLimitLineMsg struct {
+	line string
+}
+
+// refreshRateLimit refreshes the GitHub rate limit picture in the
+// background; a failed refresh (no gh, offline) just leaves the line empty
+func (m *MainMenuModel) refreshRateLimit() tea.Cmd {
+	return func() tea.Msg {
+		if err := core.RefreshGitHubRateLimit(m.logger); err != nil {
+			m.logger.Debug("main_menu", fmt.Sprintf("Rate limit refresh skipped: %v", err))
+		}
+		return rateLimitLineMsg{line: core.GitHubRateLimitLine()}
+	}
 }
 
 // Update handles messages for the main menu
@@ -152,7 +427,14 @@ func (m *MainMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKeys(msg)
 
+	case tea.MouseMsg:
+		return m.handleMouse(msg)
+
 	case timerTickMsg:
+		if !m.timerActive {
+			// A key press cancelled the countdown; drop stale ticks
+			return m, nil
+		}
 		m.menuTimeout--
 		if m.menuTimeout <= 0 {
 			// Auto-select default choice (shell script behavior)
@@ -164,6 +446,10 @@ func (m *MainMenuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		// Handle window resize if needed
 		return m, nil
+
+	case rateLimitLineMsg:
+		m.rateLine = msg.line
+		return m, nil
 	}
 
 	return m, nil
@@ -186,11 +472,11 @@ func (m *MainMenuModel) View() string {
 	// Beautiful bordered header with warm amber color (consistent with source selection)
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#F59E0B")).
-		Foreground(lipgloss.Color("#F59E0B")).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Width(62).
+		Width(headerBoxWidth()).
 		Bold(true).
 		Render("🎯 Flutter Package Manager")
 
@@ -199,50 +485,92 @@ func (m *MainMenuModel) View() string {
 	m.menuLines = append(m.menuLines, "")
 	m.menuLines = append(m.menuLines, "📱 Flutter Package Manager - Main Menu:")
 
-	// Get dynamic menu options
-	options := m.getMenuOptions()
-
-	// Menu options with optimized string building
-	// Determine which option is the "update" option for greying out
-	updateOptionIndex := -1
-	if m.shared.LocalPubspecAvailable {
-		updateOptionIndex = 4 // Option 5 when local project exists
-	} else {
-		updateOptionIndex = 3 // Option 4 when no local project
+	// FVM awareness: show which SDK pub commands will use when the local
+	// project pins one (see core/fvm.go); silent for the global default
+	if !m.sdkChecked {
+		m.sdkChecked = true
+		if m.shared.DetectedPubspecPath != "" {
+			m.sdkLine = core.ActiveSDKDescription(filepath.Dir(m.shared.DetectedPubspecPath))
+		}
+	}
+	if m.sdkLine != "" {
+		m.menuLines = append(m.menuLines, m.subtleStyle.Render("🔧 SDK: "+m.sdkLine))
 	}
+	if m.rateLine != "" {
+		m.menuLines = append(m.menuLines, m.subtleStyle.Render("🌐 "+m.rateLine))
+	}
+
+	// Render grouped sections with continuous numbering across groups.
+	// Each item's element index is remembered so clicks can be mapped back
+	itemIndex := 0
+	itemElems := make([]int, 0, 20)
+	for _, group := range m.getMenuGroups() {
+		// Skip groups whose actions are all hidden
+		visibleActions := make([]MenuAction, 0, len(group.actions))
+		for _, action := range group.actions {
+			if action.visible != nil && !action.visible(m.shared) {
+				continue
+			}
+			visibleActions = append(visibleActions, action)
+		}
+		if len(visibleActions) == 0 {
+			continue
+		}
 
-	for i, option := range options {
-		var line string
-		// Check if this is the update option and no project found
-		isDisabled := (i == updateOptionIndex && !m.shared.LocalPubspecAvailable)
+		m.menuLines = append(m.menuLines, "")
+		m.menuLines = append(m.menuLines, m.headerStyle.Render("─── "+group.title+" ───"))
 
-		if c == i {
-			line = "► " + strconv.Itoa(i+1) + ". " + option.title
-			if isDisabled {
-				line = m.subtleStyle.Render(line) // Grey out disabled option
+		for _, action := range visibleActions {
+			enabled := action.enabled == nil || action.enabled(m.shared)
+
+			var line string
+			if c == itemIndex {
+				line = "► " + strconv.Itoa(itemIndex+1) + ". " + action.title
+				if enabled {
+					line = m.checkboxStyle.Render(line)
+				} else {
+					line = m.subtleStyle.Render(line) // Grey out unavailable action
+				}
 			} else {
-				line = m.checkboxStyle.Render(line)
-			}
-		} else {
-			line = "  " + strconv.Itoa(i+1) + ". " + option.title
-			if isDisabled {
-				line = m.subtleStyle.Render(line) // Grey out disabled option
+				line = "  " + strconv.Itoa(itemIndex+1) + ". " + action.title
+				if !enabled {
+					line = m.subtleStyle.Render(line) // Grey out unavailable action
+				}
 			}
+			itemElems = append(itemElems, len(m.menuLines))
+			m.menuLines = append(m.menuLines, line)
+			itemIndex++
 		}
-		m.menuLines = append(m.menuLines, line)
 	}
 
 	m.menuLines = append(m.menuLines, "")
 
-	// Timeout info with pre-computed string
-	timeoutText := "Program quits in " + m.ticksStyle.Render(strconv.Itoa(m.menuTimeout)) + " seconds"
-	m.menuLines = append(m.menuLines, timeoutText)
-	m.menuLines = append(m.menuLines, "")
+	// Live countdown to the auto-select; hidden once cancelled or disabled
+	if m.timerActive {
+		timeoutText := "Auto-selecting 'Scan directories' in " + m.ticksStyle.Render(strconv.Itoa(m.menuTimeout)) + "s - press any key to cancel"
+		m.menuLines = append(m.menuLines, timeoutText)
+		m.menuLines = append(m.menuLines, "")
+	}
 
 	// Help text in beautiful style
-	helpText := "↑/↓ navigate • enter/1-5 select • q quit"
+	helpText := fmt.Sprintf("↑/↓ navigate • enter/1-%d select • q quit", itemIndex)
+	if len(m.shared.RecentProjects) > 0 {
+		helpText = fmt.Sprintf("↑/↓ navigate • enter/1-%d select • p pin recent • q quit", itemIndex)
+	}
 	m.menuLines = append(m.menuLines, m.subtleStyle.Render(helpText))
 
+	// Convert item element indexes to terminal rows for click-to-select
+	// (the header box spans several rows, so elements and rows differ)
+	m.itemRows = m.itemRows[:0]
+	row, next := 0, 0
+	for i, line := range m.menuLines {
+		if next < len(itemElems) && itemElems[next] == i {
+			m.itemRows = append(m.itemRows, row)
+			next++
+		}
+		row += strings.Count(line, "\n") + 1
+	}
+
 	// Join all lines efficiently using pre-allocated builder
 	for i, line := range m.menuLines {
 		if i > 0 {
@@ -254,9 +582,30 @@ func (m *MainMenuModel) View() string {
 	return m.renderBuffer.String()
 }
 
+// handleMouse maps a left click onto the menu item rendered at that row.
+// Clicking an item both moves the cursor and selects it, matching the
+// number-key shortcuts. Row positions come from the last View render.
+func (m *MainMenuModel) handleMouse(msg tea.MouseMsg) (tea.Model, tea.Cmd) {
+	if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+		return m, nil
+	}
+	// A click cancels the auto-select countdown like a key press does
+	m.timerActive = false
+	for i, row := range m.itemRows {
+		if row == msg.Y {
+			m.choice = i
+			return m.selectCurrentChoice()
+		}
+	}
+	return m, nil
+}
+
 // handleKeys handles keyboard input
 func (m *MainMenuModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
-	options := m.getMenuOptions()
+	// Any key press cancels the auto-select countdown - the user is here
+	m.timerActive = false
+
+	items := m.getMenuItems()
 
 	switch msg.String() {
 	case "q", "ctrl+c", "esc":
@@ -265,8 +614,8 @@ func (m *MainMenuModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case "j", "down":
 		m.choice++
-		if m.choice >= len(options) {
-			m.choice = len(options) - 1
+		if m.choice >= len(items) {
+			m.choice = len(items) - 1
 		}
 		return m, nil
 
@@ -280,10 +629,24 @@ func (m *MainMenuModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case "enter":
 		return m.selectCurrentChoice()
 
-	case "1", "2", "3", "4", "5", "6":
-		// Handle number selection dynamically
+	case "p":
+		// Pin/unpin the recent project under the cursor
+		if m.choice >= 0 && m.choice < len(items) && items[m.choice].action.recent != nil {
+			recent := items[m.choice].action.recent
+			pinned, err := core.ToggleRecentPin(recent.Path)
+			if err != nil {
+				m.logger.Error("menu", fmt.Errorf("failed to toggle pin: %w", err))
+				return m, nil
+			}
+			m.logger.Info("menu", fmt.Sprintf("Pinned %s: %v", recent.Path, pinned))
+			m.shared.RecentProjects = core.LoadRecentProjects()
+		}
+		return m, nil
+
+	case "1", "2", "3", "4", "5", "6", "7", "8", "9":
+		// Handle number selection dynamically against the flattened item list
 		num := int(msg.String()[0] - '0')
-		if num > 0 && num <= len(options) {
+		if num > 0 && num <= len(items) {
 			m.choice = num - 1
 			return m.selectCurrentChoice()
 		}
@@ -292,19 +655,47 @@ func (m *MainMenuModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
-// selectCurrentChoice handles selection of the current menu item
+// selectCurrentChoice handles selection of the current menu item.
+// Selecting an unavailable (greyed out) action is a no-op.
 func (m *MainMenuModel) selectCurrentChoice() (tea.Model, tea.Cmd) {
-	options := m.getMenuOptions()
+	items := m.getMenuItems()
+
+	if m.choice >= 0 && m.choice < len(items) {
+		selected := items[m.choice]
+		if !selected.enabled {
+			m.logger.Debug("menu", fmt.Sprintf("Ignoring selection of unavailable action: %s", selected.action.title))
+			return m, nil
+		}
 
-	if m.choice >= 0 && m.choice < len(options) {
-		selectedOption := options[m.choice]
 		m.shared.ProjectSourceChoice = m.choice + 1 // Convert to 1-based for shell script compatibility
 
 		// Log the selection
-		m.logger.Info("menu", fmt.Sprintf("Selected: %s", selectedOption.title))
+		m.logger.Info("menu", fmt.Sprintf("Selected: %s", selected.action.title))
+
+		// Recent project entries activate the saved project directly, the
+		// same way the scan selection screen does
+		if recent := selected.action.recent; recent != nil {
+			project := core.Project{Path: recent.Path, Name: recent.Name, PubspecPath: recent.PubspecPath}
+			m.shared.SourceProject = &project
+			m.shared.SourceProjectPath = project.Path
+			m.shared.DetectedPubspecPath = project.PubspecPath
+			m.shared.DetectedProject = project.Name
+			m.shared.LocalPubspecAvailable = true
+			if err := core.TouchRecentProject(project); err != nil {
+				m.logger.Debug("menu", fmt.Sprintf("Could not update recent projects: %v", err))
+			}
+			m.shared.RecentProjects = core.LoadRecentProjects()
+			m.choice = 0
+			return m, nil
+		}
+
+		// Plugin actions carry the plugin to run to the plugin run screen
+		if selected.action.plugin != nil {
+			m.shared.SelectedPlugin = selected.action.plugin
+		}
 
 		// Transition to the appropriate screen
-		return m, TransitionToScreen(selectedOption.action)
+		return m, TransitionToScreen(selected.action.screen)
 	}
 
 	return m, nil
@@ -0,0 +1,72 @@
+// Package models/main_menu_test.go - Tests for the main menu auto-select
+//
+// Covers the configurable countdown: ticking down, cancellation on key
+// press, and disabling via config.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+)
+
+// menuTestState returns shared state with the once-per-session lookups
+// already marked done, so constructing the model touches no files
+func menuTestState() *AppState {
+	return &AppState{
+		PluginsDiscovered: true,
+		ResumeChecked:     true,
+		RecentChecked:     true,
+	}
+}
+
+func TestMenuTimeoutTicksAndCancels(t *testing.T) {
+	cfg := core.Config{MenuTimeout: 10}
+	logger := &core.Logger{}
+
+	model := NewMainMenuModel(cfg, logger, menuTestState())
+	if !model.timerActive {
+		t.Fatal("expected countdown active with a configured timeout")
+	}
+
+	// Ticks count down and the view shows the remaining seconds
+	model.Update(timerTickMsg{})
+	if model.menuTimeout != 9 {
+		t.Errorf("expected 9 seconds remaining after one tick, got %d", model.menuTimeout)
+	}
+	if !strings.Contains(model.View(), "press any key to cancel") {
+		t.Error("expected the countdown line in the view")
+	}
+
+	// Any key press cancels the countdown and hides the line
+	model.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if model.timerActive {
+		t.Error("expected a key press to cancel the countdown")
+	}
+	if strings.Contains(model.View(), "press any key to cancel") {
+		t.Error("expected the countdown line hidden after cancellation")
+	}
+
+	// A stale tick after cancellation must not count down or auto-select
+	before := model.menuTimeout
+	model.Update(timerTickMsg{})
+	if model.menuTimeout != before {
+		t.Error("expected stale ticks to be ignored after cancellation")
+	}
+}
+
+func TestMenuTimeoutDisabled(t *testing.T) {
+	cfg := core.Config{MenuTimeout: 0}
+	logger := &core.Logger{}
+
+	model := NewMainMenuModel(cfg, logger, menuTestState())
+	if model.timerActive {
+		t.Error("expected no countdown with timeout 0")
+	}
+	if strings.Contains(model.View(), "press any key to cancel") {
+		t.Error("expected no countdown line with timeout disabled")
+	}
+}
@@ -0,0 +1,69 @@
+// Package models/markdown.go - Lightweight Markdown Rendering
+//
+// This file renders GitHub-flavoured markdown (release note bodies) into
+// styled terminal text for viewport panes: headings become bold and
+// coloured, list markers become bullets, inline code keeps its backticks
+// but gets an accent colour, and long lines wrap to the pane width. It
+// intentionally covers only the constructs common in release notes rather
+// than the full markdown grammar.
+
+package models
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+var (
+	markdownHeadingStyle = lipgloss.NewStyle().Foreground(theme.Primary()).Bold(true)
+	markdownCodeStyle    = lipgloss.NewStyle().Foreground(theme.Accent())
+	markdownQuoteStyle   = lipgloss.NewStyle().Foreground(theme.Subtle()).Italic(true)
+
+	markdownInlineCode = regexp.MustCompile("`[^`]+`")
+	markdownLink       = regexp.MustCompile(`\[([^\]]+)\]\([^)]+\)`)
+	markdownListMarker = regexp.MustCompile(`^(\s*)[-*+] `)
+)
+
+// renderMarkdownLite converts markdown text into styled terminal lines
+// wrapped to the given width
+func renderMarkdownLite(markdown string, width int) string {
+	wrapStyle := lipgloss.NewStyle().Width(width)
+	inCodeBlock := false
+
+	var out []string
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		// Fenced code blocks pass through verbatim, styled as code
+		if strings.HasPrefix(trimmed, "```") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+		if inCodeBlock {
+			out = append(out, markdownCodeStyle.Render("  "+line))
+			continue
+		}
+
+		// Links collapse to their text - URLs are rarely useful in a pane
+		line = markdownLink.ReplaceAllString(line, "$1")
+
+		switch {
+		case strings.HasPrefix(trimmed, "#"):
+			heading := strings.TrimSpace(strings.TrimLeft(trimmed, "#"))
+			out = append(out, markdownHeadingStyle.Render(heading))
+		case strings.HasPrefix(trimmed, ">"):
+			quote := strings.TrimSpace(strings.TrimPrefix(trimmed, ">"))
+			out = append(out, markdownQuoteStyle.Render(wrapStyle.Render("│ "+quote)))
+		default:
+			line = markdownListMarker.ReplaceAllString(line, "$1• ")
+			line = markdownInlineCode.ReplaceAllStringFunc(line, func(code string) string {
+				return markdownCodeStyle.Render(code)
+			})
+			out = append(out, wrapStyle.Render(line))
+		}
+	}
+	return strings.Join(out, "\n")
+}
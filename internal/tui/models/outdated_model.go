@@ -0,0 +1,323 @@
+// Package models/outdated_model.go - Hosted Dependency Upgrade Screen
+//
+// This file implements the "Outdated packages" screen backed by
+// `pub outdated --json` (see core/outdated.go). It lists every hosted
+// dependency with the current/upgradable/resolvable/latest version columns
+// pub reports, lets the user multi-select with the space bar, and runs
+// `pub upgrade` for the confirmed packages - optionally with
+// --major-versions (toggled with 'm') to cross constraint boundaries,
+// which creates a tracked pubspec.yaml backup first.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// OutdatedModel handles the hosted dependency upgrade screen
+type OutdatedModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// List state
+	loading  bool
+	loadErr  string
+	packages []core.OutdatedPackage
+	cursor   int
+	selected map[int]bool
+	major    bool // Upgrade across major versions (--major-versions)
+
+	// Upgrade state
+	upgrading bool
+	done      bool
+	result    core.ActionResult
+
+	// Styles
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	normalStyle   lipgloss.Style
+	successStyle  lipgloss.Style
+	warningStyle  lipgloss.Style
+	errorStyle    lipgloss.Style
+	helpStyle     lipgloss.Style
+}
+
+// outdatedLoadedMsg is sent when the pub outdated report has been parsed
+type outdatedLoadedMsg struct {
+	packages []core.OutdatedPackage
+	err      error
+}
+
+// outdatedUpgradeDoneMsg is sent when the pub upgrade run has finished
+type outdatedUpgradeDoneMsg struct {
+	result core.ActionResult
+}
+
+// NewOutdatedModel creates a new outdated packages model
+func NewOutdatedModel(cfg core.Config, logger *core.Logger, shared *AppState) *OutdatedModel {
+	return &OutdatedModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		loading:  true,
+		selected: make(map[int]bool),
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		selectedStyle: lipgloss.NewStyle().
+			Foreground(theme.Selected()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		warningStyle: lipgloss.NewStyle().
+			Foreground(theme.Warning()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts loading the pub outdated report
+func (m *OutdatedModel) Init() tea.Cmd {
+	return m.loadOutdated()
+}
+
+// Update handles messages for the outdated packages screen
+func (m *OutdatedModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while background work is running
+		if m.loading || m.upgrading {
+			return m, nil
+		}
+
+		// After an upgrade (or load error), enter/esc returns to the menu
+		if m.done || m.loadErr != "" {
+			switch msg.String() {
+			case "enter", "esc", "q":
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			return m, NavigateBack()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.packages)-1 {
+				m.cursor++
+			}
+		case " ":
+			// Up-to-date packages have nothing to upgrade to
+			if !m.packages[m.cursor].UpToDate() {
+				m.selected[m.cursor] = !m.selected[m.cursor]
+			}
+		case "m":
+			m.major = !m.major
+		case "enter":
+			if m.selectedCount() == 0 {
+				return m, nil
+			}
+			m.upgrading = true
+			return m, m.upgradeSelected()
+		}
+		return m, nil
+
+	case outdatedLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.packages = msg.packages
+		if len(m.packages) == 0 {
+			m.loadErr = "No hosted dependencies found (pub outdated reported nothing)"
+		}
+		return m, nil
+
+	case outdatedUpgradeDoneMsg:
+		m.upgrading = false
+		m.done = true
+		m.result = msg.result
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the outdated packages screen
+func (m *OutdatedModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("⬆️ Outdated Packages")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render("⏳ Running pub outdated...") + "\n")
+		return b.String()
+	}
+
+	if m.upgrading {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ Upgrading %d package(s)...", m.selectedCount())) + "\n")
+		return b.String()
+	}
+
+	if m.done {
+		if m.result.OK {
+			b.WriteString(m.successStyle.Render("✅ "+m.result.Message) + "\n")
+		} else {
+			b.WriteString(m.errorStyle.Render("❌ "+m.result.Err) + "\n")
+			for _, log := range m.result.Logs {
+				b.WriteString(m.normalStyle.Render("   "+log) + "\n")
+			}
+		}
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Hosted dependencies in %s:", m.shared.DetectedProject)) + "\n\n")
+
+	// Column header matching pub outdated's own table
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("   %-22s %-11s %-11s %-11s %-11s",
+		"PACKAGE", "CURRENT", "UPGRADABLE", "RESOLVABLE", "LATEST")) + "\n")
+
+	for i, pkg := range m.packages {
+		check := "☐"
+		if m.selected[i] {
+			check = "✅"
+		}
+		if pkg.UpToDate() {
+			check = "✓" // Nothing to upgrade - not selectable
+		}
+
+		name := pkg.Name
+		if pkg.IsDiscontinued {
+			name += " (discontinued)"
+		}
+
+		line := fmt.Sprintf("%s %-22s %-11s %-11s %-11s %-11s",
+			check, name,
+			outdatedColumn(pkg.Current), outdatedColumn(pkg.Upgradable),
+			outdatedColumn(pkg.Resolvable), outdatedColumn(pkg.Latest))
+
+		switch {
+		case i == m.cursor:
+			b.WriteString(m.selectedStyle.Render("> "+line) + "\n")
+		case pkg.UpToDate():
+			b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+		default:
+			b.WriteString(m.warningStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	if m.major {
+		b.WriteString(m.warningStyle.Render("Major-version mode: pub upgrade --major-versions will rewrite pubspec.yaml constraints (backup created)") + "\n")
+	}
+	if count := m.selectedCount(); count > 0 {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("Selected: %d package(s)", count)) + "\n")
+	}
+	b.WriteString("\n" + m.helpStyle.Render("↑/↓ navigate • space select • m toggle major versions • enter upgrade • esc back") + "\n")
+
+	return b.String()
+}
+
+// outdatedColumn renders one version cell, with a dash for unknown values
+func outdatedColumn(version string) string {
+	if version == "" {
+		return "-"
+	}
+	return version
+}
+
+// selectedCount returns how many packages are currently marked
+func (m *OutdatedModel) selectedCount() int {
+	count := 0
+	for _, on := range m.selected {
+		if on {
+			count++
+		}
+	}
+	return count
+}
+
+// projectPath resolves the target project directory from shared state
+func (m *OutdatedModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// loadOutdated runs pub outdated in the background
+func (m *OutdatedModel) loadOutdated() tea.Cmd {
+	return func() tea.Msg {
+		m.logger.Narrate("Checking for outdated hosted packages")
+		packages, err := core.ListOutdatedPackages(m.logger, &m.cfg, m.projectPath())
+		return outdatedLoadedMsg{packages: packages, err: err}
+	}
+}
+
+// upgradeSelected runs pub upgrade for the marked packages
+func (m *OutdatedModel) upgradeSelected() tea.Cmd {
+	var names []string
+	for i, pkg := range m.packages {
+		if m.selected[i] {
+			names = append(names, pkg.Name)
+		}
+	}
+	major := m.major
+
+	return func() tea.Msg {
+		result := core.UpgradeHostedPackages(m.logger, &m.cfg, m.projectPath(), names, major)
+		return outdatedUpgradeDoneMsg{result: result}
+	}
+}
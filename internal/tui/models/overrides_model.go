@@ -0,0 +1,287 @@
+// Package models/overrides_model.go - dependency_overrides Management Screen
+//
+// This file implements the overrides cleanup workflow: it lists the
+// dependency_overrides section of the detected local project's pubspec.yaml
+// (via ListDependencyOverrides) with the journaled reason each override
+// exists, lets the user multi-select stale entries with the space bar, and
+// removes them safely - each removal is verified with pub get and rolled
+// back from the backup if the project no longer resolves.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// OverridesModel handles multi-select removal of dependency overrides
+type OverridesModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// List state
+	loading   bool
+	loadErr   string
+	overrides []core.DependencyOverride
+	cursor    int
+	selected  map[int]bool
+
+	// Removal state
+	removing bool
+	done     bool
+	results  []core.ActionResult
+
+	// Styles
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	normalStyle   lipgloss.Style
+	successStyle  lipgloss.Style
+	errorStyle    lipgloss.Style
+	helpStyle     lipgloss.Style
+}
+
+// overridesLoadedMsg is sent when the override list has been parsed
+type overridesLoadedMsg struct {
+	overrides []core.DependencyOverride
+	err       error
+}
+
+// overridesDoneMsg is sent when all removals have finished
+type overridesDoneMsg struct {
+	results []core.ActionResult
+}
+
+// NewOverridesModel creates a new overrides management model
+func NewOverridesModel(cfg core.Config, logger *core.Logger, shared *AppState) *OverridesModel {
+	return &OverridesModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		loading:  true,
+		selected: make(map[int]bool),
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		selectedStyle: lipgloss.NewStyle().
+			Foreground(theme.Selected()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts loading the override list
+func (m *OverridesModel) Init() tea.Cmd {
+	return m.loadOverrides()
+}
+
+// Update handles messages for the overrides screen
+func (m *OverridesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while background work is running
+		if m.loading || m.removing {
+			return m, nil
+		}
+
+		// After removal (or load error), enter/esc returns to the main menu
+		if m.done || m.loadErr != "" {
+			switch msg.String() {
+			case "enter", "esc", "q":
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			return m, NavigateBack()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.overrides)-1 {
+				m.cursor++
+			}
+		case " ":
+			m.selected[m.cursor] = !m.selected[m.cursor]
+		case "enter":
+			if m.selectedCount() == 0 {
+				return m, nil
+			}
+			m.removing = true
+			return m, m.removeSelected()
+		}
+		return m, nil
+
+	case overridesLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.overrides = msg.overrides
+		if len(m.overrides) == 0 {
+			m.loadErr = "No dependency_overrides in pubspec.yaml"
+		}
+		return m, nil
+
+	case overridesDoneMsg:
+		m.removing = false
+		m.done = true
+		m.results = msg.results
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the overrides screen
+func (m *OverridesModel) View() string {
+	var b strings.Builder
+
+	// Header with warm amber theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🧹 Manage Overrides")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render("⏳ Reading pubspec.yaml...") + "\n")
+		return b.String()
+	}
+
+	if m.removing {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ Removing %d override(s) and verifying resolution...", m.selectedCount())) + "\n")
+		return b.String()
+	}
+
+	if m.done {
+		for _, result := range m.results {
+			if result.OK {
+				b.WriteString(m.successStyle.Render("✅ "+result.Message) + "\n")
+			} else {
+				b.WriteString(m.errorStyle.Render("❌ "+result.Err) + "\n")
+			}
+		}
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Dependency overrides in %s:", m.shared.DetectedProject)) + "\n\n")
+
+	for i, override := range m.overrides {
+		check := "☐"
+		if m.selected[i] {
+			check = "✅"
+		}
+
+		line := fmt.Sprintf("%s %s (%s: %s)", check, override.Name, override.Source, override.Detail)
+		why := "    why: " + override.Why()
+
+		if i == m.cursor {
+			b.WriteString(m.selectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+		}
+		b.WriteString(m.helpStyle.Render(why) + "\n")
+	}
+
+	b.WriteString("\n")
+	if count := m.selectedCount(); count > 0 {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("Selected: %d override(s)", count)) + "\n\n")
+	}
+	b.WriteString(m.helpStyle.Render("↑/↓ navigate • space select • enter remove selected • esc back") + "\n")
+
+	return b.String()
+}
+
+// selectedCount returns how many overrides are currently marked
+func (m *OverridesModel) selectedCount() int {
+	count := 0
+	for _, on := range m.selected {
+		if on {
+			count++
+		}
+	}
+	return count
+}
+
+// projectPath resolves the target project directory from shared state
+func (m *OverridesModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// loadOverrides parses pubspec.yaml and the origin journal in the background
+func (m *OverridesModel) loadOverrides() tea.Cmd {
+	return func() tea.Msg {
+		overrides, err := core.ListDependencyOverrides(m.projectPath())
+		return overridesLoadedMsg{overrides: overrides, err: err}
+	}
+}
+
+// removeSelected removes each marked override; RemoveDependencyOverride
+// creates the backup and restores it if the project stops resolving
+func (m *OverridesModel) removeSelected() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.projectPath()
+		m.logger.Narrate(fmt.Sprintf("Removing %d dependency overrides", m.selectedCount()))
+
+		var results []core.ActionResult
+		for i, override := range m.overrides {
+			if !m.selected[i] {
+				continue
+			}
+			results = append(results, core.RemoveDependencyOverride(m.logger, &m.cfg, projectPath, override.Name))
+		}
+
+		return overridesDoneMsg{results: results}
+	}
+}
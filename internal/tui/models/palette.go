@@ -0,0 +1,243 @@
+// Package models/palette.go - Global Command Palette Overlay
+//
+// This file implements the ctrl+p command palette: a fuzzy-matched action
+// list that jumps straight to any screen ("add dependency", "express
+// update", "view logs", "switch project") without traversing the menus.
+// The coordinator (app_model.go) owns the overlay like it owns the "?"
+// help overlay: while open, every key lands here; selecting an action
+// emits the usual screen transition.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// paletteAction is one jumpable destination. Keywords hold the synonyms
+// users are likely to type ("switch project" for the directory scan).
+type paletteAction struct {
+	title    string
+	keywords string
+	screen   AppScreen
+	enabled  func(s *AppState) bool // nil = always available
+}
+
+// paletteActions returns the registry of palette destinations. Ordering is
+// the display order for an empty query, roughly main-menu order.
+func paletteActions() []paletteAction {
+	localAvailable := func(s *AppState) bool { return s.LocalPubspecAvailable }
+	gitDepsAvailable := func(s *AppState) bool { return s.LocalPubspecAvailable && s.HasGitDeps }
+
+	return []paletteAction{
+		{title: "📁 Search local Flutter sources", keywords: "switch project scan directories open", screen: ScreenScanDirectories},
+		{title: "🐙 Add dependency from GitHub", keywords: "github repo browse packages", screen: ScreenGitHubRepo},
+		{title: "📦 Add package to local project", keywords: "add dependency local", screen: ScreenDependencySelection, enabled: localAvailable},
+		{title: "🔍 Search pub.dev", keywords: "hosted packages pubdev", screen: ScreenPubDevSearch, enabled: localAvailable},
+		{title: "🧰 Install dependency bundle", keywords: "bundles presets team group", screen: ScreenBundles, enabled: localAvailable},
+		{title: "⚡ Express update stale packages", keywords: "force update git dependencies stale", screen: ScreenForceUpdate, enabled: gitDepsAvailable},
+		{title: "🔀 Switch dependency ref", keywords: "branch tag pin repin", screen: ScreenRefSwitch, enabled: gitDepsAvailable},
+		{title: "🗑️ Remove dependencies", keywords: "delete uninstall git", screen: ScreenRemoveDeps, enabled: gitDepsAvailable},
+		{title: "📋 Update plan", keywords: "lockfile breaking risk compare", screen: ScreenUpdatePlan, enabled: localAvailable},
+		{title: "🧹 Manage overrides", keywords: "dependency overrides cleanup", screen: ScreenOverrides, enabled: localAvailable},
+		{title: "⚖️ License report", keywords: "licenses copyleft", screen: ScreenLicenses, enabled: localAvailable},
+		{title: "⚙️ Configure search", keywords: "settings filters preferences", screen: ScreenSearchConfig},
+		{title: "⌨️ Command pane", keywords: "run shell dart flutter git", screen: ScreenCommandPane},
+		{title: "🩺 Environment diagnostics", keywords: "doctor checks network sdk", screen: ScreenEnvironment},
+		{title: "📜 View logs", keywords: "session log browse", screen: ScreenLogViewer},
+		{title: "📈 Local stats", keywords: "metrics timings cache", screen: ScreenStats},
+		{title: "🔄 Check for Flutter-PM updates", keywords: "self update upgrade version", screen: ScreenSelfUpdate},
+		{title: "🏠 Main menu", keywords: "home back start", screen: ScreenMainMenu},
+	}
+}
+
+// PaletteModel is the command palette overlay state
+type PaletteModel struct {
+	shared  *AppState
+	input   textinput.Model
+	actions []paletteAction // Full registry
+	matches []paletteAction // Actions matching the current query
+	cursor  int
+
+	titleStyle  lipgloss.Style
+	cursorStyle lipgloss.Style
+	normalStyle lipgloss.Style
+	helpStyle   lipgloss.Style
+}
+
+// NewPaletteModel creates the palette with an empty query (all actions)
+func NewPaletteModel(shared *AppState) *PaletteModel {
+	input := textinput.New()
+	input.Placeholder = "Type to search actions..."
+	input.Prompt = "🔎 "
+	input.Focus()
+	input.CharLimit = 60
+
+	m := &PaletteModel{
+		shared:  shared,
+		input:   input,
+		actions: paletteActions(),
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Highlight()).
+			Bold(true),
+
+		cursorStyle: lipgloss.NewStyle().
+			Foreground(theme.Secondary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+	m.refilter()
+	return m
+}
+
+// Handle processes one key press while the palette is open. done reports
+// that the overlay should close (dismissed or an action was selected).
+func (m *PaletteModel) Handle(msg tea.KeyMsg) (cmd tea.Cmd, done bool) {
+	switch msg.String() {
+	case "esc", "ctrl+p", "ctrl+c":
+		return nil, true
+
+	case "up", "ctrl+k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+		return nil, false
+
+	case "down", "ctrl+j":
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		}
+		return nil, false
+
+	case "enter":
+		if m.cursor >= 0 && m.cursor < len(m.matches) {
+			action := m.matches[m.cursor]
+			if action.enabled != nil && !action.enabled(m.shared) {
+				// Unavailable actions stay listed (greyed) but don't fire
+				return nil, false
+			}
+			return TransitionToScreen(action.screen), true
+		}
+		return nil, false
+	}
+
+	// Everything else edits the query
+	var inputCmd tea.Cmd
+	m.input, inputCmd = m.input.Update(msg)
+	m.refilter()
+	return inputCmd, false
+}
+
+// refilter rebuilds the match list for the current query and clamps the
+// cursor into it. Exact substring matches rank above subsequence-only
+// matches so the top entry is the one the user most likely meant.
+func (m *PaletteModel) refilter() {
+	query := strings.TrimSpace(m.input.Value())
+
+	m.matches = m.matches[:0]
+	var fuzzy []paletteAction
+	for _, action := range m.actions {
+		switch paletteMatch(query, action.title+" "+action.keywords) {
+		case matchSubstring:
+			m.matches = append(m.matches, action)
+		case matchSubsequence:
+			fuzzy = append(fuzzy, action)
+		}
+	}
+	m.matches = append(m.matches, fuzzy...)
+
+	if m.cursor >= len(m.matches) {
+		m.cursor = len(m.matches) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+}
+
+// Match quality for ranking: substring hits beat subsequence-only hits
+const (
+	matchNone = iota
+	matchSubsequence
+	matchSubstring
+)
+
+// paletteMatch scores how well the query matches the haystack. Every query
+// word must match, either as a substring or as an in-order character
+// subsequence (so "exup" still finds "Express update"); the weakest word
+// determines the overall score.
+func paletteMatch(query, haystack string) int {
+	if query == "" {
+		return matchSubstring
+	}
+	haystack = strings.ToLower(haystack)
+	score := matchSubstring
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		switch {
+		case strings.Contains(haystack, word):
+			// Keeps the current score
+		case subsequenceMatch(word, haystack):
+			score = matchSubsequence
+		default:
+			return matchNone
+		}
+	}
+	return score
+}
+
+// subsequenceMatch reports whether needle's characters appear in order
+// within haystack
+func subsequenceMatch(needle, haystack string) bool {
+	pos := 0
+	for _, r := range haystack {
+		if pos < len(needle) && r == rune(needle[pos]) {
+			pos++
+		}
+	}
+	return pos == len(needle)
+}
+
+// View renders the palette overlay
+func (m *PaletteModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(m.titleStyle.Render("🚀 Command Palette") + "\n\n")
+	b.WriteString(m.input.View() + "\n\n")
+
+	if len(m.matches) == 0 {
+		b.WriteString(m.normalStyle.Render("No matching actions") + "\n")
+	}
+
+	for i, action := range m.matches {
+		available := action.enabled == nil || action.enabled(m.shared)
+		line := action.title
+		if !available {
+			line += " (needs a local project)"
+		}
+
+		switch {
+		case i == m.cursor && available:
+			b.WriteString(m.cursorStyle.Render("▶ "+line) + "\n")
+		case i == m.cursor:
+			b.WriteString(m.cursorStyle.Render("▶ ") + m.helpStyle.Render(line) + "\n")
+		case available:
+			b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+		default:
+			b.WriteString(m.helpStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	b.WriteString("\n" + m.helpStyle.Render(fmt.Sprintf("%d action(s) • ↑/↓ navigate • enter: jump • esc: close", len(m.matches))))
+	return b.String()
+}
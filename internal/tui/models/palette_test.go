@@ -0,0 +1,114 @@
+// Package models/palette_test.go - Tests for the ctrl+p command palette
+//
+// Covers query filtering (substring and subsequence), the availability
+// guard on actions that need a local project, and the transition emitted
+// on selection.
+
+package models
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// typeQuery feeds a string into the palette's text input one rune at a time
+func typeQuery(m *PaletteModel, query string) {
+	for _, r := range query {
+		m.Handle(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
+
+func TestPaletteFiltering(t *testing.T) {
+	m := NewPaletteModel(&AppState{})
+	if len(m.matches) != len(m.actions) {
+		t.Fatalf("expected an empty query to match all %d actions, got %d", len(m.actions), len(m.matches))
+	}
+
+	// Substring match on keywords ("logs" → view logs) ranks first, ahead
+	// of any subsequence-only hits
+	typeQuery(m, "logs")
+	if len(m.matches) == 0 || m.matches[0].screen != ScreenLogViewer {
+		t.Errorf("expected 'logs' to rank the log viewer first, got %d matches", len(m.matches))
+	}
+	if m.cursor != 0 {
+		t.Errorf("expected the cursor on the top match, got %d", m.cursor)
+	}
+
+	// Subsequence match still finds express update
+	m = NewPaletteModel(&AppState{})
+	typeQuery(m, "exup")
+	found := false
+	for _, a := range m.matches {
+		if a.screen == ScreenForceUpdate {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("expected subsequence 'exup' to match the express update action")
+	}
+
+	// Nonsense matches nothing
+	m = NewPaletteModel(&AppState{})
+	typeQuery(m, "zzqx")
+	if len(m.matches) != 0 {
+		t.Errorf("expected no matches for nonsense query, got %d", len(m.matches))
+	}
+	if !strings.Contains(m.View(), "No matching actions") {
+		t.Error("expected the empty state line in the view")
+	}
+}
+
+func TestPaletteSelectionEmitsTransition(t *testing.T) {
+	m := NewPaletteModel(&AppState{})
+	typeQuery(m, "logs")
+
+	cmd, done := m.Handle(tea.KeyMsg{Type: tea.KeyEnter})
+	if !done {
+		t.Fatal("expected enter on a match to close the palette")
+	}
+	if cmd == nil {
+		t.Fatal("expected enter to emit a screen transition")
+	}
+	msg, ok := cmd().(ScreenTransitionMsg)
+	if !ok {
+		t.Fatalf("expected ScreenTransitionMsg, got %T", cmd())
+	}
+	if msg.Screen != ScreenLogViewer {
+		t.Errorf("expected transition to the log viewer, got %v", msg.Screen)
+	}
+}
+
+func TestPaletteUnavailableActionDoesNotFire(t *testing.T) {
+	// No local project: express update is listed greyed but not selectable
+	m := NewPaletteModel(&AppState{})
+	typeQuery(m, "express")
+	if len(m.matches) == 0 {
+		t.Fatal("expected the express update action to stay listed")
+	}
+
+	cmd, done := m.Handle(tea.KeyMsg{Type: tea.KeyEnter})
+	if done || cmd != nil {
+		t.Error("expected enter on an unavailable action to do nothing")
+	}
+
+	// With a project and git deps it fires
+	m = NewPaletteModel(&AppState{LocalPubspecAvailable: true, HasGitDeps: true})
+	typeQuery(m, "express")
+	cmd, done = m.Handle(tea.KeyMsg{Type: tea.KeyEnter})
+	if !done || cmd == nil {
+		t.Error("expected enter to fire once the action is available")
+	}
+}
+
+func TestPaletteEscCloses(t *testing.T) {
+	m := NewPaletteModel(&AppState{})
+	cmd, done := m.Handle(tea.KeyMsg{Type: tea.KeyEsc})
+	if !done {
+		t.Error("expected esc to close the palette")
+	}
+	if cmd != nil {
+		t.Error("expected no command on dismissal")
+	}
+}
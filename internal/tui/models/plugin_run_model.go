@@ -0,0 +1,188 @@
+// Package models/plugin_run_model.go - Plugin Run Screen
+//
+// This file implements the screen that runs an external plugin selected from
+// the main menu. The plugin receives the current project context as JSON on
+// stdin (see core/plugins.go) and its result is displayed here before the
+// user returns to the main menu.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// PluginRunModel handles running an external plugin and showing its result
+type PluginRunModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// Run state
+	running bool
+	result  core.ActionResult
+
+	// Styles
+	titleStyle   lipgloss.Style
+	successStyle lipgloss.Style
+	errorStyle   lipgloss.Style
+	infoStyle    lipgloss.Style
+	helpStyle    lipgloss.Style
+}
+
+// pluginResultMsg is sent when the plugin finishes
+type pluginResultMsg struct {
+	result core.ActionResult
+}
+
+// NewPluginRunModel creates a new plugin run model
+func NewPluginRunModel(cfg core.Config, logger *core.Logger, shared *AppState) *PluginRunModel {
+	return &PluginRunModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		running: true,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		infoStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts the plugin in the background
+func (m *PluginRunModel) Init() tea.Cmd {
+	return m.runPlugin()
+}
+
+// Update handles messages for the plugin run model
+func (m *PluginRunModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "esc", "enter":
+			if m.running {
+				// Don't allow leaving while the plugin is still running
+				return m, nil
+			}
+			return m, NavigateBack()
+		}
+
+	case pluginResultMsg:
+		m.running = false
+		m.result = msg.result
+
+		if msg.result.OK {
+			m.logger.Info("plugins", msg.result.Message)
+		} else {
+			m.logger.Error("plugins", fmt.Errorf("%s", msg.result.Err))
+		}
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the plugin run screen
+func (m *PluginRunModel) View() string {
+	var b strings.Builder
+
+	pluginName := "plugin"
+	if m.shared.SelectedPlugin != nil {
+		pluginName = m.shared.SelectedPlugin.Manifest.Title
+	}
+
+	// Header with warm amber theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🔌 Plugin: " + pluginName)
+
+	b.WriteString(header + "\n\n")
+
+	if m.running {
+		b.WriteString(m.infoStyle.Render("⏳ Running plugin...") + "\n\n")
+		b.WriteString(m.helpStyle.Render("Please wait...") + "\n")
+		return b.String()
+	}
+
+	if m.result.OK {
+		message := m.result.Message
+		if message == "" {
+			message = "Plugin completed"
+		}
+		b.WriteString(m.successStyle.Render("✅ "+message) + "\n\n")
+	} else {
+		b.WriteString(m.errorStyle.Render("❌ "+m.result.Err) + "\n\n")
+	}
+
+	// Show plugin output (logs) if any
+	if len(m.result.Logs) > 0 {
+		b.WriteString(m.titleStyle.Render("📋 Output:") + "\n")
+		for _, line := range m.result.Logs {
+			b.WriteString(m.infoStyle.Render("  "+line) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+
+	return b.String()
+}
+
+// runPlugin executes the selected plugin in the background
+func (m *PluginRunModel) runPlugin() tea.Cmd {
+	return func() tea.Msg {
+		if m.shared.SelectedPlugin == nil {
+			return pluginResultMsg{result: core.ActionResult{
+				OK:  false,
+				Err: "no plugin selected",
+			}}
+		}
+
+		// Pass the current project context (if a local project was detected)
+		var project *core.Project
+		if m.shared.SourceProject != nil {
+			project = m.shared.SourceProject
+		} else if m.shared.DetectedPubspecPath != "" {
+			project = &core.Project{
+				Path:        filepath.Dir(m.shared.DetectedPubspecPath),
+				PubspecPath: m.shared.DetectedPubspecPath,
+				Name:        m.shared.DetectedProject,
+			}
+		}
+
+		result := core.RunPlugin(m.logger, &m.cfg, *m.shared.SelectedPlugin, project)
+		return pluginResultMsg{result: result}
+	}
+}
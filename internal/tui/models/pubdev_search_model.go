@@ -0,0 +1,312 @@
+// Package models/pubdev_search_model.go - pub.dev Package Search Screen
+//
+// This file implements the hosted package search screen. The user types a
+// query, the pub.dev search API returns the top matches (with latest version
+// and score), and a selected package is added to the detected local project
+// with `dart pub add name:^x.y.z` - making pub.dev a first-class source next
+// to git dependencies.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// PubDevSearchModel handles searching pub.dev and adding hosted dependencies
+type PubDevSearchModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// Search state
+	searchInput textinput.Model
+	searching   bool
+	results     []core.PubDevSearchResult
+	cursor      int
+	searchErr   string
+
+	// Install state
+	installing  bool
+	installPkg  string
+	installDone bool
+	result      core.ActionResult
+
+	// Styles
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	normalStyle   lipgloss.Style
+	successStyle  lipgloss.Style
+	errorStyle    lipgloss.Style
+	helpStyle     lipgloss.Style
+}
+
+// pubDevSearchDoneMsg is sent when the search completes
+type pubDevSearchDoneMsg struct {
+	results []core.PubDevSearchResult
+	err     error
+}
+
+// pubDevInstallDoneMsg is sent when the pub add completes
+type pubDevInstallDoneMsg struct {
+	result core.ActionResult
+}
+
+// NewPubDevSearchModel creates a new pub.dev search model
+func NewPubDevSearchModel(cfg core.Config, logger *core.Logger, shared *AppState) *PubDevSearchModel {
+	ti := textinput.New()
+	ti.Placeholder = "package name or keywords"
+	ti.CharLimit = 100
+	ti.Width = 50
+
+	return &PubDevSearchModel{
+		cfg:         cfg,
+		logger:      logger,
+		shared:      shared,
+		searchInput: ti,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		selectedStyle: lipgloss.NewStyle().
+			Foreground(theme.Selected()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init focuses the search input
+func (m *PubDevSearchModel) Init() tea.Cmd {
+	return m.searchInput.Focus()
+}
+
+// Update handles messages for the pub.dev search screen
+func (m *PubDevSearchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while background work is running
+		if m.searching || m.installing {
+			return m, nil
+		}
+
+		// After an install, enter/esc returns to the main menu
+		if m.installDone {
+			switch msg.String() {
+			case "enter", "esc", "q":
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			return m, nil
+		}
+
+		// While the query input is focused, keys go to the textinput
+		if m.searchInput.Focused() {
+			switch msg.String() {
+			case "esc":
+				return m, NavigateBack()
+			case "enter":
+				query := strings.TrimSpace(m.searchInput.Value())
+				if query == "" {
+					return m, nil
+				}
+				m.searchInput.Blur()
+				m.searching = true
+				m.searchErr = ""
+				m.results = nil
+				m.cursor = 0
+				return m, m.search(query)
+			default:
+				var cmd tea.Cmd
+				m.searchInput, cmd = m.searchInput.Update(msg)
+				return m, cmd
+			}
+		}
+
+		// Results navigation
+		switch msg.String() {
+		case "esc", "q":
+			return m, NavigateBack()
+		case "/":
+			// Edit the query again
+			m.results = nil
+			m.searchErr = ""
+			return m, m.searchInput.Focus()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.results)-1 {
+				m.cursor++
+			}
+		case "enter":
+			if m.cursor >= 0 && m.cursor < len(m.results) {
+				selected := m.results[m.cursor]
+				m.installing = true
+				m.installPkg = selected.Name
+				return m, m.install(selected)
+			}
+		}
+		return m, nil
+
+	case pubDevSearchDoneMsg:
+		m.searching = false
+		if msg.err != nil {
+			m.searchErr = msg.err.Error()
+			return m, m.searchInput.Focus()
+		}
+		m.results = msg.results
+		if len(m.results) == 0 {
+			m.searchErr = "No packages found"
+			return m, m.searchInput.Focus()
+		}
+		return m, nil
+
+	case pubDevInstallDoneMsg:
+		m.installing = false
+		m.installDone = true
+		m.result = msg.result
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the pub.dev search screen
+func (m *PubDevSearchModel) View() string {
+	var b strings.Builder
+
+	// Header with warm amber theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🔍 Search pub.dev Packages")
+
+	b.WriteString(header + "\n\n")
+
+	if m.installing {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ Adding %s to %s...", m.installPkg, m.shared.DetectedProject)) + "\n")
+		return b.String()
+	}
+
+	if m.installDone {
+		if m.result.OK {
+			b.WriteString(m.successStyle.Render("✅ "+m.result.Message) + "\n\n")
+		} else {
+			b.WriteString(m.errorStyle.Render("❌ "+m.result.Err) + "\n\n")
+		}
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.searching {
+		b.WriteString(m.normalStyle.Render("⏳ Searching pub.dev...") + "\n")
+		return b.String()
+	}
+
+	// Query input (shown until results are displayed)
+	if m.searchInput.Focused() || len(m.results) == 0 {
+		b.WriteString(m.titleStyle.Render("Search query:") + "\n")
+		b.WriteString(m.searchInput.View() + "\n\n")
+		if m.searchErr != "" {
+			b.WriteString(m.errorStyle.Render("❌ "+m.searchErr) + "\n\n")
+		}
+		b.WriteString(m.helpStyle.Render("enter search • esc back to menu") + "\n")
+		return b.String()
+	}
+
+	// Results list with version and score
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Results for %q:", strings.TrimSpace(m.searchInput.Value()))) + "\n\n")
+	for i, result := range m.results {
+		line := result.Name
+		if result.LatestVersion != "" {
+			line += " " + result.LatestVersion
+		}
+		if result.MaxPoints > 0 {
+			line += fmt.Sprintf("  (%d likes, %d/%d points)", result.LikeCount, result.GrantedPoints, result.MaxPoints)
+		}
+
+		if i == m.cursor {
+			b.WriteString(m.selectedStyle.Render("> "+line) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("Target project: %s", m.shared.DetectedProject)) + "\n\n")
+	b.WriteString(m.helpStyle.Render("↑/↓ navigate • enter add package • / new search • esc back") + "\n")
+
+	return b.String()
+}
+
+// search queries pub.dev in the background
+func (m *PubDevSearchModel) search(query string) tea.Cmd {
+	return func() tea.Msg {
+		results, err := core.SearchPubDev(m.logger, query)
+		return pubDevSearchDoneMsg{results: results, err: err}
+	}
+}
+
+// install adds the selected hosted package to the detected local project
+// with a caret constraint on the latest version (backup created first)
+func (m *PubDevSearchModel) install(selected core.PubDevSearchResult) tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.shared.SourceProjectPath
+		if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+			projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+		}
+		if projectPath == "" {
+			projectPath = "."
+		}
+		m.logger.Narrate(fmt.Sprintf("Adding %s from pub.dev", selected.Name))
+
+		// Same safety net as the git dependency flow
+		if !m.cfg.DryRun {
+			if backup, err := core.CreateTrackedBackup(m.logger, projectPath, "add"); err != nil {
+				m.logger.Error("backup", err)
+			} else {
+				m.logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+			}
+		}
+
+		constraint := ""
+		if selected.LatestVersion != "" {
+			constraint = "^" + selected.LatestVersion
+		}
+
+		result := core.AddHostedDependency(m.logger, &m.cfg, projectPath, selected.Name, constraint)
+		return pubDevInstallDoneMsg{result: result}
+	}
+}
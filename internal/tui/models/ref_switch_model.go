@@ -0,0 +1,570 @@
+// Package models/ref_switch_model.go - Dependency Ref Switching Screen
+//
+// This file implements the branch/tag picker for re-pinning a git
+// dependency. The user selects one of the project's git dependencies, the
+// repository's branches and tags are fetched via git ls-remote (see
+// core/refs.go), and the chosen ref is applied through
+// core.UpdateSingleDependency - so the switch gets the same backup, pub
+// pipeline and old -> new SHA diff as every other update. The same list
+// also hosts the local-development toggle: 'p' rewrites a dependency to a
+// path: reference at its local clone and back (see core/pathswitch.go).
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/components"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// refSwitchPhase tracks which step of the flow is active
+type refSwitchPhase int
+
+const (
+	refPhasePickDep  refSwitchPhase = iota // Choose which git dependency to re-pin
+	refPhaseLoading                        // Fetching branches/tags via ls-remote
+	refPhasePickRef                        // Choose the target branch or tag
+	refPhaseNotes                          // Reviewing release notes before a tag-to-tag upgrade
+	refPhaseApplying                       // Running the update
+	refPhaseDone                           // Result shown
+)
+
+// RefSwitchModel handles switching a git dependency's pinned ref
+type RefSwitchModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	phase   refSwitchPhase
+	loadErr string
+
+	// Dependency selection: git deps first, then path-switched ones (see
+	// core/pathswitch.go), flattened under one cursor
+	deps      []core.PkgSpec
+	switches  []core.PathSwitch
+	depCursor int
+	busyText  string // Spinner label while applying a switch or toggle
+
+	// Ref selection: branches first, then tags, flattened for one cursor
+	refs      *core.RemoteRefs
+	refCursor int
+
+	// Impact panel: which packages depend on the dependency being re-pinned
+	// (see core/impact.go); nil when pub deps is unavailable
+	impact *core.DependencyImpact
+
+	// Release notes confirmation for tag-to-tag upgrades (see
+	// core/relnotes.go); pendingRef holds the chosen tag while reviewing
+	pendingRef string
+	notes      viewport.Model
+
+	// Result
+	result core.ActionResult
+
+	// UI components
+	spinner spinner.Model
+
+	// Styles
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	normalStyle   lipgloss.Style
+	successStyle  lipgloss.Style
+	errorStyle    lipgloss.Style
+	helpStyle     lipgloss.Style
+}
+
+// refSwitchDepsLoadedMsg is sent when the git dependency list is ready
+type refSwitchDepsLoadedMsg struct {
+	deps     []core.PkgSpec
+	switches []core.PathSwitch
+	err      error
+}
+
+// refSwitchRefsLoadedMsg is sent when the remote refs have been fetched
+type refSwitchRefsLoadedMsg struct {
+	refs   *core.RemoteRefs
+	impact *core.DependencyImpact // nil when impact analysis was unavailable
+	err    error
+}
+
+// refSwitchNotesMsg is sent when release notes for a tag-to-tag upgrade
+// have been fetched (or the fetch gave up)
+type refSwitchNotesMsg struct {
+	notes []core.ReleaseNotes
+	err   error
+}
+
+// refSwitchDoneMsg is sent when the re-pin has finished
+type refSwitchDoneMsg struct {
+	result core.ActionResult
+}
+
+// NewRefSwitchModel creates a new ref switching model
+func NewRefSwitchModel(cfg core.Config, logger *core.Logger, shared *AppState) *RefSwitchModel {
+	s := components.NewSpinner()
+
+	return &RefSwitchModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		phase:   refPhasePickDep,
+		spinner: s,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		selectedStyle: lipgloss.NewStyle().
+			Foreground(theme.Selected()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts loading the git dependency list
+func (m *RefSwitchModel) Init() tea.Cmd {
+	return tea.Batch(m.spinner.Tick, m.loadDeps())
+}
+
+// Update handles messages for the ref switching screen
+func (m *RefSwitchModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while background work is running
+		if m.phase == refPhaseLoading || m.phase == refPhaseApplying {
+			return m, nil
+		}
+
+		// After the switch (or a load error), enter/esc returns to the menu
+		if m.phase == refPhaseDone || m.loadErr != "" {
+			switch msg.String() {
+			case "enter", "esc", "q":
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			return m, nil
+		}
+
+		// Release notes review: confirm, go back, or scroll
+		if m.phase == refPhaseNotes {
+			switch msg.String() {
+			case "enter", "y":
+				m.busyText = fmt.Sprintf("Re-pinning %s to %s...", m.deps[m.depCursor].Name, m.pendingRef)
+				m.phase = refPhaseApplying
+				return m, tea.Batch(m.spinner.Tick, m.applyRef(m.pendingRef))
+			case "esc", "q", "n":
+				m.phase = refPhasePickRef
+				return m, nil
+			}
+			var cmd tea.Cmd
+			m.notes, cmd = m.notes.Update(msg)
+			return m, cmd
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			if m.phase == refPhasePickRef {
+				// Back to the dependency list instead of leaving the screen
+				m.phase = refPhasePickDep
+				return m, nil
+			}
+			return m, NavigateBack()
+		case "up", "k":
+			if m.phase == refPhasePickRef && m.refCursor > 0 {
+				m.refCursor--
+			} else if m.phase == refPhasePickDep && m.depCursor > 0 {
+				m.depCursor--
+			}
+		case "down", "j":
+			if m.phase == refPhasePickRef && m.refCursor < m.refCount()-1 {
+				m.refCursor++
+			} else if m.phase == refPhasePickDep && m.depCursor < m.depCount()-1 {
+				m.depCursor++
+			}
+		case "p":
+			// Toggle between git and local path (see core/pathswitch.go)
+			if m.phase != refPhasePickDep {
+				return m, nil
+			}
+			if m.depCursor < len(m.deps) {
+				dep := m.deps[m.depCursor]
+				m.busyText = fmt.Sprintf("Searching for a local clone of %s...", dep.Name)
+				m.phase = refPhaseApplying
+				return m, tea.Batch(m.spinner.Tick, m.switchToPath(dep))
+			}
+			if index := m.depCursor - len(m.deps); index < len(m.switches) {
+				sw := m.switches[index]
+				m.busyText = fmt.Sprintf("Restoring %s to its git source...", sw.Name)
+				m.phase = refPhaseApplying
+				return m, tea.Batch(m.spinner.Tick, m.switchToGit(sw))
+			}
+		case "enter":
+			// Ref picking only applies to git dependencies; a path-switched
+			// entry has no ref until it is toggled back with 'p'
+			if m.phase == refPhasePickDep && m.depCursor < len(m.deps) {
+				m.phase = refPhaseLoading
+				return m, tea.Batch(m.spinner.Tick, m.loadRefs())
+			}
+			if m.phase == refPhasePickRef && m.refCount() > 0 {
+				dep := m.deps[m.depCursor]
+				chosen := m.refAt(m.refCursor)
+
+				// Tag-to-tag upgrades go through the release notes review
+				// first (see core/relnotes.go); everything else applies
+				// immediately as before
+				if m.isTag(chosen) && m.isTag(dep.Ref) && chosen != dep.Ref {
+					m.pendingRef = chosen
+					m.busyText = fmt.Sprintf("Fetching release notes %s → %s...", dep.Ref, chosen)
+					m.phase = refPhaseApplying
+					return m, tea.Batch(m.spinner.Tick, m.loadReleaseNotes(dep, chosen))
+				}
+
+				m.busyText = fmt.Sprintf("Re-pinning %s to %s...", dep.Name, chosen)
+				m.phase = refPhaseApplying
+				return m, tea.Batch(m.spinner.Tick, m.applyRef(chosen))
+			}
+		}
+		return m, nil
+
+	case refSwitchDepsLoadedMsg:
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.deps = msg.deps
+		m.switches = msg.switches
+		if m.depCount() == 0 {
+			m.loadErr = "No git dependencies found in pubspec.yaml"
+		}
+		return m, nil
+
+	case refSwitchRefsLoadedMsg:
+		if msg.err != nil {
+			// Fetching refs failed; stay on the dependency list with the error
+			m.phase = refPhasePickDep
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.refs = msg.refs
+		m.impact = msg.impact
+		m.refCursor = 0
+		if m.refCount() == 0 {
+			m.phase = refPhasePickDep
+			m.loadErr = fmt.Sprintf("No branches or tags found for %s", m.deps[m.depCursor].Name)
+			return m, nil
+		}
+		m.phase = refPhasePickRef
+		return m, nil
+
+	case refSwitchNotesMsg:
+		// No notes (repo without releases, gh missing, fetch failed): apply
+		// straight away - the review is an aid, not a gate
+		if msg.err != nil || len(msg.notes) == 0 {
+			if msg.err != nil {
+				m.logger.Debug("ref_switch", fmt.Sprintf("Release notes unavailable: %v", msg.err))
+			}
+			m.busyText = fmt.Sprintf("Re-pinning %s to %s...", m.deps[m.depCursor].Name, m.pendingRef)
+			return m, tea.Batch(m.spinner.Tick, m.applyRef(m.pendingRef))
+		}
+
+		var content strings.Builder
+		for i, note := range msg.notes {
+			if i > 0 {
+				content.WriteString("\n\n")
+			}
+			content.WriteString(m.titleStyle.Render(fmt.Sprintf("── %s ──", note.Name)) + "\n")
+			if note.Body == "" {
+				content.WriteString(m.normalStyle.Render("(no release notes)"))
+			} else {
+				content.WriteString(renderMarkdownLite(note.Body, 74))
+			}
+		}
+		m.notes = viewport.New(76, 14)
+		m.notes.SetContent(content.String())
+		m.phase = refPhaseNotes
+		return m, nil
+
+	case refSwitchDoneMsg:
+		m.phase = refPhaseDone
+		m.result = msg.result
+		return m, nil
+
+	case spinner.TickMsg:
+		if m.phase == refPhaseLoading || m.phase == refPhaseApplying {
+			var cmd tea.Cmd
+			m.spinner, cmd = m.spinner.Update(msg)
+			return m, cmd
+		}
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the ref switching screen
+func (m *RefSwitchModel) View() string {
+	var b strings.Builder
+
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🔀 Switch Dependency Ref")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	switch m.phase {
+	case refPhasePickDep:
+		if m.depCount() == 0 {
+			b.WriteString(m.normalStyle.Render("⏳ Reading pubspec.yaml...") + "\n")
+			return b.String()
+		}
+		b.WriteString(m.titleStyle.Render(fmt.Sprintf("Git dependencies in %s:", m.shared.DetectedProject)) + "\n\n")
+		for i, dep := range m.deps {
+			ref := dep.Ref
+			if ref == "" {
+				ref = "default branch"
+			}
+			line := fmt.Sprintf("%s (pinned to %s)", dep.Name, ref)
+			if i == m.depCursor {
+				b.WriteString(m.selectedStyle.Render("> "+line) + "\n")
+			} else {
+				b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+			}
+		}
+		for i, sw := range m.switches {
+			line := fmt.Sprintf("%s (📁 local path: %s)", sw.Name, sw.LocalPath)
+			if len(m.deps)+i == m.depCursor {
+				b.WriteString(m.selectedStyle.Render("> "+line) + "\n")
+			} else {
+				b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+			}
+		}
+		b.WriteString("\n" + m.helpStyle.Render("↑/↓ navigate • enter pick ref • p toggle local path • esc back") + "\n")
+
+	case refPhaseLoading:
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s Fetching branches and tags for %s...",
+			m.spinner.View(), m.deps[m.depCursor].Name)) + "\n")
+
+	case refPhasePickRef:
+		dep := m.deps[m.depCursor]
+		b.WriteString(m.titleStyle.Render(fmt.Sprintf("Pick a ref for %s:", dep.Name)) + "\n\n")
+
+		// Impact panel: who depends on this package (see core/impact.go)
+		if m.impact != nil {
+			b.WriteString(m.titleStyle.Render("💥 Bump impact:") + "\n")
+			for _, line := range m.impact.PanelLines() {
+				b.WriteString(m.normalStyle.Render("  "+line) + "\n")
+			}
+			b.WriteString("\n")
+		}
+
+		index := 0
+		renderSection := func(label string, refs []string) {
+			if len(refs) == 0 {
+				return
+			}
+			b.WriteString(m.normalStyle.Render(label) + "\n")
+			for _, ref := range refs {
+				line := ref
+				if ref == dep.Ref {
+					line += " (current)"
+				}
+				if index == m.refCursor {
+					b.WriteString(m.selectedStyle.Render("  > "+line) + "\n")
+				} else {
+					b.WriteString(m.normalStyle.Render("    "+line) + "\n")
+				}
+				index++
+			}
+		}
+		renderSection("Branches:", m.refs.Branches)
+		renderSection("Tags:", m.refs.Tags)
+
+		b.WriteString("\n" + m.helpStyle.Render("↑/↓ navigate • enter switch ref • esc back to dependencies") + "\n")
+
+	case refPhaseNotes:
+		dep := m.deps[m.depCursor]
+		b.WriteString(m.titleStyle.Render(fmt.Sprintf("📰 Release notes for %s: %s → %s",
+			dep.Name, dep.Ref, m.pendingRef)) + "\n\n")
+		b.WriteString(m.notes.View() + "\n\n")
+		b.WriteString(m.helpStyle.Render("enter confirm upgrade • up/down scroll • esc back to refs") + "\n")
+
+	case refPhaseApplying:
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("%s %s", m.spinner.View(), m.busyText)) + "\n")
+
+	case refPhaseDone:
+		if m.result.OK {
+			b.WriteString(m.successStyle.Render("✅ "+m.result.Message) + "\n")
+		} else {
+			b.WriteString(m.errorStyle.Render("❌ "+m.result.Err) + "\n")
+		}
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+	}
+
+	return b.String()
+}
+
+// depCount returns the number of selectable dependency rows: git deps
+// plus path-switched ones
+func (m *RefSwitchModel) depCount() int {
+	return len(m.deps) + len(m.switches)
+}
+
+// refCount returns the number of selectable refs
+func (m *RefSwitchModel) refCount() int {
+	if m.refs == nil {
+		return 0
+	}
+	return len(m.refs.Branches) + len(m.refs.Tags)
+}
+
+// refAt maps the flattened cursor onto branches-then-tags
+func (m *RefSwitchModel) refAt(index int) string {
+	if index < len(m.refs.Branches) {
+		return m.refs.Branches[index]
+	}
+	return m.refs.Tags[index-len(m.refs.Branches)]
+}
+
+// isTag reports whether ref is one of the repository's tags
+func (m *RefSwitchModel) isTag(ref string) bool {
+	if m.refs == nil || ref == "" {
+		return false
+	}
+	for _, tag := range m.refs.Tags {
+		if tag == ref {
+			return true
+		}
+	}
+	return false
+}
+
+// projectPath resolves the target project directory from shared state
+func (m *RefSwitchModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// loadDeps lists the project's git dependencies and any dependencies
+// currently switched to a local path in the background
+func (m *RefSwitchModel) loadDeps() tea.Cmd {
+	return func() tea.Msg {
+		deps, err := core.ListGitDependencies(m.projectPath())
+		if err != nil {
+			return refSwitchDepsLoadedMsg{err: err}
+		}
+		// Path-switched deps don't look like git deps anymore, so they come
+		// from the marker scan instead (best-effort)
+		switches, err := core.ListPathSwitches(m.projectPath())
+		if err != nil {
+			m.logger.Debug("ref_switch", fmt.Sprintf("Could not list path switches: %v", err))
+		}
+		return refSwitchDepsLoadedMsg{deps: deps, switches: switches}
+	}
+}
+
+// switchToPath finds a local clone of the dependency and rewrites it to a
+// path: reference pointing at it
+func (m *RefSwitchModel) switchToPath(dep core.PkgSpec) tea.Cmd {
+	return func() tea.Msg {
+		clonePath, err := core.FindLocalClone(m.logger, m.shared.SearchPaths, m.shared.SearchDepth, dep)
+		if err != nil {
+			return refSwitchDoneMsg{result: core.ActionResult{
+				OK:  false,
+				Err: err.Error(),
+			}}
+		}
+		m.logger.Narrate(fmt.Sprintf("Switching %s to local clone %s", dep.Name, clonePath))
+		result := core.SwitchDependencyToPath(m.logger, &m.cfg, m.projectPath(), dep, clonePath)
+		return refSwitchDoneMsg{result: result}
+	}
+}
+
+// switchToGit restores a path-switched dependency to its recorded git
+// source
+func (m *RefSwitchModel) switchToGit(sw core.PathSwitch) tea.Cmd {
+	return func() tea.Msg {
+		m.logger.Narrate(fmt.Sprintf("Restoring %s to %s", sw.Name, sw.Spec.URL))
+		result := core.SwitchDependencyToGit(m.logger, &m.cfg, m.projectPath(), sw.Name)
+		return refSwitchDoneMsg{result: result}
+	}
+}
+
+// loadRefs fetches the selected dependency's branches and tags, and
+// computes the impact panel ("what breaks if I bump this?") alongside -
+// a failed impact analysis just omits the panel
+func (m *RefSwitchModel) loadRefs() tea.Cmd {
+	return func() tea.Msg {
+		dep := m.deps[m.depCursor]
+		refs, err := core.ListRemoteRefs(dep.URL)
+		if err != nil {
+			return refSwitchRefsLoadedMsg{err: err}
+		}
+		impact, impactErr := core.AnalyzeDependencyImpact(m.logger, m.projectPath(), dep.Name)
+		if impactErr != nil {
+			m.logger.Debug("ref_switch", fmt.Sprintf("Impact analysis unavailable: %v", impactErr))
+		}
+		return refSwitchRefsLoadedMsg{refs: refs, impact: impact}
+	}
+}
+
+// loadReleaseNotes fetches the releases between the current and chosen tag
+// in the background (see core/relnotes.go)
+func (m *RefSwitchModel) loadReleaseNotes(dep core.PkgSpec, toTag string) tea.Cmd {
+	return func() tea.Msg {
+		notes, err := core.FetchReleaseNotesBetween(m.logger, dep.URL, dep.Ref, toTag)
+		return refSwitchNotesMsg{notes: notes, err: err}
+	}
+}
+
+// applyRef re-pins the selected dependency through the single-dependency
+// update pipeline (backup, pub add, old -> new SHA diff)
+func (m *RefSwitchModel) applyRef(ref string) tea.Cmd {
+	return func() tea.Msg {
+		dep := m.deps[m.depCursor]
+		m.logger.Narrate(fmt.Sprintf("Switching %s to ref %s", dep.Name, ref))
+		result := core.UpdateSingleDependency(m.logger, &m.cfg, m.projectPath(), dep.Name, ref, false)
+		return refSwitchDoneMsg{result: result}
+	}
+}
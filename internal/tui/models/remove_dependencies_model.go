@@ -0,0 +1,315 @@
+// Package models/remove_dependencies_model.go - Dependency Management/Removal Screen
+//
+// This file implements the manage/remove workflow: it lists the detected
+// local project's dependencies (via ListDependencyOverview) with source,
+// override, pin and freshness badges (see badges.go), lets the user
+// multi-select with the space bar, creates a pubspec.yaml backup, and runs
+// `dart pub remove` for each confirmed package.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// RemoveDependenciesModel handles multi-select removal of git dependencies
+type RemoveDependenciesModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	// List state
+	loading  bool
+	loadErr  string
+	deps     []core.DepOverview
+	stale    bool // Heuristic: pubspec.lock is older than 24h
+	cursor   int
+	selected map[int]bool
+
+	// Removal state
+	removing bool
+	done     bool
+	results  []core.ActionResult
+
+	// Styles
+	titleStyle    lipgloss.Style
+	selectedStyle lipgloss.Style
+	normalStyle   lipgloss.Style
+	successStyle  lipgloss.Style
+	errorStyle    lipgloss.Style
+	helpStyle     lipgloss.Style
+}
+
+// removeDepsLoadedMsg is sent when the dependency list has been parsed
+type removeDepsLoadedMsg struct {
+	deps  []core.DepOverview
+	stale bool
+	err   error
+}
+
+// removeDepsDoneMsg is sent when all pub remove commands have finished
+type removeDepsDoneMsg struct {
+	results []core.ActionResult
+}
+
+// NewRemoveDependenciesModel creates a new dependency removal model
+func NewRemoveDependenciesModel(cfg core.Config, logger *core.Logger, shared *AppState) *RemoveDependenciesModel {
+	return &RemoveDependenciesModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		loading:  true,
+		selected: make(map[int]bool),
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		selectedStyle: lipgloss.NewStyle().
+			Foreground(theme.Selected()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		successStyle: lipgloss.NewStyle().
+			Foreground(theme.Success()),
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts loading the git dependency list
+func (m *RemoveDependenciesModel) Init() tea.Cmd {
+	return m.loadDependencies()
+}
+
+// Update handles messages for the removal screen
+func (m *RemoveDependenciesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		// Ignore input while background work is running
+		if m.loading || m.removing {
+			return m, nil
+		}
+
+		// After removal (or load error), enter/esc returns to the main menu
+		if m.done || m.loadErr != "" {
+			switch msg.String() {
+			case "enter", "esc", "q":
+				return m, TransitionToScreen(ScreenMainMenu)
+			}
+			return m, nil
+		}
+
+		switch msg.String() {
+		case "esc", "q":
+			return m, NavigateBack()
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.deps)-1 {
+				m.cursor++
+			}
+		case " ":
+			m.selected[m.cursor] = !m.selected[m.cursor]
+		case "enter":
+			if m.selectedCount() == 0 {
+				return m, nil
+			}
+			m.removing = true
+			return m, m.removeSelected()
+		}
+		return m, nil
+
+	case removeDepsLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.deps = msg.deps
+		m.stale = msg.stale
+		if len(m.deps) == 0 {
+			m.loadErr = "No removable dependencies found in pubspec.yaml"
+		}
+		return m, nil
+
+	case removeDepsDoneMsg:
+		m.removing = false
+		m.done = true
+		m.results = msg.results
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the removal screen
+func (m *RemoveDependenciesModel) View() string {
+	var b strings.Builder
+
+	// Header with warning red theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("#EF4444")).
+		Foreground(lipgloss.Color("#EF4444")).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("🗑️ Remove Dependencies")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render("⏳ Reading pubspec.yaml...") + "\n")
+		return b.String()
+	}
+
+	if m.removing {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("⏳ Removing %d package(s)...", m.selectedCount())) + "\n")
+		return b.String()
+	}
+
+	if m.done {
+		for _, result := range m.results {
+			if result.OK {
+				b.WriteString(m.successStyle.Render("✅ "+result.Message) + "\n")
+			} else {
+				b.WriteString(m.errorStyle.Render("❌ "+result.Err) + "\n")
+			}
+		}
+		b.WriteString("\n" + m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Dependencies in %s:", m.shared.DetectedProject)) + "\n\n")
+
+	for i, dep := range m.deps {
+		check := "☐"
+		if m.selected[i] {
+			check = "✅"
+		}
+
+		line := fmt.Sprintf("%s %s", check, dep.Name)
+		switch {
+		case dep.Ref != "":
+			line += fmt.Sprintf(" (%s)", dep.Ref)
+		case dep.Constraint != "":
+			line += fmt.Sprintf(" (%s)", dep.Constraint)
+		case dep.Path != "":
+			line += fmt.Sprintf(" (%s)", dep.Path)
+		}
+
+		if i == m.cursor {
+			b.WriteString(m.selectedStyle.Render("> "+line) + " " + depBadges(dep, m.stale) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render("  "+line) + " " + depBadges(dep, m.stale) + "\n")
+		}
+	}
+
+	b.WriteString("\n")
+	if count := m.selectedCount(); count > 0 {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("Selected: %d package(s)", count)) + "\n\n")
+	}
+	b.WriteString(m.helpStyle.Render("↑/↓ navigate • space select • enter remove selected • esc back") + "\n")
+
+	return b.String()
+}
+
+// selectedCount returns how many dependencies are currently marked
+func (m *RemoveDependenciesModel) selectedCount() int {
+	count := 0
+	for _, on := range m.selected {
+		if on {
+			count++
+		}
+	}
+	return count
+}
+
+// projectPath resolves the target project directory from shared state
+func (m *RemoveDependenciesModel) projectPath() string {
+	if m.shared.SourceProjectPath != "" {
+		return m.shared.SourceProjectPath
+	}
+	if m.shared.DetectedPubspecPath != "" {
+		return filepath.Dir(m.shared.DetectedPubspecPath)
+	}
+	return "."
+}
+
+// loadDependencies parses pubspec.yaml in the background and runs the
+// cheap lock-file freshness heuristic for the stale badge
+func (m *RemoveDependenciesModel) loadDependencies() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.projectPath()
+		deps, err := core.ListDependencyOverview(projectPath)
+
+		// SDK dependencies (flutter, flutter_test) are not removable
+		removable := deps[:0]
+		for _, dep := range deps {
+			if dep.Source != "sdk" {
+				removable = append(removable, dep)
+			}
+		}
+
+		stale, _, _ := core.CheckStaleHeuristic(projectPath)
+		return removeDepsLoadedMsg{deps: removable, stale: stale, err: err}
+	}
+}
+
+// removeSelected creates a backup and runs pub remove for each marked package
+func (m *RemoveDependenciesModel) removeSelected() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.projectPath()
+		m.logger.Narrate(fmt.Sprintf("Removing %d dependencies", m.selectedCount()))
+
+		// Same safety net as the add flows
+		if !m.cfg.DryRun {
+			if backup, err := core.CreateTrackedBackup(m.logger, projectPath, "remove"); err != nil {
+				m.logger.Error("backup", err)
+			} else {
+				m.logger.Info("backup", fmt.Sprintf("Created backup: %s", backup.BackupPath))
+			}
+		}
+
+		var results []core.ActionResult
+		for i, dep := range m.deps {
+			if !m.selected[i] {
+				continue
+			}
+			results = append(results, core.RemoveDependency(m.logger, &m.cfg, projectPath, dep.Name))
+		}
+
+		return removeDepsDoneMsg{results: results}
+	}
+}
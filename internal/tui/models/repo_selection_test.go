@@ -6,8 +6,10 @@
 package models
 
 import (
+	"strings"
 	"testing"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
 )
 
@@ -86,3 +88,217 @@ func TestRepoSelectionWithValidData(t *testing.T) {
 		t.Errorf("Expected repo2, got %s", shared.SelectedDependencies[0].Name)
 	}
 }
+
+// TestRepoViewFilterAndSort verifies the sort modes and the privacy/Dart
+// filter toggles rebuild the visible list correctly
+func TestRepoViewFilterAndSort(t *testing.T) {
+	cfg := core.Config{}
+	logger := &core.Logger{}
+
+	repos := []core.RepoCandidate{
+		{Owner: "u", Name: "zeta", Privacy: "public", Language: "Dart", Stars: 1},
+		{Owner: "u", Name: "alpha", Privacy: "private", Language: "Go", Stars: 5},
+		{Owner: "u", Name: "beta", Privacy: "public", Topics: []string{"flutter"}, Stars: 3},
+	}
+	shared := &AppState{AvailableDependencies: repos}
+
+	model := NewRepoSelectionModel(cfg, logger, shared)
+	model.setupList()
+
+	// Default view shows everything in provider order
+	if got := len(model.list.Items()); got != 3 {
+		t.Fatalf("Expected 3 items, got %d", got)
+	}
+
+	// Name sort is alphabetical
+	model.sortMode = repoSortName
+	model.applyListView()
+	if first := model.list.Items()[0].(RepoItem); first.repo.Name != "alpha" {
+		t.Errorf("Expected alpha first under name sort, got %s", first.repo.Name)
+	}
+
+	// Stars sort is descending
+	model.sortMode = repoSortStars
+	model.applyListView()
+	if first := model.list.Items()[0].(RepoItem); first.repo.Name != "alpha" {
+		t.Errorf("Expected alpha (5 stars) first under star sort, got %s", first.repo.Name)
+	}
+
+	// Dart filter matches both the language and the flutter topic
+	model.sortMode = repoSortPushed
+	model.dartOnly = true
+	model.applyListView()
+	if got := len(model.list.Items()); got != 2 {
+		t.Errorf("Expected 2 Dart-like repos, got %d", got)
+	}
+
+	// Item indices stay stable under filtering so selections stick
+	for _, item := range model.list.Items() {
+		repoItem := item.(RepoItem)
+		if repos[repoItem.index].Name != repoItem.repo.Name {
+			t.Errorf("Item index %d does not point at %s", repoItem.index, repoItem.repo.Name)
+		}
+	}
+
+	// Privacy filter composes with the Dart filter
+	model.privacyOnly = "private"
+	model.applyListView()
+	if got := len(model.list.Items()); got != 0 {
+		t.Errorf("Expected no private Dart repos, got %d", got)
+	}
+}
+
+// TestDartFilterPrefersProbeResults verifies that a definitive pubspec
+// probe result overrides the language/topic heuristic
+func TestDartFilterPrefersProbeResults(t *testing.T) {
+	// Dart language but the probe found no pubspec - not a package
+	noPubspec := core.RepoCandidate{Name: "tools", Language: "Dart", PubspecChecked: true, HasPubspec: false}
+	if repoLooksLikeDartPackage(noPubspec) {
+		t.Error("Expected probe result (no pubspec) to override the Dart language heuristic")
+	}
+
+	// No Dart signals but the probe found a pubspec - definitely a package
+	confirmed := core.RepoCandidate{Name: "app", Language: "Kotlin", PubspecChecked: true, HasPubspec: true}
+	if !repoLooksLikeDartPackage(confirmed) {
+		t.Error("Expected probe result (has pubspec) to override the language heuristic")
+	}
+
+	// Unchecked repos fall back to the heuristic
+	unchecked := core.RepoCandidate{Name: "pkg", Language: "Dart"}
+	if !repoLooksLikeDartPackage(unchecked) {
+		t.Error("Expected unchecked Dart repo to match via the heuristic")
+	}
+}
+
+// TestResizeDebounce verifies that a burst of WindowSizeMsg only re-lays
+// out the list once the resize settles, and that stale settle messages
+// from superseded resizes are ignored
+func TestResizeDebounce(t *testing.T) {
+	cfg := core.Config{}
+	logger := &core.Logger{}
+	shared := &AppState{
+		AvailableDependencies: []core.RepoCandidate{
+			{Owner: "u", Name: "repo", URL: "https://github.com/u/repo"},
+		},
+	}
+
+	model := NewRepoSelectionModel(cfg, logger, shared)
+	model.setupList()
+	originalWidth := model.list.Width()
+
+	// Two rapid resizes: neither applies immediately
+	model.Update(tea.WindowSizeMsg{Width: 100, Height: 30})
+	firstSeq := model.resizeSeq
+	model.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	if model.list.Width() != originalWidth {
+		t.Errorf("Expected list width unchanged during resize burst, got %d", model.list.Width())
+	}
+
+	// The stale settle message from the superseded first resize is ignored
+	model.Update(resizeSettledMsg{seq: firstSeq})
+	if model.list.Width() != originalWidth {
+		t.Error("Expected stale settle message to be ignored")
+	}
+
+	// The settle message for the latest resize applies the pending size
+	model.Update(resizeSettledMsg{seq: model.resizeSeq})
+	if model.list.Width() != 120 {
+		t.Errorf("Expected list width 120 after settled resize, got %d", model.list.Width())
+	}
+	if model.pendingSize != nil {
+		t.Error("Expected pending size to be cleared after applying")
+	}
+}
+
+// TestFavoritesPinnedFirst verifies quick-add favorites sort above the
+// rest of the listing regardless of the active sort mode
+func TestFavoritesPinnedFirst(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // Keep the constructor off any real favorites
+	cfg := core.Config{}
+	logger := &core.Logger{}
+	shared := &AppState{
+		AvailableDependencies: []core.RepoCandidate{
+			{Owner: "u", Name: "alpha", URL: "https://github.com/u/alpha", Stars: 9},
+			{Owner: "u", Name: "favored", URL: "https://github.com/u/favored.git", Stars: 1},
+		},
+	}
+
+	model := NewRepoSelectionModel(cfg, logger, shared)
+	model.favoriteURLs = map[string]bool{"https://github.com/u/favored": true}
+	model.sortMode = repoSortStars
+	model.setupList()
+
+	first := model.list.Items()[0].(RepoItem)
+	if first.repo.Name != "favored" || !first.favorite {
+		t.Errorf("Expected the favorite pinned first despite star sort, got %s", first.repo.Name)
+	}
+	if got := first.Title(); !strings.HasPrefix(got, "⭐ ") {
+		t.Errorf("Expected the ⭐ quick-add marker in the title, got %q", got)
+	}
+}
+
+// TestTeamBundleSelection verifies the one-keystroke bundle selects listed
+// repos and appends synthetic candidates for config-only packages
+func TestTeamBundleSelection(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := core.Config{}
+	logger := &core.Logger{}
+	shared := &AppState{
+		AvailableDependencies: []core.RepoCandidate{
+			{Owner: "acme", Name: "listed", URL: "https://github.com/acme/listed.git"},
+			{Owner: "acme", Name: "other", URL: "https://github.com/acme/other.git"},
+		},
+	}
+
+	model := NewRepoSelectionModel(cfg, logger, shared)
+	model.teamBundle = []core.PkgSpec{
+		{Name: "listed", URL: "https://github.com/acme/listed"}, // Matches despite .git suffix
+		{Name: "config_only", URL: "https://github.com/acme/config_only.git"},
+	}
+	model.setupList()
+
+	model.selectTeamBundle()
+
+	// The config-only package was appended as a synthetic candidate
+	if len(shared.AvailableDependencies) != 3 {
+		t.Fatalf("Expected 3 candidates after bundle selection, got %d", len(shared.AvailableDependencies))
+	}
+	appended := shared.AvailableDependencies[2]
+	if appended.Owner != "acme" || appended.Name != "config_only" {
+		t.Errorf("Expected owner/name derived from the URL, got %s/%s", appended.Owner, appended.Name)
+	}
+
+	// Both bundle packages are selected; the unrelated repo is not
+	model.finalizeSelection()
+	if len(shared.SelectedDependencies) != 2 {
+		t.Fatalf("Expected 2 selected packages, got %d", len(shared.SelectedDependencies))
+	}
+	selected := map[string]bool{}
+	for _, repo := range shared.SelectedDependencies {
+		selected[repo.Name] = true
+	}
+	if !selected["listed"] || !selected["config_only"] || selected["other"] {
+		t.Errorf("Unexpected bundle selection: %v", selected)
+	}
+}
+
+// TestRowCacheInvalidation verifies the memoized styled rows are dropped
+// when the view is rebuilt, so probe-updated titles never render stale
+func TestRowCacheInvalidation(t *testing.T) {
+	cfg := core.Config{}
+	logger := &core.Logger{}
+	shared := &AppState{
+		AvailableDependencies: []core.RepoCandidate{
+			{Owner: "u", Name: "repo", URL: "https://github.com/u/repo"},
+		},
+	}
+
+	model := NewRepoSelectionModel(cfg, logger, shared)
+	model.setupList()
+
+	model.delegate.rowCache[rowRenderKey{index: 0, width: 80}] = "stale row"
+	model.applyListView()
+	if len(model.delegate.rowCache) != 0 {
+		t.Errorf("Expected row cache cleared by applyListView, got %d entries", len(model.delegate.rowCache))
+	}
+}
@@ -4,12 +4,15 @@ package models
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ResultsModel handles displaying operation results
@@ -22,6 +25,26 @@ type ResultsModel struct {
 	viewport viewport.Model
 	ready    bool
 
+	// Undo state (see core/rollback.go)
+	undoing    bool
+	undoResult *core.ActionResult
+
+	// Issue report state (see core/issue_report.go)
+	issueNote string
+
+	// Run report export state (see core/report.go)
+	reportNote string
+
+	// Pull request creation state (see core/prcreate.go)
+	prCreating bool
+	prNote     string
+
+	// Fix picker state (see core/recofix.go); the cursor and selection
+	// index into fixableRecoIndexes()
+	fixMode     bool
+	fixCursor   int
+	fixSelected map[int]bool
+
 	// Styles
 	headerStyle  lipgloss.Style
 	successStyle lipgloss.Style
@@ -36,10 +59,11 @@ func NewResultsModel(cfg core.Config, logger *core.Logger, shared *AppState) *Re
 	vp := viewport.New(78, 20)
 
 	return &ResultsModel{
-		cfg:      cfg,
-		logger:   logger,
-		shared:   shared,
-		viewport: vp,
+		cfg:         cfg,
+		logger:      logger,
+		shared:      shared,
+		viewport:    vp,
+		fixSelected: make(map[int]bool),
 
 		// Styles
 		headerStyle: lipgloss.NewStyle().
@@ -51,7 +75,7 @@ func NewResultsModel(cfg core.Config, logger *core.Logger, shared *AppState) *Re
 			Bold(true),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		warningStyle: lipgloss.NewStyle().
@@ -59,7 +83,7 @@ func NewResultsModel(cfg core.Config, logger *core.Logger, shared *AppState) *Re
 			Bold(true),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		codeStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("244")).
@@ -81,10 +105,60 @@ func (m *ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.fixMode {
+			return m.updateFixPicker(msg)
+		}
+
 		switch msg.String() {
 		case "q", "ctrl+c", "enter":
+			if m.undoing {
+				return m, nil
+			}
 			return m, TransitionToScreen(ScreenMainMenu)
 
+		case "f":
+			// Open the fix picker for recommendations the tool can apply
+			// itself (see core/recofix.go)
+			if m.undoing || len(m.fixableRecoIndexes()) == 0 {
+				return m, nil
+			}
+			m.fixMode = true
+			m.fixCursor = 0
+			m.fixSelected = make(map[int]bool)
+			return m, nil
+
+		case "u":
+			// Undo last change: restore the most recent journaled backup
+			if m.undoing || m.undoResult != nil {
+				return m, nil
+			}
+			m.undoing = true
+			return m, m.undoLastChange()
+
+		case "i":
+			// Generate a pre-filled bug report for a repeated failure
+			m.generateIssueReport()
+			return m, nil
+
+		case "e", "E":
+			// Export a shareable run report, markdown with 'e' and HTML
+			// with 'E' (see core/report.go)
+			m.exportRunReport(msg.String() == "E")
+			return m, nil
+
+		case "p":
+			// Move the changes onto a branch and open a pull request
+			// (see core/prcreate.go)
+			if m.prCreating || m.prNote != "" || m.undoing {
+				return m, nil
+			}
+			changes := core.DependencyChangesFromResults(m.shared.Results)
+			if len(changes) == 0 {
+				return m, nil
+			}
+			m.prCreating = true
+			return m, m.createPullRequest(changes)
+
 		case "up", "k":
 			m.viewport.LineUp(1)
 			return m, nil
@@ -110,6 +184,12 @@ func (m *ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+	case tea.MouseMsg:
+		// Wheel scrolling through the results
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
 	case tea.WindowSizeMsg:
 		if !m.ready {
 			m.viewport = viewport.New(msg.Width, msg.Height-8)
@@ -121,6 +201,20 @@ func (m *ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case resultsUndoDoneMsg:
+		m.undoing = false
+		m.undoResult = &msg.result
+		return m, nil
+
+	case resultsPRDoneMsg:
+		m.prCreating = false
+		if msg.result.OK {
+			m.prNote = "🔀 " + msg.result.Message
+		} else {
+			m.prNote = "❌ Pull request failed: " + msg.result.Err
+		}
+		return m, nil
+
 	default:
 		var cmd tea.Cmd
 		m.viewport, cmd = m.viewport.Update(msg)
@@ -130,10 +224,76 @@ func (m *ResultsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+// fixableRecoIndexes returns the indexes of recommendations that carry a
+// machine-applicable fix
+func (m *ResultsModel) fixableRecoIndexes() []int {
+	var indexes []int
+	for i, reco := range m.shared.Recommendations {
+		if reco.FixAction != nil {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// updateFixPicker handles keys while the fix picker is open: the cursor
+// moves over the applicable fixes, space toggles, enter hands the selection
+// to the execution screen and esc cancels
+func (m *ResultsModel) updateFixPicker(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	fixable := m.fixableRecoIndexes()
+
+	switch msg.String() {
+	case "esc", "q", "ctrl+c":
+		m.fixMode = false
+		return m, nil
+
+	case "up", "k":
+		if m.fixCursor > 0 {
+			m.fixCursor--
+		}
+
+	case "down", "j":
+		if m.fixCursor < len(fixable)-1 {
+			m.fixCursor++
+		}
+
+	case " ":
+		m.fixSelected[m.fixCursor] = !m.fixSelected[m.fixCursor]
+
+	case "enter":
+		var actions []core.RecoFixAction
+		for i, recoIndex := range fixable {
+			if m.fixSelected[i] {
+				actions = append(actions, *m.shared.Recommendations[recoIndex].FixAction)
+			}
+		}
+		// Nothing ticked: apply the fix under the cursor
+		if len(actions) == 0 && m.fixCursor < len(fixable) {
+			actions = append(actions, *m.shared.Recommendations[fixable[m.fixCursor]].FixAction)
+		}
+		if len(actions) == 0 {
+			m.fixMode = false
+			return m, nil
+		}
+
+		m.logger.Narrate(fmt.Sprintf("Applying %d recommended fix(es)", len(actions)))
+		m.shared.PendingFixes = actions
+		m.shared.Results = nil // The execution screen collects per-fix results
+		return m, TransitionToScreen(ScreenExecution)
+	}
+
+	return m, nil
+}
+
 // View renders the results screen
 func (m *ResultsModel) View() string {
 	var b strings.Builder
 
+	// Fix picker replaces the results view while open
+	if m.fixMode {
+		return m.fixPickerView()
+	}
+
 	// Header
 	b.WriteString(m.headerStyle.Render("📊 Installation Results") + "\n\n")
 
@@ -141,16 +301,253 @@ func (m *ResultsModel) View() string {
 		return b.String() + "Preparing results..."
 	}
 
+	// Issue report status line
+	if m.issueNote != "" {
+		b.WriteString(m.warningStyle.Render(m.issueNote) + "\n\n")
+	}
+
+	// Run report export status line
+	if m.reportNote != "" {
+		b.WriteString(m.successStyle.Render(m.reportNote) + "\n\n")
+	}
+
+	// Pull request status line
+	if m.prCreating {
+		b.WriteString(m.warningStyle.Render("⏳ Creating pull request (branch, push, PR)...") + "\n\n")
+	} else if m.prNote != "" {
+		b.WriteString(m.successStyle.Render(m.prNote) + "\n\n")
+	}
+
+	// Undo status line
+	if m.undoing {
+		b.WriteString(m.warningStyle.Render("⏳ Undoing last change (restoring pubspec.yaml and running pub get)...") + "\n\n")
+	} else if m.undoResult != nil {
+		if m.undoResult.OK {
+			b.WriteString(m.successStyle.Render("↩️  "+m.undoResult.Message) + "\n\n")
+		} else {
+			b.WriteString(m.errorStyle.Render("❌ Undo failed: "+m.undoResult.Err) + "\n\n")
+		}
+	}
+
 	// Viewport content
 	b.WriteString(m.viewport.View() + "\n")
 
 	// Footer
-	footerText := "↑/↓ scroll • pgup/pgdown page • home/end • enter/q: back to menu"
+	footerText := "↑/↓ scroll • pgup/pgdown page • u: undo last change • p: open PR • e: export report • enter/q: back to menu"
+	if len(m.fixableRecoIndexes()) > 0 {
+		footerText = "↑/↓ scroll • u: undo last change • f: apply fixes • p: open PR • e: export report • enter/q: back to menu"
+	}
 	b.WriteString(m.normalStyle.Render(footerText))
 
 	return b.String()
 }
 
+// fixPickerView renders the list of machine-applicable fixes with the
+// cursor and selection checkmarks
+func (m *ResultsModel) fixPickerView() string {
+	var b strings.Builder
+
+	b.WriteString(m.headerStyle.Render("🔧 Apply Recommended Fixes") + "\n\n")
+	b.WriteString(m.normalStyle.Render("Each fix creates a backup first; 'u' on the results screen undoes the last one.") + "\n\n")
+
+	for i, recoIndex := range m.fixableRecoIndexes() {
+		cursor := "  "
+		if i == m.fixCursor {
+			cursor = "> "
+		}
+		check := "[ ]"
+		if m.fixSelected[i] {
+			check = "[✓]"
+		}
+
+		line := fmt.Sprintf("%s%s %s", cursor, check, m.shared.Recommendations[recoIndex].FixAction.Label)
+		if i == m.fixCursor {
+			b.WriteString(m.headerStyle.Render(line) + "\n")
+		} else {
+			b.WriteString(m.normalStyle.Render(line) + "\n")
+		}
+	}
+
+	b.WriteString("\n" + m.normalStyle.Render("space: toggle • enter: apply selected • esc: cancel"))
+
+	return b.String()
+}
+
+// resultsUndoDoneMsg is sent when the rollback has finished
+type resultsUndoDoneMsg struct {
+	result core.ActionResult
+}
+
+// undoLastChange restores the most recent journaled backup in the
+// background and re-runs pub get (see core.RollbackLast)
+func (m *ResultsModel) undoLastChange() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.shared.SourceProjectPath
+		if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+			projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+		}
+		if projectPath == "" {
+			projectPath = "."
+		}
+
+		m.logger.Narrate("Undoing last pubspec change")
+		return resultsUndoDoneMsg{result: core.RollbackLast(m.logger, &m.cfg, projectPath)}
+	}
+}
+
+// resultsPRDoneMsg is sent when pull request creation has finished
+type resultsPRDoneMsg struct {
+	result core.ActionResult
+}
+
+// createPullRequest moves the run's pubspec changes onto a fresh branch,
+// pushes it and opens a GitHub pull request in the background (see
+// core/prcreate.go)
+func (m *ResultsModel) createPullRequest(changes []core.DependencyChange) tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.shared.SourceProjectPath
+		if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+			projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+		}
+		if projectPath == "" {
+			projectPath = "."
+		}
+
+		m.logger.Narrate("Creating a pull request for the dependency changes")
+		return resultsPRDoneMsg{result: core.CreateUpdatePR(m.logger, &m.cfg, projectPath, changes)}
+	}
+}
+
+// repeatedFailure returns the first failed result whose error category has
+// repeated often enough to offer a bug report (see core/issue_report.go)
+func (m *ResultsModel) repeatedFailure() (core.ActionResult, string, bool) {
+	for _, result := range m.shared.Results {
+		if result.OK {
+			continue
+		}
+
+		category := "unknown"
+		if result.Data != nil {
+			if conflictType, ok := result.Data["conflict_type"].(string); ok && conflictType != "" {
+				category = conflictType
+			}
+		}
+
+		if core.ShouldOfferIssueReport("add", category) {
+			return result, category, true
+		}
+	}
+	return core.ActionResult{}, "", false
+}
+
+// generateIssueReport writes a sanitized pre-filled bug report for the
+// repeated failure, if one is on offer
+func (m *ResultsModel) generateIssueReport() {
+	result, category, ok := m.repeatedFailure()
+	if !ok {
+		return
+	}
+
+	logs := append([]string{result.Err}, result.Logs...)
+	report := core.BuildIssueReport(&m.cfg, "add", category, logs)
+
+	path, err := core.WriteIssueReport(report)
+	if err != nil {
+		m.issueNote = fmt.Sprintf("❌ Failed to write issue report: %s", err)
+		return
+	}
+
+	m.logger.Info("issue", fmt.Sprintf("Wrote issue report: %s", path))
+	m.issueNote = fmt.Sprintf("🐞 Issue report saved to %s (contains the prefilled tracker link)", path)
+}
+
+// exportRunReport writes a shareable report of this run - packages,
+// results, commands, timings - as markdown or HTML (see core/report.go)
+func (m *ResultsModel) exportRunReport(asHTML bool) {
+	report := &core.RunReport{GeneratedAt: time.Now()}
+	// The session log supplies command lines, errors and timings; without
+	// one the report still carries the packages and results
+	if path := m.logger.SessionLogPath(); path != "" {
+		if fromLog, err := core.BuildRunReportFromLog(path); err == nil {
+			report = fromLog
+		}
+	}
+	report.Packages = m.shared.PackageSpecs
+	report.Results = m.shared.Results
+
+	format := "markdown"
+	if asHTML {
+		format = "html"
+	}
+	path, err := report.WriteFile("", format)
+	if err != nil {
+		m.reportNote = fmt.Sprintf("❌ Failed to export report: %s", err)
+		return
+	}
+
+	m.logger.Info("report", fmt.Sprintf("Wrote run report: %s", path))
+	m.reportNote = fmt.Sprintf("📄 Run report saved to %s", path)
+}
+
+// writeProjectMatrix renders a package-by-project grid when the run targeted
+// more than one project (extra targets selected on the scan screen). Each
+// cell shows whether that package landed in that project's pubspec.
+func (m *ResultsModel) writeProjectMatrix(content *strings.Builder) {
+	// Collect projects and packages in first-seen order, plus per-cell status
+	var projectPaths []string
+	var packages []string
+	status := make(map[string]bool)
+	for _, result := range m.shared.Results {
+		if result.Data == nil {
+			continue
+		}
+		pkg, okPkg := result.Data["package"].(string)
+		projectPath, okPath := result.Data["projectPath"].(string)
+		if !okPkg || !okPath {
+			continue
+		}
+		if !containsString(projectPaths, projectPath) {
+			projectPaths = append(projectPaths, projectPath)
+		}
+		if !containsString(packages, pkg) {
+			packages = append(packages, pkg)
+		}
+		status[pkg+"\x00"+projectPath] = result.OK
+	}
+
+	// Single-project runs don't need the grid - the details list covers them
+	if len(projectPaths) < 2 {
+		return
+	}
+
+	content.WriteString(m.headerStyle.Render("Per-Project Results:") + "\n\n")
+	for _, pkg := range packages {
+		content.WriteString(fmt.Sprintf("   %s\n", pkg))
+		for _, projectPath := range projectPaths {
+			mark := "—"
+			if ok, recorded := status[pkg+"\x00"+projectPath]; recorded {
+				if ok {
+					mark = "✅"
+				} else {
+					mark = "❌"
+				}
+			}
+			content.WriteString(fmt.Sprintf("      %s %s\n", mark, filepath.Base(projectPath)))
+		}
+	}
+	content.WriteString("\n")
+}
+
+// containsString reports whether list already holds value
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
+
 // updateContent populates the viewport with results
 func (m *ResultsModel) updateContent() {
 	var content strings.Builder
@@ -205,12 +602,35 @@ func (m *ResultsModel) updateContent() {
 		}
 	}
 
+	// Repeated identical failures earn a bug report offer
+	if _, category, ok := m.repeatedFailure(); ok {
+		content.WriteString(m.warningStyle.Render(fmt.Sprintf(
+			"🐞 This %q failure has occurred %d times — press 'i' to generate a pre-filled bug report",
+			category, core.FailureCount("add", category))) + "\n\n")
+	}
+
 	content.WriteString(fmt.Sprintf("Total packages processed: %d\n", len(m.shared.Results)))
 	if conflictCount > 0 {
 		content.WriteString(fmt.Sprintf("Dependency conflicts resolved: %d\n", conflictCount))
 	}
+	// Auto-commit post-step outcome (see core/autocommit.go)
+	for _, result := range m.shared.Results {
+		if result.Data == nil {
+			continue
+		}
+		if hash, ok := result.Data["commit"].(string); ok && hash != "" {
+			line := fmt.Sprintf("Auto-committed as %s", hash)
+			if branch, ok := result.Data["branch"].(string); ok && branch != "" {
+				line += fmt.Sprintf(" on branch %s", branch)
+			}
+			content.WriteString(m.successStyle.Render("🔖 "+line) + "\n")
+		}
+	}
 	content.WriteString("\n")
 
+	// Multi-project runs get a package-by-project matrix before the details
+	m.writeProjectMatrix(&content)
+
 	// Detailed results
 	content.WriteString(m.headerStyle.Render("Detailed Results:") + "\n\n")
 
@@ -283,6 +703,49 @@ func (m *ResultsModel) updateContent() {
 		content.WriteString("\n")
 	}
 
+	// Security advisories from the post-install OSV.dev audit
+	// (see core/audit.go)
+	if len(m.shared.AuditFindings) > 0 {
+		content.WriteString(m.headerStyle.Render("🛡️ Security:") + "\n\n")
+		for _, finding := range m.shared.AuditFindings {
+			content.WriteString(m.errorStyle.Render(fmt.Sprintf("❗ %s %s has %d known vulnerability(ies)",
+				finding.Package, finding.Version, len(finding.Vulns))) + "\n")
+			for _, vuln := range finding.Vulns {
+				content.WriteString(fmt.Sprintf("   • %s: %s\n", vuln.ID, vuln.Summary))
+				content.WriteString(m.normalStyle.Render(fmt.Sprintf("     %s", vuln.URL)) + "\n")
+			}
+			content.WriteString("\n")
+		}
+		content.WriteString(m.warningStyle.Render("Run 'flutter-pm audit' for the full report, or update the affected packages") + "\n\n")
+	}
+
+	// Smart recommendations (e.g. hosted pub.dev alternatives to git deps)
+	if len(m.shared.Recommendations) > 0 {
+		content.WriteString(m.headerStyle.Render("💡 Recommendations:") + "\n\n")
+		for _, reco := range m.shared.Recommendations {
+			switch reco.Severity {
+			case "error":
+				content.WriteString(m.errorStyle.Render(fmt.Sprintf("❗ %s", reco.Message)) + "\n")
+			case "warn":
+				content.WriteString(m.warningStyle.Render(fmt.Sprintf("⚠️  %s", reco.Message)) + "\n")
+			default:
+				content.WriteString(fmt.Sprintf("ℹ️  %s\n", reco.Message))
+			}
+			if reco.Rationale != "" {
+				content.WriteString(m.normalStyle.Render(fmt.Sprintf("   %s", reco.Rationale)) + "\n")
+			}
+			if reco.Fix != "" {
+				content.WriteString(m.normalStyle.Render(fmt.Sprintf("   Fix: %s", reco.Fix)) + "\n")
+			}
+			content.WriteString("\n")
+		}
+
+		if fixable := len(m.fixableRecoIndexes()); fixable > 0 {
+			content.WriteString(m.warningStyle.Render(fmt.Sprintf(
+				"🔧 %d of these can be applied automatically — press 'f' to pick and apply", fixable)) + "\n\n")
+		}
+	}
+
 	// Next steps
 	content.WriteString(m.headerStyle.Render("Next Steps:") + "\n")
 	if errorCount == 0 {
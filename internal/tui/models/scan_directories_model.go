@@ -6,11 +6,15 @@
 package models
 
 import (
+	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // ScanDirectoriesModel handles directory scanning for Flutter projects
@@ -21,10 +25,13 @@ type ScanDirectoriesModel struct {
 
 	// State
 	scanning      bool
+	progress      *core.ScanProgress // Live counters updated by the scan workers
 	projects      []core.Project
+	infos         []core.ProjectInfo // Per-project details, parallel to projects
 	complete      bool
 	quitting      bool
-	selectedIndex int // Currently selected project index
+	selectedIndex int          // Currently selected project index
+	checked       map[int]bool // Extra target projects toggled with space
 
 	// Styles
 	headerStyle   lipgloss.Style
@@ -41,7 +48,9 @@ func NewScanDirectoriesModel(cfg core.Config, logger *core.Logger, shared *AppSt
 		logger:        logger,
 		shared:        shared,
 		scanning:      true,
+		progress:      &core.ScanProgress{},
 		selectedIndex: 0,
+		checked:       make(map[int]bool),
 
 		// Styles
 		headerStyle: lipgloss.NewStyle().
@@ -53,28 +62,39 @@ func NewScanDirectoriesModel(cfg core.Config, logger *core.Logger, shared *AppSt
 			Bold(true),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
+			Foreground(theme.Error()).
 			Bold(true),
 
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#0EA5E9")).
+			Foreground(theme.Text()).
+			Background(theme.Primary()).
 			Padding(0, 1),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 	}
 }
 
 // scanCompleteMsg is sent when scanning is complete
 type scanCompleteMsg struct {
 	projects []core.Project
+	infos    []core.ProjectInfo // Per-project details, parallel to projects
 	err      error
 }
 
+// scanTickMsg refreshes the live directory counter while scanning
+type scanTickMsg struct{}
+
 // Init initializes the scan directories screen
 func (m *ScanDirectoriesModel) Init() tea.Cmd {
-	return m.scanForProjects()
+	return tea.Batch(m.scanForProjects(), scanTick())
+}
+
+// scanTick schedules the next progress counter refresh
+func scanTick() tea.Cmd {
+	return tea.Tick(100*time.Millisecond, func(time.Time) tea.Msg {
+		return scanTickMsg{}
+	})
 }
 
 // Update handles messages for directory scanning
@@ -97,12 +117,20 @@ func (m *ScanDirectoriesModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m.projects = msg.projects
+		m.infos = msg.infos
 		m.shared.SourceProject = nil // Will be set if user selects one
 		m.complete = true
 
 		m.logger.Info("scan_directories", fmt.Sprintf("Found %d Flutter projects", len(msg.projects)))
 		return m, nil
 
+	case scanTickMsg:
+		// Keep refreshing the counter until the scan finishes
+		if m.scanning {
+			return m, scanTick()
+		}
+		return m, nil
+
 	case tea.WindowSizeMsg:
 		// Handle window resize gracefully
 		return m, nil
@@ -118,7 +146,23 @@ func (m *ScanDirectoriesModel) View() string {
 	}
 
 	if m.scanning {
-		return m.headerStyle.Render("🔍 Scanning for Flutter Projects...") + "\n\nPlease wait while we scan common directories for Flutter projects.\n\n"
+		content := m.headerStyle.Render("🔍 Scanning for Flutter Projects...") + "\n\nPlease wait while we scan common directories for Flutter projects.\n"
+		if scanned := m.progress.DirsScanned(); scanned > 0 {
+			content += m.normalStyle.Render(fmt.Sprintf("Scanned %d directories, found %d projects so far...", scanned, m.progress.ProjectsFound())) + "\n"
+		}
+		// Stream discoveries into view as the workers find them
+		if found := m.progress.Found(); len(found) > 0 {
+			content += "\n"
+			start := 0
+			if len(found) > 5 {
+				start = len(found) - 5
+				content += m.normalStyle.Render(fmt.Sprintf("  ... and %d earlier", start)) + "\n"
+			}
+			for _, project := range found[start:] {
+				content += m.successStyle.Render("  ✓ ") + m.normalStyle.Render(project.Path) + "\n"
+			}
+		}
+		return content + "\n"
 	}
 
 	if len(m.projects) == 0 {
@@ -127,11 +171,14 @@ func (m *ScanDirectoriesModel) View() string {
 
 	var content string
 
-	// Single project - auto-select it
+	// Single project - still shown with its details so the choice is informed
 	if len(m.projects) == 1 {
 		content = m.successStyle.Render("✅ Found 1 Flutter Project") + "\n\n"
-		content += fmt.Sprintf("  %s\n\n", m.projects[0].Path)
-		content += "Press Enter to use this project or Q to return to main menu"
+		content += fmt.Sprintf("  %s\n", m.projects[0].Path)
+		if details := m.projectDetails(0); details != "" {
+			content += m.normalStyle.Render("     "+details) + "\n"
+		}
+		content += "\nPress Enter to use this project or Q to return to main menu"
 		return content
 	}
 
@@ -139,12 +186,19 @@ func (m *ScanDirectoriesModel) View() string {
 	content = m.successStyle.Render(fmt.Sprintf("✅ Found %d Flutter Projects", len(m.projects))) + "\n\n"
 
 	for i, project := range m.projects {
-		projectText := fmt.Sprintf("%d. %s", i+1, project.Path)
+		mark := " "
+		if m.checked[i] {
+			mark = "✓"
+		}
+		projectText := fmt.Sprintf("[%s] %d. %s", mark, i+1, project.Path)
 		if i == m.selectedIndex {
 			content += m.selectedStyle.Render("▶ "+projectText) + "\n"
 		} else {
 			content += m.normalStyle.Render("  "+projectText) + "\n"
 		}
+		if details := m.projectDetails(i); details != "" {
+			content += m.normalStyle.Render("     "+details) + "\n"
+		}
 
 		if i >= 9 { // Limit display to first 10
 			content += fmt.Sprintf("... and %d more\n", len(m.projects)-10)
@@ -152,15 +206,51 @@ func (m *ScanDirectoriesModel) View() string {
 		}
 	}
 
-	content += "\n↑/↓ or j/k: navigate • Enter: select project • Q: back to menu"
+	content += "\n↑/↓ or j/k: navigate • Space: also target • Enter: select project • Q: back to menu"
 	return content
 }
 
+// projectDetails formats one project's metadata line: Flutter vs Dart, SDK
+// constraint, git dependency count, last modified time, and git status
+func (m *ScanDirectoriesModel) projectDetails(i int) string {
+	if i < 0 || i >= len(m.infos) {
+		return ""
+	}
+	info := m.infos[i]
+
+	kind := "Dart"
+	if info.IsFlutter {
+		kind = "Flutter"
+	}
+	parts := []string{kind}
+
+	if info.SDKConstraint != "" {
+		parts = append(parts, "sdk "+info.SDKConstraint)
+	}
+	if info.GitDepCount == 1 {
+		parts = append(parts, "1 git dep")
+	} else {
+		parts = append(parts, fmt.Sprintf("%d git deps", info.GitDepCount))
+	}
+	if !info.Modified.IsZero() {
+		parts = append(parts, "modified "+info.Modified.Format("2006-01-02"))
+	}
+	if info.HasGit {
+		if info.Dirty {
+			parts = append(parts, "dirty")
+		} else {
+			parts = append(parts, "clean")
+		}
+	}
+
+	return strings.Join(parts, " • ")
+}
+
 // handleKeys handles keyboard input
 func (m *ScanDirectoriesModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
-	case "q", "ctrl+c":
-		return m, TransitionToScreen(ScreenMainMenu)
+	case "esc", "q", "ctrl+c":
+		return m, NavigateBack()
 
 	case "up", "k":
 		if len(m.projects) > 1 {
@@ -180,6 +270,14 @@ func (m *ScanDirectoriesModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case " ":
+		// Toggle an extra target project; the dependency add applies to it
+		// in addition to the primary project selected with Enter
+		if len(m.projects) > 1 && m.selectedIndex >= 0 && m.selectedIndex < len(m.projects) {
+			m.checked[m.selectedIndex] = !m.checked[m.selectedIndex]
+		}
+		return m, nil
+
 	case "enter":
 		// If no projects found, return to main menu
 		if len(m.projects) == 0 {
@@ -195,8 +293,23 @@ func (m *ScanDirectoriesModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.shared.DetectedProject = selectedProject.Name
 			m.shared.LocalPubspecAvailable = true
 
+			// Checked projects (other than the primary) become extra targets
+			// that receive the same dependency adds
+			m.shared.TargetProjects = nil
+			for i := range m.projects {
+				if m.checked[i] && i != m.selectedIndex {
+					m.shared.TargetProjects = append(m.shared.TargetProjects, m.projects[i])
+				}
+			}
+
 			m.logger.Info("scan_directories", fmt.Sprintf("Selected project: %s at %s", selectedProject.Name, selectedProject.Path))
 
+			// Remember the choice for the main menu's recent projects list
+			if err := core.TouchRecentProject(selectedProject); err != nil {
+				m.logger.Debug("scan_directories", fmt.Sprintf("Could not update recent projects: %v", err))
+			}
+			m.shared.RecentProjects = core.LoadRecentProjects()
+
 			// Return to main menu with selected project
 			return m, TransitionToScreen(ScreenMainMenu)
 		}
@@ -211,32 +324,11 @@ func (m *ScanDirectoriesModel) scanForProjects() tea.Cmd {
 	return func() tea.Msg {
 		m.logger.Info("scan_directories", "Starting directory scan for Flutter projects")
 
-		// Check if local project was detected - use that first
-		if m.shared.LocalPubspecAvailable && m.shared.SourceProjectPath != "" {
-			m.logger.Info("scan_directories", fmt.Sprintf("Using detected local project: %s", m.shared.DetectedProject))
-			project := core.Project{
-				Path:        m.shared.SourceProjectPath,
-				Name:        m.shared.DetectedProject,
-				PubspecPath: m.shared.DetectedPubspecPath,
-			}
-			return scanCompleteMsg{
-				projects: []core.Project{project},
-				err:      nil,
-			}
-		}
-
-		// Otherwise, scan for projects within +-3 levels from current directory
-		if project, err := core.FindPubspecNearCurrent(); err == nil {
-			m.logger.Info("scan_directories", fmt.Sprintf("Found project within +-3 levels: %s", project.Name))
-			return scanCompleteMsg{
-				projects: []core.Project{*project},
-				err:      nil,
-			}
-		}
-
-		// If nothing found nearby, scan common roots
-		m.logger.Info("scan_directories", "Scanning common development directories")
-		projects, err := core.ScanCommonRoots()
+		// Scan the configured search paths (falling back to the common
+		// roots) with live progress
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		projects, err := core.ScanRootsWithProgress(ctx, m.shared.SearchPaths, m.shared.SearchDepth, m.progress)
 		if err != nil {
 			m.logger.Error("scan_directories", fmt.Errorf("scan failed: %w", err))
 			return scanCompleteMsg{
@@ -245,10 +337,45 @@ func (m *ScanDirectoriesModel) scanForProjects() tea.Cmd {
 			}
 		}
 
+		// A project detected at launch (or found near the working directory)
+		// joins the front of the list instead of short-circuiting the scan,
+		// so the user always picks from the full selection screen
+		if m.shared.LocalPubspecAvailable && m.shared.SourceProjectPath != "" {
+			m.logger.Info("scan_directories", fmt.Sprintf("Listing detected local project first: %s", m.shared.DetectedProject))
+			projects = prependProject(projects, core.Project{
+				Path:        m.shared.SourceProjectPath,
+				Name:        m.shared.DetectedProject,
+				PubspecPath: m.shared.DetectedPubspecPath,
+			})
+		} else if nearby, err := core.FindPubspecNearCurrent(); err == nil {
+			m.logger.Info("scan_directories", fmt.Sprintf("Listing nearby project first: %s", nearby.Name))
+			projects = prependProject(projects, *nearby)
+		}
+
+		// Probe each project for the details shown next to it (Flutter vs
+		// Dart, SDK constraint, git dependency count, git status)
+		infos := make([]core.ProjectInfo, len(projects))
+		for i := range projects {
+			infos[i] = core.InspectProject(projects[i])
+		}
+
 		m.logger.Info("scan_directories", fmt.Sprintf("Scan complete: found %d projects", len(projects)))
 		return scanCompleteMsg{
 			projects: projects,
+			infos:    infos,
 			err:      nil,
 		}
 	}
 }
+
+// prependProject puts project at the front of the list, removing any
+// duplicate entry the scan already found for the same path
+func prependProject(projects []core.Project, project core.Project) []core.Project {
+	result := []core.Project{project}
+	for _, existing := range projects {
+		if existing.Path != project.Path {
+			result = append(result, existing)
+		}
+	}
+	return result
+}
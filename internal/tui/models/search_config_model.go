@@ -14,6 +14,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // SearchConfigModel handles directory search configuration
@@ -64,15 +65,15 @@ func NewSearchConfigModel(cfg core.Config, logger *core.Logger, shared *AppState
 			Bold(true),
 
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#8B5CF6")).
+			Foreground(theme.Text()).
+			Background(theme.Highlight()).
 			Padding(0, 1),
 
 		normalStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		helpStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
+			Foreground(theme.Muted()).
 			Italic(true),
 	}
 
@@ -139,11 +140,11 @@ func (m *SearchConfigModel) View() string {
 	// Beautiful bordered header with warm amber theme
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#F59E0B")).
-		Foreground(lipgloss.Color("#F59E0B")).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Width(62).
+		Width(headerBoxWidth()).
 		Bold(true).
 		Render("⚙️ Configure Directory Search")
 
@@ -204,8 +205,8 @@ func (m *SearchConfigModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	}
 
 	switch msg.String() {
-	case "q", "ctrl+c":
-		return m, TransitionToScreen(ScreenMainMenu)
+	case "esc", "q", "ctrl+c":
+		return m, NavigateBack()
 
 	case "j", "down":
 		m.currentOption++
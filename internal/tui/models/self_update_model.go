@@ -12,6 +12,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // SelfUpdateModel handles the self-update screen
@@ -56,20 +57,20 @@ func NewSelfUpdateModel(cfg core.Config, logger *core.Logger, shared *AppState)
 		checking: true,
 
 		titleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#0EA5E9")).
+			Foreground(theme.Primary()).
 			Bold(true),
 
 		successStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")),
+			Foreground(theme.Success()),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")),
+			Foreground(theme.Error()),
 
 		infoStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		helpStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#94A3B8")).
+			Foreground(theme.Subtle()).
 			Italic(true),
 	}
 }
@@ -89,7 +90,7 @@ func (m *SelfUpdateModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				// Don't allow quitting during update
 				return m, nil
 			}
-			return m, TransitionToScreen(ScreenMainMenu)
+			return m, NavigateBack()
 
 		case "y", "Y":
 			// User confirmed update
@@ -160,11 +161,11 @@ func (m *SelfUpdateModel) View() string {
 	// Header with warm amber theme
 	header := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#F59E0B")).
-		Foreground(lipgloss.Color("#F59E0B")).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Width(62).
+		Width(headerBoxWidth()).
 		Bold(true).
 		Render("🔄 Flutter-PM Self-Update")
 
@@ -13,6 +13,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // SourceConfigModel handles source project configuration
@@ -71,19 +72,19 @@ func NewSourceConfigModel(cfg core.Config, logger *core.Logger, shared *AppState
 		focusIndex: 0,
 
 		headerStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#0EA5E9")).
+			Foreground(theme.Primary()).
 			Bold(true),
 
 		selectedStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#FFFFFF")).
-			Background(lipgloss.Color("#0EA5E9")).
+			Foreground(theme.Text()).
+			Background(theme.Primary()).
 			Padding(0, 1),
 
 		normalStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#374151")),
 
 		helpStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#94A3B8")).
+			Foreground(theme.Subtle()).
 			Italic(true),
 	}
 }
@@ -160,18 +161,18 @@ func (m *SourceConfigModel) View() string {
 	// Beautiful bordered header with warm amber theme
 	headerBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#F59E0B")).
-		Foreground(lipgloss.Color("#F59E0B")).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
 		Padding(1, 2).
 		Align(lipgloss.Center).
-		Width(62).
+		Width(headerBoxWidth()).
 		Bold(true).
 		Render("⚙️ Configure Source Project")
 
 	b.WriteString(headerBox + "\n\n")
 
 	if m.shared.SourceProject != nil {
-		titleStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#0EA5E9")).Bold(true)
+		titleStyle := lipgloss.NewStyle().Foreground(theme.Primary()).Bold(true)
 		b.WriteString(fmt.Sprintf("Selected project: %s\n\n", titleStyle.Render(m.shared.SourceProject.Name)))
 	}
 
@@ -218,7 +219,8 @@ func (m *SourceConfigModel) View() string {
 func (m *SourceConfigModel) handleKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.String() {
 	case "q", "ctrl+c", "esc":
-		return m, TransitionToScreen(ScreenMainMenu)
+		// Back to the source selection list
+		return m, NavigateBack()
 
 	case "b", "B":
 		// Open filepicker for directory browsing (only when on path field)
@@ -14,6 +14,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // SplashScreenModel handles the splash screen with prerequisites checking
@@ -96,23 +97,23 @@ func NewSplashScreenModel(cfg core.Config, logger *core.Logger, shared *AppState
 		progress:         prog,
 
 		titleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#0EA5E9")).
+			Foreground(theme.Primary()).
 			Bold(true),
 
 		subtitleStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")),
+			Foreground(theme.Muted()),
 
 		statusStyle: lipgloss.NewStyle().
 			Foreground(lipgloss.Color("79")),
 
 		successStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("42")),
+			Foreground(theme.Success()),
 
 		warningStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("214")),
+			Foreground(theme.Warning()),
 
 		errorStyle: lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")),
+			Foreground(theme.Error()),
 	}
 }
 
@@ -242,8 +243,8 @@ func (m *SplashScreenModel) View() string {
 	// Compact logo with warm amber theme
 	logoBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
-		BorderForeground(lipgloss.Color("#F59E0B")).
-		Foreground(lipgloss.Color("#F59E0B")).
+		BorderForeground(theme.Secondary()).
+		Foreground(theme.Secondary()).
 		Padding(0, 2).
 		Align(lipgloss.Center).
 		Bold(true)
@@ -0,0 +1,119 @@
+// Package models/stats_model.go - Local Metrics Screen
+//
+// This file implements the "Stats" screen that renders the locally
+// recorded operation timings and cache hit rates (see core/metrics.go) in
+// a scrollable viewport. The same aggregates back the `flutter-pm stats`
+// CLI command; nothing is collected beyond the user's own machine, and 'r'
+// resets the aggregates in place.
+
+package models
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// StatsModel handles the local metrics screen
+type StatsModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	viewport viewport.Model
+	note     string // Outcome of a reset requested with 'r'
+
+	titleStyle  lipgloss.Style
+	normalStyle lipgloss.Style
+	helpStyle   lipgloss.Style
+}
+
+// NewStatsModel creates a new local metrics screen model
+func NewStatsModel(cfg core.Config, logger *core.Logger, shared *AppState) *StatsModel {
+	vp := viewport.New(76, 14)
+
+	return &StatsModel{
+		cfg:      cfg,
+		logger:   logger,
+		shared:   shared,
+		viewport: vp,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init loads the aggregates into the viewport
+func (m *StatsModel) Init() tea.Cmd {
+	m.refresh()
+	return nil
+}
+
+// refresh re-reads the metrics file and rebuilds the viewport content
+func (m *StatsModel) refresh() {
+	lines := core.MetricsLines(core.LoadMetrics())
+	m.viewport.SetContent(strings.Join(lines, "\n"))
+	m.viewport.GotoTop()
+}
+
+// Update handles messages for the stats screen
+func (m *StatsModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "q", "ctrl+c":
+			return m, NavigateBack()
+		case "r":
+			// Reset the aggregates and show the empty state
+			if err := core.ResetMetrics(); err != nil {
+				m.note = "❌ " + err.Error()
+			} else {
+				m.logger.Info("stats", "Local metrics cleared")
+				m.note = "🧹 Local metrics cleared"
+			}
+			m.refresh()
+			return m, nil
+		}
+		// Remaining keys scroll the viewport
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+
+	case tea.MouseMsg:
+		// Wheel scrolling through the stats
+		var cmd tea.Cmd
+		m.viewport, cmd = m.viewport.Update(msg)
+		return m, cmd
+	}
+
+	return m, nil
+}
+
+// View renders the stats screen
+func (m *StatsModel) View() string {
+	var b strings.Builder
+
+	b.WriteString(m.titleStyle.Render("📈 Local Stats") + "\n")
+	b.WriteString(m.normalStyle.Render("Operation timings and cache hit rates recorded on this machine only") + "\n\n")
+
+	if m.note != "" {
+		b.WriteString(m.normalStyle.Render(m.note) + "\n\n")
+	}
+
+	b.WriteString(m.viewport.View() + "\n\n")
+	b.WriteString(m.helpStyle.Render("↑/↓ scroll • r: reset stats • esc/q: back"))
+
+	return b.String()
+}
@@ -0,0 +1,185 @@
+// Package models/update_plan_model.go - Update Plan Screen
+//
+// This file implements the read-only update plan view. It runs
+// core.BuildUpdatePlan against the detected local project and renders one
+// row per outdated direct dependency (current -> target) with a colour-coded
+// breaking-risk estimate. Nothing is modified from this screen; it informs
+// the decision to run an update.
+
+package models
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
+)
+
+// UpdatePlanModel handles the lockfile-aware update plan screen
+type UpdatePlanModel struct {
+	cfg    core.Config
+	logger *core.Logger
+	shared *AppState
+
+	loading bool
+	loadErr string
+	plan    *core.UpdatePlan
+
+	// Styles
+	titleStyle  lipgloss.Style
+	normalStyle lipgloss.Style
+	riskStyles  map[string]lipgloss.Style
+	errorStyle  lipgloss.Style
+	helpStyle   lipgloss.Style
+}
+
+// updatePlanLoadedMsg is sent when the plan has been built
+type updatePlanLoadedMsg struct {
+	plan *core.UpdatePlan
+	err  error
+}
+
+// NewUpdatePlanModel creates a new update plan model
+func NewUpdatePlanModel(cfg core.Config, logger *core.Logger, shared *AppState) *UpdatePlanModel {
+	return &UpdatePlanModel{
+		cfg:     cfg,
+		logger:  logger,
+		shared:  shared,
+		loading: true,
+
+		titleStyle: lipgloss.NewStyle().
+			Foreground(theme.Primary()).
+			Bold(true),
+
+		normalStyle: lipgloss.NewStyle().
+			Foreground(theme.Muted()),
+
+		// Risk colouring: patch green, minor yellow, major/unknown red-ish
+		riskStyles: map[string]lipgloss.Style{
+			core.RiskPatch:   lipgloss.NewStyle().Foreground(theme.Success()),
+			core.RiskMinor:   lipgloss.NewStyle().Foreground(lipgloss.Color("220")),
+			core.RiskMajor:   lipgloss.NewStyle().Foreground(theme.Error()),
+			core.RiskUnknown: lipgloss.NewStyle().Foreground(lipgloss.Color("208")),
+		},
+
+		errorStyle: lipgloss.NewStyle().
+			Foreground(theme.Error()),
+
+		helpStyle: lipgloss.NewStyle().
+			Foreground(theme.Subtle()).
+			Italic(true),
+	}
+}
+
+// Init starts building the update plan
+func (m *UpdatePlanModel) Init() tea.Cmd {
+	return m.buildPlan()
+}
+
+// Update handles messages for the update plan screen
+func (m *UpdatePlanModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if m.loading {
+			return m, nil
+		}
+		switch msg.String() {
+		case "enter", "esc", "q":
+			return m, NavigateBack()
+		}
+		return m, nil
+
+	case updatePlanLoadedMsg:
+		m.loading = false
+		if msg.err != nil {
+			m.loadErr = msg.err.Error()
+			return m, nil
+		}
+		m.plan = msg.plan
+		m.logger.Narrate(fmt.Sprintf("Update plan ready: %d outdated dependencies", len(msg.plan.Items)))
+		return m, nil
+
+	case ScreenTransitionMsg:
+		// Forward transition message
+		return m, func() tea.Msg { return msg }
+
+	case tea.WindowSizeMsg:
+		return m, nil
+	}
+
+	return m, nil
+}
+
+// View renders the update plan screen
+func (m *UpdatePlanModel) View() string {
+	var b strings.Builder
+
+	// Header with cool blue theme
+	header := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(theme.Primary()).
+		Foreground(theme.Primary()).
+		Padding(1, 2).
+		Align(lipgloss.Center).
+		Width(headerBoxWidth()).
+		Bold(true).
+		Render("📋 Update Plan")
+
+	b.WriteString(header + "\n\n")
+
+	if m.loading {
+		b.WriteString(m.normalStyle.Render("⏳ Comparing pubspec.lock against upstream...") + "\n")
+		return b.String()
+	}
+
+	if m.loadErr != "" {
+		b.WriteString(m.errorStyle.Render("❌ "+m.loadErr) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	b.WriteString(m.titleStyle.Render(fmt.Sprintf("Project: %s", m.shared.DetectedProject)) + "\n\n")
+
+	if len(m.plan.Items) == 0 {
+		b.WriteString(m.normalStyle.Render(fmt.Sprintf("✅ All %d direct dependencies are up to date", m.plan.UpToDate)) + "\n\n")
+		b.WriteString(m.helpStyle.Render("Press 'enter' to return to main menu") + "\n")
+		return b.String()
+	}
+
+	for _, item := range m.plan.Items {
+		riskStyle, ok := m.riskStyles[item.BreakingRisk]
+		if !ok {
+			riskStyle = m.normalStyle
+		}
+
+		line := fmt.Sprintf("%s (%s): %s → %s", item.Package, item.Source, item.Current, item.Target)
+		b.WriteString(m.normalStyle.Render("  "+line) + " " + riskStyle.Render("["+item.BreakingRisk+"]") + "\n")
+	}
+
+	b.WriteString("\n")
+	b.WriteString(m.normalStyle.Render(fmt.Sprintf("%d of %d direct dependencies have updates available",
+		len(m.plan.Items), len(m.plan.Items)+m.plan.UpToDate)) + "\n\n")
+	b.WriteString(m.helpStyle.Render("enter/esc back to menu • run 'Update stale packages' to apply git updates") + "\n")
+
+	return b.String()
+}
+
+// buildPlan runs the planner against the detected project in the background
+func (m *UpdatePlanModel) buildPlan() tea.Cmd {
+	return func() tea.Msg {
+		projectPath := m.shared.SourceProjectPath
+		if projectPath == "" && m.shared.DetectedPubspecPath != "" {
+			projectPath = filepath.Dir(m.shared.DetectedPubspecPath)
+		}
+		if projectPath == "" {
+			projectPath = "."
+		}
+
+		plan, err := core.BuildUpdatePlan(m.logger, projectPath)
+		return updatePlanLoadedMsg{plan: plan, err: err}
+	}
+}
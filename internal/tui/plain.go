@@ -0,0 +1,311 @@
+// Package tui - Plain Sequential Prompt Mode
+//
+// This file implements the --plain accessibility mode. Instead of the
+// bubbletea TUI it renders each step as sequential prompts with numbered
+// choices, much like the original shell script: no alt-screen, no
+// spinners, no emoji and no color. The linear output works in screen
+// readers, dumb terminals and CI logs, and every answer is read from
+// stdin so the flow can also be scripted with a heredoc.
+
+package tui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
+)
+
+// RunPlain runs the sequential prompt flow used by --plain mode
+func RunPlain(cfg core.Config, logger *core.Logger) error {
+	// Keep operational logs out of the prompt stream, same as the TUI
+	logger.SetLevel(core.LogLevelError)
+
+	in := bufio.NewScanner(os.Stdin)
+
+	// Resolve the active project once up front; option 2 can change it
+	projectPath := plainDetectProject(cfg)
+
+	for {
+		fmt.Println()
+		fmt.Println("Flutter Package Manager (plain mode)")
+		if projectPath != "" {
+			fmt.Printf("Active project: %s\n", projectPath)
+		} else {
+			fmt.Println("Active project: none detected")
+		}
+		fmt.Println()
+		fmt.Println("  1. Add GitHub packages to the active project")
+		fmt.Println("  2. Select a local Flutter project")
+		fmt.Println("  3. Update stale git packages")
+		fmt.Println("  4. Show git dependency status")
+		fmt.Println("  5. Quit")
+		fmt.Println()
+
+		choice, ok := plainPrompt(in, "Choice [1-5]")
+		if !ok || choice == "5" || choice == "q" {
+			return nil
+		}
+
+		var err error
+		switch choice {
+		case "1":
+			err = plainAddPackages(cfg, logger, in, projectPath)
+		case "2":
+			projectPath = plainSelectProject(in, projectPath)
+		case "3":
+			err = plainUpdateStale(cfg, logger, projectPath)
+		case "4":
+			err = plainStatus(projectPath)
+		default:
+			fmt.Println("Please enter a number between 1 and 5.")
+		}
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+		}
+	}
+}
+
+// plainDetectProject finds the initial active project: the configured root
+// when one was given, otherwise the nearest pubspec.yaml
+func plainDetectProject(cfg core.Config) string {
+	if cfg.RootDir != "" {
+		return cfg.RootDir
+	}
+	if project, err := core.FindPubspecNearCurrent(); err == nil && project != nil {
+		return project.Path
+	}
+	return ""
+}
+
+// plainPrompt prints a prompt and reads one trimmed line from stdin.
+// ok is false when stdin is exhausted (e.g. a scripted run ran out of input).
+func plainPrompt(in *bufio.Scanner, label string) (string, bool) {
+	fmt.Printf("%s: ", label)
+	if !in.Scan() {
+		fmt.Println()
+		return "", false
+	}
+	return strings.TrimSpace(in.Text()), true
+}
+
+// plainPromptDefault is plainPrompt with a default used for empty answers
+func plainPromptDefault(in *bufio.Scanner, label, def string) (string, bool) {
+	answer, ok := plainPrompt(in, fmt.Sprintf("%s [%s]", label, def))
+	if !ok {
+		return "", false
+	}
+	if answer == "" {
+		return def, true
+	}
+	return answer, true
+}
+
+// plainParseSelection parses a comma/space separated list of 1-based
+// numbers ("1,3 5") into unique 0-based indices, rejecting out-of-range ones
+func plainParseSelection(answer string, count int) ([]int, error) {
+	fields := strings.FieldsFunc(answer, func(r rune) bool {
+		return r == ',' || r == ' '
+	})
+	seen := make(map[int]bool)
+	var indices []int
+	for _, field := range fields {
+		num, err := strconv.Atoi(field)
+		if err != nil || num < 1 || num > count {
+			return nil, fmt.Errorf("invalid selection %q (expected numbers 1-%d)", field, count)
+		}
+		if !seen[num-1] {
+			seen[num-1] = true
+			indices = append(indices, num-1)
+		}
+	}
+	return indices, nil
+}
+
+// plainSelectProject scans the common roots and lets the user pick a
+// project by number; the previous selection is kept on bad or empty input
+func plainSelectProject(in *bufio.Scanner, current string) string {
+	fmt.Println("Scanning for Flutter projects...")
+	projects, err := core.ScanCommonRoots()
+	if err != nil {
+		fmt.Printf("Error: scan failed: %v\n", err)
+		return current
+	}
+	if len(projects) == 0 {
+		fmt.Println("No Flutter projects found in the common directories.")
+		return current
+	}
+
+	fmt.Printf("Found %d projects:\n", len(projects))
+	for i, project := range projects {
+		fmt.Printf("  %d. %s (%s)\n", i+1, project.Name, project.Path)
+	}
+
+	answer, ok := plainPrompt(in, fmt.Sprintf("Select project [1-%d]", len(projects)))
+	if !ok || answer == "" {
+		return current
+	}
+	indices, err := plainParseSelection(answer, len(projects))
+	if err != nil || len(indices) != 1 {
+		fmt.Println("Selection unchanged.")
+		return current
+	}
+
+	selected := projects[indices[0]]
+	fmt.Printf("Active project is now %s\n", selected.Path)
+	return selected.Path
+}
+
+// plainAddPackages lists the user's GitHub repositories and adds the chosen
+// ones as git dependencies, with the same backup safety net as the TUI flow
+func plainAddPackages(cfg core.Config, logger *core.Logger, in *bufio.Scanner, projectPath string) error {
+	if projectPath == "" {
+		return fmt.Errorf("no active project - select one first (option 2)")
+	}
+
+	fmt.Println("Fetching repositories from GitHub...")
+	repos, err := core.ListGitHubRepos(logger)
+	if err != nil {
+		return fmt.Errorf("failed to list repositories: %w", err)
+	}
+	if len(repos) == 0 {
+		fmt.Println("No repositories found.")
+		return nil
+	}
+
+	fmt.Printf("Found %d repositories:\n", len(repos))
+	for i, repo := range repos {
+		line := fmt.Sprintf("  %d. %s/%s (%s)", i+1, repo.Owner, repo.Name, repo.Privacy)
+		if repo.Desc != "" {
+			line += " - " + repo.Desc
+		}
+		fmt.Println(line)
+	}
+
+	answer, ok := plainPrompt(in, "Select packages, e.g. 1,3,5")
+	if !ok || answer == "" {
+		return nil
+	}
+	indices, err := plainParseSelection(answer, len(repos))
+	if err != nil {
+		return err
+	}
+	if len(indices) == 0 {
+		return nil
+	}
+
+	// Configure each selection: name and ref default like the TUI flow
+	var specs []core.PkgSpec
+	for _, idx := range indices {
+		repo := repos[idx]
+		fmt.Printf("Configuring %s/%s\n", repo.Owner, repo.Name)
+
+		defaultName := repo.PackageName
+		if defaultName == "" {
+			defaultName = repo.Name
+		}
+		name, ok := plainPromptDefault(in, "  Package name", defaultName)
+		if !ok {
+			return nil
+		}
+		ref, ok := plainPromptDefault(in, "  Git ref", "default branch")
+		if !ok {
+			return nil
+		}
+		if ref == "default branch" {
+			ref = ""
+		}
+
+		specs = append(specs, core.PkgSpec{
+			Name: name,
+			URL:  repo.URL,
+			Ref:  ref,
+		})
+	}
+
+	// Confirm before touching pubspec.yaml
+	fmt.Printf("About to add %d package(s) to %s:\n", len(specs), projectPath)
+	for _, spec := range specs {
+		ref := spec.Ref
+		if ref == "" {
+			ref = "default branch"
+		}
+		fmt.Printf("  %s from %s (%s)\n", spec.Name, spec.URL, ref)
+	}
+	confirm, ok := plainPromptDefault(in, "Proceed? (y/n)", "y")
+	if !ok || !strings.HasPrefix(strings.ToLower(confirm), "y") {
+		fmt.Println("Cancelled.")
+		return nil
+	}
+
+	// Backup pubspec.yaml before modifying (same safety net as the TUI flow)
+	if !cfg.DryRun {
+		if backup, err := core.CreateTrackedBackup(logger, projectPath, "add"); err != nil {
+			fmt.Printf("Warning: backup failed: %v\n", err)
+		} else {
+			fmt.Printf("Created backup: %s\n", backup.BackupPath)
+		}
+	}
+
+	failures := 0
+	for _, spec := range specs {
+		fmt.Printf("Adding %s...\n", spec.Name)
+		result := core.AddGitDependency(logger, &cfg, projectPath, spec, true)
+		if result.OK {
+			fmt.Printf("  OK: %s\n", result.Message)
+		} else {
+			failures++
+			fmt.Printf("  FAILED: %s\n", result.Err)
+		}
+	}
+
+	if failures > 0 {
+		return fmt.Errorf("%d of %d package(s) failed to add", failures, len(specs))
+	}
+	fmt.Printf("Added %d package(s).\n", len(specs))
+	return nil
+}
+
+// plainUpdateStale runs the express git update on the active project
+func plainUpdateStale(cfg core.Config, logger *core.Logger, projectPath string) error {
+	if projectPath == "" {
+		return fmt.Errorf("no active project - select one first (option 2)")
+	}
+
+	fmt.Printf("Checking git dependencies in %s...\n", projectPath)
+	result := core.ExpressGitUpdate(logger, &cfg, projectPath)
+	if !result.OK {
+		return fmt.Errorf("update failed: %s", result.Err)
+	}
+	fmt.Println(result.Message)
+	return nil
+}
+
+// plainStatus lists the active project's git dependencies
+func plainStatus(projectPath string) error {
+	if projectPath == "" {
+		return fmt.Errorf("no active project - select one first (option 2)")
+	}
+
+	deps, err := core.ListGitDependencies(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to read dependencies: %w", err)
+	}
+	if len(deps) == 0 {
+		fmt.Println("No git dependencies found.")
+		return nil
+	}
+
+	fmt.Printf("%d git dependencies in %s:\n", len(deps), projectPath)
+	for _, dep := range deps {
+		ref := dep.Ref
+		if ref == "" {
+			ref = "default branch"
+		}
+		fmt.Printf("  %s from %s (%s)\n", dep.Name, dep.URL, ref)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+// Package theme - Named Color Roles for the TUI
+//
+// Colors used to be hard-coded lipgloss values scattered across every screen
+// model, which made the TUI unreadable on light terminals and impossible to
+// restyle in one place. This package centralizes them behind named roles
+// (primary, success, error, muted, ...) with a dark and a light palette.
+// The dark palette preserves the exact values the screens always used, so
+// the default look is unchanged.
+//
+// Key features:
+// - Named roles resolved against the active palette (Primary(), Error(), ...)
+// - Dark and light palettes with automatic background detection
+// - NO_COLOR (https://no-color.org) disables all colors regardless of theme
+// - Select: explicit palette choice via FLUTTER_PM_THEME / --theme
+package theme
+
+import (
+	"os"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Palette maps the named color roles to concrete terminal colors
+type Palette struct {
+	Primary   lipgloss.TerminalColor // Headers, borders, titles
+	Accent    lipgloss.TerminalColor // Spinners and progress accents
+	Secondary lipgloss.TerminalColor // The warm amber used by the main menu
+	Success   lipgloss.TerminalColor
+	Warning   lipgloss.TerminalColor
+	Error     lipgloss.TerminalColor
+	Selected  lipgloss.TerminalColor // Cursor rows and active choices
+	Highlight lipgloss.TerminalColor // Badges and secondary emphasis
+	Muted     lipgloss.TerminalColor // De-emphasized rows and disabled actions
+	Subtle    lipgloss.TerminalColor // Help lines and hints
+	Text      lipgloss.TerminalColor // High-contrast foreground on colored fills
+}
+
+// darkPalette keeps the exact colors the screens used before the theme
+// system existed, so dark terminals look identical
+var darkPalette = Palette{
+	Primary:   lipgloss.Color("#0EA5E9"),
+	Accent:    lipgloss.Color("#13B9FD"),
+	Secondary: lipgloss.Color("#F59E0B"),
+	Success:   lipgloss.Color("42"),
+	Warning:   lipgloss.Color("214"),
+	Error:     lipgloss.Color("196"),
+	Selected:  lipgloss.Color("212"),
+	Highlight: lipgloss.Color("#8B5CF6"),
+	Muted:     lipgloss.Color("241"),
+	Subtle:    lipgloss.Color("#94A3B8"),
+	Text:      lipgloss.Color("#FFFFFF"),
+}
+
+// lightPalette uses darker shades of the same hues so text stays readable
+// on light backgrounds
+var lightPalette = Palette{
+	Primary:   lipgloss.Color("#0369A1"),
+	Accent:    lipgloss.Color("#0284C7"),
+	Secondary: lipgloss.Color("#B45309"),
+	Success:   lipgloss.Color("28"),
+	Warning:   lipgloss.Color("130"),
+	Error:     lipgloss.Color("124"),
+	Selected:  lipgloss.Color("162"),
+	Highlight: lipgloss.Color("#6D28D9"),
+	Muted:     lipgloss.Color("245"),
+	Subtle:    lipgloss.Color("#64748B"),
+	Text:      lipgloss.Color("#111827"),
+}
+
+// monoPalette renders everything in the terminal's default foreground,
+// honoring NO_COLOR
+var monoPalette = Palette{
+	Primary:   lipgloss.NoColor{},
+	Accent:    lipgloss.NoColor{},
+	Secondary: lipgloss.NoColor{},
+	Success:   lipgloss.NoColor{},
+	Warning:   lipgloss.NoColor{},
+	Error:     lipgloss.NoColor{},
+	Selected:  lipgloss.NoColor{},
+	Highlight: lipgloss.NoColor{},
+	Muted:     lipgloss.NoColor{},
+	Subtle:    lipgloss.NoColor{},
+	Text:      lipgloss.NoColor{},
+}
+
+// current is the active palette; the default is resolved once at startup
+var current = detect("")
+
+// Select switches the active palette by name: "dark", "light", "none" (or
+// "mono"), and "auto" / "" for background detection. NO_COLOR always wins.
+func Select(name string) {
+	current = detect(name)
+}
+
+// Current returns the active palette, for callers that want to derive
+// their own styles from it
+func Current() Palette {
+	return current
+}
+
+// detect resolves a palette name to a palette, applying NO_COLOR and
+// background detection
+func detect(name string) Palette {
+	// NO_COLOR overrides any configured theme (https://no-color.org)
+	if os.Getenv("NO_COLOR") != "" {
+		return monoPalette
+	}
+
+	switch name {
+	case "dark":
+		return darkPalette
+	case "light":
+		return lightPalette
+	case "none", "mono":
+		return monoPalette
+	}
+
+	// Auto: ask the terminal for its background color
+	if lipgloss.HasDarkBackground() {
+		return darkPalette
+	}
+	return lightPalette
+}
+
+// Role accessors, so call sites read as theme.Primary() where they used to
+// hard-code lipgloss.Color("#0EA5E9")
+
+func Primary() lipgloss.TerminalColor   { return current.Primary }
+func Accent() lipgloss.TerminalColor    { return current.Accent }
+func Secondary() lipgloss.TerminalColor { return current.Secondary }
+func Success() lipgloss.TerminalColor   { return current.Success }
+func Warning() lipgloss.TerminalColor   { return current.Warning }
+func Error() lipgloss.TerminalColor     { return current.Error }
+func Selected() lipgloss.TerminalColor  { return current.Selected }
+func Highlight() lipgloss.TerminalColor { return current.Highlight }
+func Muted() lipgloss.TerminalColor     { return current.Muted }
+func Subtle() lipgloss.TerminalColor    { return current.Subtle }
+func Text() lipgloss.TerminalColor      { return current.Text }
@@ -0,0 +1,41 @@
+package theme
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TestSelectSwitchesPalette verifies explicit palette selection
+func TestSelectSwitchesPalette(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	Select("dark")
+	if Primary() != lipgloss.Color("#0EA5E9") {
+		t.Errorf("expected dark primary, got %v", Primary())
+	}
+
+	Select("light")
+	if Primary() != lipgloss.Color("#0369A1") {
+		t.Errorf("expected light primary, got %v", Primary())
+	}
+
+	Select("none")
+	if _, ok := Error().(lipgloss.NoColor); !ok {
+		t.Errorf("expected no color in mono palette, got %v", Error())
+	}
+
+	Select("dark") // Restore the default for other tests
+}
+
+// TestNoColorOverridesTheme verifies NO_COLOR wins over any selection
+func TestNoColorOverridesTheme(t *testing.T) {
+	t.Setenv("NO_COLOR", "1")
+	Select("dark")
+	if _, ok := Primary().(lipgloss.NoColor); !ok {
+		t.Errorf("expected NO_COLOR to disable colors, got %v", Primary())
+	}
+
+	t.Setenv("NO_COLOR", "")
+	Select("dark")
+}
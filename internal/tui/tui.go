@@ -9,10 +9,14 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/core"
 	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/models"
+	"github.com/daslaller/GoFlutterGithubPackageManager/internal/tui/theme"
 )
 
 // RunNew starts the new multimodel TUI application
 func RunNew(cfg core.Config, logger *core.Logger) error {
+	// Resolve the color palette before any screen builds its styles
+	theme.Select(cfg.Theme)
+
 	// Enable quiet mode to prevent logs from bleeding into TUI
 	cfg.Quiet = true
 
@@ -25,7 +29,8 @@ func RunNew(cfg core.Config, logger *core.Logger) error {
 
 	// Start the bubbletea program
 	// Note: We request a larger initial terminal via LINES/COLUMNS env vars if needed
-	p := tea.NewProgram(app, tea.WithAltScreen())
+	// Mouse cell motion enables wheel scrolling and click-to-select
+	p := tea.NewProgram(app, tea.WithAltScreen(), tea.WithMouseCellMotion())
 	_, err := p.Run()
 	return err
 }